@@ -0,0 +1,695 @@
+package cache
+
+import (
+	"github.com/llr104/lightkv/cache/kv"
+	"log"
+	"math/rand"
+	"sync"
+	"sync/atomic"
+	"time"
+	"unsafe"
+)
+
+/*
+string 类型值的存储方式
+heap 每个值是一个 StringValue，保存在lru的链表中
+arena key和值的数据保存在大块的字节数组中，元数据保存在不含指针的数组中，gc只需要扫描很少的指针
+*/
+const (
+	StorageHeap  = "heap"
+	StorageArena = "arena"
+)
+
+func checkStorage(storage string) bool {
+	return storage == StorageHeap || storage == StorageArena
+}
+
+/*
+每块arena的大小，超过这个大小的值单独分配一块
+*/
+const arenaChunkSize = 1 << 20
+
+const arenaNil = int32(-1)
+
+/*
+arena中一个key的元数据，不包含指针，gc不扫描 slots 数组
+key和值的数据连续保存在 chunks[chunk][off:off+klen+vlen] 中
+prev、next 为lru链表中前后的下标，nextHash 为哈希冲突时同一个哈希的下一个下标
+*/
+type arenaSlot struct {
+	revision uint64
+	expire   int64
+	created  int64
+	modified int64
+	accessed int64
+	promoted int64
+	hits     uint64
+	hash     uint64
+	lfu      uint32
+	chunk    int32
+	off      uint32
+	klen     uint32
+	vlen     uint32
+	size     int32
+	prev     int32
+	next     int32
+	nextHash int32
+}
+
+var arenaSlotOverhead = int(unsafe.Sizeof(arenaSlot{})) + mapEntrySize(8, 4)
+
+/*
+arena的一个分片，和lru的分片一样按槽的下标划分，有自己的锁、arena和lru链表
+live 为存活的key和值的字节数，garbage 为删除或者修改后留下的字节数，压缩后为0
+*/
+type arenaShard struct {
+	hits     uint64
+	misses   uint64
+	rwMutex  sync.RWMutex
+	chunks   [][]byte
+	slots    []arenaSlot
+	free     []int32
+	head     int32
+	tail     int32
+	count    int
+	live     int
+	garbage  int
+}
+
+func (s *arenaShard) reset() {
+	s.chunks = nil
+	s.slots = nil
+	s.free = nil
+	s.head = arenaNil
+	s.tail = arenaNil
+	s.count = 0
+	s.live = 0
+	s.garbage = 0
+}
+
+func (s *arenaShard) key(i int32) string {
+	slot := &s.slots[i]
+	return string(s.chunks[slot.chunk][slot.off : slot.off+slot.klen])
+}
+
+func (s *arenaShard) data(i int32) string {
+	slot := &s.slots[i]
+	start := slot.off + slot.klen
+	return string(s.chunks[slot.chunk][start : start+slot.vlen])
+}
+
+func (s *arenaShard) keyEqual(i int32, key string) bool {
+	slot := &s.slots[i]
+	return string(s.chunks[slot.chunk][slot.off : slot.off+slot.klen]) == key
+}
+
+/*
+返回的值复制了arena中的数据，arena压缩或者key被修改后仍然有效
+*/
+func (s *arenaShard) value(i int32, key string) kv.StringValue {
+	return kv.StringValue{Key: key, Expire: s.slots[i].expire, Data: s.data(i)}
+}
+
+func (s *arenaShard) isExpire(i int32, now int64) bool {
+	expire := s.slots[i].expire
+	return expire != kv.ExpireForever && expire <= now
+}
+
+/*
+在最后一块arena中分配n个字节，放不下时分配新的一块，返回分配的字节
+*/
+func (s *arenaShard) alloc(n int) (int32, uint32, []byte) {
+	if n > arenaChunkSize {
+		s.chunks = append(s.chunks, make([]byte, n))
+		return int32(len(s.chunks) - 1), 0, s.chunks[len(s.chunks) - 1]
+	}
+
+	last := len(s.chunks) - 1
+	if last < 0 || len(s.chunks[last]) + n > cap(s.chunks[last]) {
+		s.chunks = append(s.chunks, make([]byte, 0, arenaChunkSize))
+		last++
+	}
+	c := s.chunks[last]
+	off := len(c)
+	s.chunks[last] = c[:off+n]
+	return int32(last), uint32(off), c[off:off+n]
+}
+
+func (s *arenaShard) write(key string, data string) (int32, uint32) {
+	chunk, off, b := s.alloc(len(key) + len(data))
+	copy(b[copy(b, key):], data)
+	return chunk, off
+}
+
+func (s *arenaShard) newSlot() int32 {
+	if n := len(s.free); n > 0 {
+		i := s.free[n-1]
+		s.free = s.free[:n-1]
+		return i
+	}
+	s.slots = append(s.slots, arenaSlot{})
+	return int32(len(s.slots) - 1)
+}
+
+func (s *arenaShard) pushFront(i int32) {
+	slot := &s.slots[i]
+	slot.prev = arenaNil
+	slot.next = s.head
+	if s.head != arenaNil {
+		s.slots[s.head].prev = i
+	}
+	s.head = i
+	if s.tail == arenaNil {
+		s.tail = i
+	}
+}
+
+func (s *arenaShard) unlink(i int32) {
+	slot := &s.slots[i]
+	if slot.prev != arenaNil {
+		s.slots[slot.prev].next = slot.next
+	}else{
+		s.head = slot.next
+	}
+	if slot.next != arenaNil {
+		s.slots[slot.next].prev = slot.prev
+	}else{
+		s.tail = slot.prev
+	}
+}
+
+func (s *arenaShard) moveToFront(i int32) {
+	if s.head == i {
+		return
+	}
+	s.unlink(i)
+	s.pushFront(i)
+}
+
+/*
+按lru链表的顺序把存活的key和值复制到新的arena，经常访问的key放在一起
+*/
+func (s *arenaShard) compact() {
+	old := s.chunks
+	s.chunks = nil
+	for i := s.head; i != arenaNil; i = s.slots[i].next {
+		slot := &s.slots[i]
+		start := slot.off
+		end := start + slot.klen + slot.vlen
+		chunk, off, b := s.alloc(int(end - start))
+		copy(b, old[slot.chunk][start:end])
+		slot.chunk, slot.off = chunk, off
+	}
+	s.garbage = 0
+}
+
+/*
+arena存储，只用于 string 类型，lru的计数、过期调度器和淘汰仍然由所属的lru管理
+index 按槽保存key的哈希到 slots 下标的映射，map的key和value都不含指针，gc不扫描
+*/
+type arenaStore struct {
+	owner  *lru
+	shards []*arenaShard
+	index  [lruSlots]map[uint64]int32
+}
+
+func newArenaStore(owner *lru) *arenaStore {
+	s := &arenaStore{owner: owner, shards: make([]*arenaShard, len(owner.shards))}
+	for i := range s.shards {
+		s.shards[i] = &arenaShard{}
+		s.shards[i].reset()
+	}
+	s.resetIndex()
+	return s
+}
+
+func (s *arenaStore) resetIndex() {
+	for i := range s.index {
+		s.index[i] = make(map[uint64]int32)
+	}
+}
+
+func (s *arenaStore) shard(key string) *arenaShard {
+	return s.slotShard(lruSlot(key))
+}
+
+func (s *arenaStore) slotShard(slot int) *arenaShard {
+	return s.shards[slot & (len(s.shards) - 1)]
+}
+
+/*
+需要持有key所在分片的锁
+*/
+func (s *arenaStore) find(sh *arenaShard, key string) int32 {
+	i, ok := s.index[lruSlot(key)][fnv64a(key)]
+	if ok == false {
+		return arenaNil
+	}
+	for ; i != arenaNil; i = sh.slots[i].nextHash {
+		if sh.keyEqual(i, key) {
+			return i
+		}
+	}
+	return arenaNil
+}
+
+/*
+值的数据、元数据和索引的占用，过期调度器和全局索引的占用和lru相同
+*/
+func (s *arenaStore) entrySize(key string, data string, expire int64) int {
+	t := len(key) + len(data) + arenaSlotOverhead
+	if s.owner.expires != nil && expire != kv.ExpireForever {
+		t += expireEntryOverhead
+	}
+	if s.owner.keys != nil {
+		t += keyIndexEntryOverhead
+	}
+	return t
+}
+
+func (s *arenaStore) pushFront(v kv.ValueCache, revision uint64) {
+	owner := s.owner
+	key := v.GetKey()
+	data := v.ToString()
+	sh := s.shard(key)
+	sh.rwMutex.Lock()
+
+	now := time.Now().UnixNano()
+	created, hits, lfu := now, uint64(0), newLFU(now)
+	if i := s.find(sh, key); i != arenaNil {
+		old := &sh.slots[i]
+		created = old.created
+		hits = atomic.LoadUint64(&old.hits)
+		lfu = atomic.LoadUint32(&old.lfu)
+		if owner.countLFU() {
+			lfuIncr(&lfu, now)
+		}
+		s.remove(sh, key, i)
+	}
+
+	i := sh.newSlot()
+	chunk, off := sh.write(key, data)
+	size := s.entrySize(key, data, v.GetExpire())
+	h := fnv64a(key)
+	slot := lruSlot(key)
+	next, ok := s.index[slot][h]
+	if ok == false {
+		next = arenaNil
+	}
+	sh.slots[i] = arenaSlot{revision: revision, expire: v.GetExpire(), created: created, modified: now, accessed: now, promoted: now,
+		hits: hits, hash: h, lfu: lfu, chunk: chunk, off: off, klen: uint32(len(key)), vlen: uint32(len(data)),
+		size: int32(size), nextHash: next}
+	s.index[slot][h] = i
+	sh.pushFront(i)
+	sh.count++
+	sh.live += len(key) + len(data)
+	atomic.AddInt64(&owner.count, 1)
+	owner.addSize(size)
+
+	if owner.expires != nil{
+		owner.expires.Set(owner.cacheType, key, v.GetExpire())
+	}
+	if owner.keys != nil{
+		owner.keys.Set(key, owner.cacheType)
+	}
+	sh.rwMutex.Unlock()
+
+	owner.evict(key)
+}
+
+/*
+需要持有key所在分片的写锁，数据留在arena中，压缩时回收
+*/
+func (s *arenaStore) remove(sh *arenaShard, key string, i int32) {
+	owner := s.owner
+	slot := &sh.slots[i]
+	m := s.index[lruSlot(key)]
+	if head := m[slot.hash]; head == i {
+		if slot.nextHash == arenaNil {
+			delete(m, slot.hash)
+		}else{
+			m[slot.hash] = slot.nextHash
+		}
+	}else{
+		for j := head; j != arenaNil; j = sh.slots[j].nextHash {
+			if sh.slots[j].nextHash == i {
+				sh.slots[j].nextHash = slot.nextHash
+				break
+			}
+		}
+	}
+
+	sh.unlink(i)
+	n := int(slot.klen + slot.vlen)
+	sh.live -= n
+	sh.garbage += n
+	sh.count--
+	size := int(slot.size)
+	*slot = arenaSlot{}
+	sh.free = append(sh.free, i)
+	atomic.AddInt64(&owner.count, -1)
+	owner.addSize(-size)
+
+	if owner.expires != nil{
+		owner.expires.Remove(owner.cacheType, key)
+	}
+	if owner.keys != nil{
+		owner.keys.Remove(key, owner.cacheType)
+	}
+}
+
+func (s *arenaStore) Remove(key string) {
+	sh := s.shard(key)
+	sh.rwMutex.Lock()
+	defer sh.rwMutex.Unlock()
+	if i := s.find(sh, key); i != arenaNil {
+		s.remove(sh, key, i)
+	}
+}
+
+/*
+和lru一样只加分片的读锁，距离上次移动超过 lruPromoteInterval 时才加写锁移动到链表头部
+*/
+func (s *arenaStore) Value(key string) (kv.ValueCache, uint64, bool) {
+	sh := s.shard(key)
+	sh.rwMutex.RLock()
+	i := s.find(sh, key)
+	if i == arenaNil {
+		sh.rwMutex.RUnlock()
+		atomic.AddUint64(&sh.misses, 1)
+		return nil, 0, false
+	}
+
+	slot := &sh.slots[i]
+	now := time.Now().UnixNano()
+	atomic.StoreInt64(&slot.accessed, now)
+	atomic.AddUint64(&slot.hits, 1)
+	if s.owner.countLFU() {
+		lfuIncr(&slot.lfu, now)
+	}
+	atomic.AddUint64(&sh.hits, 1)
+	v := sh.value(i, key)
+	revision := slot.revision
+	modified := slot.modified
+	promote := now - atomic.LoadInt64(&slot.promoted) > lruPromoteInterval
+	sh.rwMutex.RUnlock()
+
+	if promote {
+		sh.rwMutex.Lock()
+		if i := s.find(sh, key); i != arenaNil && sh.slots[i].modified == modified {
+			sh.moveToFront(i)
+			atomic.StoreInt64(&sh.slots[i].promoted, now)
+		}
+		sh.rwMutex.Unlock()
+	}
+	return v, revision, true
+}
+
+func (s *arenaStore) Peek(key string) (kv.ValueCache, bool) {
+	sh := s.shard(key)
+	sh.rwMutex.RLock()
+	defer sh.rwMutex.RUnlock()
+
+	if i := s.find(sh, key); i != arenaNil {
+		return sh.value(i, key), true
+	}
+	return nil, false
+}
+
+func (s *arenaStore) Revision(key string) uint64 {
+	sh := s.shard(key)
+	sh.rwMutex.RLock()
+	defer sh.rwMutex.RUnlock()
+
+	i := s.find(sh, key)
+	if i == arenaNil || sh.isExpire(i, time.Now().UnixNano()) {
+		return 0
+	}
+	return sh.slots[i].revision
+}
+
+func (s *arenaStore) Info(key string) (kv.KeyInfo, bool) {
+	sh := s.shard(key)
+	sh.rwMutex.RLock()
+	defer sh.rwMutex.RUnlock()
+
+	now := time.Now().UnixNano()
+	i := s.find(sh, key)
+	if i == arenaNil || sh.isExpire(i, now) {
+		return kv.KeyInfo{}, false
+	}
+
+	slot := &sh.slots[i]
+	entry := &lruEntry{value: sh.value(i, key), size: int(slot.size), created: slot.created, modified: slot.modified,
+		accessed: atomic.LoadInt64(&slot.accessed), hits: atomic.LoadUint64(&slot.hits), lfu: atomic.LoadUint32(&slot.lfu)}
+	r := s.owner.info(&lruShard{}, entry, now)
+	r.Encoding = StorageArena
+	return r, true
+}
+
+/*
+遍历一个槽中的key，key和值都复制出来
+*/
+func (s *arenaStore) ScanSlot(slot int, f func(key string, v kv.ValueCache)) {
+	sh := s.slotShard(slot)
+	sh.rwMutex.RLock()
+	defer sh.rwMutex.RUnlock()
+
+	for _, head := range s.index[slot] {
+		for i := head; i != arenaNil; i = sh.slots[i].nextHash {
+			key := sh.key(i)
+			f(key, sh.value(i, key))
+		}
+	}
+}
+
+func (s *arenaStore) stats(r *kv.TypeStats, now int64) (idle int64) {
+	for _, sh := range s.shards {
+		r.Hits += atomic.LoadUint64(&sh.hits)
+		r.Misses += atomic.LoadUint64(&sh.misses)
+
+		sh.rwMutex.RLock()
+		for i := sh.head; i != arenaNil; i = sh.slots[i].next {
+			if sh.isExpire(i, now) {
+				continue
+			}
+			slot := &sh.slots[i]
+			t := (now - atomic.LoadInt64(&slot.accessed)) / int64(time.Millisecond)
+			idle += t
+			if t > r.MaxIdle {
+				r.MaxIdle = t
+			}
+			if slot.expire != kv.ExpireForever {
+				r.Volatile++
+			}
+			r.Keys++
+		}
+		sh.rwMutex.RUnlock()
+	}
+	return idle
+}
+
+/*
+需要持有所有分片的写锁
+*/
+func (s *arenaStore) reset() {
+	for _, sh := range s.shards {
+		sh.reset()
+	}
+	s.resetIndex()
+}
+
+func (s *arenaStore) lockAll() {
+	for _, sh := range s.shards {
+		sh.rwMutex.Lock()
+	}
+}
+
+func (s *arenaStore) unlockAll() {
+	for _, sh := range s.shards {
+		sh.rwMutex.Unlock()
+	}
+}
+
+/*
+淘汰一个key，lfu 策略抽样选择，其他策略按lru，tinylfu 不支持arena，按lru淘汰
+*/
+func (s *arenaStore) evictOne(except string, now int64) bool {
+	owner := s.owner
+	if owner.policy == PolicyLFU {
+		entry := s.sample(MaxMemoryAllKeysLFU, lfuSamples, now, except)
+		if entry == nil {
+			return false
+		}
+		s.evictEntry(entry, owner.policy, now)
+		return true
+	}
+
+	for i := owner.Len(); i >= 0; i-- {
+		sh := s.oldestShard(except)
+		if sh == nil {
+			return false
+		}
+		if s.dropBack(sh, except, i > 0, now) {
+			return true
+		}
+	}
+	return false
+}
+
+func (s *arenaStore) oldestShard(except string) *arenaShard {
+	var r *arenaShard
+	oldest := int64(0)
+	for _, sh := range s.shards {
+		sh.rwMutex.RLock()
+		if i := sh.back(except); i != arenaNil {
+			if p := atomic.LoadInt64(&sh.slots[i].promoted); r == nil || p < oldest {
+				r, oldest = sh, p
+			}
+		}
+		sh.rwMutex.RUnlock()
+	}
+	return r
+}
+
+func (s *arenaShard) back(except string) int32 {
+	i := s.tail
+	if i != arenaNil && s.keyEqual(i, except) {
+		i = s.slots[i].prev
+	}
+	return i
+}
+
+/*
+和lru的 dropBack 相同，上次移动之后又被读取过的key移动到头部再给一次机会
+*/
+func (s *arenaStore) dropBack(sh *arenaShard, except string, second bool, now int64) bool {
+	sh.rwMutex.Lock()
+	defer sh.rwMutex.Unlock()
+
+	i := sh.back(except)
+	if i == arenaNil {
+		return false
+	}
+	slot := &sh.slots[i]
+	accessed := atomic.LoadInt64(&slot.accessed)
+	if second && accessed > atomic.LoadInt64(&slot.promoted) {
+		sh.moveToFront(i)
+		atomic.StoreInt64(&slot.promoted, accessed)
+		return false
+	}
+	s.drop(sh, i, s.owner.policy, now)
+	return true
+}
+
+/*
+需要持有分片的写锁
+*/
+func (s *arenaStore) drop(sh *arenaShard, i int32, policy string, now int64) {
+	owner := s.owner
+	key := sh.key(i)
+	slot := &sh.slots[i]
+	if owner.expireTrigger != nil{
+		owner.expireTrigger(key, sh.value(i, key))
+	}
+
+	log.Printf("type:%d %s remove key:%s, idle:%dms, hits:%d", owner.cacheType, policy, key,
+		(now - atomic.LoadInt64(&slot.accessed)) / int64(time.Millisecond), atomic.LoadUint64(&slot.hits))
+	s.remove(sh, key, i)
+	atomic.AddUint64(&owner.evictions, 1)
+}
+
+/*
+和lru的 sample 相同，从随机的槽开始抽样，返回的 lruEntry 是元数据的快照，淘汰时按 modified 检查key有没有被修改
+*/
+func (s *arenaStore) sample(policy string, samples int, now int64, except string) *lruEntry {
+	volatile := volatilePolicy(policy)
+	random := randomPolicy(policy)
+
+	var r *lruEntry
+	n := 0
+	start := rand.Intn(lruSlots)
+	for j := 0; j < lruSlots && n < samples; j++ {
+		slot := (start + j) & (lruSlots - 1)
+		sh := s.slotShard(slot)
+		sh.rwMutex.RLock()
+		for _, head := range s.index[slot] {
+			for i := head; i != arenaNil && n < samples; i = sh.slots[i].nextHash {
+				a := &sh.slots[i]
+				if volatile && a.expire == kv.ExpireForever {
+					continue
+				}
+				key := sh.key(i)
+				if key == except {
+					continue
+				}
+				entry := &lruEntry{value: kv.StringValue{Key: key, Expire: a.expire}, modified: a.modified,
+					accessed: atomic.LoadInt64(&a.accessed), hits: atomic.LoadUint64(&a.hits), lfu: atomic.LoadUint32(&a.lfu)}
+				if random {
+					sh.rwMutex.RUnlock()
+					return entry
+				}
+				if r == nil || evictBefore(policy, entry, r, now) {
+					r = entry
+				}
+				n++
+			}
+			if n >= samples {
+				break
+			}
+		}
+		sh.rwMutex.RUnlock()
+	}
+	return r
+}
+
+func (s *arenaStore) evictEntry(entry *lruEntry, policy string, now int64) {
+	key := entry.value.GetKey()
+	sh := s.shard(key)
+	sh.rwMutex.Lock()
+	defer sh.rwMutex.Unlock()
+
+	i := s.find(sh, key)
+	if i == arenaNil || sh.slots[i].modified != entry.modified {
+		return
+	}
+	s.drop(sh, i, policy, now)
+}
+
+/*
+每隔 arenaCompactInterval 秒检查一次，删除和修改留下的数据超过arena的 arenaCompactRatio% 时压缩，
+每次只压缩一个分片，压缩时阻塞这个分片的读写
+*/
+func (s *arenaStore) run() {
+	if Conf.ArenaCompactInterval <= 0 {
+		return
+	}
+	ticker := time.NewTicker(time.Duration(Conf.ArenaCompactInterval) * time.Second)
+	defer ticker.Stop()
+
+	for range ticker.C {
+		for i, sh := range s.shards {
+			sh.rwMutex.Lock()
+			total := sh.live + sh.garbage
+			if sh.garbage > 0 && sh.garbage * 100 >= total * Conf.ArenaCompactRatio {
+				begin := time.Now()
+				sh.compact()
+				log.Printf("type:%d arena shard:%d compact %d bytes to %d bytes, cost:%v", s.owner.cacheType, i,
+					total, sh.live, time.Since(begin))
+			}
+			sh.rwMutex.Unlock()
+		}
+	}
+}
+
+/*
+fnv-1a 64位哈希，用作arena索引的key
+*/
+func fnv64a(key string) uint64 {
+	h := uint64(14695981039346656037)
+	for i := 0; i < len(key); i++ {
+		h ^= uint64(key[i])
+		h *= 1099511628211
+	}
+	return h
+}
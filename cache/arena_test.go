@@ -0,0 +1,165 @@
+package cache
+
+import (
+	"fmt"
+	"github.com/llr104/lightkv/cache/kv"
+	"math/rand"
+	"strings"
+	"testing"
+)
+
+func newArenaLRU(maxSize int) *lru {
+	old := Conf.StringStorage
+	defer func() { Conf.StringStorage = old }()
+	Conf.StringStorage = StorageArena
+	return newLRU(kv.ValueData, maxSize, PolicyLRU)
+}
+
+/*
+同样的写入、删除、读取在arena和heap存储中的结果一致
+*/
+func TestArenaMatchesHeap(t *testing.T) {
+	arena := newArenaLRU(0)
+	heap := newLRU(kv.ValueData, 0, PolicyLRU)
+	rnd := rand.New(rand.NewSource(1))
+
+	for i := 0; i < 20000; i++ {
+		key := fmt.Sprintf("k%d", rnd.Intn(500))
+		switch rnd.Intn(4) {
+		case 0, 1:
+			v := kv.StringValue{Key: key, Data: strings.Repeat("v", rnd.Intn(100)), Expire: kv.ExpireForever}
+			arena.PushFront(v)
+			heap.PushFront(v)
+		case 2:
+			arena.Remove(key)
+			heap.Remove(key)
+		case 3:
+			a, errA := arena.Value(key)
+			h, errH := heap.Value(key)
+			if (errA == nil) != (errH == nil) || (errA == nil && a.ToString() != h.ToString()) {
+				t.Fatalf("Key:%s arena %v %v, heap %v %v", key, a, errA, h, errH)
+			}
+		}
+	}
+
+	if arena.Len() != heap.Len() {
+		t.Fatalf("arena has %d keys, heap has %d", arena.Len(), heap.Len())
+	}
+	keys := 0
+	for slot := 0; slot < lruSlots; slot++ {
+		arena.ScanSlot(slot, func(key string, v kv.ValueCache) {
+			keys++
+			if h, ok := heap.Peek(key); ok == false || h.ToString() != v.ToString() {
+				t.Fatalf("Key:%s arena %q, heap %v", key, v.ToString(), h)
+			}
+		})
+	}
+	if keys != heap.Len() {
+		t.Fatalf("scan arena got %d keys, want %d", keys, heap.Len())
+	}
+}
+
+/*
+压缩后只留下存活的数据，压缩前读取的值和压缩后读取的值都不变
+*/
+func TestArenaCompact(t *testing.T) {
+	s := newArenaLRU(0)
+	big := strings.Repeat("b", arenaChunkSize+1)
+	want := make(map[string]string)
+	for i := 0; i < 1000; i++ {
+		key := fmt.Sprintf("k%04d", i)
+		want[key] = fmt.Sprintf("v%d", i)
+		s.PushFront(kv.StringValue{Key: key, Data: want[key], Expire: kv.ExpireForever})
+	}
+	want["big"] = big
+	s.PushFront(kv.StringValue{Key: "big", Data: big, Expire: kv.ExpireForever})
+
+	before, _ := s.Value("k0000")
+	for i := 0; i < 1000; i += 2 {
+		key := fmt.Sprintf("k%04d", i)
+		if i%4 == 0 {
+			s.Remove(key)
+			delete(want, key)
+		}else{
+			want[key] = "changed"
+			s.PushFront(kv.StringValue{Key: key, Data: want[key], Expire: kv.ExpireForever})
+		}
+	}
+
+	live, garbage := 0, 0
+	for _, sh := range s.arena.shards {
+		live += sh.live
+		garbage += sh.garbage
+	}
+	if garbage == 0 {
+		t.Fatal("no garbage after remove and overwrite")
+	}
+
+	for _, sh := range s.arena.shards {
+		sh.rwMutex.Lock()
+		sh.compact()
+		sh.rwMutex.Unlock()
+	}
+
+	used := 0
+	for _, sh := range s.arena.shards {
+		if sh.garbage != 0 {
+			t.Fatalf("garbage %d after compact", sh.garbage)
+		}
+		for _, c := range sh.chunks {
+			used += len(c)
+		}
+	}
+	if used != live {
+		t.Fatalf("arena uses %d bytes after compact, want %d live bytes", used, live)
+	}
+
+	if before.ToString() != "v0" {
+		t.Fatalf("value read before compact changed to %q", before.ToString())
+	}
+	for key, v := range want {
+		got, err := s.Value(key)
+		if err != nil || got.ToString() != v {
+			t.Fatalf("Key:%s after compact is %v %v, want %q", key, got, err, v)
+		}
+	}
+	if s.Len() != len(want) {
+		t.Fatalf("len %d after compact, want %d", s.Len(), len(want))
+	}
+}
+
+/*
+arena存储和heap存储一样按lru顺序淘汰，读取过的key多给一次机会
+*/
+func TestArenaEvict(t *testing.T) {
+	one := newArenaLRU(0)
+	one.PushFront(stringValue(0))
+	s := newArenaLRU(one.Size() * 100)
+
+	for i := 0; i < 100; i++ {
+		s.PushFront(stringValue(i))
+	}
+	s.Value("k0000")
+	for i := 100; i < 150; i++ {
+		s.PushFront(stringValue(i))
+	}
+
+	tests := []struct {
+		from, to int
+		exists   bool
+	}{
+		{0, 1, true},
+		{1, 51, false},
+		{51, 150, true},
+	}
+	for _, tt := range tests {
+		for i := tt.from; i < tt.to; i++ {
+			if _, ok := s.Peek(stringValue(i).Key); ok != tt.exists {
+				t.Fatalf("Key:%s exists %v, want %v", stringValue(i).Key, ok, tt.exists)
+			}
+		}
+	}
+	if s.Len() != 100 || s.Size() > s.maxSize {
+		t.Fatalf("len %d, size %d, max size %d", s.Len(), s.Size(), s.maxSize)
+	}
+}
@@ -0,0 +1,314 @@
+package cache
+
+import (
+	"errors"
+	"fmt"
+	"github.com/llr104/lightkv/cache/kv"
+	"strconv"
+	"strings"
+)
+
+/*
+批量操作中除了事务命令之外还可以使用的命令，覆盖所有类型
+*/
+const (
+	BatchPFAdd        = "pfadd"        //args: element ...，Value 为1表示基数估计有变化
+	BatchPFCount      = "pfcount"      //args: 其他的key ...
+	BatchPFDel        = "pfdel"
+	BatchGeoAdd       = "geoadd"       //args: longitude latitude name ...，Value 为新增的个数
+	BatchGeoDist      = "geodist"      //args: member1 member2 [unit]
+	BatchGeoDelMember = "geodelmember" //args: member
+	BatchGeoDel       = "geodel"
+	BatchXAdd         = "xadd"         //args: id field value ...，Value 为新增消息的id
+	BatchXLen         = "xlen"
+	BatchXDel         = "xdel"
+	BatchJSet         = "jset"         //args: path value
+	BatchJGet         = "jget"         //args: path ...
+	BatchJNumIncrBy   = "jnumincrby"   //args: path by
+	BatchJDel         = "jdel"
+	BatchBFAdd        = "bfadd"        //args: item ...，Values 为每个item是否新增
+	BatchBFExists     = "bfexists"     //args: item ...
+	BatchBFDel        = "bfdel"
+	BatchCFAdd        = "cfadd"        //args: item ...
+	BatchCFExists     = "cfexists"     //args: item ...
+	BatchCFDel        = "cfdel"
+	BatchTSAdd        = "tsadd"        //args: time value ...，Values 为写入的时间戳
+	BatchTSGet        = "tsget"        //Values 为最新样本的 time value
+	BatchTSDel        = "tsdel"
+	BatchExpire       = "expire"       //args: seconds，Value 为1表示设置成功
+	BatchTTL          = "ttl"
+	BatchPersist      = "persist"
+	BatchType         = "type"
+	BatchExists       = "exists"
+)
+
+/*
+批量执行的命令个数上限
+*/
+const MaxBatchCommands = 10000
+
+/*
+按顺序执行一批命令，每条命令单独加锁执行，互相之间不是原子的
+可以使用事务中的所有命令和 Batch 开头的命令，del 会删除任意类型中的key
+某条命令失败时只在它的结果中记录错误，后面的命令继续执行
+*/
+func (s *Cache) Batch(cmds []kv.TxCommand) ([]kv.BatchResult, error) {
+	if len(cmds) > MaxBatchCommands {
+		str := fmt.Sprintf("Batch too many commands:%d, max:%d", len(cmds), MaxBatchCommands)
+		return nil, errors.New(str)
+	}
+
+	results := make([]kv.BatchResult, len(cmds))
+	for i, cmd := range cmds {
+		r, err := s.batchCommand(cmd)
+		results[i].TxResult = r
+		if err != nil {
+			results[i].Err = err.Error()
+		}
+	}
+	return results, nil
+}
+
+func (s *Cache) batchCommand(cmd kv.TxCommand) (kv.TxResult, error) {
+	r := kv.TxResult{}
+	if err := checkBatchCommand(cmd); err != nil {
+		return r, err
+	}
+
+	key, args := cmd.Key, cmd.Args
+	var err error
+	switch strings.ToLower(cmd.Cmd) {
+	case TxDel:
+		r.Value = strconv.Itoa(s.DelKeys([]string{key}))
+	case BatchPFAdd:
+		var changed bool
+		if changed, err = s.PFAdd(key, args, cmd.Expire); err == nil {
+			r.Value = boolString(changed)
+		}
+	case BatchPFCount:
+		var n uint64
+		if n, err = s.PFCount(append([]string{key}, args...)); err == nil {
+			r.Value = strconv.FormatUint(n, 10)
+		}
+	case BatchPFDel:
+		err = s.PFDel(key)
+	case BatchGeoAdd:
+		members := make([]kv.GeoMember, 0, len(args)/3)
+		for i := 0; i < len(args); i += 3 {
+			lng, err1 := strconv.ParseFloat(args[i], 64)
+			lat, err2 := strconv.ParseFloat(args[i+1], 64)
+			if err1 != nil || err2 != nil {
+				str := fmt.Sprintf("%s Key:%s, invalid position %s %s", cmd.Cmd, key, args[i], args[i+1])
+				return r, errors.New(str)
+			}
+			members = append(members, kv.GeoMember{Name: args[i+2], Longitude: lng, Latitude: lat})
+		}
+		var n int
+		if n, err = s.GeoAdd(key, members, cmd.Expire); err == nil {
+			r.Value = strconv.Itoa(n)
+		}
+	case BatchGeoDist:
+		unit := ""
+		if len(args) == 3 {
+			unit = args[2]
+		}
+		var d float64
+		if d, err = s.GeoDist(key, args[0], args[1], unit); err == nil {
+			r.Value = strconv.FormatFloat(d, 'f', -1, 64)
+		}
+	case BatchGeoDelMember:
+		err = s.GeoDelMember(key, args[0])
+	case BatchGeoDel:
+		err = s.GeoDel(key)
+	case BatchXAdd:
+		fields := make([]string, 0, len(args)/2)
+		values := make([]string, 0, len(args)/2)
+		for i := 1; i < len(args); i += 2 {
+			fields = append(fields, args[i])
+			values = append(values, args[i+1])
+		}
+		r.Value, err = s.XAdd(key, args[0], fields, values, kv.StreamTrim{})
+	case BatchXLen:
+		var n int
+		if n, err = s.XLen(key); err == nil {
+			r.Value = strconv.Itoa(n)
+		}
+	case BatchXDel:
+		err = s.XDel(key)
+	case BatchJSet:
+		err = s.JSet(key, args[0], args[1], cmd.Expire)
+	case BatchJGet:
+		r.Value, err = s.JGet(key, args)
+	case BatchJNumIncrBy:
+		r.Value, err = s.JNumIncrBy(key, args[0], args[1])
+	case BatchJDel:
+		err = s.JDel(key)
+	case BatchBFAdd, BatchBFExists, BatchCFAdd, BatchCFExists:
+		var arr []bool
+		switch strings.ToLower(cmd.Cmd) {
+		case BatchBFAdd:
+			arr, err = s.BFAdd(key, args, cmd.Expire)
+		case BatchBFExists:
+			arr, err = s.BFExists(key, args)
+		case BatchCFAdd:
+			arr, err = s.CFAdd(key, args, false, cmd.Expire)
+		default:
+			arr, err = s.CFExists(key, args)
+		}
+		if err == nil {
+			r.Values = make([]string, len(arr))
+			for i, b := range arr {
+				r.Values[i] = boolString(b)
+			}
+		}
+	case BatchBFDel:
+		err = s.BFDel(key)
+	case BatchCFDel:
+		err = s.CFDel(key)
+	case BatchTSAdd:
+		samples := make([]kv.TSSample, 0, len(args)/2)
+		for i := 0; i < len(args); i += 2 {
+			t, err1 := strconv.ParseInt(args[i], 10, 64)
+			v, err2 := strconv.ParseFloat(args[i+1], 64)
+			if err1 != nil || err2 != nil {
+				str := fmt.Sprintf("%s Key:%s, invalid sample %s %s", cmd.Cmd, key, args[i], args[i+1])
+				return r, errors.New(str)
+			}
+			samples = append(samples, kv.TSSample{Time: t, Value: v})
+		}
+		var times []int64
+		if times, err = s.TSAdd(key, samples, cmd.Expire); err == nil {
+			r.Values = make([]string, len(times))
+			for i, t := range times {
+				r.Values[i] = strconv.FormatInt(t, 10)
+			}
+		}
+	case BatchTSGet:
+		var sample kv.TSSample
+		if sample, err = s.TSGet(key); err == nil {
+			r.Values = []string{strconv.FormatInt(sample.Time, 10), strconv.FormatFloat(sample.Value, 'f', -1, 64)}
+		}
+	case BatchTSDel:
+		err = s.TSDel(key)
+	case BatchExpire:
+		seconds, err1 := strconv.ParseInt(args[0], 10, 64)
+		if err1 != nil {
+			str := fmt.Sprintf("%s Key:%s, invalid seconds %s", cmd.Cmd, key, args[0])
+			return r, errors.New(str)
+		}
+		var ok bool
+		if ok, err = s.Expire(key, "", seconds); err == nil {
+			r.Value = boolString(ok)
+		}
+	case BatchTTL:
+		var t int64
+		if t, err = s.TTL(key, ""); err == nil {
+			r.Value = strconv.FormatInt(t, 10)
+		}
+	case BatchPersist:
+		var ok bool
+		if ok, err = s.Persist(key, ""); err == nil {
+			r.Value = boolString(ok)
+		}
+	case BatchType:
+		r.Value, err = s.Type(key)
+	case BatchExists:
+		r.Value = strconv.Itoa(s.Exists([]string{key}))
+	default:
+		//事务命令，和普通写入一样只锁住自己的key
+		s.txMutex.RLock()
+		defer s.txMutex.RUnlock()
+		defer s.keyLocks.lock(key)()
+		return s.execCommand(cmd)
+	}
+	return r, err
+}
+
+func checkBatchCommand(cmd kv.TxCommand) error {
+	if cmd.Key == "" {
+		return errors.New("key is empty")
+	}
+
+	n := len(cmd.Args)
+	ok := false
+	switch strings.ToLower(cmd.Cmd) {
+	case BatchPFDel, BatchGeoDel, BatchXLen, BatchXDel, BatchJDel, BatchBFDel, BatchCFDel,
+		BatchTSGet, BatchTSDel, BatchTTL, BatchPersist, BatchType, BatchExists:
+		ok = n == 0
+	case BatchPFCount, BatchJGet:
+		ok = true
+	case BatchGeoDelMember, BatchExpire:
+		ok = n == 1
+	case BatchJSet, BatchJNumIncrBy:
+		ok = n == 2
+	case BatchGeoDist:
+		ok = n == 2 || n == 3
+	case BatchPFAdd, BatchBFAdd, BatchBFExists, BatchCFAdd, BatchCFExists:
+		ok = n > 0
+	case BatchGeoAdd:
+		ok = n > 0 && n % 3 == 0
+	case BatchXAdd:
+		ok = n > 1 && n % 2 == 1
+	case BatchTSAdd:
+		ok = n > 0 && n % 2 == 0
+	default:
+		return checkTxCommand(cmd)
+	}
+	if ok == false {
+		str := fmt.Sprintf("wrong number of arguments for %s", cmd.Cmd)
+		return errors.New(str)
+	}
+	return nil
+}
+
+func boolString(b bool) string {
+	if b {
+		return "1"
+	}
+	return "0"
+}
+
+/*
+批量读取string，不存在、已过期或者不是string的key对应的 found 为false
+*/
+func (s *Cache) MGet(keys []string) ([]string, []bool) {
+	s.txMutex.RLock()
+	defer s.txMutex.RUnlock()
+
+	values := make([]string, len(keys))
+	found := make([]bool, len(keys))
+	for i, key := range keys {
+		if v, _, err := s.get(key); err == nil {
+			values[i] = v
+			found[i] = true
+		}
+	}
+	return values, found
+}
+
+/*
+批量写入string，所有key使用同样的过期设置
+写入前检查所有key，任意一个key不能写入时都不写入，写入期间持有 txMutex 的写锁，其他读写看不到只写了一部分的结果
+*/
+func (s *Cache) MSet(keys []string, values []string, e kv.Expiration) error {
+	if len(keys) != len(values) {
+		return errors.New("MSet keys len not equal values len")
+	}
+	if err := e.Check(); err != nil {
+		return err
+	}
+
+	s.txMutex.Lock()
+	defer s.txMutex.Unlock()
+	defer s.keyLocks.lock(keys...)()
+
+	if err := s.checkWrite(kv.ValueData, keys...); err != nil {
+		return err
+	}
+	for i, key := range keys {
+		if err := s.putEx(key, values[i], e); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,112 @@
+package cache
+
+import (
+	"fmt"
+	"github.com/llr104/lightkv/cache/kv"
+	"testing"
+	"time"
+)
+
+/*
+每条命令单独返回结果，失败的命令不影响后面的命令
+*/
+func TestBatch(t *testing.T) {
+	c, clean := newTestCache(t)
+	defer clean()
+
+	tests := []struct {
+		cmd    kv.TxCommand
+		value  string
+		values string
+		err    bool
+	}{
+		{kv.TxCommand{Cmd: TxPut, Key: "s", Args: []string{"1"}}, "", "[]", false},
+		{kv.TxCommand{Cmd: TxIncrBy, Key: "s", Args: []string{"2"}}, "3", "[]", false},
+		{kv.TxCommand{Cmd: "GET", Key: "s"}, "3", "[]", false},
+		{kv.TxCommand{Cmd: TxHMPut, Key: "m", Args: []string{"f", "v"}}, "", "[]", false},
+		//非统一键空间模式下不同类型的key互不影响
+		{kv.TxCommand{Cmd: TxIncrBy, Key: "m", Args: []string{"1"}}, "1", "[]", false},
+		{kv.TxCommand{Cmd: TxPut, Key: "x", Args: []string{"abc"}}, "", "[]", false},
+		{kv.TxCommand{Cmd: TxIncrBy, Key: "x", Args: []string{"1"}}, "", "[]", true},
+		{kv.TxCommand{Cmd: TxGet, Key: "x"}, "abc", "[]", false},
+		{kv.TxCommand{Cmd: BatchPFAdd, Key: "h", Args: []string{"a", "b"}}, "1", "[]", false},
+		{kv.TxCommand{Cmd: BatchPFCount, Key: "h"}, "2", "[]", false},
+		{kv.TxCommand{Cmd: BatchBFAdd, Key: "b", Args: []string{"a", "a"}}, "", "[1 0]", false},
+		{kv.TxCommand{Cmd: BatchTSAdd, Key: "t", Args: []string{"1", "1.5", "2", "2"}}, "", "[1 2]", false},
+		{kv.TxCommand{Cmd: BatchTSGet, Key: "t"}, "", "[2 2]", false},
+		{kv.TxCommand{Cmd: BatchTSAdd, Key: "t", Args: []string{"x", "1"}}, "", "[]", true},
+		{kv.TxCommand{Cmd: BatchJSet, Key: "j", Args: []string{"$", `{"a":1}`}}, "", "[]", false},
+		{kv.TxCommand{Cmd: BatchJNumIncrBy, Key: "j", Args: []string{"$.a", "2"}}, "3", "[]", false},
+		{kv.TxCommand{Cmd: BatchExpire, Key: "s", Args: []string{"100"}}, "1", "[]", false},
+		{kv.TxCommand{Cmd: BatchTTL, Key: "s"}, "100", "[]", false},
+		{kv.TxCommand{Cmd: BatchPersist, Key: "s"}, "1", "[]", false},
+		{kv.TxCommand{Cmd: BatchType, Key: "t"}, "timeseries", "[]", false},
+		{kv.TxCommand{Cmd: BatchExists, Key: "j"}, "1", "[]", false},
+		{kv.TxCommand{Cmd: TxDel, Key: "j"}, "1", "[]", false},
+		{kv.TxCommand{Cmd: BatchExists, Key: "j"}, "0", "[]", false},
+		{kv.TxCommand{Cmd: "unknown", Key: "s"}, "", "[]", true},
+		{kv.TxCommand{Cmd: BatchExpire, Key: "s"}, "", "[]", true},
+		{kv.TxCommand{Cmd: BatchGeoAdd, Key: "g", Args: []string{"1", "2"}}, "", "[]", true},
+		{kv.TxCommand{Cmd: TxGet, Key: ""}, "", "[]", true},
+		{kv.TxCommand{Cmd: TxGet, Key: "s"}, "3", "[]", false},
+	}
+
+	cmds := make([]kv.TxCommand, len(tests))
+	for i, tt := range tests {
+		cmds[i] = tt.cmd
+	}
+	results, err := c.Batch(cmds)
+	if err != nil || len(results) != len(tests) {
+		t.Fatalf("got %d results, error %v, want %d results", len(results), err, len(tests))
+	}
+
+	for i, tt := range tests {
+		r := results[i]
+		if (r.Err != "") != tt.err {
+			t.Fatalf("%d %s Key:%s: error %q, want error %v", i, tt.cmd.Cmd, tt.cmd.Key, r.Err, tt.err)
+		}
+		values := fmt.Sprint(r.Values)
+		if r.Value != tt.value || values != tt.values {
+			t.Fatalf("%d %s Key:%s: got %q %s, want %q %s", i, tt.cmd.Cmd, tt.cmd.Key, r.Value, values, tt.value, tt.values)
+		}
+	}
+
+	if _, err := c.Batch(make([]kv.TxCommand, MaxBatchCommands+1)); err == nil {
+		t.Fatal("batch with too many commands succeeded")
+	}
+}
+
+func TestMGetMSet(t *testing.T) {
+	unified := Conf.UnifiedKeyspace
+	Conf.UnifiedKeyspace = true
+	defer func() { Conf.UnifiedKeyspace = unified }()
+	c, clean := newTestCache(t)
+	defer clean()
+
+	c.HMPut("m", []string{"f"}, []string{"v"}, 0)
+	c.PutEx("old", "v", kv.ExpireMillis(1))
+	time.Sleep(5 * time.Millisecond)
+
+	tests := []struct {
+		name   string
+		keys   []string
+		values []string
+		err    bool
+	}{
+		{"mset", []string{"a", "b"}, []string{"1", "2"}, false},
+		{"mset length", []string{"a", "b"}, []string{"3"}, true},
+		//m 是map，所有key都不写入
+		{"mset wrong type", []string{"a", "m"}, []string{"4", "5"}, true},
+		{"mset overwrite", []string{"b", "c"}, []string{"6", "7"}, false},
+	}
+	for _, tt := range tests {
+		if err := c.MSet(tt.keys, tt.values, kv.KeepTTL()); (err != nil) != tt.err {
+			t.Fatalf("%s: error %v, want error %v", tt.name, err, tt.err)
+		}
+	}
+
+	values, found := c.MGet([]string{"a", "b", "c", "m", "old", "none", "a"})
+	if fmt.Sprint(values) != "[1 6 7    1]" || fmt.Sprint(found) != "[true true true false false false true]" {
+		t.Fatalf("MGet got %q %v", values, found)
+	}
+}
@@ -0,0 +1,198 @@
+package cache
+
+import (
+	"errors"
+	"fmt"
+	"github.com/llr104/lightkv/cache/kv"
+	"io/ioutil"
+	"log"
+	"os"
+	"path/filepath"
+)
+
+/*
+布隆过滤器
+写操作修改的是lru中的过滤器，读写都在key的锁内完成，持久化时使用修改后的副本
+*/
+func (s *Cache) BFReserve(key string, errorRate float64, capacity uint64, expire int64) error{
+	defer s.keyLocks.lock(key)()
+
+	if err := s.checkWrite(kv.BloomData, key); err != nil {
+		return err
+	}
+
+	c, err := kv.NewBloomContent(errorRate, capacity)
+	if err != nil {
+		return err
+	}
+
+	if _, ok := s.bloomValue(key); ok {
+		str := fmt.Sprintf("BFReserve Key:%s, already exists", key)
+		return errors.New(str)
+	}
+
+	b := kv.BloomValue{Key: key, Data: c, Expire: expireTime(expire)}
+	s.bloomLRU.PushFront(b)
+	snapshot := kv.BloomValue{Key: key, Expire: b.Expire, Data: b.Data.Copy()}
+	s.persistBloom(kv.BloomValue{Key: key}, snapshot)
+	return nil
+}
+
+/*
+添加多个元素，不存在的key按配置中的默认误判率和容量创建，返回每个元素是否是新增的
+*/
+func (s *Cache) BFAdd(key string, items []string, expire int64) ([]bool, error){
+	defer s.keyLocks.lock(key)()
+
+	if err := s.checkWrite(kv.BloomData, key); err != nil {
+		return nil, err
+	}
+
+	b, ok := s.bloomValue(key)
+	old := kv.BloomValue{Key: key, Expire: b.Expire}
+	if ok == false {
+		c, err := kv.NewBloomContent(Conf.BloomErrorRate, uint64(Conf.BloomCapacity))
+		if err != nil {
+			return []bool{}, err
+		}
+		b = kv.BloomValue{Key: key, Data: c}
+	}
+
+	r := make([]bool, len(items))
+	for i, item := range items {
+		r[i] = b.Data.Add(item)
+	}
+
+	b.Expire = expireTime(expire)
+	s.bloomLRU.PushFront(b)
+	snapshot := kv.BloomValue{Key: key, Expire: b.Expire, Data: b.Data.Copy()}
+	s.persistBloom(old, snapshot)
+	return r, nil
+}
+
+/*
+判断多个元素是否存在，key不存在时都返回false
+*/
+func (s *Cache) BFExists(key string, items []string) ([]bool, error){
+	defer s.keyLocks.lock(key)()
+
+	if err := s.checkType(kv.BloomData, key); err != nil {
+		return nil, err
+	}
+
+	r := make([]bool, len(items))
+	b, ok := s.bloomValue(key)
+	if ok == false {
+		return r, nil
+	}
+
+	for i, item := range items {
+		r[i] = b.Data.Exists(item)
+	}
+	return r, nil
+}
+
+func (s *Cache) BFInfo(key string) (kv.BloomInfo, error){
+	defer s.keyLocks.lock(key)()
+
+	if err := s.checkType(kv.BloomData, key); err != nil {
+		return kv.BloomInfo{}, err
+	}
+
+	b, ok := s.bloomValue(key)
+	if ok == false {
+		str := fmt.Sprintf("BFInfo Key:%s, not found", key)
+		return kv.BloomInfo{}, errors.New(str)
+	}
+	return b.Data.Info(), nil
+}
+
+func (s *Cache) BFDel(key string) error{
+	defer s.keyLocks.lock(key)()
+
+	if err := s.checkType(kv.BloomData, key); err != nil {
+		return err
+	}
+
+	return s.bfDel(key)
+}
+
+func (s *Cache) ClearBloom()  {
+	defer s.keyLocks.lockAll()()
+
+	s.bloomLRU.Clear()
+	op := kv.PersistentBloomOp{OpType: kv.Clear}
+	s.persistentBloomChan <- op
+}
+
+func (s *Cache) BloomCaches() ([]byte, error) {
+	defer s.keyLocks.lockAll()()
+
+	return s.bloomLRU.CacheToString()
+}
+
+func (s *Cache) bloomValue(key string) (kv.BloomValue, bool){
+	v, err := s.bloomLRU.Value(key)
+	if err != nil || v.IsExpire() {
+		return kv.BloomValue{}, false
+	}
+	return v.(kv.BloomValue), true
+}
+
+func (s *Cache) persistBloom(old kv.BloomValue, v kv.BloomValue) {
+	op := kv.PersistentBloomOp{Item: v, OpType: kv.Add}
+	s.persistentBloomChan <- op
+
+	if s.opFunction != nil{
+		s.opFunction(kv.Add, old, v)
+	}
+}
+
+func (s *Cache) bfDel(key string) error{
+	oldVal, err := s.bloomLRU.Lookup(key)
+	if err != nil{
+		return err
+	}
+
+	log.Printf("bfDel Key:%s", key)
+	s.bloomLRU.Remove(key)
+
+	s.bloomExpire(key, oldVal)
+
+	return nil
+}
+
+func (s *Cache) bloomExpire(key string, v kv.ValueCache){
+
+	val := kv.BloomValue{Key: key, Expire: kv.ExpireForever}
+	op := kv.PersistentBloomOp{Item: val, OpType: kv.Del}
+	s.persistentBloomChan <- op
+
+	if s.opFunction != nil{
+		s.opFunction(kv.Del, v, nil)
+	}
+}
+
+func (s *Cache) saveBloom(key string, v kv.BloomValue) {
+	b := encodeBloom(v)
+
+	fullPath := filepath.Join(s.paths.BloomDBPath, key)
+	path, _ := filepath.Split(fullPath)
+
+	createDir(path)
+
+	err := ioutil.WriteFile(fullPath, b, os.ModePerm)
+	if err != nil{
+		log.Printf("saveBloom error:%s", err.Error())
+	}
+}
+
+func (s *Cache) delBloom(key string)  {
+	fullPath := filepath.Join(s.paths.BloomDBPath, key)
+	os.Remove(fullPath)
+}
+
+func (s *Cache) clearBloom()  {
+	os.RemoveAll(s.paths.BloomDBPath)
+	createDir(s.paths.BloomDBPath)
+}
@@ -0,0 +1,60 @@
+package cache
+
+import (
+	"fmt"
+	"testing"
+)
+
+/*
+新建过滤器后马上写入，持久化的是新建时的副本，不会和之后的写入竞争
+*/
+func TestReserveThenAdd(t *testing.T) {
+	c, clean := newTestCache(t)
+	defer clean()
+
+	tests := []struct {
+		name    string
+		reserve func(key string) error
+		add     func(key string, item string) error
+		exists  func(key string, item string) bool
+	}{
+		{"bloom", func(key string) error {
+			return c.BFReserve(key, 0.01, 100, 0)
+		}, func(key string, item string) error {
+			_, err := c.BFAdd(key, []string{item}, 0)
+			return err
+		}, func(key string, item string) bool {
+			r, _ := c.BFExists(key, []string{item})
+			return len(r) == 1 && r[0]
+		}},
+		{"cuckoo", func(key string) error {
+			return c.CFReserve(key, 100, 0)
+		}, func(key string, item string) error {
+			_, err := c.CFAdd(key, []string{item}, false, 0)
+			return err
+		}, func(key string, item string) bool {
+			r, _ := c.CFExists(key, []string{item})
+			return len(r) == 1 && r[0]
+		}},
+	}
+
+	for _, tt := range tests {
+		for i := 0; i < 20; i++ {
+			key := fmt.Sprintf("%s%d", tt.name, i)
+			if err := tt.reserve(key); err != nil {
+				t.Fatalf("%s: %s", tt.name, err)
+			}
+			if err := tt.reserve(key); err == nil {
+				t.Fatalf("%s: reserve existing Key:%s", tt.name, key)
+			}
+			for j := 0; j < 50; j++ {
+				if err := tt.add(key, fmt.Sprint(j)); err != nil {
+					t.Fatalf("%s: %s", tt.name, err)
+				}
+			}
+			if tt.exists(key, "10") == false {
+				t.Fatalf("%s: item not found in Key:%s", tt.name, key)
+			}
+		}
+	}
+}
@@ -1,7 +1,6 @@
 package cache
 
 import (
-	"encoding/json"
 	"errors"
 	"fmt"
 	"github.com/llr104/lightkv/cache/kv"
@@ -10,38 +9,90 @@ import (
 	"math"
 	"os"
 	"path/filepath"
+	"sync"
+	"sync/atomic"
 	"time"
 )
 
 
 
 type Cache struct{
+	name        string
+	paths       dbPaths
+
 	stringLRU   *lru
 	mapLRU		*lru
 	listLRU		*lru
 	setLRU      *lru
+	hllLRU      *lru
+	geoLRU      *lru
+	streamLRU   *lru
+	jsonLRU     *lru
+	bloomLRU    *lru
+	cuckooLRU   *lru
+	tsLRU       *lru
 
 	persistentStringChan chan kv.PersistentStringOp
 	persistentMapChan    chan kv.PersistentMapOp
 	persistentListChan   chan kv.PersistentListOp
 	persistentSetChan    chan kv.PersistentSetOp
+	persistentHLLChan    chan kv.PersistentHLLOp
+	persistentGeoChan    chan kv.PersistentGeoOp
+	persistentStreamChan chan kv.PersistentStreamOp
+	persistentJSONChan   chan kv.PersistentJSONOp
+	persistentBloomChan  chan kv.PersistentBloomOp
+	persistentCuckooChan chan kv.PersistentCuckooOp
+	persistentTSChan     chan kv.PersistentTSOp
 	opFunction           func(kv.OpType, kv.ValueCache, kv.ValueCache)
+	expires              *expireQueue
+	keys                 *keyIndex
+
+	streamWaitMutex      sync.Mutex
+	streamWaiters        map[string]map[chan struct{}]bool
+	tsMutex              sync.RWMutex
+	txMutex              sync.RWMutex
+	txBuffer             atomic.Value
+	keyLocks             keyLocks
 
 }
 
 
+/*
+默认数据库，持久化在 dbPath 下
+*/
 func NewCache() *Cache {
+	return newCache(DefaultDB, Conf.dbPaths)
+}
+
+func newCache(name string, paths dbPaths) *Cache {
 	 c := Cache{
-	 	stringLRU:			 newLRU(kv.ValueData, Conf.CacheStringSize),
-	 	mapLRU:				 newLRU(kv.MapData, Conf.CacheMapSize),
-	 	listLRU:			 newLRU(kv.ListData, Conf.CacheListSize),
-	 	setLRU:				 newLRU(kv.SetData, Conf.CacheSetSize),
+	 	name:                 name,
+	 	paths:                paths,
+	 	stringLRU:			 newLRU(kv.ValueData, Conf.CacheStringSize, Conf.CachePolicy[kv.ValueData]),
+	 	mapLRU:				 newLRU(kv.MapData, Conf.CacheMapSize, Conf.CachePolicy[kv.MapData]),
+	 	listLRU:			 newLRU(kv.ListData, Conf.CacheListSize, Conf.CachePolicy[kv.ListData]),
+	 	setLRU:				 newLRU(kv.SetData, Conf.CacheSetSize, Conf.CachePolicy[kv.SetData]),
+	 	hllLRU:				 newLRU(kv.HLLData, Conf.CacheHLLSize, Conf.CachePolicy[kv.HLLData]),
+	 	geoLRU:				 newLRU(kv.GeoData, Conf.CacheGeoSize, Conf.CachePolicy[kv.GeoData]),
+	 	streamLRU:			 newLRU(kv.StreamData, Conf.CacheStreamSize, Conf.CachePolicy[kv.StreamData]),
+	 	jsonLRU:			 newLRU(kv.JSONData, Conf.CacheJSONSize, Conf.CachePolicy[kv.JSONData]),
+	 	bloomLRU:			 newLRU(kv.BloomData, Conf.CacheBloomSize, Conf.CachePolicy[kv.BloomData]),
+	 	cuckooLRU:			 newLRU(kv.CuckooData, Conf.CacheCuckooSize, Conf.CachePolicy[kv.CuckooData]),
+	 	tsLRU:				 newLRU(kv.TSData, Conf.CacheTSSize, Conf.CachePolicy[kv.TSData]),
 
 	 	persistentStringChan: make(chan kv.PersistentStringOp),
 	 	persistentMapChan:    make(chan kv.PersistentMapOp),
 	 	persistentListChan:   make(chan kv.PersistentListOp),
 	 	persistentSetChan:    make(chan kv.PersistentSetOp),
+	 	persistentHLLChan:    make(chan kv.PersistentHLLOp),
+	 	persistentGeoChan:    make(chan kv.PersistentGeoOp),
+	 	persistentStreamChan: make(chan kv.PersistentStreamOp),
+	 	persistentJSONChan:   make(chan kv.PersistentJSONOp),
+	 	persistentBloomChan:  make(chan kv.PersistentBloomOp),
+	 	persistentCuckooChan: make(chan kv.PersistentCuckooOp),
+	 	persistentTSChan:     make(chan kv.PersistentTSOp),
 	 	opFunction:           nil,
+	 	streamWaiters:        make(map[string]map[chan struct{}]bool),
 	 }
 	 c.init()
 	 return &c
@@ -49,27 +100,54 @@ func NewCache() *Cache {
 
 func (s*Cache) init() {
 
-	s.stringLRU.SetExpireTrigger(s.stringExpire)
-	s.mapLRU.SetExpireTrigger(s.mapExpire)
-	s.listLRU.SetExpireTrigger(s.listExpire)
-	s.setLRU.SetExpireTrigger(s.setExpire)
-
+	//淘汰不持有key的锁，不能按事务缓存，见 afterEvict
+	s.stringLRU.SetExpireTrigger(s.evictTrigger(kv.ValueData, s.stringDelOp))
+	s.mapLRU.SetExpireTrigger(s.evictTrigger(kv.MapData, s.mapDelOp))
+	s.listLRU.SetExpireTrigger(s.evictTrigger(kv.ListData, s.listDelOp))
+	s.setLRU.SetExpireTrigger(s.evictTrigger(kv.SetData, s.setDelOp))
+	s.hllLRU.SetExpireTrigger(s.hllExpire)
+	s.geoLRU.SetExpireTrigger(s.geoExpire)
+	s.streamLRU.SetExpireTrigger(s.streamExpire)
+	s.jsonLRU.SetExpireTrigger(s.jsonExpire)
+	s.bloomLRU.SetExpireTrigger(s.bloomExpire)
+	s.cuckooLRU.SetExpireTrigger(s.cuckooExpire)
+	s.tsLRU.SetExpireTrigger(s.tsExpire)
+
+	s.expires = newExpireQueue(s.expireKey)
+	if Conf.UnifiedKeyspace {
+		s.keys = newKeyIndex()
+	}
+	for _, h := range s.typeHandles() {
+		h.lru.SetExpireQueue(s.expires)
+		h.lru.SetKeyIndex(s.keys)
+	}
 
-	createDir(Conf.ValueDBPath)
-	createDir(Conf.MapDBPath)
-	createDir(Conf.ListDBPath)
-	createDir(Conf.SetDBPath)
+	createDir(s.paths.ValueDBPath)
+	createDir(s.paths.MapDBPath)
+	createDir(s.paths.ListDBPath)
+	createDir(s.paths.SetDBPath)
+	createDir(s.paths.HLLDBPath)
+	createDir(s.paths.GeoDBPath)
+	createDir(s.paths.StreamDBPath)
+	createDir(s.paths.JSONDBPath)
+	createDir(s.paths.BloomDBPath)
+	createDir(s.paths.CuckooDBPath)
+	createDir(s.paths.TSDBPath)
 
 	s.loadDB()
 
 	go s.persistent()
+	go s.expires.run()
+	if s.stringLRU.arena != nil {
+		go s.stringLRU.arena.run()
+	}
 
 }
 
 func (s *Cache) loadDB()  {
 
 	 //普通类型
-	 filepath.Walk(Conf.ValueDBPath, func(path string, f os.FileInfo, err error) error {
+	 filepath.Walk(s.paths.ValueDBPath, func(path string, f os.FileInfo, err error) error {
 		if f == nil {
 			return err
 		}
@@ -87,7 +165,7 @@ func (s *Cache) loadDB()  {
 	})
 
 	//map类型
-	filepath.Walk(Conf.MapDBPath, func(path string, f os.FileInfo, err error) error {
+	filepath.Walk(s.paths.MapDBPath, func(path string, f os.FileInfo, err error) error {
 		if f == nil {
 			return err
 		}
@@ -105,7 +183,7 @@ func (s *Cache) loadDB()  {
 	})
 
 	//list类型
-	filepath.Walk(Conf.ListDBPath, func(path string, f os.FileInfo, err error) error {
+	filepath.Walk(s.paths.ListDBPath, func(path string, f os.FileInfo, err error) error {
 		if f == nil {
 			return err
 		}
@@ -117,13 +195,18 @@ func (s *Cache) loadDB()  {
 			log.Println(err)
 		}else {
 			v := decodeList(data)
+			if e, ok := migrateExpire(v.Expire, f.ModTime()); ok {
+				log.Printf("migrate list Key:%s expire:%d", v.Key, v.Expire)
+				v.Expire = e
+				s.saveList(v.Key, v)
+			}
 			s.listLRU.PushFront(v)
 		}
 		return nil
 	})
 
 	//set类型
-	filepath.Walk(Conf.SetDBPath, func(path string, f os.FileInfo, err error) error {
+	filepath.Walk(s.paths.SetDBPath, func(path string, f os.FileInfo, err error) error {
 		if f == nil {
 			return err
 		}
@@ -135,70 +218,257 @@ func (s *Cache) loadDB()  {
 			log.Println(err)
 		}else {
 			v := decodeSet(data)
+			if e, ok := migrateExpire(v.Expire, f.ModTime()); ok {
+				log.Printf("migrate set Key:%s expire:%d", v.Key, v.Expire)
+				v.Expire = e
+				s.saveSet(v.Key, v)
+			}
 			s.setLRU.PushFront(v)
 		}
 		return nil
 	})
 
-	 size := s.stringLRU.Size()+s.mapLRU.Size()+s.listLRU.Size()+s.setLRU.Size()
-	 len := s.stringLRU.Len()+s.mapLRU.Len()+s.listLRU.Len()+s.setLRU.Len()
+	//HyperLogLog类型
+	filepath.Walk(s.paths.HLLDBPath, func(path string, f os.FileInfo, err error) error {
+		if f == nil {
+			return err
+		}
+		if f.IsDir() {
+			return nil
+		}
+
+		if data, err := ioutil.ReadFile(path); err != nil {
+			log.Println(err)
+		}else {
+			v := decodeHLL(data)
+			s.hllLRU.PushFront(v)
+		}
+		return nil
+	})
+
+	//geo类型
+	filepath.Walk(s.paths.GeoDBPath, func(path string, f os.FileInfo, err error) error {
+		if f == nil {
+			return err
+		}
+		if f.IsDir() {
+			return nil
+		}
+
+		if data, err := ioutil.ReadFile(path); err != nil {
+			log.Println(err)
+		}else {
+			v := decodeGeo(data)
+			s.geoLRU.PushFront(v)
+		}
+		return nil
+	})
+
+	//stream类型
+	filepath.Walk(s.paths.StreamDBPath, func(path string, f os.FileInfo, err error) error {
+		if f == nil {
+			return err
+		}
+		if f.IsDir() {
+			return nil
+		}
+
+		if data, err := ioutil.ReadFile(path); err != nil {
+			log.Println(err)
+		}else {
+			v := decodeStream(data)
+			s.streamLRU.PushFront(v)
+		}
+		return nil
+	})
+
+	//json类型
+	filepath.Walk(s.paths.JSONDBPath, func(path string, f os.FileInfo, err error) error {
+		if f == nil {
+			return err
+		}
+		if f.IsDir() {
+			return nil
+		}
+
+		if data, err := ioutil.ReadFile(path); err != nil {
+			log.Println(err)
+		}else {
+			v := decodeJSON(data)
+			s.jsonLRU.PushFront(v)
+		}
+		return nil
+	})
+
+	//布隆过滤器
+	filepath.Walk(s.paths.BloomDBPath, func(path string, f os.FileInfo, err error) error {
+		if f == nil {
+			return err
+		}
+		if f.IsDir() {
+			return nil
+		}
+
+		if data, err := ioutil.ReadFile(path); err != nil {
+			log.Println(err)
+		}else if v, ok := decodeBloom(data); ok {
+			s.bloomLRU.PushFront(v)
+		}
+		return nil
+	})
+
+	//布谷鸟过滤器
+	filepath.Walk(s.paths.CuckooDBPath, func(path string, f os.FileInfo, err error) error {
+		if f == nil {
+			return err
+		}
+		if f.IsDir() {
+			return nil
+		}
+
+		if data, err := ioutil.ReadFile(path); err != nil {
+			log.Println(err)
+		}else if v, ok := decodeCuckoo(data); ok {
+			s.cuckooLRU.PushFront(v)
+		}
+		return nil
+	})
+
+	//时间序列
+	filepath.Walk(s.paths.TSDBPath, func(path string, f os.FileInfo, err error) error {
+		if f == nil {
+			return err
+		}
+		if f.IsDir() {
+			return nil
+		}
+
+		if data, err := ioutil.ReadFile(path); err != nil {
+			log.Println(err)
+		}else if v, ok := decodeTS(data); ok {
+			s.tsLRU.PushFront(v)
+		}
+		return nil
+	})
+
+	 size := s.stringLRU.Size()+s.mapLRU.Size()+s.listLRU.Size()+s.setLRU.Size()+s.hllLRU.Size()+s.geoLRU.Size()+s.streamLRU.Size()+s.jsonLRU.Size()+s.bloomLRU.Size()+s.cuckooLRU.Size()+s.tsLRU.Size()
+	 len := s.stringLRU.Len()+s.mapLRU.Len()+s.listLRU.Len()+s.setLRU.Len()+s.hllLRU.Len()+s.geoLRU.Len()+s.streamLRU.Len()+s.jsonLRU.Len()+s.bloomLRU.Len()+s.cuckooLRU.Len()+s.tsLRU.Len()
 	 log.Printf("load db finish, %d Key-cacheValue memory: %.2f kb", len, float32(size)/1024.0)
 }
 
+/*
+数据库名
+*/
+func (s*Cache) Name() string {
+	return s.name
+}
+
 func (s*Cache) SetOnOP(opFunc func(kv.OpType, kv.ValueCache, kv.ValueCache)) {
 	s.opFunction = opFunc
 }
 
+/*
+expire 为秒数，转换成过期的时间点
+*/
+func expireTime(expire int64) int64 {
+	if expire == kv.ExpireForever {
+		return kv.ExpireForever
+	}
+	return time.Now().UnixNano() + expire*int64(time.Second)
+}
+
 
 /*
 StringValue
 */
 func (s*Cache) Put(key string, v string, expire int64) error{
+	return s.PutEx(key, v, kv.ExpireSeconds(expire))
+}
+
+/*
+e 为过期设置，可以是毫秒的相对时间、绝对时间或者保留原有的过期时间
+*/
+func (s*Cache) PutEx(key string, v string, e kv.Expiration) error{
+	s.txMutex.RLock()
+	defer s.txMutex.RUnlock()
+	defer s.keyLocks.lock(key)()
+	return s.putEx(key, v, e)
+}
+
+func (s*Cache) putEx(key string, v string, e kv.Expiration) error{
+	if err := s.checkWrite(kv.ValueData, key); err != nil {
+		return err
+	}
+
+	if err := e.Check(); err != nil {
+		return err
+	}
 
 	var newVal kv.ValueCache = nil
-	oldVal, _ := s.stringLRU.Value(key)
+	oldVal, _ := s.stringLRU.Lookup(key)
 
 	if oldVal == nil{
 		oldVal = kv.StringValue{Key: key}
 	}
 
-	if expire == kv.ExpireForever {
-		newVal = kv.StringValue{Key: key, Data: v, Expire:kv.ExpireForever}
-	}else{
-		e := time.Now().UnixNano() + expire*int64(time.Second)
-		newVal = kv.StringValue{Key: key, Data: v, Expire:e}
-	}
+	newVal = kv.StringValue{Key: key, Data: v, Expire:e.Deadline(liveExpire(oldVal))}
 	s.stringLRU.PushFront(newVal)
 
-	if s.opFunction != nil{
-		s.opFunction(kv.Add, oldVal, newVal)
-	}
-
 	t := newVal.(kv.StringValue)
 
 	op := kv.PersistentStringOp{Item: t, OpType: kv.Add}
-	s.persistentStringChan <- op
+	s.afterCommit(key, func() {
+		if s.opFunction != nil{
+			s.opFunction(kv.Add, oldVal, newVal)
+		}
+		s.persistentStringChan <- op
+	})
 
 	return nil
 }
 
 func (s *Cache) Get(key string) (string, error) {
+	v, _, err := s.GetVersion(key)
+	return v, err
+}
+
+/*
+同时返回值的修改版本，可以作为 PutCAS 等写入的前提条件
+*/
+func (s *Cache) GetVersion(key string) (string, uint64, error) {
+	s.txMutex.RLock()
+	defer s.txMutex.RUnlock()
+	return s.get(key)
+}
 
-	if v, err := s.stringLRU.Value(key); err == nil{
+func (s *Cache) get(key string) (string, uint64, error) {
+	if err := s.checkType(kv.ValueData, key); err != nil {
+		return "", 0, err
+	}
+
+	if v, revision, err := s.stringLRU.ValueRevision(key); err == nil{
 		if v.IsExpire() {
 			str := fmt.Sprintf("Get Key:%s, is expire ", key)
-			return "", errors.New(str)
+			return "", 0, errors.New(str)
 		}else{
-			return v.ToString(), nil
+			return v.ToString(), revision, nil
 		}
 	}else{
 		str := fmt.Sprintf("Get Key:%s, not found", key)
-		return "", errors.New(str)
+		return "", 0, errors.New(str)
 	}
 
 }
 
 func (s *Cache) Delete (key string) error{
+	s.txMutex.RLock()
+	defer s.txMutex.RUnlock()
+	defer s.keyLocks.lock(key)()
+
+	if err := s.checkType(kv.ValueData, key); err != nil {
+		return err
+	}
+
 	return s.del(key)
 }
 
@@ -213,7 +483,7 @@ func (s *Cache) ClearString()  {
 }
 
 func (s *Cache) del(key string) error{
-	oldVal, err := s.stringLRU.Value(key)
+	oldVal, err := s.stringLRU.Lookup(key)
 	if err != nil{
 		return err
 	}
@@ -227,20 +497,28 @@ func (s *Cache) del(key string) error{
 }
 
 func (s *Cache) stringExpire(key string, v kv.ValueCache){
+	s.afterCommit(key, s.stringDelOp(key, v))
+}
+
+/*
+删除key的持久化和通知，mapDelOp、listDelOp、setDelOp 相同
+*/
+func (s *Cache) stringDelOp(key string, v kv.ValueCache) func() {
 
 	val := kv.StringValue{Key: key, Expire: kv.ExpireForever, Data:""}
 	op := kv.PersistentStringOp{Item: val, OpType: kv.Del}
-	s.persistentStringChan <- op
-
-	if s.opFunction != nil{
-		s.opFunction(kv.Del, v, nil)
+	return func() {
+		s.persistentStringChan <- op
+		if s.opFunction != nil{
+			s.opFunction(kv.Del, v, nil)
+		}
 	}
 }
 
 func (s *Cache) saveString(key string, v kv.StringValue) {
 	b := encodeValue(v)
 
-	fullPath := filepath.Join(Conf.ValueDBPath, key)
+	fullPath := filepath.Join(s.paths.ValueDBPath, key)
 	path, _ := filepath.Split(fullPath)
 
 	createDir(path)
@@ -252,70 +530,114 @@ func (s *Cache) saveString(key string, v kv.StringValue) {
 }
 
 func (s *Cache) delString(key string)  {
-	fullPath := filepath.Join(Conf.ValueDBPath, key)
+	fullPath := filepath.Join(s.paths.ValueDBPath, key)
 	os.Remove(fullPath)
 }
 
 func (s *Cache) clearString()  {
-	os.RemoveAll(Conf.ValueDBPath)
-	createDir(Conf.ValueDBPath)
+	os.RemoveAll(s.paths.ValueDBPath)
+	createDir(s.paths.ValueDBPath)
 }
 
 /*
 map
 */
 func (s *Cache) HMPut(hmKey string, keys [] string,  fields [] string, expire int64) error{
+	return s.HMPutEx(hmKey, keys, fields, kv.ExpireSeconds(expire))
+}
+
+func (s *Cache) HMPutEx(hmKey string, keys [] string,  fields [] string, e kv.Expiration) error{
+	s.txMutex.RLock()
+	defer s.txMutex.RUnlock()
+	defer s.keyLocks.lock(hmKey)()
+	return s.hmPutEx(hmKey, keys, fields, e)
+}
+
+func (s *Cache) hmPutEx(hmKey string, keys [] string,  fields [] string, e kv.Expiration) error{
+	if err := s.checkWrite(kv.MapData, hmKey); err != nil {
+		return err
+	}
+
+	if err := e.Check(); err != nil {
+		return err
+	}
 	if len(keys) != len(fields){
 		return errors.New("map keys len not equal fields len")
 	}
 
-	val, err := s.mapLRU.Value(hmKey)
+	val, err := s.mapLRU.Lookup(hmKey)
+	var old kv.ValueCache = kv.MapValue{Key:hmKey}
 	m := kv.MapValue{}
-	old := kv.MapValue{Key:hmKey}
 	if err != nil{
 		m = kv.MapValue{Data: kv.NewMapContent(), Key:hmKey, Expire:kv.ExpireForever}
 	}else{
-		old = kv.MapValue{Data: kv.Copy(m.Data), Key:m.Key, Expire:m.Expire}
+		//写入时复制，lru中原来的值可能正在被读取或者持久化
+		old = val
 		m = val.(kv.MapValue)
+		m.Data = kv.Copy(m.Data)
 	}
 
-	if expire == kv.ExpireForever{
-		m.Expire = kv.ExpireForever
-	}else{
-		m.Expire = time.Now().UnixNano() + expire*int64(time.Second)
-	}
+	m.Expire = e.Deadline(liveExpire(val))
 
 	m.Add(keys, fields)
 	s.mapLRU.PushFront(m)
 
 	op := kv.PersistentMapOp{Item: m, OpType: kv.Add}
-	s.persistentMapChan <- op
-
-	if s.opFunction != nil{
-		s.opFunction(kv.Add, old, m)
-	}
+	s.afterCommit(hmKey, func() {
+		s.persistentMapChan <- op
+		if s.opFunction != nil{
+			s.opFunction(kv.Add, old, m)
+		}
+	})
 
 	return nil
 }
 
-func (s *Cache) HMGet(hmKey string) (string, error){
-	if v, err := s.mapLRU.Value(hmKey); err == nil{
+/*
+返回的map和lru中的值共享，只读，调用者不要修改
+*/
+func (s *Cache) HMGet(hmKey string) (map[string]string, error){
+	m, _, err := s.HMGetVersion(hmKey)
+	return m, err
+}
+
+func (s *Cache) HMGetVersion(hmKey string) (map[string]string, uint64, error){
+	s.txMutex.RLock()
+	defer s.txMutex.RUnlock()
+	return s.hmGet(hmKey)
+}
+
+func (s *Cache) hmGet(hmKey string) (map[string]string, uint64, error){
+	if err := s.checkType(kv.MapData, hmKey); err != nil {
+		return nil, 0, err
+	}
+
+	if v, revision, err := s.mapLRU.PackedValue(hmKey); err == nil{
 		if v.IsExpire() {
 			str := fmt.Sprintf("HMGet Key:%s, is expire ", hmKey)
-			return "", errors.New(str)
+			return map[string]string{}, 0, errors.New(str)
 		}else{
-			return v.ToString(), nil
+			return v.(mapReader).Fields(), revision, nil
 		}
 	}else{
 		str := fmt.Sprintf("HMGet Key:%s, not found", hmKey)
-		return "", errors.New(str)
+		return map[string]string{}, 0, errors.New(str)
 	}
 }
 
 
 func (s *Cache) HMGetMember(hmKey string, fieldKey string) (string, error){
+	s.txMutex.RLock()
+	defer s.txMutex.RUnlock()
+	return s.hmGetMember(hmKey, fieldKey)
+}
+
+func (s *Cache) hmGetMember(hmKey string, fieldKey string) (string, error){
+	if err := s.checkType(kv.MapData, hmKey); err != nil {
+		return "", err
+	}
 
-	val, err := s.mapLRU.Value(hmKey)
+	val, _, err := s.mapLRU.PackedValue(hmKey)
 
 	if err != nil {
 		str := fmt.Sprintf("HMGetMember not have key:%s map", hmKey)
@@ -327,8 +649,7 @@ func (s *Cache) HMGetMember(hmKey string, fieldKey string) (string, error){
 		return "", errors.New(str)
 	}
 
-	v := val.(kv.MapValue)
-	d, ok := v.Data[fieldKey]
+	d, ok := val.(mapReader).Get(fieldKey)
 	if ok {
 		return d, nil
 	}else{
@@ -338,8 +659,18 @@ func (s *Cache) HMGetMember(hmKey string, fieldKey string) (string, error){
 }
 
 func (s *Cache) HMDelMember(hmKey string, fieldKey string) error{
+	s.txMutex.RLock()
+	defer s.txMutex.RUnlock()
+	defer s.keyLocks.lock(hmKey)()
+	return s.hmDelMember(hmKey, fieldKey)
+}
+
+func (s *Cache) hmDelMember(hmKey string, fieldKey string) error{
+	if err := s.checkType(kv.MapData, hmKey); err != nil {
+		return err
+	}
 
-	val, err := s.mapLRU.Value(hmKey)
+	val, err := s.mapLRU.Lookup(hmKey)
 
 	if err != nil {
 		str := fmt.Sprintf("HMDelMember not have key:%s map", hmKey)
@@ -352,18 +683,21 @@ func (s *Cache) HMDelMember(hmKey string, fieldKey string) error{
 	}
 
 
-	m := val.(kv.MapValue)
-	old := kv.MapValue{Data: kv.Copy(m.Data), Key:m.Key, Expire:m.Expire}
+	old := val.(kv.MapValue)
+	m := old
 
 	_, ok1 := m.Get(fieldKey)
 	if ok1 {
+		m.Data = kv.Copy(old.Data)
 		m.Remove(fieldKey)
+		s.mapLRU.PushFront(m)
 		op := kv.PersistentMapOp{Item: m, OpType: kv.Del}
-		s.persistentMapChan <- op
-
-		if s.opFunction != nil{
-			s.opFunction(kv.Del, old, m)
-		}
+		s.afterCommit(hmKey, func() {
+			s.persistentMapChan <- op
+			if s.opFunction != nil{
+				s.opFunction(kv.Del, old, m)
+			}
+		})
 	}
 
 	return nil
@@ -371,6 +705,14 @@ func (s *Cache) HMDelMember(hmKey string, fieldKey string) error{
 
 
 func (s *Cache) HMDel(hmKey string) error{
+	s.txMutex.RLock()
+	defer s.txMutex.RUnlock()
+	defer s.keyLocks.lock(hmKey)()
+
+	if err := s.checkType(kv.MapData, hmKey); err != nil {
+		return err
+	}
+
 	return s.hDel(hmKey)
 }
 
@@ -387,7 +729,7 @@ func (s *Cache) MapCaches() ([]byte, error) {
 
 func (s *Cache) hDel(key string) error{
 
-	oldVal, err := s.mapLRU.Value(key)
+	oldVal, err := s.mapLRU.Lookup(key)
 	if err != nil{
 		return err
 	}
@@ -401,20 +743,25 @@ func (s *Cache) hDel(key string) error{
 }
 
 func (s *Cache) mapExpire(key string, v kv.ValueCache){
+	s.afterCommit(key, s.mapDelOp(key, v))
+}
+
+func (s *Cache) mapDelOp(key string, v kv.ValueCache) func() {
 
 	val := kv.MapValue{Key: key, Expire: kv.ExpireForever, Data: kv.NewMapContent()}
 	op := kv.PersistentMapOp{Item: val, OpType: kv.Del}
-	s.persistentMapChan <- op
-
-	if s.opFunction != nil{
-		s.opFunction(kv.Del, v, nil)
+	return func() {
+		s.persistentMapChan <- op
+		if s.opFunction != nil{
+			s.opFunction(kv.Del, v, nil)
+		}
 	}
 }
 
 func (s *Cache) saveMap(key string, v kv.MapValue) {
 	b := encodeHM(v)
 
-	fullPath := filepath.Join(Conf.MapDBPath, key)
+	fullPath := filepath.Join(s.paths.MapDBPath, key)
 	path, _ := filepath.Split(fullPath)
 
 	createDir(path)
@@ -426,13 +773,13 @@ func (s *Cache) saveMap(key string, v kv.MapValue) {
 }
 
 func (s *Cache) delMap(key string)  {
-	fullPath := filepath.Join(Conf.MapDBPath, key)
+	fullPath := filepath.Join(s.paths.MapDBPath, key)
 	os.Remove(fullPath)
 }
 
 func (s *Cache) clearMap()  {
-	os.RemoveAll(Conf.MapDBPath)
-	createDir(Conf.MapDBPath)
+	os.RemoveAll(s.paths.MapDBPath)
+	createDir(s.paths.MapDBPath)
 }
 
 
@@ -440,76 +787,126 @@ func (s *Cache) clearMap()  {
 list
 */
 func (s *Cache) LPut(key string, value []string, expire int64) error{
+	return s.LPutEx(key, value, kv.ExpireSeconds(expire))
+}
+
+func (s *Cache) LPutEx(key string, value []string, e kv.Expiration) error{
+	s.txMutex.RLock()
+	defer s.txMutex.RUnlock()
+	defer s.keyLocks.lock(key)()
+	return s.lPutEx(key, value, e)
+}
+
+func (s *Cache) lPutEx(key string, value []string, e kv.Expiration) error{
+	if err := s.checkWrite(kv.ListData, key); err != nil {
+		return err
+	}
+
+	if err := e.Check(); err != nil {
+		return err
+	}
 
 	var newVal kv.ValueCache = nil
 	var oldVal kv.ValueCache = nil
 
-	if v, err := s.listLRU.Value(key); err != nil {
-		n := kv.ListValue{Expire: expire, Key:key, Data:[]string{}}
-		n.Expire = expire
+	if v, err := s.listLRU.Lookup(key); err != nil {
+		n := kv.ListValue{Expire: e.Deadline(kv.ExpireForever), Key:key, Data:[]string{}}
 		n.Data = append(n.Data, value...)
 		newVal = n
 		oldVal = kv.ListValue{Key:key}
 	}else{
+		//写入时复制，原来的值可能还在持久化或者被监听者持有，不能在它的底层数组后面追加
 		oldVal = v
 		n := v.(kv.ListValue)
-		n.Expire = expire
-		n.Data = append(n.Data, value...)
+		n.Expire = e.Deadline(liveExpire(v))
+		data := make([]string, 0, len(n.Data)+len(value))
+		data = append(data, n.Data...)
+		n.Data = append(data, value...)
 		newVal = n
 	}
 
 	s.listLRU.PushFront(newVal)
 	op := kv.PersistentListOp{Item: newVal.(kv.ListValue), OpType: kv.Add}
-	s.persistentListChan <- op
-
-	if s.opFunction != nil{
-		s.opFunction(kv.Add, oldVal, newVal)
-	}
+	s.afterCommit(key, func() {
+		s.persistentListChan <- op
+		if s.opFunction != nil{
+			s.opFunction(kv.Add, oldVal, newVal)
+		}
+	})
 
 	return nil
 }
 
 func (s *Cache) LDel(key string) error{
+	s.txMutex.RLock()
+	defer s.txMutex.RUnlock()
+	defer s.keyLocks.lock(key)()
+
+	if err := s.checkType(kv.ListData, key); err != nil {
+		return err
+	}
+
 	return s.lDel(key)
 }
 
+/*
+返回的切片和lru中的值共享，只读，调用者不要修改
+*/
 func (s *Cache) LGet(key string) ([]string, error){
+	arr, _, err := s.LGetVersion(key)
+	return arr, err
+}
 
-	if v, err := s.listLRU.Value(key); err == nil{
+func (s *Cache) LGetVersion(key string) ([]string, uint64, error){
+	s.txMutex.RLock()
+	defer s.txMutex.RUnlock()
+	return s.lGet(key)
+}
+
+func (s *Cache) lGet(key string) ([]string, uint64, error){
+	if err := s.checkType(kv.ListData, key); err != nil {
+		return nil, 0, err
+	}
+
+	if v, revision, err := s.listLRU.PackedValue(key); err == nil{
 		if v.IsExpire() {
 			str := fmt.Sprintf("LGet Key:%s, is expire ", key)
-			return []string{}, errors.New(str)
+			return []string{}, 0, errors.New(str)
 		}else{
-			var arr []string
-			json.Unmarshal([]byte(v.ToString()), &arr)
-			return arr, nil
+			return v.(listReader).Items(), revision, nil
 		}
 	}else{
 		str := fmt.Sprintf("LGet Key:%s, not found", key)
-		return []string{}, errors.New(str)
+		return []string{}, 0, errors.New(str)
 	}
 
 }
 
+/*
+返回的切片和lru中的值共享，只读，调用者不要修改
+*/
 func (s *Cache) LGetRange(key string, beg int32, end int32) ([]string, error){
+	s.txMutex.RLock()
+	defer s.txMutex.RUnlock()
+	return s.lGetRange(key, beg, end)
+}
+
+func (s *Cache) lGetRange(key string, beg int32, end int32) ([]string, error){
+	if err := s.checkType(kv.ListData, key); err != nil {
+		return nil, err
+	}
 
 	if beg > end{
 		str := fmt.Sprintf("list: %s begin index > end index ", key)
 		return []string{}, errors.New(str)
 	}
 
-	if v, err := s.listLRU.Value(key); err == nil{
+	if v, _, err := s.listLRU.PackedValue(key); err == nil{
 		if v.IsExpire() {
 			str := fmt.Sprintf("LGetRange Key:%s, is expire ", key)
 			return []string{}, errors.New(str)
 		}else{
-			var arr []string
-			json.Unmarshal([]byte(v.ToString()), &arr)
-
-			l := len(arr)
-			min := int(math.Min(float64(end), float64(l)))
-			r := arr[beg:min]
-			return r, nil
+			return v.(listReader).Range(int(beg), int(end)), nil
 		}
 	}else{
 		str := fmt.Sprintf("LGetRange Key:%s, not found", key)
@@ -519,14 +916,30 @@ func (s *Cache) LGetRange(key string, beg int32, end int32) ([]string, error){
 }
 
 func (s *Cache) LDelRange(key string, beg int32, end int32)  error{
+	s.txMutex.RLock()
+	defer s.txMutex.RUnlock()
+	defer s.keyLocks.lock(key)()
+	return s.lDelRange(key, beg, end)
+}
+
+func (s *Cache) lDelRange(key string, beg int32, end int32)  error{
+	if err := s.checkType(kv.ListData, key); err != nil {
+		return err
+	}
+
+	if beg < 0 || end < 0 {
+		str := fmt.Sprintf("list: %s negative index %d %d", key, beg, end)
+		return errors.New(str)
+	}
 
 	if beg > end{
 		str := fmt.Sprintf("list: %s begin index > end index ", key)
 		return errors.New(str)
 	}
 
-	val, err := s.listLRU.Value(key)
-	if err != nil{
+	//写操作不改变lru的顺序，不计入命中率
+	val, err := s.listLRU.Lookup(key)
+	if err != nil || val.IsExpire() {
 		str := fmt.Sprintf("not have key:%s list", key)
 		return errors.New(str)
 	}
@@ -543,16 +956,19 @@ func (s *Cache) LDelRange(key string, beg int32, end int32)  error{
 	b := m.Data[0:beg]
 	e := m.Data[min:]
 
-	m.Data = append(b, e...)
+	m.Data = make([]string, 0, len(b) + len(e))
+	m.Data = append(m.Data, b...)
+	m.Data = append(m.Data, e...)
 
 	s.listLRU.PushFront(m)
 
 	op := kv.PersistentListOp{Item: m, OpType: kv.Del}
-	s.persistentListChan <- op
-
-	if s.opFunction != nil{
-		s.opFunction(kv.Del, oldVar, m)
-	}
+	s.afterCommit(key, func() {
+		s.persistentListChan <- op
+		if s.opFunction != nil{
+			s.opFunction(kv.Del, oldVar, m)
+		}
+	})
 
 	return  nil
 }
@@ -570,7 +986,7 @@ func (s *Cache) ListCaches() ([]byte, error) {
 
 func (s *Cache) lDel(key string) error{
 
-	oldVal, err := s.listLRU.Value(key)
+	oldVal, err := s.listLRU.Lookup(key)
 	if err != nil{
 		return err
 	}
@@ -582,20 +998,25 @@ func (s *Cache) lDel(key string) error{
 }
 
 func (s *Cache) listExpire(key string, v kv.ValueCache){
+	s.afterCommit(key, s.listDelOp(key, v))
+}
 
-	val := kv.StringValue{Key: key, Expire: kv.ExpireForever, Data:""}
-	op := kv.PersistentStringOp{Item: val, OpType: kv.Del}
-	s.persistentStringChan <- op
+func (s *Cache) listDelOp(key string, v kv.ValueCache) func() {
 
-	if s.opFunction != nil{
-		s.opFunction(kv.Del, v, nil)
+	val := kv.ListValue{Key: key, Expire: kv.ExpireForever}
+	op := kv.PersistentListOp{Item: val, OpType: kv.Del}
+	return func() {
+		s.persistentListChan <- op
+		if s.opFunction != nil{
+			s.opFunction(kv.Del, v, nil)
+		}
 	}
 }
 
 func (s *Cache) saveList(key string, v kv.ListValue) {
 	b := encodeList(v)
 
-	fullPath := filepath.Join(Conf.ListDBPath, key)
+	fullPath := filepath.Join(s.paths.ListDBPath, key)
 	path, _ := filepath.Split(fullPath)
 
 	createDir(path)
@@ -607,14 +1028,14 @@ func (s *Cache) saveList(key string, v kv.ListValue) {
 }
 
 func (s *Cache) delList(key string)  {
-	fullPath := filepath.Join(Conf.ListDBPath, key)
+	fullPath := filepath.Join(s.paths.ListDBPath, key)
 	os.Remove(fullPath)
 }
 
 
 func (s *Cache) clearList()  {
-	os.RemoveAll(Conf.ListDBPath)
-	createDir(Conf.ListDBPath)
+	os.RemoveAll(s.paths.ListDBPath)
+	createDir(s.paths.ListDBPath)
 }
 
 
@@ -622,11 +1043,29 @@ func (s *Cache) clearList()  {
 set
 */
 func (s *Cache) SPut(key string, value []string, expire int64) error{
+	return s.SPutEx(key, value, kv.ExpireSeconds(expire))
+}
 
-	oldVal, err := s.setLRU.Value(key)
+func (s *Cache) SPutEx(key string, value []string, e kv.Expiration) error{
+	s.txMutex.RLock()
+	defer s.txMutex.RUnlock()
+	defer s.keyLocks.lock(key)()
+	return s.sPutEx(key, value, e)
+}
+
+func (s *Cache) sPutEx(key string, value []string, e kv.Expiration) error{
+	if err := s.checkWrite(kv.SetData, key); err != nil {
+		return err
+	}
+
+	if err := e.Check(); err != nil {
+		return err
+	}
+
+	oldVal, err := s.setLRU.Lookup(key)
 	var newVal kv.ValueCache
 	if err != nil{
-		sv := kv.SetValue{Expire: expire, Key:key, Data: kv.NewSetContent()}
+		sv := kv.SetValue{Expire: e.Deadline(kv.ExpireForever), Key:key, Data: kv.NewSetContent()}
 		for _,v := range value{
 			sv.Add(v)
 		}
@@ -636,7 +1075,8 @@ func (s *Cache) SPut(key string, value []string, expire int64) error{
 
 	}else{
 		sv := oldVal.(kv.SetValue)
-		sv.Expire = expire
+		sv.Expire = e.Deadline(liveExpire(oldVal))
+		sv.Data = kv.Copy(sv.Data)
 		for _,v := range value{
 			sv.Add(v)
 		}
@@ -645,36 +1085,78 @@ func (s *Cache) SPut(key string, value []string, expire int64) error{
 	}
 
 	op := kv.PersistentSetOp{Item: newVal.(kv.SetValue), OpType: kv.Add}
-	s.persistentSetChan <- op
-
-	if s.opFunction != nil{
-		s.opFunction(kv.Add, oldVal, newVal)
-	}
+	s.afterCommit(key, func() {
+		s.persistentSetChan <- op
+		if s.opFunction != nil{
+			s.opFunction(kv.Add, oldVal, newVal)
+		}
+	})
 
 	return nil
 }
 
 func (s *Cache) SGet(key string) ([]string, error){
+	arr, _, err := s.SGetVersion(key)
+	return arr, err
+}
 
-	if v, err := s.setLRU.Value(key); err == nil{
+func (s *Cache) SGetVersion(key string) ([]string, uint64, error){
+	s.txMutex.RLock()
+	defer s.txMutex.RUnlock()
+	return s.sGet(key)
+}
+
+func (s *Cache) sGet(key string) ([]string, uint64, error){
+	if err := s.checkType(kv.SetData, key); err != nil {
+		return nil, 0, err
+	}
+
+	if v, revision, err := s.setLRU.PackedValue(key); err == nil{
 		if v.IsExpire() {
 			str := fmt.Sprintf("SGet Key:%s, is expire ", key)
-			return []string{}, errors.New(str)
+			return []string{}, 0, errors.New(str)
 		}else{
-			var arr []string
-			json.Unmarshal([]byte(v.ToString()), &arr)
-			return arr, nil
+			return v.(setReader).Members(), revision, nil
 		}
 	}else{
 		str := fmt.Sprintf("SGet Key:%s, not found", key)
-		return []string{}, errors.New(str)
+		return []string{}, 0, errors.New(str)
 	}
 
 }
 
+/*
+member是否在set中，key不存在或者已经过期时返回错误
+*/
+func (s *Cache) SIsMember(key string, member string) (bool, error){
+	s.txMutex.RLock()
+	defer s.txMutex.RUnlock()
+
+	if err := s.checkType(kv.SetData, key); err != nil {
+		return false, err
+	}
+
+	v, _, err := s.setLRU.PackedValue(key)
+	if err != nil || v.IsExpire() {
+		str := fmt.Sprintf("SIsMember Key:%s, not found", key)
+		return false, errors.New(str)
+	}
+	return v.(setReader).IsExist(member), nil
+}
+
 func (s *Cache) SDelMember(key string, value string) error{
+	s.txMutex.RLock()
+	defer s.txMutex.RUnlock()
+	defer s.keyLocks.lock(key)()
+	return s.sDelMember(key, value)
+}
+
+func (s *Cache) sDelMember(key string, value string) error{
+	if err := s.checkType(kv.SetData, key); err != nil {
+		return err
+	}
 
-	oldVal, err := s.setLRU.Value(key)
+	oldVal, err := s.setLRU.Lookup(key)
 	if err != nil {
 		str := fmt.Sprintf("not have key:%s set", key)
 		return errors.New(str)
@@ -685,21 +1167,31 @@ func (s *Cache) SDelMember(key string, value string) error{
 	ok := m.IsExist(value)
 	if ok {
 
+		m.Data = kv.Copy(old.Data)
 		m.Del(value)
 		s.setLRU.PushFront(m)
 
 		op := kv.PersistentSetOp{Item: m, OpType: kv.Del}
-		s.persistentSetChan <- op
-
-		if s.opFunction != nil{
-			s.opFunction(kv.Del, old, m)
-		}
+		s.afterCommit(key, func() {
+			s.persistentSetChan <- op
+			if s.opFunction != nil{
+				s.opFunction(kv.Del, old, m)
+			}
+		})
 	}
 
 	return nil
 }
 
 func (s *Cache) SDel(key string) error{
+	s.txMutex.RLock()
+	defer s.txMutex.RUnlock()
+	defer s.keyLocks.lock(key)()
+
+	if err := s.checkType(kv.SetData, key); err != nil {
+		return err
+	}
+
 	return s.sDel(key)
 }
 
@@ -714,7 +1206,7 @@ func (s *Cache) SetCaches() ([]byte, error) {
 }
 
 func (s *Cache) sDel(key string) error{
-	oldVal, err := s.setLRU.Value(key)
+	oldVal, err := s.setLRU.Lookup(key)
 	if err != nil{
 		return err
 	}
@@ -728,13 +1220,18 @@ func (s *Cache) sDel(key string) error{
 }
 
 func (s *Cache) setExpire(key string, v kv.ValueCache){
+	s.afterCommit(key, s.setDelOp(key, v))
+}
+
+func (s *Cache) setDelOp(key string, v kv.ValueCache) func() {
 
 	val := kv.SetValue{Key: key, Expire: kv.ExpireForever, Data: kv.NewSetContent()}
 	op := kv.PersistentSetOp{Item: val, OpType: kv.Del}
-	s.persistentSetChan <- op
-
-	if s.opFunction != nil{
-		s.opFunction(kv.Del, v, nil)
+	return func() {
+		s.persistentSetChan <- op
+		if s.opFunction != nil{
+			s.opFunction(kv.Del, v, nil)
+		}
 	}
 }
 
@@ -742,7 +1239,7 @@ func (s *Cache) setExpire(key string, v kv.ValueCache){
 func (s *Cache) saveSet(key string, v kv.SetValue) {
 	b := encodeSet(v)
 
-	fullPath := filepath.Join(Conf.SetDBPath, key)
+	fullPath := filepath.Join(s.paths.SetDBPath, key)
 	path, _ := filepath.Split(fullPath)
 
 	createDir(path)
@@ -754,13 +1251,13 @@ func (s *Cache) saveSet(key string, v kv.SetValue) {
 }
 
 func (s *Cache) delSet(key string)  {
-	fullPath := filepath.Join(Conf.SetDBPath, key)
+	fullPath := filepath.Join(s.paths.SetDBPath, key)
 	os.Remove(fullPath)
 }
 
 func (s *Cache) clearSet()  {
-	os.RemoveAll(Conf.SetDBPath)
-	createDir(Conf.SetDBPath)
+	os.RemoveAll(s.paths.SetDBPath)
+	createDir(s.paths.SetDBPath)
 }
 
 
@@ -816,6 +1313,75 @@ func (s *Cache) persistent()  {
 			}else if op.OpType == kv.Clear {
 				s.clearSet()
 			}
+		case op := <-s.persistentHLLChan:
+			v := op.Item
+			if op.OpType == kv.Add {
+				s.saveHLL(v.Key, v)
+			}else if op.OpType == kv.Del {
+				s.delHLL(v.Key)
+			}else if op.OpType == kv.Clear {
+				s.clearHLL()
+			}
+		case op := <-s.persistentGeoChan:
+			v := op.Item
+			if op.OpType == kv.Add {
+				s.saveGeo(v.Key, v)
+			}else if op.OpType == kv.Del {
+				if len(v.Data) == 0 {
+					s.delGeo(v.Key)
+				}else{
+					s.saveGeo(v.Key, v)
+				}
+			}else if op.OpType == kv.Clear {
+				s.clearGeo()
+			}
+		case op := <-s.persistentStreamChan:
+			v := op.Item
+			if op.OpType == kv.Add {
+				s.saveStream(v.Key, v)
+			}else if op.OpType == kv.Append {
+				s.appendStream(v.Key, op.Changes)
+			}else if op.OpType == kv.Del {
+				s.delStream(v.Key)
+			}else if op.OpType == kv.Clear {
+				s.clearStream()
+			}
+		case op := <-s.persistentJSONChan:
+			v := op.Item
+			if op.OpType == kv.Add {
+				s.saveJSON(v.Key, v)
+			}else if op.OpType == kv.Del {
+				s.delJSON(v.Key)
+			}else if op.OpType == kv.Clear {
+				s.clearJSON()
+			}
+		case op := <-s.persistentBloomChan:
+			v := op.Item
+			if op.OpType == kv.Add {
+				s.saveBloom(v.Key, v)
+			}else if op.OpType == kv.Del {
+				s.delBloom(v.Key)
+			}else if op.OpType == kv.Clear {
+				s.clearBloom()
+			}
+		case op := <-s.persistentCuckooChan:
+			v := op.Item
+			if op.OpType == kv.Add {
+				s.saveCuckoo(v.Key, v)
+			}else if op.OpType == kv.Del {
+				s.delCuckoo(v.Key)
+			}else if op.OpType == kv.Clear {
+				s.clearCuckoo()
+			}
+		case op := <-s.persistentTSChan:
+			v := op.Item
+			if op.OpType == kv.Add {
+				s.saveTS(v.Key, v)
+			}else if op.OpType == kv.Del {
+				s.delTS(v.Key)
+			}else if op.OpType == kv.Clear {
+				s.clearTS()
+			}
 		}
 	}
 }
@@ -200,4 +200,362 @@ func decodeSet(b [] byte) kv.SetValue {
 	return c
 }
 
+func encodeHLL(value kv.HLLValue) [] byte{
 
+	k := value.Key
+	e := value.Expire
+	d := value.Data
+
+	kl := int32(len(k))
+	vl := int32(len(d))
+
+	bytesBuffer := bytes.NewBuffer([]byte{})
+	binary.Write(bytesBuffer, binary.BigEndian, e)
+	binary.Write(bytesBuffer, binary.BigEndian, kl)
+
+	key := []byte(k)
+	binary.Write(bytesBuffer, binary.BigEndian, key)
+	binary.Write(bytesBuffer, binary.BigEndian, vl)
+	binary.Write(bytesBuffer, binary.BigEndian, []byte(d))
+
+	return bytesBuffer.Bytes()
+}
+
+func decodeHLL(b [] byte) kv.HLLValue {
+
+	c := kv.HLLValue{}
+	var dataLen int32 = 0
+	var keyLen int32 = 0
+
+	bytesBuffer := bytes.NewBuffer(b)
+	binary.Read(bytesBuffer, binary.BigEndian, &c.Expire)
+
+	binary.Read(bytesBuffer, binary.BigEndian, &keyLen)
+	key := make([]byte, keyLen)
+	binary.Read(bytesBuffer, binary.BigEndian, &key)
+
+	binary.Read(bytesBuffer, binary.BigEndian, &dataLen)
+	data := make([]byte, dataLen)
+	binary.Read(bytesBuffer, binary.BigEndian, &data)
+
+	c.Key = string(key)
+	c.Data = data
+
+	return c
+}
+
+func encodeGeo(value kv.GeoValue) [] byte{
+
+	k := value.Key
+	e := value.Expire
+	d, _ := json.Marshal(value.Data)
+
+	kl := int32(len(k))
+	vl := int32(len(d))
+
+	bytesBuffer := bytes.NewBuffer([]byte{})
+	binary.Write(bytesBuffer, binary.BigEndian, e)
+	binary.Write(bytesBuffer, binary.BigEndian, kl)
+
+	key := []byte(k)
+	binary.Write(bytesBuffer, binary.BigEndian, key)
+	binary.Write(bytesBuffer, binary.BigEndian, vl)
+	binary.Write(bytesBuffer, binary.BigEndian, d)
+
+	return bytesBuffer.Bytes()
+}
+
+func decodeGeo(b [] byte) kv.GeoValue {
+
+	c := kv.GeoValue{}
+	var dataLen int32 = 0
+	var keyLen int32 = 0
+
+	bytesBuffer := bytes.NewBuffer(b)
+	binary.Read(bytesBuffer, binary.BigEndian, &c.Expire)
+
+	binary.Read(bytesBuffer, binary.BigEndian, &keyLen)
+	key := make([]byte, keyLen)
+	binary.Read(bytesBuffer, binary.BigEndian, &key)
+
+	binary.Read(bytesBuffer, binary.BigEndian, &dataLen)
+	data := make([]byte, dataLen)
+	binary.Read(bytesBuffer, binary.BigEndian, &data)
+
+	c.Key = string(key)
+	c.Data = kv.NewGeoContent()
+	json.Unmarshal(data, &c.Data)
+	c.Reindex()
+
+	return c
+}
+
+func encodeStream(value kv.StreamValue) [] byte{
+
+	k := value.Key
+	e := value.Expire
+	d, _ := json.Marshal(value.Data)
+
+	kl := int32(len(k))
+	vl := int32(len(d))
+
+	bytesBuffer := bytes.NewBuffer([]byte{})
+	binary.Write(bytesBuffer, binary.BigEndian, e)
+	binary.Write(bytesBuffer, binary.BigEndian, kl)
+
+	key := []byte(k)
+	binary.Write(bytesBuffer, binary.BigEndian, key)
+	binary.Write(bytesBuffer, binary.BigEndian, vl)
+	binary.Write(bytesBuffer, binary.BigEndian, d)
+
+	return bytesBuffer.Bytes()
+}
+
+func decodeStream(b [] byte) kv.StreamValue {
+
+	c := kv.StreamValue{}
+	var dataLen int32 = 0
+	var keyLen int32 = 0
+
+	bytesBuffer := bytes.NewBuffer(b)
+	binary.Read(bytesBuffer, binary.BigEndian, &c.Expire)
+
+	binary.Read(bytesBuffer, binary.BigEndian, &keyLen)
+	key := make([]byte, keyLen)
+	binary.Read(bytesBuffer, binary.BigEndian, &key)
+
+	binary.Read(bytesBuffer, binary.BigEndian, &dataLen)
+	data := make([]byte, dataLen)
+	binary.Read(bytesBuffer, binary.BigEndian, &data)
+
+	c.Key = string(key)
+	c.Data = kv.NewStreamContent()
+	json.Unmarshal(data, c.Data)
+
+	//快照之后追加的修改
+	for {
+		var changeLen int32 = 0
+		if binary.Read(bytesBuffer, binary.BigEndian, &changeLen) != nil {
+			break
+		}
+		b := make([]byte, changeLen)
+		if binary.Read(bytesBuffer, binary.BigEndian, &b) != nil {
+			break
+		}
+
+		change := kv.StreamChange{}
+		if json.Unmarshal(b, &change) != nil {
+			break
+		}
+		c.Data.Apply(change)
+	}
+
+	return c
+}
+
+/*
+追加到stream文件末尾的修改，每条修改是长度加json
+*/
+func encodeStreamChanges(changes []kv.StreamChange) [] byte{
+	bytesBuffer := bytes.NewBuffer([]byte{})
+	for _, change := range changes {
+		d, _ := json.Marshal(change)
+		binary.Write(bytesBuffer, binary.BigEndian, int32(len(d)))
+		binary.Write(bytesBuffer, binary.BigEndian, d)
+	}
+	return bytesBuffer.Bytes()
+}
+
+func encodeJSON(value kv.JSONValue) [] byte{
+
+	k := value.Key
+	e := value.Expire
+	d, _ := json.Marshal(value.Data)
+
+	kl := int32(len(k))
+	vl := int32(len(d))
+
+	bytesBuffer := bytes.NewBuffer([]byte{})
+	binary.Write(bytesBuffer, binary.BigEndian, e)
+	binary.Write(bytesBuffer, binary.BigEndian, kl)
+
+	key := []byte(k)
+	binary.Write(bytesBuffer, binary.BigEndian, key)
+	binary.Write(bytesBuffer, binary.BigEndian, vl)
+	binary.Write(bytesBuffer, binary.BigEndian, d)
+
+	return bytesBuffer.Bytes()
+}
+
+func decodeJSON(b [] byte) kv.JSONValue {
+
+	c := kv.JSONValue{}
+	var dataLen int32 = 0
+	var keyLen int32 = 0
+
+	bytesBuffer := bytes.NewBuffer(b)
+	binary.Read(bytesBuffer, binary.BigEndian, &c.Expire)
+
+	binary.Read(bytesBuffer, binary.BigEndian, &keyLen)
+	key := make([]byte, keyLen)
+	binary.Read(bytesBuffer, binary.BigEndian, &key)
+
+	binary.Read(bytesBuffer, binary.BigEndian, &dataLen)
+	data := make([]byte, dataLen)
+	binary.Read(bytesBuffer, binary.BigEndian, &data)
+
+	c.Key = string(key)
+	c.Data, _ = kv.DecodeJSON(string(data))
+
+	return c
+}
+
+func encodeBloom(value kv.BloomValue) [] byte{
+
+	k := value.Key
+	e := value.Expire
+	d, _ := json.Marshal(value.Data)
+
+	kl := int32(len(k))
+	vl := int32(len(d))
+
+	bytesBuffer := bytes.NewBuffer([]byte{})
+	binary.Write(bytesBuffer, binary.BigEndian, e)
+	binary.Write(bytesBuffer, binary.BigEndian, kl)
+
+	key := []byte(k)
+	binary.Write(bytesBuffer, binary.BigEndian, key)
+	binary.Write(bytesBuffer, binary.BigEndian, vl)
+	binary.Write(bytesBuffer, binary.BigEndian, d)
+
+	return bytesBuffer.Bytes()
+}
+
+/*
+过滤器数据损坏时返回false，不加载
+*/
+func decodeBloom(b [] byte) (kv.BloomValue, bool) {
+
+	c := kv.BloomValue{}
+	var dataLen int32 = 0
+	var keyLen int32 = 0
+
+	bytesBuffer := bytes.NewBuffer(b)
+	binary.Read(bytesBuffer, binary.BigEndian, &c.Expire)
+
+	binary.Read(bytesBuffer, binary.BigEndian, &keyLen)
+	key := make([]byte, keyLen)
+	binary.Read(bytesBuffer, binary.BigEndian, &key)
+
+	binary.Read(bytesBuffer, binary.BigEndian, &dataLen)
+	data := make([]byte, dataLen)
+	binary.Read(bytesBuffer, binary.BigEndian, &data)
+
+	c.Key = string(key)
+	c.Data = &kv.BloomContent{}
+	if err := json.Unmarshal(data, c.Data); err != nil || len(c.Data.Filters) == 0 {
+		return c, false
+	}
+
+	return c, true
+}
+
+func encodeCuckoo(value kv.CuckooValue) [] byte{
+
+	k := value.Key
+	e := value.Expire
+	d, _ := json.Marshal(value.Data)
+
+	kl := int32(len(k))
+	vl := int32(len(d))
+
+	bytesBuffer := bytes.NewBuffer([]byte{})
+	binary.Write(bytesBuffer, binary.BigEndian, e)
+	binary.Write(bytesBuffer, binary.BigEndian, kl)
+
+	key := []byte(k)
+	binary.Write(bytesBuffer, binary.BigEndian, key)
+	binary.Write(bytesBuffer, binary.BigEndian, vl)
+	binary.Write(bytesBuffer, binary.BigEndian, d)
+
+	return bytesBuffer.Bytes()
+}
+
+/*
+过滤器数据损坏时返回false，不加载
+*/
+func decodeCuckoo(b [] byte) (kv.CuckooValue, bool) {
+
+	c := kv.CuckooValue{}
+	var dataLen int32 = 0
+	var keyLen int32 = 0
+
+	bytesBuffer := bytes.NewBuffer(b)
+	binary.Read(bytesBuffer, binary.BigEndian, &c.Expire)
+
+	binary.Read(bytesBuffer, binary.BigEndian, &keyLen)
+	key := make([]byte, keyLen)
+	binary.Read(bytesBuffer, binary.BigEndian, &key)
+
+	binary.Read(bytesBuffer, binary.BigEndian, &dataLen)
+	data := make([]byte, dataLen)
+	binary.Read(bytesBuffer, binary.BigEndian, &data)
+
+	c.Key = string(key)
+	c.Data = &kv.CuckooContent{}
+	if err := json.Unmarshal(data, c.Data); err != nil || len(c.Data.Filters) == 0 {
+		return c, false
+	}
+
+	return c, true
+}
+
+func encodeTS(value kv.TSValue) [] byte{
+
+	k := value.Key
+	e := value.Expire
+	d, _ := json.Marshal(value.Data)
+
+	kl := int32(len(k))
+	vl := int32(len(d))
+
+	bytesBuffer := bytes.NewBuffer([]byte{})
+	binary.Write(bytesBuffer, binary.BigEndian, e)
+	binary.Write(bytesBuffer, binary.BigEndian, kl)
+
+	key := []byte(k)
+	binary.Write(bytesBuffer, binary.BigEndian, key)
+	binary.Write(bytesBuffer, binary.BigEndian, vl)
+	binary.Write(bytesBuffer, binary.BigEndian, d)
+
+	return bytesBuffer.Bytes()
+}
+
+/*
+时间序列数据损坏时返回false，不加载
+*/
+func decodeTS(b [] byte) (kv.TSValue, bool) {
+
+	c := kv.TSValue{}
+	var dataLen int32 = 0
+	var keyLen int32 = 0
+
+	bytesBuffer := bytes.NewBuffer(b)
+	binary.Read(bytesBuffer, binary.BigEndian, &c.Expire)
+
+	binary.Read(bytesBuffer, binary.BigEndian, &keyLen)
+	key := make([]byte, keyLen)
+	binary.Read(bytesBuffer, binary.BigEndian, &key)
+
+	binary.Read(bytesBuffer, binary.BigEndian, &dataLen)
+	data := make([]byte, dataLen)
+	binary.Read(bytesBuffer, binary.BigEndian, &data)
+
+	c.Key = string(key)
+	c.Data = kv.NewTSContent(0)
+	if err := json.Unmarshal(data, c.Data); err != nil {
+		return c, false
+	}
+
+	return c, true
+}
@@ -0,0 +1,379 @@
+package cache
+
+import (
+	"fmt"
+	"github.com/llr104/lightkv/cache/kv"
+	"io/ioutil"
+	"os"
+	"strconv"
+	"sync"
+	"testing"
+)
+
+const (
+	testWriters = 8
+	testRounds  = 100
+)
+
+func newTestCache(t *testing.T) (*Cache, func()) {
+	dir, err := ioutil.TempDir("", "lightkv")
+	if err != nil {
+		t.Fatal(err)
+	}
+	return newCache("test", newDBPaths(dir)), func() {
+		os.RemoveAll(dir)
+	}
+}
+
+/*
+writers 个协程同时执行 f，readers 个协程在写入期间不停执行 read
+*/
+func parallel(writers int, readers int, f func(g int), read func()) {
+	var wg sync.WaitGroup
+	done := make(chan struct{})
+	for i := 0; i < readers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for {
+				select {
+				case <-done:
+					return
+				default:
+					read()
+				}
+			}
+		}()
+	}
+
+	var ww sync.WaitGroup
+	for g := 0; g < writers; g++ {
+		ww.Add(1)
+		go func(g int) {
+			defer ww.Done()
+			f(g)
+		}(g)
+	}
+	ww.Wait()
+	close(done)
+	wg.Wait()
+}
+
+func TestConcurrentHMPut(t *testing.T) {
+	c, clean := newTestCache(t)
+	defer clean()
+
+	parallel(testWriters, 2, func(g int) {
+		for i := 0; i < testRounds; i++ {
+			f := fmt.Sprintf("g%d-%d", g, i)
+			if err := c.HMPut("hm", []string{f}, []string{f}, 0); err != nil {
+				t.Error(err)
+				return
+			}
+			if i % 2 == 1 {
+				c.HMDelMember("hm", fmt.Sprintf("g%d-%d", g, i-1))
+			}
+		}
+	}, func() {
+		if m, err := c.HMGet("hm"); err == nil {
+			for k, v := range m {
+				if k != v {
+					t.Errorf("field %s has value %s", k, v)
+				}
+			}
+		}
+	})
+
+	m, err := c.HMGet("hm")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(m) != testWriters*testRounds/2 {
+		t.Fatalf("map has %d fields, want %d", len(m), testWriters*testRounds/2)
+	}
+	for g := 0; g < testWriters; g++ {
+		for i := 1; i < testRounds; i += 2 {
+			if _, ok := m[fmt.Sprintf("g%d-%d", g, i)]; ok == false {
+				t.Fatalf("field g%d-%d lost", g, i)
+			}
+		}
+	}
+}
+
+func TestConcurrentSPut(t *testing.T) {
+	c, clean := newTestCache(t)
+	defer clean()
+
+	parallel(testWriters, 2, func(g int) {
+		for i := 0; i < testRounds; i++ {
+			c.SPut("set", []string{fmt.Sprintf("g%d-%d", g, i)}, 0)
+			if i % 2 == 1 {
+				c.SDelMember("set", fmt.Sprintf("g%d-%d", g, i-1))
+			}
+		}
+	}, func() {
+		c.SGet("set")
+	})
+
+	arr, err := c.SGet("set")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(arr) != testWriters*testRounds/2 {
+		t.Fatalf("set has %d members, want %d", len(arr), testWriters*testRounds/2)
+	}
+}
+
+func TestConcurrentLPut(t *testing.T) {
+	c, clean := newTestCache(t)
+	defer clean()
+
+	parallel(testWriters, 2, func(g int) {
+		for i := 0; i < testRounds; i++ {
+			c.LPut("list", []string{strconv.Itoa(g)}, 0)
+			if i % 4 == 3 {
+				c.LDelRange("list", 0, 1)
+			}
+		}
+	}, func() {
+		if l, err := c.LGet("list"); err == nil {
+			for _, v := range l {
+				if n, err := strconv.Atoi(v); err != nil || n < 0 || n >= testWriters {
+					t.Errorf("unexpected item %q", v)
+				}
+			}
+		}
+	})
+
+	l, err := c.LGet("list")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(l) != testWriters*testRounds*3/4 {
+		t.Fatalf("list has %d items, want %d", len(l), testWriters*testRounds*3/4)
+	}
+}
+
+func TestConcurrentPut(t *testing.T) {
+	c, clean := newTestCache(t)
+	defer clean()
+
+	parallel(testWriters, 2, func(g int) {
+		for i := 0; i < testRounds; i++ {
+			c.PutEx("str", strconv.Itoa(i), kv.KeepTTL())
+			if i % 10 == 0 {
+				c.Expire("str", "", 100)
+				c.Persist("str", "")
+			}
+		}
+	}, func() {
+		c.Get("str")
+	})
+
+	if _, err := c.Get("str"); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestConcurrentHLLAndGeo(t *testing.T) {
+	c, clean := newTestCache(t)
+	defer clean()
+
+	parallel(testWriters, 2, func(g int) {
+		for i := 0; i < testRounds; i++ {
+			name := fmt.Sprintf("g%d-%d", g, i)
+			c.PFAdd("hll", []string{name}, 0)
+			c.GeoAdd("geo", []kv.GeoMember{{Name: name, Longitude: float64(g), Latitude: float64(i % 80)}}, 0)
+			if i % 2 == 1 {
+				c.GeoDelMember("geo", fmt.Sprintf("g%d-%d", g, i-1))
+			}
+		}
+	}, func() {
+		c.PFCount([]string{"hll"})
+		c.GeoSearch("geo", kv.GeoSearchOption{Longitude: 0, Latitude: 0, Radius: 1000, Unit: "km"})
+	})
+
+	names := make([]string, 0, testWriters*testRounds)
+	for g := 0; g < testWriters; g++ {
+		for i := 0; i < testRounds; i++ {
+			names = append(names, fmt.Sprintf("g%d-%d", g, i))
+		}
+	}
+	arr, err := c.GeoPos("geo", names)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(arr) != testWriters*testRounds/2 {
+		t.Fatalf("geo has %d members, want %d", len(arr), testWriters*testRounds/2)
+	}
+
+	n, _ := c.PFCount([]string{"hll"})
+	if n < testWriters*testRounds*9/10 || n > testWriters*testRounds*11/10 {
+		t.Fatalf("hll count %d too far from %d", n, testWriters*testRounds)
+	}
+}
+
+/*
+事务、CAS 和普通写入同时修改同一个key，计数不能丢失
+*/
+func TestConcurrentExecAndCAS(t *testing.T) {
+	c, clean := newTestCache(t)
+	defer clean()
+
+	parallel(testWriters, 2, func(g int) {
+		for i := 0; i < testRounds / 4; i++ {
+			if g % 2 == 0 {
+				_, err := c.Exec([]kv.TxCommand{
+					{Cmd: TxIncrBy, Key: "cnt", Args: []string{"1"}},
+					{Cmd: TxHMPut, Key: "hm", Args: []string{fmt.Sprintf("tx%d-%d", g, i), "1"}},
+				}, nil)
+				if err != nil {
+					t.Error(err)
+					return
+				}
+				continue
+			}
+
+			c.HMPut("hm", []string{fmt.Sprintf("put%d-%d", g, i)}, []string{"1"}, 0)
+			for {
+				v, ver, _ := c.GetVersion("cnt")
+				n, _ := strconv.Atoi(v)
+				_, err := c.PutCAS("cnt", strconv.Itoa(n+1), kv.KeepTTL(), ver)
+				if err == nil {
+					break
+				}
+				if IsVersionError(err) == false {
+					t.Error(err)
+					return
+				}
+			}
+		}
+	}, func() {
+		c.HMGet("hm")
+	})
+
+	v, _ := c.Get("cnt")
+	if v != strconv.Itoa(testWriters*testRounds/4) {
+		t.Fatalf("cnt is %s, want %d", v, testWriters*testRounds/4)
+	}
+	m, _ := c.HMGet("hm")
+	if len(m) != testWriters*testRounds/4 {
+		t.Fatalf("map has %d fields, want %d", len(m), testWriters*testRounds/4)
+	}
+}
+
+/*
+通知中的旧值是修改前的值，不会被之后的修改影响
+*/
+func TestHMPutOldValue(t *testing.T) {
+	c, clean := newTestCache(t)
+	defer clean()
+
+	var olds []kv.ValueCache
+	var mutex sync.Mutex
+	c.SetOnOP(func(op kv.OpType, before kv.ValueCache, after kv.ValueCache) {
+		mutex.Lock()
+		olds = append(olds, before)
+		mutex.Unlock()
+	})
+
+	c.HMPut("hm", []string{"a"}, []string{"1"}, 0)
+	c.HMPut("hm", []string{"b"}, []string{"2"}, 0)
+	c.HMDelMember("hm", "a")
+
+	mutex.Lock()
+	defer mutex.Unlock()
+	if len(olds) != 3 {
+		t.Fatalf("got %d notifications, want 3", len(olds))
+	}
+	if n := len(olds[0].(kv.MapValue).Data); n != 0 {
+		t.Fatalf("first old value has %d fields, want 0", n)
+	}
+	if d := olds[1].(kv.MapValue).Data; len(d) != 1 || d["a"] != "1" {
+		t.Fatalf("second old value is %v, want map[a:1]", d)
+	}
+	if d := olds[2].(kv.MapValue).Data; len(d) != 2 {
+		t.Fatalf("third old value is %v, want map[a:1 b:2]", d)
+	}
+}
+
+/*
+统一键空间模式下不同类型同时写入同一个key，key只会出现在一种类型中
+*/
+func TestConcurrentWrongType(t *testing.T) {
+	unified := Conf.UnifiedKeyspace
+	Conf.UnifiedKeyspace = true
+	defer func() { Conf.UnifiedKeyspace = unified }()
+
+	c, clean := newTestCache(t)
+	defer clean()
+
+	writers := []func(key string, i int) error{
+		func(key string, i int) error { return c.Put(key, "v", 0) },
+		func(key string, i int) error { return c.HMPut(key, []string{"f"}, []string{"v"}, 0) },
+		func(key string, i int) error { return c.LPut(key, []string{"v"}, 0) },
+		func(key string, i int) error { return c.SPut(key, []string{"v"}, 0) },
+		func(key string, i int) error { _, err := c.PFAdd(key, []string{"v"}, 0); return err },
+		func(key string, i int) error {
+			_, err := c.GeoAdd(key, []kv.GeoMember{{Name: "v", Longitude: 1, Latitude: 1}}, 0)
+			return err
+		},
+		func(key string, i int) error {
+			_, err := c.XAdd(key, "*", []string{"f"}, []string{"v"}, kv.StreamTrim{})
+			return err
+		},
+		func(key string, i int) error { return c.JSet(key, "$", `{"v":1}`, 0) },
+		func(key string, i int) error { _, err := c.BFAdd(key, []string{"v"}, 0); return err },
+		func(key string, i int) error { _, err := c.CFAdd(key, []string{"v"}, false, 0); return err },
+		func(key string, i int) error {
+			_, err := c.TSAdd(key, []kv.TSSample{{Time: int64(i + 1), Value: 1}}, 0)
+			return err
+		},
+	}
+
+	keys := []string{"k0", "k1"}
+	parallel(len(writers), 2, func(g int) {
+		for i := 0; i < testRounds*5; i++ {
+			key := keys[i%len(keys)]
+			if err := writers[g](key, i); err != nil && IsWrongType(err) == false {
+				t.Error(err)
+				return
+			}
+			if i%4 == g%4 {
+				c.DelKeys([]string{key})
+			}
+		}
+	}, func() {
+		//在key的锁内检查，其他类型不会在检查期间删除或写入这个key
+		for _, key := range keys {
+			unlock := c.keyLocks.lock(key)
+			if found := keyTypes(c, key); len(found) > 1 {
+				t.Errorf("Key:%s exists in types %v", key, found)
+			}
+			unlock()
+		}
+	})
+
+	for _, key := range keys {
+		found := keyTypes(c, key)
+		if len(found) > 1 {
+			t.Fatalf("Key:%s exists in types %v", key, found)
+		}
+		if t2, ok := c.keys.Type(key); len(found) == 1 && (ok == false || kv.DataTypeNames[t2] != found[0]) {
+			t.Fatalf("Key:%s is indexed as %s, want %s", key, kv.DataTypeNames[t2], found[0])
+		}
+	}
+}
+
+/*
+key所在的所有类型
+*/
+func keyTypes(c *Cache, key string) []string {
+	found := make([]string, 0)
+	for _, h := range c.typeHandles() {
+		if v, ok := h.lru.Peek(key); ok && v.IsExpire() == false {
+			found = append(found, kv.DataTypeNames[h.dataType])
+		}
+	}
+	return found
+}
@@ -9,28 +9,112 @@ import (
 var DefaultDBPath = "db"
 var DefaultRpcHost = ":9980"
 var DefaultApiHost = ":9981"
-var DefaultCheckExpireInterval = 15
+var DefaultBloomErrorRate = 0.01
+var DefaultBloomCapacity = 100
+var DefaultCuckooCapacity = 1024
+var DefaultTSRetention int64 = 0
+var DefaultUnifiedKeyspace = false
+var DefaultMaxDatabases = 16
+var DefaultCachePolicy = PolicyLRU
+var DefaultLFULogFactor = 10
+var DefaultLFUDecayTime = 1
+var DefaultMaxMemory int64 = 0
+var DefaultMaxMemoryPolicy = MaxMemoryAllKeysLRU
+var DefaultMaxMemorySamples = 5
+var DefaultLRUShards = 16
+var DefaultStringStorage = StorageHeap
+var DefaultArenaCompactInterval = 60
+var DefaultArenaCompactRatio = 50
+var DefaultMapMaxPackEntries = 128
+var DefaultMapMaxPackValue = 64
+var DefaultListMaxPackEntries = 128
+var DefaultListMaxPackValue = 64
+var DefaultSetMaxPackEntries = 128
+var DefaultSetMaxPackValue = 64
+
+/*
+各类型淘汰策略的配置项后缀，按数据类型排列，cachePolicy 为所有类型的默认策略
+*/
+var cachePolicyKeys = []string{"String", "Map", "List", "Set", "HLL", "Geo", "Stream", "JSON", "Bloom", "Cuckoo", "TS"}
 
 var Conf config
 
-type config struct {
+/*
+一个数据库中各类型的持久化目录
+*/
+type dbPaths struct {
 	ValueDBPath         string
 	MapDBPath           string
 	ListDBPath          string
 	SetDBPath           string
+	HLLDBPath           string
+	GeoDBPath           string
+	StreamDBPath        string
+	JSONDBPath          string
+	BloomDBPath         string
+	CuckooDBPath        string
+	TSDBPath            string
+}
+
+func newDBPaths(root string) dbPaths {
+	return dbPaths{
+		ValueDBPath:  path.Join(root, "string"),
+		MapDBPath:    path.Join(root, "map"),
+		ListDBPath:   path.Join(root, "list"),
+		SetDBPath:    path.Join(root, "set"),
+		HLLDBPath:    path.Join(root, "hll"),
+		GeoDBPath:    path.Join(root, "geo"),
+		StreamDBPath: path.Join(root, "stream"),
+		JSONDBPath:   path.Join(root, "json"),
+		BloomDBPath:  path.Join(root, "bloom"),
+		CuckooDBPath: path.Join(root, "cuckoo"),
+		TSDBPath:     path.Join(root, "timeseries"),
+	}
+}
+
+type config struct {
+	dbPaths
 	RpcHost             string
 	ApiHost             string
-	CheckExpireInterval int
 	CacheStringSize     int
 	CacheMapSize        int
 	CacheListSize       int
 	CacheSetSize        int
-
+	CacheHLLSize        int
+	CacheGeoSize        int
+	CacheStreamSize     int
+	CacheJSONSize       int
+	CacheBloomSize      int
+	CacheCuckooSize     int
+	CacheTSSize         int
+	BloomErrorRate      float64
+	BloomCapacity       int
+	CuckooCapacity      int
+	TSRetention         int64
+	UnifiedKeyspace     bool
+	MaxDatabases        int
+	CachePolicy         []string
+	LFULogFactor        int
+	LFUDecayTime        int
+	MaxMemory           int64
+	MaxMemoryPolicy     string
+	MaxMemorySamples    int
+	LRUShards           int
+	StringStorage       string
+	ArenaCompactInterval int
+	ArenaCompactRatio   int
+	MapMaxPackEntries   int
+	MapMaxPackValue     int
+	ListMaxPackEntries  int
+	ListMaxPackValue    int
+	SetMaxPackEntries   int
+	SetMaxPackValue     int
 }
 
 func init() {
 
 	Conf = config{}
+	policies := make([]string, len(cachePolicyKeys))
 	cfg, err := ini.Load("conf/kv.ini")
 	if err != nil{
 		log.Printf("no conf/kv.ini conf, use default")
@@ -51,10 +135,6 @@ func init() {
 		}
 
 
-		if checkExpireInterval, err := cfg.Section("").Key("checkExpireInterval").Int(); err == nil{
-			DefaultCheckExpireInterval = checkExpireInterval
-		}
-
 		if cacheStringSize, err := cfg.Section("").Key("cacheStringSize").Int(); err == nil{
 			Conf.CacheStringSize = cacheStringSize * (1024*1024)
 		}else{
@@ -78,14 +158,197 @@ func init() {
 		}else{
 			Conf.CacheSetSize = 500 * (1024*1024) //500M
 		}
+
+		if cacheHLLSize, err := cfg.Section("").Key("cacheHLLSize").Int(); err == nil{
+			Conf.CacheHLLSize = cacheHLLSize * (1024*1024)
+		}else{
+			Conf.CacheHLLSize = 500 * (1024*1024) //500M
+		}
+
+		if cacheGeoSize, err := cfg.Section("").Key("cacheGeoSize").Int(); err == nil{
+			Conf.CacheGeoSize = cacheGeoSize * (1024*1024)
+		}else{
+			Conf.CacheGeoSize = 500 * (1024*1024) //500M
+		}
+
+		if cacheStreamSize, err := cfg.Section("").Key("cacheStreamSize").Int(); err == nil{
+			Conf.CacheStreamSize = cacheStreamSize * (1024*1024)
+		}else{
+			Conf.CacheStreamSize = 500 * (1024*1024) //500M
+		}
+
+		if cacheJSONSize, err := cfg.Section("").Key("cacheJSONSize").Int(); err == nil{
+			Conf.CacheJSONSize = cacheJSONSize * (1024*1024)
+		}else{
+			Conf.CacheJSONSize = 500 * (1024*1024) //500M
+		}
+
+		if cacheBloomSize, err := cfg.Section("").Key("cacheBloomSize").Int(); err == nil{
+			Conf.CacheBloomSize = cacheBloomSize * (1024*1024)
+		}else{
+			Conf.CacheBloomSize = 500 * (1024*1024) //500M
+		}
+
+		if cacheCuckooSize, err := cfg.Section("").Key("cacheCuckooSize").Int(); err == nil{
+			Conf.CacheCuckooSize = cacheCuckooSize * (1024*1024)
+		}else{
+			Conf.CacheCuckooSize = 500 * (1024*1024) //500M
+		}
+
+		if cacheTSSize, err := cfg.Section("").Key("cacheTSSize").Int(); err == nil{
+			Conf.CacheTSSize = cacheTSSize * (1024*1024)
+		}else{
+			Conf.CacheTSSize = 500 * (1024*1024) //500M
+		}
+
+		if bloomErrorRate, err := cfg.Section("").Key("bloomErrorRate").Float64(); err == nil{
+			DefaultBloomErrorRate = bloomErrorRate
+		}
+
+		if bloomCapacity, err := cfg.Section("").Key("bloomCapacity").Int(); err == nil{
+			DefaultBloomCapacity = bloomCapacity
+		}
+
+		if cuckooCapacity, err := cfg.Section("").Key("cuckooCapacity").Int(); err == nil{
+			DefaultCuckooCapacity = cuckooCapacity
+		}
+
+		if tsRetention, err := cfg.Section("").Key("tsRetention").Int64(); err == nil{
+			DefaultTSRetention = tsRetention
+		}
+
+		if unifiedKeyspace, err := cfg.Section("").Key("unifiedKeyspace").Bool(); err == nil{
+			DefaultUnifiedKeyspace = unifiedKeyspace
+		}
+
+		if maxDatabases, err := cfg.Section("").Key("maxDatabases").Int(); err == nil{
+			DefaultMaxDatabases = maxDatabases
+		}
+
+		if cachePolicy := cfg.Section("").Key("cachePolicy").String(); cachePolicy != ""{
+			if checkPolicy(cachePolicy) {
+				DefaultCachePolicy = cachePolicy
+			}else{
+				log.Printf("invalid cachePolicy:%s, use %s", cachePolicy, DefaultCachePolicy)
+			}
+		}
+
+		for i, k := range cachePolicyKeys {
+			name := "cache" + k + "Policy"
+			policy := cfg.Section("").Key(name).String()
+			if policy == "" {
+				continue
+			}
+			if checkPolicy(policy) {
+				policies[i] = policy
+			}else{
+				log.Printf("invalid %s:%s, use cachePolicy", name, policy)
+			}
+		}
+
+		if lfuLogFactor, err := cfg.Section("").Key("lfuLogFactor").Int(); err == nil{
+			DefaultLFULogFactor = lfuLogFactor
+		}
+
+		if lfuDecayTime, err := cfg.Section("").Key("lfuDecayTime").Int(); err == nil{
+			DefaultLFUDecayTime = lfuDecayTime
+		}
+
+		if maxMemory, err := cfg.Section("").Key("maxmemory").Int64(); err == nil{
+			DefaultMaxMemory = maxMemory * (1024*1024)
+		}
+
+		if maxMemoryPolicy := cfg.Section("").Key("maxmemoryPolicy").String(); maxMemoryPolicy != ""{
+			if checkMaxMemoryPolicy(maxMemoryPolicy) {
+				DefaultMaxMemoryPolicy = maxMemoryPolicy
+			}else{
+				log.Printf("invalid maxmemoryPolicy:%s, use %s", maxMemoryPolicy, DefaultMaxMemoryPolicy)
+			}
+		}
+
+		if maxMemorySamples, err := cfg.Section("").Key("maxmemorySamples").Int(); err == nil && maxMemorySamples > 0{
+			DefaultMaxMemorySamples = maxMemorySamples
+		}
+
+		if lruShards, err := cfg.Section("").Key("lruShards").Int(); err == nil{
+			if checkShards(lruShards) {
+				DefaultLRUShards = lruShards
+			}else{
+				log.Printf("invalid lruShards:%d, use %d", lruShards, DefaultLRUShards)
+			}
+		}
+
+		if stringStorage := cfg.Section("").Key("stringStorage").String(); stringStorage != ""{
+			if checkStorage(stringStorage) {
+				DefaultStringStorage = stringStorage
+			}else{
+				log.Printf("invalid stringStorage:%s, use %s", stringStorage, DefaultStringStorage)
+			}
+		}
+
+		if arenaCompactInterval, err := cfg.Section("").Key("arenaCompactInterval").Int(); err == nil{
+			DefaultArenaCompactInterval = arenaCompactInterval
+		}
+
+		if arenaCompactRatio, err := cfg.Section("").Key("arenaCompactRatio").Int(); err == nil && arenaCompactRatio > 0 && arenaCompactRatio <= 100{
+			DefaultArenaCompactRatio = arenaCompactRatio
+		}
+
+		if mapMaxPackEntries, err := cfg.Section("").Key("mapMaxPackEntries").Int(); err == nil && mapMaxPackEntries >= 0{
+			DefaultMapMaxPackEntries = mapMaxPackEntries
+		}
+
+		if mapMaxPackValue, err := cfg.Section("").Key("mapMaxPackValue").Int(); err == nil && mapMaxPackValue >= 0{
+			DefaultMapMaxPackValue = mapMaxPackValue
+		}
+
+		if listMaxPackEntries, err := cfg.Section("").Key("listMaxPackEntries").Int(); err == nil && listMaxPackEntries >= 0{
+			DefaultListMaxPackEntries = listMaxPackEntries
+		}
+
+		if listMaxPackValue, err := cfg.Section("").Key("listMaxPackValue").Int(); err == nil && listMaxPackValue >= 0{
+			DefaultListMaxPackValue = listMaxPackValue
+		}
+
+		if setMaxPackEntries, err := cfg.Section("").Key("setMaxPackEntries").Int(); err == nil && setMaxPackEntries >= 0{
+			DefaultSetMaxPackEntries = setMaxPackEntries
+		}
+
+		if setMaxPackValue, err := cfg.Section("").Key("setMaxPackValue").Int(); err == nil && setMaxPackValue >= 0{
+			DefaultSetMaxPackValue = setMaxPackValue
+		}
+	}
+
+	for i, policy := range policies {
+		if policy == "" {
+			policies[i] = DefaultCachePolicy
+		}
 	}
 
-	Conf.ValueDBPath = path.Join(DefaultDBPath, "string")
-	Conf.MapDBPath = path.Join(DefaultDBPath, "map")
-	Conf.ListDBPath = path.Join(DefaultDBPath, "list")
-	Conf.SetDBPath = path.Join(DefaultDBPath, "set")
+	Conf.dbPaths = newDBPaths(DefaultDBPath)
 	Conf.RpcHost = DefaultRpcHost
 	Conf.ApiHost = DefaultApiHost
-	Conf.CheckExpireInterval = DefaultCheckExpireInterval
+	Conf.BloomErrorRate = DefaultBloomErrorRate
+	Conf.BloomCapacity = DefaultBloomCapacity
+	Conf.CuckooCapacity = DefaultCuckooCapacity
+	Conf.TSRetention = DefaultTSRetention
+	Conf.UnifiedKeyspace = DefaultUnifiedKeyspace
+	Conf.MaxDatabases = DefaultMaxDatabases
+	Conf.CachePolicy = policies
+	Conf.LFULogFactor = DefaultLFULogFactor
+	Conf.LFUDecayTime = DefaultLFUDecayTime
+	Conf.MaxMemory = DefaultMaxMemory
+	Conf.MaxMemoryPolicy = DefaultMaxMemoryPolicy
+	Conf.MaxMemorySamples = DefaultMaxMemorySamples
+	Conf.LRUShards = DefaultLRUShards
+	Conf.StringStorage = DefaultStringStorage
+	Conf.ArenaCompactInterval = DefaultArenaCompactInterval
+	Conf.ArenaCompactRatio = DefaultArenaCompactRatio
+	Conf.MapMaxPackEntries = DefaultMapMaxPackEntries
+	Conf.MapMaxPackValue = DefaultMapMaxPackValue
+	Conf.ListMaxPackEntries = DefaultListMaxPackEntries
+	Conf.ListMaxPackValue = DefaultListMaxPackValue
+	Conf.SetMaxPackEntries = DefaultSetMaxPackEntries
+	Conf.SetMaxPackValue = DefaultSetMaxPackValue
 
 }
\ No newline at end of file
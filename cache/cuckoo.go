@@ -0,0 +1,230 @@
+package cache
+
+import (
+	"errors"
+	"fmt"
+	"github.com/llr104/lightkv/cache/kv"
+	"io/ioutil"
+	"log"
+	"os"
+	"path/filepath"
+)
+
+/*
+布谷鸟过滤器，支持删除
+写操作修改的是lru中的过滤器，读写都在key的锁内完成，持久化时使用修改后的副本
+*/
+func (s *Cache) CFReserve(key string, capacity uint64, expire int64) error{
+	defer s.keyLocks.lock(key)()
+
+	if err := s.checkWrite(kv.CuckooData, key); err != nil {
+		return err
+	}
+
+	c, err := kv.NewCuckooContent(capacity)
+	if err != nil {
+		return err
+	}
+
+	if _, ok := s.cuckooValue(key); ok {
+		str := fmt.Sprintf("CFReserve Key:%s, already exists", key)
+		return errors.New(str)
+	}
+
+	b := kv.CuckooValue{Key: key, Data: c, Expire: expireTime(expire)}
+	s.cuckooLRU.PushFront(b)
+	snapshot := kv.CuckooValue{Key: key, Expire: b.Expire, Data: b.Data.Copy()}
+	s.persistCuckoo(kv.CuckooValue{Key: key}, snapshot)
+	return nil
+}
+
+/*
+添加多个元素，不存在的key按配置中的默认容量创建，nx 为true时已存在的元素不再添加
+返回每个元素是否添加成功
+*/
+func (s *Cache) CFAdd(key string, items []string, nx bool, expire int64) ([]bool, error){
+	defer s.keyLocks.lock(key)()
+
+	if err := s.checkWrite(kv.CuckooData, key); err != nil {
+		return nil, err
+	}
+
+	b, ok := s.cuckooValue(key)
+	old := kv.CuckooValue{Key: key, Expire: b.Expire}
+	if ok == false {
+		c, err := kv.NewCuckooContent(uint64(Conf.CuckooCapacity))
+		if err != nil {
+			return []bool{}, err
+		}
+		b = kv.CuckooValue{Key: key, Data: c}
+	}
+
+	r := make([]bool, len(items))
+	for i, item := range items {
+		r[i] = b.Data.Add(item, nx)
+	}
+
+	b.Expire = expireTime(expire)
+	s.cuckooLRU.PushFront(b)
+	snapshot := kv.CuckooValue{Key: key, Expire: b.Expire, Data: b.Data.Copy()}
+	s.persistCuckoo(old, snapshot)
+	return r, nil
+}
+
+/*
+判断多个元素是否存在，key不存在时都返回false
+*/
+func (s *Cache) CFExists(key string, items []string) ([]bool, error){
+	defer s.keyLocks.lock(key)()
+
+	if err := s.checkType(kv.CuckooData, key); err != nil {
+		return nil, err
+	}
+
+	r := make([]bool, len(items))
+	b, ok := s.cuckooValue(key)
+	if ok == false {
+		return r, nil
+	}
+
+	for i, item := range items {
+		r[i] = b.Data.Exists(item)
+	}
+	return r, nil
+}
+
+func (s *Cache) CFInfo(key string) (kv.CuckooInfo, error){
+	defer s.keyLocks.lock(key)()
+
+	if err := s.checkType(kv.CuckooData, key); err != nil {
+		return kv.CuckooInfo{}, err
+	}
+
+	b, ok := s.cuckooValue(key)
+	if ok == false {
+		str := fmt.Sprintf("CFInfo Key:%s, not found", key)
+		return kv.CuckooInfo{}, errors.New(str)
+	}
+	return b.Data.Info(), nil
+}
+
+/*
+删除一次添加过的元素，返回是否删除成功
+*/
+func (s *Cache) CFDelMember(key string, item string) (bool, error){
+	defer s.keyLocks.lock(key)()
+
+	if err := s.checkType(kv.CuckooData, key); err != nil {
+		return false, err
+	}
+
+	b, ok := s.cuckooValue(key)
+	if ok == false {
+		str := fmt.Sprintf("CFDelMember Key:%s, not found", key)
+		return false, errors.New(str)
+	}
+
+	if b.Data.Remove(item) == false {
+		return false, nil
+	}
+
+	s.cuckooLRU.PushFront(b)
+	snapshot := kv.CuckooValue{Key: key, Expire: b.Expire, Data: b.Data.Copy()}
+	op := kv.PersistentCuckooOp{Item: snapshot, OpType: kv.Add}
+	s.persistentCuckooChan <- op
+
+	if s.opFunction != nil{
+		s.opFunction(kv.Del, kv.CuckooValue{Key: key, Expire: b.Expire}, snapshot)
+	}
+	return true, nil
+}
+
+func (s *Cache) CFDel(key string) error{
+	defer s.keyLocks.lock(key)()
+
+	if err := s.checkType(kv.CuckooData, key); err != nil {
+		return err
+	}
+
+	return s.cfDel(key)
+}
+
+func (s *Cache) ClearCuckoo()  {
+	defer s.keyLocks.lockAll()()
+
+	s.cuckooLRU.Clear()
+	op := kv.PersistentCuckooOp{OpType: kv.Clear}
+	s.persistentCuckooChan <- op
+}
+
+func (s *Cache) CuckooCaches() ([]byte, error) {
+	defer s.keyLocks.lockAll()()
+
+	return s.cuckooLRU.CacheToString()
+}
+
+func (s *Cache) cuckooValue(key string) (kv.CuckooValue, bool){
+	v, err := s.cuckooLRU.Value(key)
+	if err != nil || v.IsExpire() {
+		return kv.CuckooValue{}, false
+	}
+	return v.(kv.CuckooValue), true
+}
+
+func (s *Cache) persistCuckoo(old kv.CuckooValue, v kv.CuckooValue) {
+	op := kv.PersistentCuckooOp{Item: v, OpType: kv.Add}
+	s.persistentCuckooChan <- op
+
+	if s.opFunction != nil{
+		s.opFunction(kv.Add, old, v)
+	}
+}
+
+func (s *Cache) cfDel(key string) error{
+	oldVal, err := s.cuckooLRU.Lookup(key)
+	if err != nil{
+		return err
+	}
+
+	log.Printf("cfDel Key:%s", key)
+	s.cuckooLRU.Remove(key)
+
+	s.cuckooExpire(key, oldVal)
+
+	return nil
+}
+
+func (s *Cache) cuckooExpire(key string, v kv.ValueCache){
+
+	val := kv.CuckooValue{Key: key, Expire: kv.ExpireForever}
+	op := kv.PersistentCuckooOp{Item: val, OpType: kv.Del}
+	s.persistentCuckooChan <- op
+
+	if s.opFunction != nil{
+		s.opFunction(kv.Del, v, nil)
+	}
+}
+
+func (s *Cache) saveCuckoo(key string, v kv.CuckooValue) {
+	b := encodeCuckoo(v)
+
+	fullPath := filepath.Join(s.paths.CuckooDBPath, key)
+	path, _ := filepath.Split(fullPath)
+
+	createDir(path)
+
+	err := ioutil.WriteFile(fullPath, b, os.ModePerm)
+	if err != nil{
+		log.Printf("saveCuckoo error:%s", err.Error())
+	}
+}
+
+func (s *Cache) delCuckoo(key string)  {
+	fullPath := filepath.Join(s.paths.CuckooDBPath, key)
+	os.Remove(fullPath)
+}
+
+func (s *Cache) clearCuckoo()  {
+	os.RemoveAll(s.paths.CuckooDBPath)
+	createDir(s.paths.CuckooDBPath)
+}
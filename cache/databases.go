@@ -0,0 +1,175 @@
+package cache
+
+import (
+	"errors"
+	"fmt"
+	"github.com/llr104/lightkv/cache/kv"
+	"io/ioutil"
+	"log"
+	"path"
+	"sort"
+	"sync"
+)
+
+/*
+默认数据库名，持久化在 dbPath 下，兼容之前的目录结构
+*/
+const DefaultDB = "0"
+
+/*
+其他数据库持久化在 dbPath/databases/<name> 下
+*/
+const DatabaseDir = "databases"
+
+const maxDBNameLen = 64
+
+/*
+请求的数据库还没有创建，需要先调用 Create
+*/
+type DBNotFoundError struct {
+	Name string
+}
+
+func (e DBNotFoundError) Error() string {
+	return fmt.Sprintf("NODB no such database:%s", e.Name)
+}
+
+func IsDBNotFound(err error) bool {
+	var e DBNotFoundError
+	return errors.As(err, &e)
+}
+
+/*
+逻辑数据库，每个数据库有独立的lru、过期调度、持久化目录，清空操作只影响所在的数据库
+*/
+type Databases struct {
+	mutex      sync.RWMutex
+	dbs        map[string]*Cache
+	opFunction func(string, kv.OpType, kv.ValueCache, kv.ValueCache)
+}
+
+/*
+打开默认数据库和 dbPath/databases 下已经存在的数据库
+*/
+func NewDatabases() *Databases {
+	s := &Databases{dbs: make(map[string]*Cache)}
+	s.dbs[DefaultDB] = NewCache()
+
+	infos, err := ioutil.ReadDir(path.Join(DefaultDBPath, DatabaseDir))
+	if err != nil {
+		return s
+	}
+	for _, f := range infos {
+		if f.IsDir() == false {
+			continue
+		}
+		if err := checkDBName(f.Name()); err != nil {
+			log.Printf("skip database dir:%s, %s", f.Name(), err.Error())
+			continue
+		}
+		s.open(f.Name())
+	}
+	return s
+}
+
+func checkDBName(name string) error {
+	if len(name) == 0 || len(name) > maxDBNameLen {
+		str := fmt.Sprintf("invalid db:%s, length must be 1-%d", name, maxDBNameLen)
+		return errors.New(str)
+	}
+	for _, c := range name {
+		if (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z') || (c >= '0' && c <= '9') || c == '_' || c == '-' {
+			continue
+		}
+		str := fmt.Sprintf("invalid db:%s, only letters, digits, '_' and '-' are allowed", name)
+		return errors.New(str)
+	}
+	return nil
+}
+
+/*
+获取已经存在的数据库，name 为空时是默认数据库，不存在时返回 DBNotFoundError，不会创建
+*/
+func (s *Databases) Get(name string) (*Cache, error) {
+	if name == "" {
+		name = DefaultDB
+	}
+
+	s.mutex.RLock()
+	c, ok := s.dbs[name]
+	s.mutex.RUnlock()
+	if ok {
+		return c, nil
+	}
+
+	if err := checkDBName(name); err != nil {
+		return nil, err
+	}
+	return nil, DBNotFoundError{Name: name}
+}
+
+/*
+创建数据库和它的持久化目录，已经存在时直接返回，created 为false
+*/
+func (s *Databases) Create(name string) (bool, error) {
+	if err := checkDBName(name); err != nil {
+		return false, err
+	}
+
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	if _, ok := s.dbs[name]; ok {
+		return false, nil
+	}
+	if len(s.dbs) >= Conf.MaxDatabases {
+		str := fmt.Sprintf("too many databases, max:%d", Conf.MaxDatabases)
+		return false, errors.New(str)
+	}
+	s.open(name)
+	return true, nil
+}
+
+func (s *Databases) open(name string) *Cache {
+	log.Printf("open database:%s", name)
+	c := newCache(name, newDBPaths(path.Join(DefaultDBPath, DatabaseDir, name)))
+	if s.opFunction != nil {
+		c.SetOnOP(s.onOP(name))
+	}
+	s.dbs[name] = c
+	return c
+}
+
+/*
+所有已经打开的数据库名
+*/
+func (s *Databases) Names() []string {
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+
+	names := make([]string, 0, len(s.dbs))
+	for name := range s.dbs {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+/*
+监听所有数据库的操作，回调中带上数据库名
+*/
+func (s *Databases) SetOnOP(opFunc func(string, kv.OpType, kv.ValueCache, kv.ValueCache)) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	s.opFunction = opFunc
+	for name, c := range s.dbs {
+		c.SetOnOP(s.onOP(name))
+	}
+}
+
+func (s *Databases) onOP(name string) func(kv.OpType, kv.ValueCache, kv.ValueCache) {
+	return func(op kv.OpType, before kv.ValueCache, after kv.ValueCache) {
+		s.opFunction(name, op, before, after)
+	}
+}
@@ -0,0 +1,104 @@
+package cache
+
+import (
+	"io/ioutil"
+	"os"
+	"testing"
+)
+
+/*
+数据库目录放到临时目录下，结束后恢复
+*/
+func withDBPath(t *testing.T) func() {
+	dir, err := ioutil.TempDir("", "lightkv")
+	if err != nil {
+		t.Fatal(err)
+	}
+	oldPath, oldPaths, oldMax := DefaultDBPath, Conf.dbPaths, Conf.MaxDatabases
+	DefaultDBPath = dir
+	Conf.dbPaths = newDBPaths(dir)
+	return func() {
+		DefaultDBPath, Conf.dbPaths, Conf.MaxDatabases = oldPath, oldPaths, oldMax
+		os.RemoveAll(dir)
+	}
+}
+
+func TestDatabases(t *testing.T) {
+	defer withDBPath(t)()
+	Conf.MaxDatabases = 3
+	dbs := NewDatabases()
+
+	tests := []struct {
+		name    string
+		create  bool
+		created bool
+		err     bool
+		noDB    bool
+	}{
+		{"", false, false, false, false},
+		{DefaultDB, false, false, false, false},
+		{"a", false, false, true, true},
+		{"a", true, true, false, false},
+		{"a", true, false, false, false},
+		{"bad name", true, false, true, false},
+		{"bad/name", false, false, true, false},
+		{"b-1", true, true, false, false},
+		{"c", true, false, true, false},
+		{"c", false, false, true, true},
+	}
+
+	for _, tt := range tests {
+		if tt.create {
+			created, err := dbs.Create(tt.name)
+			if (err != nil) != tt.err || created != tt.created {
+				t.Fatalf("Create(%q) = %v %v, want created %v, error %v", tt.name, created, err, tt.created, tt.err)
+			}
+			continue
+		}
+		_, err := dbs.Get(tt.name)
+		if (err != nil) != tt.err || IsDBNotFound(err) != tt.noDB {
+			t.Fatalf("Get(%q) error %v, want error %v, NODB %v", tt.name, err, tt.err, tt.noDB)
+		}
+	}
+}
+
+/*
+不同数据库的键互不影响，清空只作用于所在的数据库，重新打开时加载已经创建的数据库
+*/
+func TestDatabasesIsolation(t *testing.T) {
+	defer withDBPath(t)()
+	dbs := NewDatabases()
+	dbs.Create("a")
+	def, _ := dbs.Get("")
+	a, _ := dbs.Get("a")
+
+	def.Put("k", "default", 0)
+	a.Put("k", "a", 0)
+	a.Put("only", "a", 0)
+	if v, _ := def.Get("k"); v != "default" {
+		t.Fatalf("default db Key:k is %q", v)
+	}
+	if _, err := def.Get("only"); err == nil {
+		t.Fatal("Key:only of db a is visible in default db")
+	}
+
+	def.ClearString()
+	if v, _ := a.Get("k"); v != "a" {
+		t.Fatalf("clear default db changed db a, Key:k is %q", v)
+	}
+	syncTx(def)
+	syncTx(a)
+
+	reopen := NewDatabases()
+	if names := reopen.Names(); len(names) != 2 || names[0] != DefaultDB || names[1] != "a" {
+		t.Fatalf("reopened databases %v, want [%s a]", names, DefaultDB)
+	}
+	a, _ = reopen.Get("a")
+	def, _ = reopen.Get("")
+	if v, _ := a.Get("k"); v != "a" {
+		t.Fatalf("reopened db a Key:k is %q, want a", v)
+	}
+	if _, err := def.Get("k"); err == nil {
+		t.Fatal("cleared Key:k is loaded into default db")
+	}
+}
@@ -0,0 +1,229 @@
+package cache
+
+import (
+	"container/heap"
+	"github.com/llr104/lightkv/cache/kv"
+	"log"
+	"sync"
+	"time"
+)
+
+/*
+每次最多处理的过期key个数，处理完一批后释放锁再处理下一批
+*/
+const expireBatch = 1000
+
+type expireKey struct {
+	dataType int32
+	key      string
+}
+
+type expireItem struct {
+	expireKey
+	expire int64
+	index  int
+}
+
+/*
+按过期时间排序的最小堆
+*/
+type expireHeap []*expireItem
+
+func (h expireHeap) Len() int           { return len(h) }
+func (h expireHeap) Less(i, j int) bool { return h[i].expire < h[j].expire }
+func (h expireHeap) Swap(i, j int) {
+	h[i], h[j] = h[j], h[i]
+	h[i].index = i
+	h[j].index = j
+}
+
+func (h *expireHeap) Push(x interface{}) {
+	item := x.(*expireItem)
+	item.index = len(*h)
+	*h = append(*h, item)
+}
+
+func (h *expireHeap) Pop() interface{} {
+	old := *h
+	n := len(old)
+	item := old[n-1]
+	old[n-1] = nil
+	*h = old[:n-1]
+	return item
+}
+
+/*
+过期调度器，lru 添加或删除key时同步更新，到期的key交给 onExpire 删除
+*/
+type expireQueue struct {
+	mutex    sync.Mutex
+	heap     expireHeap
+	items    map[expireKey]*expireItem
+	wake     chan struct{}
+	onExpire func(dataType int32, key string)
+}
+
+func newExpireQueue(onExpire func(dataType int32, key string)) *expireQueue {
+	return &expireQueue{
+		heap:     expireHeap{},
+		items:    make(map[expireKey]*expireItem),
+		wake:     make(chan struct{}, 1),
+		onExpire: onExpire,
+	}
+}
+
+/*
+设置key的过期时间，expire 为 ExpireForever 时取消
+*/
+func (s *expireQueue) Set(dataType int32, key string, expire int64) {
+	if expire == kv.ExpireForever {
+		s.Remove(dataType, key)
+		return
+	}
+
+	s.mutex.Lock()
+	k := expireKey{dataType, key}
+	if item, ok := s.items[k]; ok {
+		item.expire = expire
+		heap.Fix(&s.heap, item.index)
+	}else{
+		item = &expireItem{expireKey: k, expire: expire}
+		heap.Push(&s.heap, item)
+		s.items[k] = item
+	}
+	first := s.heap[0].expireKey == k
+	s.mutex.Unlock()
+
+	//最早过期的key变了，唤醒调度协程重新计时
+	if first {
+		select {
+		case s.wake <- struct{}{}:
+		default:
+		}
+	}
+}
+
+func (s *expireQueue) Remove(dataType int32, key string) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	k := expireKey{dataType, key}
+	if item, ok := s.items[k]; ok {
+		heap.Remove(&s.heap, item.index)
+		delete(s.items, k)
+	}
+}
+
+/*
+删除一种类型的所有key
+*/
+func (s *expireQueue) RemoveType(dataType int32) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	h := s.heap[:0]
+	for _, item := range s.heap {
+		if item.dataType == dataType {
+			delete(s.items, item.expireKey)
+		}else{
+			h = append(h, item)
+		}
+	}
+	for i := len(h); i < len(s.heap); i++ {
+		s.heap[i] = nil
+	}
+	s.heap = h
+	for i, item := range s.heap {
+		item.index = i
+	}
+	heap.Init(&s.heap)
+}
+
+func (s *expireQueue) Len() int {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	return len(s.heap)
+}
+
+/*
+取出已经到期的key，返回下一个key的过期时间，没有key时返回 ExpireForever
+*/
+func (s *expireQueue) due(now int64) ([]expireKey, int64) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	r := make([]expireKey, 0)
+	for len(s.heap) > 0 && len(r) < expireBatch && s.heap[0].expire <= now {
+		item := heap.Pop(&s.heap).(*expireItem)
+		delete(s.items, item.expireKey)
+		r = append(r, item.expireKey)
+	}
+
+	if len(s.heap) == 0 {
+		return r, kv.ExpireForever
+	}
+	return r, s.heap[0].expire
+}
+
+func (s *expireQueue) run() {
+	timer := time.NewTimer(time.Hour)
+	for {
+		keys, next := s.due(time.Now().UnixNano())
+		for _, k := range keys {
+			s.onExpire(k.dataType, k.key)
+		}
+		if len(keys) >= expireBatch {
+			continue
+		}
+
+		wait := time.Hour
+		if next != kv.ExpireForever {
+			wait = time.Duration(next - time.Now().UnixNano())
+		}
+
+		if timer.Stop() == false {
+			select {
+			case <-timer.C:
+			default:
+			}
+		}
+		timer.Reset(wait)
+
+		select {
+		case <-timer.C:
+		case <-s.wake:
+		}
+	}
+}
+
+/*
+到期后在key的锁内确认key确实已经过期再删除，删除后持久化并通知监听者
+*/
+func (s *Cache) expireKey(dataType int32, key string) {
+	h := s.typeHandles()[dataType]
+	unlock := h.lockKey(key)
+
+	v, ok := h.lru.Peek(key)
+	if ok == false || v.IsExpire() == false {
+		unlock()
+		return
+	}
+
+	h.lru.Remove(key)
+	changed := make([]kv.TSValue, 0)
+	if t, ok := v.(kv.TSValue); ok {
+		changed = s.tsUnlink(t)
+	}
+	//在锁内持久化删除，之后重新写入的key不会被删除
+	s.persistDel(v)
+	unlock()
+
+	log.Printf("expire type:%s Key:%s", kv.DataTypeNames[dataType], key)
+	if s.opFunction != nil{
+		s.opFunction(kv.Expired, v, nil)
+	}
+
+	for _, t := range changed {
+		s.persistTS(kv.TSValue{Key: t.Key, Expire: t.Expire}, t)
+	}
+}
@@ -0,0 +1,119 @@
+package cache
+
+import (
+	"fmt"
+	"github.com/llr104/lightkv/cache/kv"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestExpireQueue(t *testing.T) {
+	q := newExpireQueue(nil)
+	tests := []struct {
+		name string
+		op   func()
+		now  int64
+		want string
+		next int64
+	}{
+		{"empty", func() {}, 1000, "[]", kv.ExpireForever},
+		{"order", func() {
+			q.Set(kv.ValueData, "a", 300)
+			q.Set(kv.ValueData, "b", 100)
+			q.Set(kv.MapData, "a", 200)
+		}, 50, "[]", 100},
+		{"update", func() { q.Set(kv.ValueData, "b", 400) }, 250, "[{1 a}]", 300},
+		{"forever removes", func() { q.Set(kv.ValueData, "a", kv.ExpireForever) }, 350, "[]", 400},
+		{"remove type", func() {
+			q.Set(kv.SetData, "c", 500)
+			q.Set(kv.SetData, "d", 600)
+			q.RemoveType(kv.SetData)
+		}, 350, "[]", 400},
+		{"remove", func() {
+			q.Set(kv.ListData, "e", 420)
+			q.Remove(kv.ValueData, "b")
+		}, 1000, "[{2 e}]", kv.ExpireForever},
+	}
+
+	for _, tt := range tests {
+		tt.op()
+		keys, next := q.due(tt.now)
+		if fmt.Sprint(keys) != tt.want || next != tt.next {
+			t.Fatalf("%s: due %v next %d, want %s next %d", tt.name, keys, next, tt.want, tt.next)
+		}
+	}
+	if q.Len() != 0 {
+		t.Fatalf("queue has %d keys left", q.Len())
+	}
+}
+
+/*
+不访问过期的key也会被删除，删除后持久化并通知监听者，过期前重新写入的key不会被删除
+*/
+func TestActiveExpire(t *testing.T) {
+	c, clean := newTestCache(t)
+	defer clean()
+
+	var mutex sync.Mutex
+	expired := make(map[string]bool)
+	c.SetOnOP(func(op kv.OpType, before kv.ValueCache, after kv.ValueCache) {
+		if op == kv.Expired {
+			mutex.Lock()
+			expired[before.GetKey()] = true
+			mutex.Unlock()
+		}
+	})
+
+	c.PutEx("s", "v", kv.ExpireMillis(30))
+	c.HMPut("m", []string{"f"}, []string{"v"}, 1)
+	c.PFAdd("h", []string{"v"}, 1)
+	c.JSet("j", "$", "1", 1)
+	c.TSAdd("ts", []kv.TSSample{{Time: 1, Value: 1}}, 1)
+	c.PutEx("keep", "v", kv.ExpireMillis(30))
+	c.Put("keep", "v", 0)
+
+	tests := []struct {
+		key      string
+		dataType int32
+		expired  bool
+	}{
+		{"s", kv.ValueData, true},
+		{"m", kv.MapData, true},
+		{"h", kv.HLLData, true},
+		{"j", kv.JSONData, true},
+		{"ts", kv.TSData, true},
+		{"keep", kv.ValueData, false},
+	}
+
+	//通知在持久化删除之后发出
+	notified := func(key string) bool {
+		mutex.Lock()
+		defer mutex.Unlock()
+		return expired[key]
+	}
+	deadline := time.Now().Add(3 * time.Second)
+	for _, tt := range tests {
+		for tt.expired && notified(tt.key) == false && time.Now().Before(deadline) {
+			time.Sleep(10 * time.Millisecond)
+		}
+	}
+
+	syncTx(c)
+	for _, tt := range tests {
+		if notified(tt.key) != tt.expired {
+			t.Fatalf("Key:%s expired notification %v, want %v", tt.key, notified(tt.key), tt.expired)
+		}
+		if _, ok := c.typeHandles()[tt.dataType].lru.Peek(tt.key); ok == tt.expired {
+			t.Fatalf("Key:%s removed %v, want %v", tt.key, ok == false, tt.expired)
+		}
+	}
+	if _, err := os.Stat(filepath.Join(c.paths.ValueDBPath, "s")); err == nil {
+		t.Fatal("file of expired Key:s is not deleted")
+	}
+	if _, err := os.Stat(filepath.Join(c.paths.ValueDBPath, "keep")); err != nil {
+		t.Fatal("file of Key:keep is deleted")
+	}
+}
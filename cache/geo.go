@@ -0,0 +1,231 @@
+package cache
+
+import (
+	"errors"
+	"fmt"
+	"github.com/llr104/lightkv/cache/kv"
+	"io/ioutil"
+	"log"
+	"os"
+	"path/filepath"
+)
+
+/*
+geo
+*/
+func (s *Cache) GeoAdd(key string, members []kv.GeoMember, expire int64) (int, error){
+	return s.GeoAddEx(key, members, kv.KeepOrExpireSeconds(expire))
+}
+
+/*
+e 和 PutEx 相同，kv.KeepTTL 保留key原有的过期时间
+*/
+func (s *Cache) GeoAddEx(key string, members []kv.GeoMember, e kv.Expiration) (int, error){
+	defer s.keyLocks.lock(key)()
+
+	if err := s.checkWrite(kv.GeoData, key); err != nil {
+		return 0, err
+	}
+
+	if err := e.Check(); err != nil {
+		return 0, err
+	}
+
+	for _, m := range members {
+		if err := kv.CheckGeoPoint(m.Longitude, m.Latitude); err != nil {
+			return 0, err
+		}
+	}
+
+	var oldVal kv.ValueCache
+	var g kv.GeoValue
+
+	if v, err := s.geoLRU.Lookup(key); err != nil || v.IsExpire() {
+		g = kv.GeoValue{Key: key, Data: kv.NewGeoContent()}
+		oldVal = kv.GeoValue{Key: key}
+	}else{
+		//写入时复制，lru中原来的值可能正在被读取或者持久化
+		oldVal = v
+		g = v.(kv.GeoValue)
+		g.Data = kv.CopyGeo(g.Data)
+	}
+
+	g.Expire = e.Deadline(liveExpire(oldVal))
+	n := g.Add(members)
+	s.geoLRU.PushFront(g)
+
+	op := kv.PersistentGeoOp{Item: g, OpType: kv.Add}
+	s.persistentGeoChan <- op
+
+	if s.opFunction != nil{
+		s.opFunction(kv.Add, oldVal, g)
+	}
+
+	return n, nil
+}
+
+/*
+获取成员坐标，不存在的成员不返回
+*/
+func (s *Cache) GeoPos(key string, members []string) ([]kv.GeoMember, error){
+	if err := s.checkType(kv.GeoData, key); err != nil {
+		return nil, err
+	}
+
+	g, err := s.geoValue("GeoPos", key)
+	if err != nil {
+		return []kv.GeoMember{}, err
+	}
+
+	r := make([]kv.GeoMember, 0, len(members))
+	for _, m := range members {
+		if p, ok := g.Get(m); ok {
+			r = append(r, kv.GeoMember{Name: m, Longitude: p.Longitude, Latitude: p.Latitude})
+		}
+	}
+	return r, nil
+}
+
+func (s *Cache) GeoDist(key string, member1 string, member2 string, unit string) (float64, error){
+	if err := s.checkType(kv.GeoData, key); err != nil {
+		return 0, err
+	}
+
+	g, err := s.geoValue("GeoDist", key)
+	if err != nil {
+		return 0, err
+	}
+	return g.Dist(member1, member2, unit)
+}
+
+func (s *Cache) GeoSearch(key string, opt kv.GeoSearchOption) ([]kv.GeoMember, error){
+	if err := s.checkType(kv.GeoData, key); err != nil {
+		return nil, err
+	}
+
+	g, err := s.geoValue("GeoSearch", key)
+	if err != nil {
+		return []kv.GeoMember{}, err
+	}
+	return g.Search(opt)
+}
+
+func (s *Cache) GeoDelMember(key string, member string) error{
+	defer s.keyLocks.lock(key)()
+
+	if err := s.checkType(kv.GeoData, key); err != nil {
+		return err
+	}
+
+	val, err := s.geoLRU.Value(key)
+	if err != nil {
+		str := fmt.Sprintf("GeoDelMember not have key:%s geo", key)
+		return errors.New(str)
+	}
+
+	if val.IsExpire(){
+		str := fmt.Sprintf("GeoDelMember Key:%s, not found ", key)
+		return errors.New(str)
+	}
+
+	old := val.(kv.GeoValue)
+	g := old
+
+	if _, ok := g.Get(member); ok {
+		g.Data = kv.CopyGeo(old.Data)
+		g.Remove(member)
+		s.geoLRU.PushFront(g)
+
+		op := kv.PersistentGeoOp{Item: g, OpType: kv.Del}
+		s.persistentGeoChan <- op
+
+		if s.opFunction != nil{
+			s.opFunction(kv.Del, old, g)
+		}
+	}
+
+	return nil
+}
+
+func (s *Cache) GeoDel(key string) error{
+	defer s.keyLocks.lock(key)()
+
+	if err := s.checkType(kv.GeoData, key); err != nil {
+		return err
+	}
+
+	return s.geoDel(key)
+}
+
+func (s *Cache) ClearGeo()  {
+	s.geoLRU.Clear()
+	op := kv.PersistentGeoOp{OpType: kv.Clear}
+	s.persistentGeoChan <- op
+}
+
+func (s *Cache) GeoCaches() ([]byte, error) {
+	return s.geoLRU.CacheToString()
+}
+
+func (s *Cache) geoValue(opName string, key string) (kv.GeoValue, error){
+	v, err := s.geoLRU.Value(key)
+	if err != nil {
+		str := fmt.Sprintf("%s Key:%s, not found", opName, key)
+		return kv.GeoValue{}, errors.New(str)
+	}
+
+	if v.IsExpire() {
+		str := fmt.Sprintf("%s Key:%s, is expire ", opName, key)
+		return kv.GeoValue{}, errors.New(str)
+	}
+	return v.(kv.GeoValue), nil
+}
+
+func (s *Cache) geoDel(key string) error{
+	oldVal, err := s.geoLRU.Lookup(key)
+	if err != nil{
+		return err
+	}
+
+	log.Printf("geoDel Key:%s", key)
+	s.geoLRU.Remove(key)
+
+	s.geoExpire(key, oldVal)
+
+	return nil
+}
+
+func (s *Cache) geoExpire(key string, v kv.ValueCache){
+
+	val := kv.GeoValue{Key: key, Expire: kv.ExpireForever, Data: kv.NewGeoContent()}
+	op := kv.PersistentGeoOp{Item: val, OpType: kv.Del}
+	s.persistentGeoChan <- op
+
+	if s.opFunction != nil{
+		s.opFunction(kv.Del, v, nil)
+	}
+}
+
+func (s *Cache) saveGeo(key string, v kv.GeoValue) {
+	b := encodeGeo(v)
+
+	fullPath := filepath.Join(s.paths.GeoDBPath, key)
+	path, _ := filepath.Split(fullPath)
+
+	createDir(path)
+
+	err := ioutil.WriteFile(fullPath, b, os.ModePerm)
+	if err != nil{
+		log.Printf("saveGeo error:%s", err.Error())
+	}
+}
+
+func (s *Cache) delGeo(key string)  {
+	fullPath := filepath.Join(s.paths.GeoDBPath, key)
+	os.Remove(fullPath)
+}
+
+func (s *Cache) clearGeo()  {
+	os.RemoveAll(s.paths.GeoDBPath)
+	createDir(s.paths.GeoDBPath)
+}
@@ -0,0 +1,108 @@
+package cache
+
+import (
+	"fmt"
+	"github.com/llr104/lightkv/cache/kv"
+	"sort"
+	"strconv"
+	"testing"
+	"time"
+)
+
+/*
+HMGet、LGet、LGetRange、SGet 直接返回类型化的数据，紧凑编码和完整的结构结果相同
+*/
+func TestTypedGet(t *testing.T) {
+	unified := Conf.UnifiedKeyspace
+	Conf.UnifiedKeyspace = true
+	defer func() { Conf.UnifiedKeyspace = unified }()
+
+	for _, entries := range []int{0, 128} {
+		restore := withPackLimits(entries, 64)
+		c, clean := newTestCache(t)
+
+		c.HMPut("m", []string{"a", "b"}, []string{"1", "2"}, 0)
+		c.LPut("l", []string{"x", "y", "z"}, 0)
+		c.SPut("s", []string{"b", "a"}, 0)
+		c.Put("str", "v", 0)
+		c.LPutEx("old", []string{"x"}, kv.ExpireMillis(1))
+		time.Sleep(5 * time.Millisecond)
+
+		sorted := func(l []string, err error) (string, error) {
+			l = append([]string{}, l...)
+			sort.Strings(l)
+			return fmt.Sprint(l), err
+		}
+		tests := []struct {
+			name string
+			read func() (string, error)
+			want string
+			err  bool
+		}{
+			{"hmget", func() (string, error) { m, err := c.HMGet("m"); return fmt.Sprint(m), err }, "map[a:1 b:2]", false},
+			{"hmget missing", func() (string, error) { m, err := c.HMGet("none"); return fmt.Sprint(m), err }, "map[]", true},
+			{"hmget wrong type", func() (string, error) { _, err := c.HMGet("str"); return "", err }, "", true},
+			{"lget", func() (string, error) { l, err := c.LGet("l"); return fmt.Sprint(l), err }, "[x y z]", false},
+			{"lget expired", func() (string, error) { l, err := c.LGet("old"); return fmt.Sprint(l), err }, "[]", true},
+			{"lgetrange", func() (string, error) { l, err := c.LGetRange("l", 1, 3); return fmt.Sprint(l), err }, "[y z]", false},
+			{"lgetrange empty", func() (string, error) { l, err := c.LGetRange("l", 3, 5); return fmt.Sprint(l), err }, "[]", false},
+			{"lgetrange reversed", func() (string, error) { _, err := c.LGetRange("l", 2, 1); return "", err }, "", true},
+			{"sget", func() (string, error) { return sorted(c.SGet("s")) }, "[a b]", false},
+			{"sget wrong type", func() (string, error) { _, err := c.SGet("m"); return "", err }, "", true},
+		}
+		for _, tt := range tests {
+			got, err := tt.read()
+			if (err != nil) != tt.err || got != tt.want {
+				t.Fatalf("pack %d %s: got %q %v, want %q error %v", entries, tt.name, got, err, tt.want, tt.err)
+			}
+		}
+
+		clean()
+		restore()
+	}
+}
+
+/*
+读取返回的map、切片和lru共享，之后的写入复制出新的值，已经返回的结果不变，追加读取结果也不会改变保存的值
+*/
+func TestTypedGetShared(t *testing.T) {
+	defer withPackLimits(0, 0)()
+	c, clean := newTestCache(t)
+	defer clean()
+
+	c.HMPut("m", []string{"a"}, []string{"1"}, 0)
+	c.LPut("l", []string{"x", "y", "z"}, 0)
+	m, _ := c.HMGet("m")
+	l, _ := c.LGet("l")
+	r, _ := c.LGetRange("l", 0, 1)
+
+	c.HMPut("m", []string{"a", "b"}, []string{"2", "3"}, 0)
+	c.HMDelMember("m", "b")
+	c.LPut("l", []string{"w"}, 0)
+	c.LDelRange("l", 0, 1)
+	_ = append(r, "appended")
+
+	if fmt.Sprint(m) != "map[a:1]" || fmt.Sprint(l) != "[x y z]" {
+		t.Fatalf("results changed by later writes: %v %v", m, l)
+	}
+	if l, _ := c.LGet("l"); fmt.Sprint(l) != "[y z w]" {
+		t.Fatalf("Key:l is %v, want [y z w]", l)
+	}
+
+	//读取的同时写入，-race 检查读到的map没有被原地修改
+	parallel(testWriters, 4, func(g int) {
+		for i := 0; i < testRounds; i++ {
+			c.HMPut("m", []string{strconv.Itoa(g)}, []string{strconv.Itoa(i)}, 0)
+			c.LPut("l", []string{strconv.Itoa(i)}, 0)
+		}
+	}, func() {
+		m, _ := c.HMGet("m")
+		for k, v := range m {
+			_ = k + v
+		}
+		l, _ := c.LGet("l")
+		for _, v := range l {
+			_ = v
+		}
+	})
+}
@@ -0,0 +1,203 @@
+package cache
+
+import (
+	"errors"
+	"fmt"
+	"github.com/llr104/lightkv/cache/kv"
+	"io/ioutil"
+	"log"
+	"os"
+	"path/filepath"
+)
+
+/*
+HyperLogLog
+*/
+func (s *Cache) PFAdd(key string, elements []string, expire int64) (bool, error){
+	return s.PFAddEx(key, elements, kv.KeepOrExpireSeconds(expire))
+}
+
+/*
+e 和 PutEx 相同，kv.KeepTTL 保留key原有的过期时间
+*/
+func (s *Cache) PFAddEx(key string, elements []string, e kv.Expiration) (bool, error){
+	defer s.keyLocks.lock(key)()
+
+	if err := s.checkWrite(kv.HLLData, key); err != nil {
+		return false, err
+	}
+
+	if err := e.Check(); err != nil {
+		return false, err
+	}
+
+	var oldVal kv.ValueCache
+	var h kv.HLLValue
+
+	if v, err := s.hllLRU.Lookup(key); err != nil || v.IsExpire() {
+		h = kv.HLLValue{Key: key, Data: kv.NewHLLContent()}
+		oldVal = kv.HLLValue{Key: key}
+	}else{
+		//写入时复制，lru中原来的值可能正在被读取或者持久化
+		oldVal = v
+		h = v.(kv.HLLValue)
+		h.Data = append(kv.HLLContent{}, h.Data...)
+	}
+
+	h.Expire = e.Deadline(liveExpire(oldVal))
+	changed := h.Add(elements...)
+	s.hllLRU.PushFront(h)
+
+	op := kv.PersistentHLLOp{Item: h, OpType: kv.Add}
+	s.persistentHLLChan <- op
+
+	if s.opFunction != nil{
+		s.opFunction(kv.Add, oldVal, h)
+	}
+
+	return changed, nil
+}
+
+/*
+多个key时返回并集的基数估算，不存在的key按空集处理
+*/
+func (s *Cache) PFCount(keys []string) (uint64, error){
+	if err := s.checkType(kv.HLLData, keys...); err != nil {
+		return 0, err
+	}
+
+	if len(keys) == 0 {
+		return 0, errors.New("PFCount need at least one key")
+	}
+
+	if len(keys) == 1 {
+		v, err := s.hllLRU.Value(keys[0])
+		if err != nil || v.IsExpire() {
+			return 0, nil
+		}
+		return v.(kv.HLLValue).Count(), nil
+	}
+
+	u := kv.HLLValue{Data: kv.NewHLLContent()}
+	for _, k := range keys {
+		if v, err := s.hllLRU.Value(k); err == nil && v.IsExpire() == false {
+			u.Merge(v.(kv.HLLValue))
+		}
+	}
+	return u.Count(), nil
+}
+
+/*
+把srcKeys合并到destKey，destKey不存在时新建，只锁住destKey，srcKeys读取的是不会再被修改的值
+*/
+func (s *Cache) PFMerge(destKey string, srcKeys []string) error{
+	defer s.keyLocks.lock(destKey)()
+
+	if err := s.checkType(kv.HLLData, srcKeys...); err != nil {
+		return err
+	}
+	if err := s.checkWrite(kv.HLLData, destKey); err != nil {
+		return err
+	}
+
+	var oldVal kv.ValueCache
+	var h kv.HLLValue
+
+	if v, err := s.hllLRU.Lookup(destKey); err != nil || v.IsExpire() {
+		h = kv.HLLValue{Key: destKey, Data: kv.NewHLLContent(), Expire: kv.ExpireForever}
+		oldVal = kv.HLLValue{Key: destKey}
+	}else{
+		oldVal = v
+		h = v.(kv.HLLValue)
+		h.Data = append(kv.HLLContent{}, h.Data...)
+	}
+
+	for _, k := range srcKeys {
+		v, err := s.hllLRU.Value(k)
+		if err != nil || v.IsExpire() {
+			continue
+		}
+		h.Merge(v.(kv.HLLValue))
+	}
+
+	s.hllLRU.PushFront(h)
+
+	op := kv.PersistentHLLOp{Item: h, OpType: kv.Add}
+	s.persistentHLLChan <- op
+
+	if s.opFunction != nil{
+		s.opFunction(kv.Add, oldVal, h)
+	}
+
+	return nil
+}
+
+func (s *Cache) PFDel(key string) error{
+	defer s.keyLocks.lock(key)()
+
+	if err := s.checkType(kv.HLLData, key); err != nil {
+		return err
+	}
+
+	return s.pfDel(key)
+}
+
+func (s *Cache) ClearHLL()  {
+	s.hllLRU.Clear()
+	op := kv.PersistentHLLOp{OpType: kv.Clear}
+	s.persistentHLLChan <- op
+}
+
+func (s *Cache) HLLCaches() ([]byte, error) {
+	return s.hllLRU.CacheToString()
+}
+
+func (s *Cache) pfDel(key string) error{
+	oldVal, err := s.hllLRU.Lookup(key)
+	if err != nil{
+		str := fmt.Sprintf("not have key:%s hll", key)
+		return errors.New(str)
+	}
+
+	log.Printf("pfDel Key:%s", key)
+	s.hllLRU.Remove(key)
+
+	s.hllExpire(key, oldVal)
+
+	return nil
+}
+
+func (s *Cache) hllExpire(key string, v kv.ValueCache){
+
+	val := kv.HLLValue{Key: key, Expire: kv.ExpireForever}
+	op := kv.PersistentHLLOp{Item: val, OpType: kv.Del}
+	s.persistentHLLChan <- op
+
+	if s.opFunction != nil{
+		s.opFunction(kv.Del, v, nil)
+	}
+}
+
+func (s *Cache) saveHLL(key string, v kv.HLLValue) {
+	b := encodeHLL(v)
+
+	fullPath := filepath.Join(s.paths.HLLDBPath, key)
+	path, _ := filepath.Split(fullPath)
+
+	createDir(path)
+
+	err := ioutil.WriteFile(fullPath, b, os.ModePerm)
+	if err != nil{
+		log.Printf("saveHLL error:%s", err.Error())
+	}
+}
+
+func (s *Cache) delHLL(key string)  {
+	fullPath := filepath.Join(s.paths.HLLDBPath, key)
+	os.Remove(fullPath)
+}
+
+func (s *Cache) clearHLL()  {
+	os.RemoveAll(s.paths.HLLDBPath)
+	createDir(s.paths.HLLDBPath)
+}
@@ -0,0 +1,309 @@
+package cache
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/llr104/lightkv/cache/kv"
+	"io/ioutil"
+	"log"
+	"os"
+	"path/filepath"
+	"time"
+)
+
+/*
+json文档
+写操作在key的锁内完成，每次修改都会生成新的树，读操作直接读取lru中的值
+*/
+func (s *Cache) JSet(key string, path string, value string, expire int64) error{
+	defer s.keyLocks.lock(key)()
+
+	if err := s.checkWrite(kv.JSONData, key); err != nil {
+		return err
+	}
+
+	p, err := kv.ParseJSONPath(path)
+	if err != nil {
+		return err
+	}
+
+	data, err := kv.DecodeJSON(value)
+	if err != nil {
+		return err
+	}
+
+	j, ok := s.jsonValue(key)
+	if ok == false {
+		if p.IsRoot() == false {
+			str := fmt.Sprintf("JSet Key:%s, not found, new document must be created at the root", key)
+			return errors.New(str)
+		}
+		j = kv.JSONValue{Key: key}
+	}
+
+	old, _ := j.Find(p)
+	n, err := j.Set(p, data)
+	if err != nil {
+		return err
+	}
+
+	if expire == kv.ExpireForever {
+		n.Expire = kv.ExpireForever
+	}else{
+		n.Expire = time.Now().UnixNano() + expire*int64(time.Second)
+	}
+
+	s.jsonPushFront(kv.Add, n, p, old, data)
+	return nil
+}
+
+/*
+一个路径时返回该路径的值，多个路径时返回 路径->值 的json对象
+*/
+func (s *Cache) JGet(key string, paths []string) (string, error){
+	if err := s.checkType(kv.JSONData, key); err != nil {
+		return "", err
+	}
+
+	j, ok := s.jsonValue(key)
+	if ok == false {
+		str := fmt.Sprintf("JGet Key:%s, not found", key)
+		return "", errors.New(str)
+	}
+
+	if len(paths) == 0 {
+		return j.ToString(), nil
+	}
+
+	r := make(map[string]interface{})
+	for _, path := range paths {
+		p, err := kv.ParseJSONPath(path)
+		if err != nil {
+			return "", err
+		}
+
+		v, ok := j.Find(p)
+		if ok == false {
+			str := fmt.Sprintf("JGet Key:%s, path:%s not found", key, p.String())
+			return "", errors.New(str)
+		}
+
+		if len(paths) == 1 {
+			data, _ := json.Marshal(v)
+			return string(data), nil
+		}
+		r[path] = v
+	}
+
+	data, _ := json.Marshal(r)
+	return string(data), nil
+}
+
+/*
+删除路径上的值，路径为根时删除整个key，返回删除的个数
+*/
+func (s *Cache) JDelPath(key string, path string) (int, error){
+	defer s.keyLocks.lock(key)()
+
+	if err := s.checkType(kv.JSONData, key); err != nil {
+		return 0, err
+	}
+
+	p, err := kv.ParseJSONPath(path)
+	if err != nil {
+		return 0, err
+	}
+
+	if p.IsRoot() {
+		if err := s.jsonDel(key); err != nil {
+			return 0, nil
+		}
+		return 1, nil
+	}
+
+	j, ok := s.jsonValue(key)
+	if ok == false {
+		return 0, nil
+	}
+
+	old, _ := j.Find(p)
+	n, c, err := j.Delete(p)
+	if err != nil || c == 0 {
+		return 0, err
+	}
+
+	s.jsonPushFront(kv.Del, n, p, old, nil)
+	return c, nil
+}
+
+/*
+往路径上的数组追加，values 为json格式，返回追加后数组的长度
+*/
+func (s *Cache) JArrAppend(key string, path string, values []string) (int, error){
+	defer s.keyLocks.lock(key)()
+
+	if err := s.checkWrite(kv.JSONData, key); err != nil {
+		return 0, err
+	}
+
+	p, err := kv.ParseJSONPath(path)
+	if err != nil {
+		return 0, err
+	}
+
+	arr := make([]interface{}, len(values))
+	for i, v := range values {
+		if arr[i], err = kv.DecodeJSON(v); err != nil {
+			return 0, err
+		}
+	}
+
+	j, ok := s.jsonValue(key)
+	if ok == false {
+		str := fmt.Sprintf("JArrAppend Key:%s, not found", key)
+		return 0, errors.New(str)
+	}
+
+	old, _ := j.Find(p)
+	n, l, err := j.ArrAppend(p, arr)
+	if err != nil {
+		return 0, err
+	}
+
+	after, _ := n.Find(p)
+	s.jsonPushFront(kv.Add, n, p, old, after)
+	return l, nil
+}
+
+/*
+路径上的数字加上by，返回新的值
+*/
+func (s *Cache) JNumIncrBy(key string, path string, by string) (string, error){
+	defer s.keyLocks.lock(key)()
+
+	if err := s.checkWrite(kv.JSONData, key); err != nil {
+		return "", err
+	}
+
+	p, err := kv.ParseJSONPath(path)
+	if err != nil {
+		return "", err
+	}
+
+	v, _ := kv.DecodeJSON(by)
+	num, ok := v.(json.Number)
+	if ok == false {
+		str := fmt.Sprintf("JNumIncrBy invalid number:%s", by)
+		return "", errors.New(str)
+	}
+
+	j, ok := s.jsonValue(key)
+	if ok == false {
+		str := fmt.Sprintf("JNumIncrBy Key:%s, not found", key)
+		return "", errors.New(str)
+	}
+
+	old, _ := j.Find(p)
+	n, r, err := j.NumIncrBy(p, num)
+	if err != nil {
+		return "", err
+	}
+
+	s.jsonPushFront(kv.Add, n, p, old, r)
+	return r.String(), nil
+}
+
+func (s *Cache) JDel(key string) error{
+	defer s.keyLocks.lock(key)()
+
+	if err := s.checkType(kv.JSONData, key); err != nil {
+		return err
+	}
+
+	return s.jsonDel(key)
+}
+
+func (s *Cache) ClearJSON()  {
+	s.jsonLRU.Clear()
+	op := kv.PersistentJSONOp{OpType: kv.Clear}
+	s.persistentJSONChan <- op
+}
+
+func (s *Cache) JSONCaches() ([]byte, error) {
+	return s.jsonLRU.CacheToString()
+}
+
+func (s *Cache) jsonValue(key string) (kv.JSONValue, bool){
+	v, err := s.jsonLRU.Value(key)
+	if err != nil || v.IsExpire() {
+		return kv.JSONValue{}, false
+	}
+	return v.(kv.JSONValue), true
+}
+
+/*
+保存修改后的文档并通知，事件中的值为变化路径上修改前后的值
+*/
+func (s *Cache) jsonPushFront(op kv.OpType, v kv.JSONValue, path kv.JSONPath, before interface{}, after interface{}){
+
+	s.jsonLRU.PushFront(v)
+	s.persistentJSONChan <- kv.PersistentJSONOp{Item: v, OpType: kv.Add}
+
+	if s.opFunction != nil{
+		b := kv.JSONValue{Key: v.Key, Data: before, Path: path.String()}
+		a := kv.JSONValue{Key: v.Key, Data: after, Path: path.String()}
+		s.opFunction(op, b, a)
+	}
+}
+
+func (s *Cache) jsonDel(key string) error{
+	oldVal, err := s.jsonLRU.Lookup(key)
+	if err != nil{
+		return err
+	}
+
+	log.Printf("jsonDel Key:%s", key)
+	s.jsonLRU.Remove(key)
+
+	s.jsonExpire(key, oldVal)
+
+	return nil
+}
+
+func (s *Cache) jsonExpire(key string, v kv.ValueCache){
+
+	val := kv.JSONValue{Key: key, Expire: kv.ExpireForever}
+	op := kv.PersistentJSONOp{Item: val, OpType: kv.Del}
+	s.persistentJSONChan <- op
+
+	if s.opFunction != nil{
+		j := v.(kv.JSONValue)
+		j.Path = kv.JSONRootPath
+		s.opFunction(kv.Del, j, nil)
+	}
+}
+
+func (s *Cache) saveJSON(key string, v kv.JSONValue) {
+	b := encodeJSON(v)
+
+	fullPath := filepath.Join(s.paths.JSONDBPath, key)
+	path, _ := filepath.Split(fullPath)
+
+	createDir(path)
+
+	err := ioutil.WriteFile(fullPath, b, os.ModePerm)
+	if err != nil{
+		log.Printf("saveJSON error:%s", err.Error())
+	}
+}
+
+func (s *Cache) delJSON(key string)  {
+	fullPath := filepath.Join(s.paths.JSONDBPath, key)
+	os.Remove(fullPath)
+}
+
+func (s *Cache) clearJSON()  {
+	os.RemoveAll(s.paths.JSONDBPath)
+	createDir(s.paths.JSONDBPath)
+}
@@ -0,0 +1,68 @@
+package cache
+
+import (
+	"sort"
+	"sync"
+)
+
+/*
+key锁的分段数，必须是2的幂
+*/
+const keyLockStripes = 1024
+
+/*
+按key的哈希分段的写锁，所有类型同一个key的读-改-写在同一个分段锁内串行执行，统一键空间模式下写入前在锁内记录key的类型
+string、map、list、set、hll、geo、json 的值在写入时复制，读取不加锁，直接读取lru中不会再被修改的值
+stream、bloom、cuckoo 原地修改，读取也要加锁；时间序列的降采样会修改其他key，读写还要持有 tsMutex
+加锁顺序为 txMutex、key锁、tsMutex、lru分段锁、key索引的锁，持有key锁时不能再去获取 txMutex
+*/
+type keyLocks struct {
+	stripes [keyLockStripes]sync.Mutex
+}
+
+func (s *keyLocks) stripe(key string) int {
+	return int(fnv32a(key) & (keyLockStripes - 1))
+}
+
+func (s *keyLocks) locker(key string) sync.Locker {
+	return &s.stripes[s.stripe(key)]
+}
+
+/*
+锁住keys所在的所有分段，按分段的顺序加锁避免死锁，返回解锁函数
+*/
+func (s *keyLocks) lock(keys ...string) func() {
+	idx := make([]int, 0, len(keys))
+	seen := make(map[int]bool, len(keys))
+	for _, key := range keys {
+		i := s.stripe(key)
+		if seen[i] == false {
+			seen[i] = true
+			idx = append(idx, i)
+		}
+	}
+	sort.Ints(idx)
+
+	for _, i := range idx {
+		s.stripes[i].Lock()
+	}
+	return func() {
+		for j := len(idx) - 1; j >= 0; j-- {
+			s.stripes[idx[j]].Unlock()
+		}
+	}
+}
+
+/*
+按顺序锁住所有分段，用于清空或者遍历原地修改的类型
+*/
+func (s *keyLocks) lockAll() func() {
+	for i := range s.stripes {
+		s.stripes[i].Lock()
+	}
+	return func() {
+		for i := len(s.stripes) - 1; i >= 0; i-- {
+			s.stripes[i].Unlock()
+		}
+	}
+}
@@ -0,0 +1,257 @@
+package cache
+
+import (
+	"errors"
+	"fmt"
+	"github.com/llr104/lightkv/cache/kv"
+	"log"
+	"sync"
+)
+
+const KeyTypeNone = "none"
+
+/*
+统一键空间模式下，key已经是其他类型时返回的错误
+*/
+type WrongTypeError struct {
+	Key  string
+	Type string
+	Want string
+}
+
+func (e WrongTypeError) Error() string {
+	return fmt.Sprintf("WRONGTYPE Key:%s holds a %s value, not %s", e.Key, e.Type, e.Want)
+}
+
+func IsWrongType(err error) bool {
+	var e WrongTypeError
+	return errors.As(err, &e)
+}
+
+/*
+全局的key索引，记录每个key所属的类型，由lru添加、删除key时同步更新
+*/
+type keyIndex struct {
+	mutex sync.RWMutex
+	types map[string]int32
+}
+
+func newKeyIndex() *keyIndex {
+	return &keyIndex{types: make(map[string]int32)}
+}
+
+func (s *keyIndex) Set(key string, dataType int32) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	if t, ok := s.types[key]; ok && t != dataType {
+		log.Printf("keyspace Key:%s exists in type %s and %s", key, kv.DataTypeNames[t], kv.DataTypeNames[dataType])
+	}
+	s.types[key] = dataType
+}
+
+/*
+key不属于其他类型时记录为dataType，属于其他类型时不修改并返回该类型
+检查和记录在同一次加锁内完成，两个类型不会同时认为key可以写入
+*/
+func (s *keyIndex) Claim(key string, dataType int32) (int32, bool) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	if t, ok := s.types[key]; ok && t != dataType {
+		return t, false
+	}
+	s.types[key] = dataType
+	return dataType, true
+}
+
+/*
+只删除属于dataType的key，避免删掉已经被其他类型覆盖的key
+*/
+func (s *keyIndex) Remove(key string, dataType int32) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	if t, ok := s.types[key]; ok && t == dataType {
+		delete(s.types, key)
+	}
+}
+
+func (s *keyIndex) RemoveType(dataType int32) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	for k, t := range s.types {
+		if t == dataType {
+			delete(s.types, k)
+		}
+	}
+}
+
+func (s *keyIndex) Type(key string) (int32, bool) {
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+
+	t, ok := s.types[key]
+	return t, ok
+}
+
+func (s *keyIndex) Len() int {
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+	return len(s.types)
+}
+
+/*
+统一键空间模式下检查key是否属于dataType，key不存在时可以被任意类型使用
+*/
+func (s *Cache) checkType(dataType int32, keys ...string) error {
+	if s.keys == nil {
+		return nil
+	}
+
+	for _, key := range keys {
+		t, ok := s.keys.Type(key)
+		if ok == false || t == dataType {
+			continue
+		}
+		if v, ok := s.typeHandles()[t].lru.Peek(key); ok && v.IsExpire() == false {
+			return WrongTypeError{Key: key, Type: kv.DataTypeNames[t], Want: kv.DataTypeNames[dataType]}
+		}
+	}
+	return nil
+}
+
+/*
+统一键空间模式下写入前把keys记录为dataType，需要在keys的锁内调用，其他类型写入同一个key前也要先获取这个锁
+key属于其他类型且没有过期时返回 WrongTypeError，记录的类型中key已经过期或者被淘汰时清除记录后重新记录
+写入失败时记录会留在索引中，lru中不存在这个key，下次其他类型写入时被清除
+*/
+func (s *Cache) claimType(dataType int32, keys ...string) error {
+	if s.keys == nil {
+		return nil
+	}
+
+	for _, key := range keys {
+		for {
+			t, ok := s.keys.Claim(key, dataType)
+			if ok {
+				break
+			}
+			//lru加锁时会更新索引，不能在索引的锁内读取lru
+			if v, ok := s.typeHandles()[t].lru.Peek(key); ok && v.IsExpire() == false {
+				return WrongTypeError{Key: key, Type: kv.DataTypeNames[t], Want: kv.DataTypeNames[dataType]}
+			}
+			s.keys.Remove(key, t)
+		}
+	}
+	return nil
+}
+
+/*
+key的类型，key不存在时返回 KeyTypeNone
+非统一键空间模式下key存在于多种类型时返回错误
+*/
+func (s *Cache) Type(key string) (string, error){
+	h, ok, err := s.keyHandle(key, "")
+	if err != nil {
+		return "", err
+	}
+	if ok == false {
+		return KeyTypeNone, nil
+	}
+	return kv.DataTypeNames[h.dataType], nil
+}
+
+/*
+存在的key的个数，同一个key出现多次时重复计算
+*/
+func (s *Cache) Exists(keys []string) int{
+	n := 0
+	handles := s.typeHandles()
+	for _, key := range keys {
+		for _, h := range handles {
+			if v, ok := h.lru.Peek(key); ok && v.IsExpire() == false {
+				n++
+				break
+			}
+		}
+	}
+	return n
+}
+
+/*
+删除任意类型的key，非统一键空间模式下删除所有类型中的同名key，返回删除的key的个数
+*/
+func (s *Cache) DelKeys(keys []string) int{
+	s.txMutex.RLock()
+	defer s.txMutex.RUnlock()
+
+	n := 0
+	handles := s.typeHandles()
+	for _, key := range keys {
+		deleted := false
+		for _, h := range handles {
+			if v, ok := h.lru.Peek(key); ok && v.IsExpire() == false {
+				if h.delete(key) == nil {
+					deleted = true
+				}
+			}
+		}
+		if deleted {
+			n++
+		}
+	}
+	return n
+}
+
+/*
+重命名key，保留过期时间，newKey 已经存在时被覆盖
+统一键空间模式下 newKey 是其他类型时也会被删除
+*/
+func (s *Cache) Rename(key string, newKey string) error{
+	ok := false
+	var err error
+	if key == newKey {
+		_, ok, err = s.keyHandle(key, "")
+	}else{
+		ok, err = s.transfer(s, key, newKey, false, true)
+	}
+	if err != nil {
+		return err
+	}
+	if ok == false {
+		str := fmt.Sprintf("Rename Key:%s, not found", key)
+		return errors.New(str)
+	}
+	return nil
+}
+
+/*
+作为通知时的旧值，表示key之前不存在
+*/
+func emptyValue(dataType int32, key string) kv.ValueCache {
+	switch dataType {
+	case kv.MapData:
+		return kv.MapValue{Key: key}
+	case kv.ListData:
+		return kv.ListValue{Key: key}
+	case kv.SetData:
+		return kv.SetValue{Key: key}
+	case kv.HLLData:
+		return kv.HLLValue{Key: key}
+	case kv.GeoData:
+		return kv.GeoValue{Key: key}
+	case kv.StreamData:
+		return kv.StreamValue{Key: key}
+	case kv.JSONData:
+		return kv.JSONValue{Key: key}
+	case kv.BloomData:
+		return kv.BloomValue{Key: key}
+	case kv.CuckooData:
+		return kv.CuckooValue{Key: key}
+	case kv.TSData:
+		return kv.TSValue{Key: key}
+	}
+	return kv.StringValue{Key: key}
+}
@@ -0,0 +1,194 @@
+package cache
+
+import (
+	"fmt"
+	"github.com/llr104/lightkv/cache/kv"
+	"strings"
+	"testing"
+	"time"
+)
+
+/*
+统一键空间模式，结束后恢复
+*/
+func withUnified(unified bool) func() {
+	old := Conf.UnifiedKeyspace
+	Conf.UnifiedKeyspace = unified
+	return func() { Conf.UnifiedKeyspace = old }
+}
+
+/*
+统一键空间模式下写入或者读取其他类型的key返回 WRONGTYPE，原来的值不变
+*/
+func TestWrongType(t *testing.T) {
+	defer withUnified(true)()
+	c, clean := newTestCache(t)
+	defer clean()
+
+	c.Put("s", "v", 0)
+	c.HMPut("m", []string{"f"}, []string{"v"}, 0)
+
+	tests := []struct {
+		name string
+		op   func() error
+		key  string
+		want string
+	}{
+		{"hmput", func() error { return c.HMPut("s", []string{"f"}, []string{"v"}, 0) }, "s", "map"},
+		{"hmget", func() error { _, err := c.HMGet("s"); return err }, "s", "map"},
+		{"lput", func() error { return c.LPut("m", []string{"v"}, 0) }, "m", "list"},
+		{"sput", func() error { return c.SPut("m", []string{"v"}, 0) }, "m", "set"},
+		{"get", func() error { _, err := c.Get("m"); return err }, "m", "string"},
+		{"put", func() error { return c.Put("m", "v", 0) }, "m", "string"},
+		{"pfadd", func() error { _, err := c.PFAdd("s", []string{"v"}, 0); return err }, "s", "hll"},
+	}
+	for _, tt := range tests {
+		err := tt.op()
+		e, ok := err.(WrongTypeError)
+		if IsWrongType(err) == false || ok == false || e.Key != tt.key || e.Want != tt.want {
+			t.Fatalf("%s: error %v, want WRONGTYPE Key:%s not %s", tt.name, err, tt.key, tt.want)
+		}
+		if strings.HasPrefix(err.Error(), "WRONGTYPE") == false {
+			t.Fatalf("%s: error message %q", tt.name, err.Error())
+		}
+	}
+
+	if v, _ := c.Get("s"); v != "v" {
+		t.Fatalf("Key:s is %q after failed writes", v)
+	}
+	if m, _ := c.HMGet("m"); fmt.Sprint(m) != "map[f:v]" {
+		t.Fatalf("Key:m is %v after failed writes", m)
+	}
+}
+
+/*
+索引中记录的类型已经过期或者被删除时，其他类型可以直接使用这个key
+*/
+func TestClaimType(t *testing.T) {
+	defer withUnified(true)()
+	c, clean := newTestCache(t)
+	defer clean()
+
+	c.PutEx("expired", "v", kv.ExpireMillis(1))
+	c.Put("live", "v", 0)
+	//只有索引没有值，例如淘汰时还没有从索引中删除
+	c.keys.Set("stale", kv.ValueData)
+	time.Sleep(5 * time.Millisecond)
+
+	tests := []struct {
+		key  string
+		err  bool
+		want int32
+	}{
+		{"expired", false, kv.MapData},
+		{"stale", false, kv.MapData},
+		{"new", false, kv.MapData},
+		{"live", true, kv.ValueData},
+	}
+	for _, tt := range tests {
+		err := c.claimType(kv.MapData, tt.key)
+		if IsWrongType(err) != tt.err {
+			t.Fatalf("claim Key:%s: error %v, want error %v", tt.key, err, tt.err)
+		}
+		if got, _ := c.keys.Type(tt.key); got != tt.want {
+			t.Fatalf("claim Key:%s: type %s, want %s", tt.key, kv.DataTypeNames[got], kv.DataTypeNames[tt.want])
+		}
+	}
+
+	if err := c.HMPut("expired", []string{"f"}, []string{"v"}, 0); err != nil {
+		t.Fatalf("hmput expired string: %s", err)
+	}
+	if tp, _ := c.Type("expired"); tp != "map" {
+		t.Fatalf("Key:expired type %s, want map", tp)
+	}
+}
+
+/*
+统一键空间模式下重命名覆盖其他类型的newKey，索引中记录新的类型
+*/
+func TestRenameOverOtherType(t *testing.T) {
+	defer withUnified(true)()
+	c, clean := newTestCache(t)
+	defer clean()
+
+	c.Put("a", "v", 100)
+	c.HMPut("b", []string{"f"}, []string{"v"}, 0)
+
+	if err := c.Rename("a", "b"); err != nil {
+		t.Fatal(err)
+	}
+	if v, err := c.Get("b"); err != nil || v != "v" {
+		t.Fatalf("Key:b is %q %v, want v", v, err)
+	}
+	if _, err := c.HMGet("b"); IsWrongType(err) == false {
+		t.Fatalf("hmget Key:b error %v, want WRONGTYPE", err)
+	}
+	if tp, _ := c.keys.Type("b"); tp != kv.ValueData {
+		t.Fatalf("Key:b indexed as %s, want string", kv.DataTypeNames[tp])
+	}
+	if _, ok := c.keys.Type("a"); ok {
+		t.Fatal("Key:a still in index after rename")
+	}
+	if ttl, _ := c.TTL("b", ""); ttl <= 0 {
+		t.Fatalf("Key:b ttl %d, want the ttl of Key:a", ttl)
+	}
+	if err := c.Rename("a", "b"); err == nil {
+		t.Fatal("rename missing key succeeded")
+	}
+}
+
+/*
+非统一键空间模式下同名key可以存在于多种类型，Type 返回错误，DelKeys 删除所有类型中的同名key
+*/
+func TestTypeExistsDelKeys(t *testing.T) {
+	for _, unified := range []bool{false, true} {
+		restore := withUnified(unified)
+		c, clean := newTestCache(t)
+
+		c.Put("s", "v", 0)
+		c.HMPut("m", []string{"f"}, []string{"v"}, 0)
+		c.LPut("both", []string{"v"}, 0)
+		c.SPut("both", []string{"v"}, 0)
+		c.PutEx("old", "v", kv.ExpireMillis(1))
+		time.Sleep(5 * time.Millisecond)
+
+		//统一键空间模式下 both 已经是list，写入set失败
+		both, bothErr := "list", false
+		if unified == false {
+			both, bothErr = "", true
+		}
+		types := []struct {
+			key  string
+			want string
+			err  bool
+		}{
+			{"s", "string", false},
+			{"m", "map", false},
+			{"old", KeyTypeNone, false},
+			{"none", KeyTypeNone, false},
+			{"both", both, bothErr},
+		}
+		for _, tt := range types {
+			got, err := c.Type(tt.key)
+			if (err != nil) != tt.err || (err == nil && got != tt.want) {
+				t.Fatalf("unified %v Type(%s) = %q %v, want %q error %v", unified, tt.key, got, err, tt.want, tt.err)
+			}
+		}
+
+		if n := c.Exists([]string{"s", "m", "both", "old", "none", "s"}); n != 4 {
+			t.Fatalf("unified %v Exists got %d, want 4", unified, n)
+		}
+		if n := c.DelKeys([]string{"both", "s", "old", "none"}); n != 2 {
+			t.Fatalf("unified %v DelKeys got %d, want 2", unified, n)
+		}
+		if n := c.Exists([]string{"s", "m", "both"}); n != 1 {
+			t.Fatalf("unified %v Exists after DelKeys got %d, want 1", unified, n)
+		}
+		if _, err := c.SGet("both"); err == nil {
+			t.Fatalf("unified %v Key:both still in set after DelKeys", unified)
+		}
+
+		clean()
+		restore()
+	}
+}
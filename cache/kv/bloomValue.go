@@ -0,0 +1,214 @@
+package kv
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"time"
+	"unsafe"
+)
+
+const (
+	BloomExpansion    = 2   //子过滤器满了之后新建过滤器的容量倍数
+	bloomTighten      = 0.5 //新建子过滤器的误判率系数，保证整体误判率不超过设定值
+	bloomHashSeed     = 0xc70f6907
+)
+
+/*
+单个定长的布隆过滤器，M 为位数，K 为哈希函数个数
+*/
+type BloomFilter struct {
+	Bits      []byte  `json:"bits"`
+	M         uint64  `json:"m"`
+	K         uint32  `json:"k"`
+	Capacity  uint64  `json:"capacity"`
+	ErrorRate float64 `json:"errorRate"`
+	Count     uint64  `json:"count"`
+}
+
+func newBloomFilter(errorRate float64, capacity uint64) *BloomFilter {
+	m := uint64(math.Ceil(-float64(capacity) * math.Log(errorRate) / (math.Ln2 * math.Ln2)))
+	if m < 8 {
+		m = 8
+	}
+	k := uint32(math.Ceil(math.Ln2 * float64(m) / float64(capacity)))
+	if k < 1 {
+		k = 1
+	}
+	return &BloomFilter{Bits: make([]byte, (m+7)/8), M: m, K: k, Capacity: capacity, ErrorRate: errorRate}
+}
+
+func (s *BloomFilter) add(h1 uint64, h2 uint64) {
+	for i := uint32(0); i < s.K; i++ {
+		pos := (h1 + uint64(i)*h2) % s.M
+		s.Bits[pos/8] |= 1 << (pos % 8)
+	}
+	s.Count++
+}
+
+func (s *BloomFilter) test(h1 uint64, h2 uint64) bool {
+	for i := uint32(0); i < s.K; i++ {
+		pos := (h1 + uint64(i)*h2) % s.M
+		if s.Bits[pos/8]&(1<<(pos%8)) == 0 {
+			return false
+		}
+	}
+	return true
+}
+
+/*
+可扩容的布隆过滤器，最后一个子过滤器满了之后按 BloomExpansion 倍容量新建
+*/
+type BloomContent struct {
+	ErrorRate float64        `json:"errorRate"`
+	Capacity  uint64         `json:"capacity"`
+	Filters   []*BloomFilter `json:"filters"`
+}
+
+func NewBloomContent(errorRate float64, capacity uint64) (*BloomContent, error) {
+	if errorRate <= 0 || errorRate >= 1 {
+		str := fmt.Sprintf("bloom error rate:%f should be between 0 and 1", errorRate)
+		return nil, errors.New(str)
+	}
+	if capacity == 0 {
+		return nil, errors.New("bloom capacity should be greater than 0")
+	}
+
+	c := &BloomContent{ErrorRate: errorRate, Capacity: capacity}
+	c.Filters = []*BloomFilter{newBloomFilter(errorRate*bloomTighten, capacity)}
+	return c, nil
+}
+
+/*
+添加元素，元素可能已存在时返回false
+*/
+func (s *BloomContent) Add(item string) bool {
+	h1, h2 := bloomHash(item)
+	if s.test(h1, h2) {
+		return false
+	}
+
+	last := s.Filters[len(s.Filters)-1]
+	if last.Count >= last.Capacity {
+		last = newBloomFilter(last.ErrorRate*bloomTighten, last.Capacity*BloomExpansion)
+		s.Filters = append(s.Filters, last)
+	}
+	last.add(h1, h2)
+	return true
+}
+
+func (s *BloomContent) Exists(item string) bool {
+	h1, h2 := bloomHash(item)
+	return s.test(h1, h2)
+}
+
+func (s *BloomContent) test(h1 uint64, h2 uint64) bool {
+	for _, f := range s.Filters {
+		if f.test(h1, h2) {
+			return true
+		}
+	}
+	return false
+}
+
+func (s *BloomContent) Info() BloomInfo {
+	if s == nil {
+		return BloomInfo{}
+	}
+	r := BloomInfo{ErrorRate: s.ErrorRate, Filters: len(s.Filters), Expansion: BloomExpansion}
+	for _, f := range s.Filters {
+		r.Capacity += f.Capacity
+		r.Items += f.Count
+		r.Size += len(f.Bits)
+	}
+	return r
+}
+
+func (s *BloomContent) Copy() *BloomContent {
+	c := &BloomContent{ErrorRate: s.ErrorRate, Capacity: s.Capacity, Filters: make([]*BloomFilter, len(s.Filters))}
+	for i, f := range s.Filters {
+		n := *f
+		n.Bits = append([]byte{}, f.Bits...)
+		c.Filters[i] = &n
+	}
+	return c
+}
+
+type BloomInfo struct {
+	Capacity  uint64  `json:"capacity"`
+	Size      int     `json:"size"`
+	Filters   int     `json:"filters"`
+	Items     uint64  `json:"items"`
+	ErrorRate float64 `json:"errorRate"`
+	Expansion int     `json:"expansion"`
+}
+
+type BloomValue struct {
+	Key    string       		`json:"key"`
+	Expire int64				`json:"expire"`
+	Data   *BloomContent		`json:"data"`
+}
+
+func (s BloomValue) ToString() string{
+	data, _ := json.Marshal(s.Data.Info())
+	return string(data)
+}
+
+func (s BloomValue) Size() int {
+	t := boxSize(unsafe.Sizeof(s)) + StringSize(s.Key)
+	if s.Data == nil {
+		return t
+	}
+	t += AllocSize(int(unsafe.Sizeof(*s.Data))) + SliceSize(cap(s.Data.Filters), WordSize)
+	for _, f := range s.Data.Filters {
+		t += AllocSize(int(unsafe.Sizeof(*f))) + SliceSize(cap(f.Bits), 1)
+	}
+	return t
+}
+
+func (s BloomValue) GetKey() string{
+	return s.Key
+}
+
+func (s BloomValue) IsExpire() bool{
+	t := time.Now().UnixNano()
+	if s.Expire != ExpireForever && s.Expire <= t{
+		return true
+	}
+	return false
+}
+
+func (s BloomValue) GetExpire() int64{
+	return s.Expire
+}
+
+func (s BloomValue) WithExpire(expire int64) ValueCache{
+	s.Expire = expire
+	return s
+}
+
+func (s BloomValue) WithKey(key string) ValueCache{
+	s.Key = key
+	return s
+}
+
+/*
+dump时只输出统计信息，不输出位数组
+*/
+func (s BloomValue) MarshalJSON() ([]byte, error) {
+	return json.Marshal(struct {
+		Key    string    `json:"key"`
+		Expire int64     `json:"expire"`
+		Info   BloomInfo `json:"info"`
+	}{s.Key, s.Expire, s.Data.Info()})
+}
+
+/*
+双重哈希，第i个哈希值为 h1 + i*h2
+*/
+func bloomHash(item string) (uint64, uint64) {
+	h1 := murmurHash64A([]byte(item), bloomHashSeed)
+	h2 := murmurHash64A([]byte(item), h1)
+	return h1, h2 | 1
+}
@@ -0,0 +1,87 @@
+package kv
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestNewBloomContent(t *testing.T) {
+	tests := []struct {
+		errorRate float64
+		capacity  uint64
+		err       bool
+	}{
+		{0.01, 100, false},
+		{0, 100, true},
+		{1, 100, true},
+		{0.01, 0, true},
+	}
+
+	for _, tt := range tests {
+		if _, err := NewBloomContent(tt.errorRate, tt.capacity); (err != nil) != tt.err {
+			t.Fatalf("NewBloomContent(%v, %d) error %v, want error %v", tt.errorRate, tt.capacity, err, tt.err)
+		}
+	}
+}
+
+/*
+添加的元素都存在，误判率不超过设定值，超过容量时扩容后仍然满足
+*/
+func TestBloomErrorRate(t *testing.T) {
+	tests := []struct {
+		errorRate float64
+		capacity  uint64
+		items     int
+		filters   int
+	}{
+		{0.01, 1000, 1000, 1},
+		{0.001, 1000, 1000, 1},
+		{0.01, 1000, 3000, 2},
+		{0.01, 100, 5000, 6},
+	}
+
+	for _, tt := range tests {
+		b, err := NewBloomContent(tt.errorRate, tt.capacity)
+		if err != nil {
+			t.Fatal(err)
+		}
+		added := 0
+		for i := 0; i < tt.items; i++ {
+			if b.Add(fmt.Sprintf("in%d", i)) {
+				added++
+			}
+		}
+		for i := 0; i < tt.items; i++ {
+			if b.Exists(fmt.Sprintf("in%d", i)) == false {
+				t.Fatalf("%+v: item %d not found", tt, i)
+			}
+		}
+
+		fp := 0
+		const probes = 100000
+		for i := 0; i < probes; i++ {
+			if b.Exists(fmt.Sprintf("out%d", i)) {
+				fp++
+			}
+		}
+		info := b.Info()
+		if rate := float64(fp) / probes; rate > tt.errorRate*1.5 {
+			t.Fatalf("%+v: false positive rate %f", tt, rate)
+		}
+		if info.Filters != tt.filters || info.Items != uint64(added) {
+			t.Fatalf("%+v: %d filters and %d items, want %d filters and %d items", tt, info.Filters, info.Items, tt.filters, added)
+		}
+	}
+}
+
+func TestBloomCopy(t *testing.T) {
+	b, _ := NewBloomContent(0.01, 10)
+	b.Add("a")
+	c := b.Copy()
+	for i := 0; i < 100; i++ {
+		c.Add(fmt.Sprint(i))
+	}
+	if len(b.Filters) != 1 || b.Info().Items != 1 || b.Exists("50") {
+		t.Fatalf("original filter changed: %+v", b.Info())
+	}
+}
@@ -0,0 +1,311 @@
+package kv
+
+import (
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"math/rand"
+	"time"
+	"unsafe"
+)
+
+const (
+	CuckooBucketSize    = 2   //每个桶的指纹数
+	CuckooMaxIterations = 500 //插入时最多踢出的次数
+	CuckooExpansion     = 2   //子过滤器满了之后新建过滤器的容量倍数
+	cuckooHashSeed      = 0x9747b28c
+)
+
+/*
+单个布谷鸟过滤器，每个槽保存16位指纹，0表示空槽
+*/
+type CuckooFilter struct {
+	Slots      []byte `json:"slots"`
+	NumBuckets uint64 `json:"numBuckets"`
+	Count      uint64 `json:"count"`
+}
+
+func newCuckooFilter(capacity uint64) *CuckooFilter {
+	n := uint64(1)
+	for n*CuckooBucketSize < capacity {
+		n <<= 1
+	}
+	return &CuckooFilter{Slots: make([]byte, n*CuckooBucketSize*2), NumBuckets: n}
+}
+
+func (s *CuckooFilter) get(bucket uint64, slot int) uint16 {
+	i := (bucket*CuckooBucketSize + uint64(slot)) * 2
+	return binary.BigEndian.Uint16(s.Slots[i:])
+}
+
+func (s *CuckooFilter) set(bucket uint64, slot int, fp uint16) {
+	i := (bucket*CuckooBucketSize + uint64(slot)) * 2
+	binary.BigEndian.PutUint16(s.Slots[i:], fp)
+}
+
+func (s *CuckooFilter) index(h uint64) uint64 {
+	return h & (s.NumBuckets - 1)
+}
+
+func (s *CuckooFilter) altIndex(bucket uint64, fp uint16) uint64 {
+	return (bucket ^ (uint64(fp) * 0x5bd1e995)) & (s.NumBuckets - 1)
+}
+
+func (s *CuckooFilter) insertTo(bucket uint64, fp uint16) bool {
+	for i := 0; i < CuckooBucketSize; i++ {
+		if s.get(bucket, i) == 0 {
+			s.set(bucket, i, fp)
+			return true
+		}
+	}
+	return false
+}
+
+func (s *CuckooFilter) removeFrom(bucket uint64, fp uint16) bool {
+	for i := 0; i < CuckooBucketSize; i++ {
+		if s.get(bucket, i) == fp {
+			s.set(bucket, i, 0)
+			return true
+		}
+	}
+	return false
+}
+
+func (s *CuckooFilter) contains(bucket uint64, fp uint16) bool {
+	for i := 0; i < CuckooBucketSize; i++ {
+		if s.get(bucket, i) == fp {
+			return true
+		}
+	}
+	return false
+}
+
+type cuckooKick struct {
+	bucket uint64
+	slot   int
+	fp     uint16
+}
+
+/*
+插入指纹，两个桶都满时随机踢出已有指纹，失败时恢复被踢出的指纹
+*/
+func (s *CuckooFilter) add(h uint64, fp uint16) bool {
+	i1 := s.index(h)
+	i2 := s.altIndex(i1, fp)
+	if s.insertTo(i1, fp) || s.insertTo(i2, fp) {
+		s.Count++
+		return true
+	}
+
+	kicks := make([]cuckooKick, 0, 16)
+	i := i1
+	if rand.Intn(2) == 1 {
+		i = i2
+	}
+	cur := fp
+	for n := 0; n < CuckooMaxIterations; n++ {
+		slot := rand.Intn(CuckooBucketSize)
+		old := s.get(i, slot)
+		s.set(i, slot, cur)
+		kicks = append(kicks, cuckooKick{bucket: i, slot: slot, fp: old})
+
+		cur = old
+		i = s.altIndex(i, cur)
+		if s.insertTo(i, cur) {
+			s.Count++
+			return true
+		}
+	}
+
+	for k := len(kicks) - 1; k >= 0; k-- {
+		s.set(kicks[k].bucket, kicks[k].slot, kicks[k].fp)
+	}
+	return false
+}
+
+func (s *CuckooFilter) exists(h uint64, fp uint16) bool {
+	i1 := s.index(h)
+	return s.contains(i1, fp) || s.contains(s.altIndex(i1, fp), fp)
+}
+
+func (s *CuckooFilter) remove(h uint64, fp uint16) bool {
+	i1 := s.index(h)
+	if s.removeFrom(i1, fp) || s.removeFrom(s.altIndex(i1, fp), fp) {
+		s.Count--
+		return true
+	}
+	return false
+}
+
+/*
+可扩容的布谷鸟过滤器，支持删除，最后一个子过滤器插入失败时按 CuckooExpansion 倍容量新建
+*/
+type CuckooContent struct {
+	Capacity uint64          `json:"capacity"`
+	Deleted  uint64          `json:"deleted"`
+	Filters  []*CuckooFilter `json:"filters"`
+}
+
+func NewCuckooContent(capacity uint64) (*CuckooContent, error) {
+	if capacity == 0 {
+		return nil, errors.New("cuckoo capacity should be greater than 0")
+	}
+	c := &CuckooContent{Capacity: capacity, Filters: []*CuckooFilter{newCuckooFilter(capacity)}}
+	return c, nil
+}
+
+/*
+添加元素，nx 为true时元素可能已存在则不添加并返回false，否则允许重复添加
+*/
+func (s *CuckooContent) Add(item string, nx bool) bool {
+	h, fp := cuckooHash(item)
+	if nx && s.exists(h, fp) {
+		return false
+	}
+
+	last := s.Filters[len(s.Filters)-1]
+	if last.add(h, fp) {
+		return true
+	}
+
+	last = newCuckooFilter(last.NumBuckets * CuckooBucketSize * CuckooExpansion)
+	s.Filters = append(s.Filters, last)
+	return last.add(h, fp)
+}
+
+func (s *CuckooContent) Exists(item string) bool {
+	h, fp := cuckooHash(item)
+	return s.exists(h, fp)
+}
+
+/*
+删除一次添加的元素，只能删除确实添加过的元素，否则可能误删其他元素
+*/
+func (s *CuckooContent) Remove(item string) bool {
+	h, fp := cuckooHash(item)
+	for i := len(s.Filters) - 1; i >= 0; i-- {
+		if s.Filters[i].remove(h, fp) {
+			s.Deleted++
+			return true
+		}
+	}
+	return false
+}
+
+func (s *CuckooContent) exists(h uint64, fp uint16) bool {
+	for _, f := range s.Filters {
+		if f.exists(h, fp) {
+			return true
+		}
+	}
+	return false
+}
+
+func (s *CuckooContent) Info() CuckooInfo {
+	if s == nil {
+		return CuckooInfo{}
+	}
+	r := CuckooInfo{Filters: len(s.Filters), Deleted: s.Deleted, BucketSize: CuckooBucketSize,
+		Expansion: CuckooExpansion, MaxIterations: CuckooMaxIterations}
+	for _, f := range s.Filters {
+		r.Capacity += f.NumBuckets * CuckooBucketSize
+		r.Buckets += f.NumBuckets
+		r.Items += f.Count
+		r.Size += len(f.Slots)
+	}
+	return r
+}
+
+func (s *CuckooContent) Copy() *CuckooContent {
+	c := &CuckooContent{Capacity: s.Capacity, Deleted: s.Deleted, Filters: make([]*CuckooFilter, len(s.Filters))}
+	for i, f := range s.Filters {
+		n := *f
+		n.Slots = append([]byte{}, f.Slots...)
+		c.Filters[i] = &n
+	}
+	return c
+}
+
+type CuckooInfo struct {
+	Capacity      uint64 `json:"capacity"`
+	Size          int    `json:"size"`
+	Buckets       uint64 `json:"buckets"`
+	Filters       int    `json:"filters"`
+	Items         uint64 `json:"items"`
+	Deleted       uint64 `json:"deleted"`
+	BucketSize    int    `json:"bucketSize"`
+	Expansion     int    `json:"expansion"`
+	MaxIterations int    `json:"maxIterations"`
+}
+
+type CuckooValue struct {
+	Key    string       		`json:"key"`
+	Expire int64				`json:"expire"`
+	Data   *CuckooContent		`json:"data"`
+}
+
+func (s CuckooValue) ToString() string{
+	data, _ := json.Marshal(s.Data.Info())
+	return string(data)
+}
+
+func (s CuckooValue) Size() int {
+	t := boxSize(unsafe.Sizeof(s)) + StringSize(s.Key)
+	if s.Data == nil {
+		return t
+	}
+	t += AllocSize(int(unsafe.Sizeof(*s.Data))) + SliceSize(cap(s.Data.Filters), WordSize)
+	for _, f := range s.Data.Filters {
+		t += AllocSize(int(unsafe.Sizeof(*f))) + SliceSize(cap(f.Slots), 1)
+	}
+	return t
+}
+
+func (s CuckooValue) GetKey() string{
+	return s.Key
+}
+
+func (s CuckooValue) IsExpire() bool{
+	t := time.Now().UnixNano()
+	if s.Expire != ExpireForever && s.Expire <= t{
+		return true
+	}
+	return false
+}
+
+func (s CuckooValue) GetExpire() int64{
+	return s.Expire
+}
+
+func (s CuckooValue) WithExpire(expire int64) ValueCache{
+	s.Expire = expire
+	return s
+}
+
+func (s CuckooValue) WithKey(key string) ValueCache{
+	s.Key = key
+	return s
+}
+
+/*
+dump时只输出统计信息，不输出指纹数组
+*/
+func (s CuckooValue) MarshalJSON() ([]byte, error) {
+	return json.Marshal(struct {
+		Key    string     `json:"key"`
+		Expire int64      `json:"expire"`
+		Info   CuckooInfo `json:"info"`
+	}{s.Key, s.Expire, s.Data.Info()})
+}
+
+/*
+低位用于定位桶，高16位作为指纹
+*/
+func cuckooHash(item string) (uint64, uint16) {
+	h := murmurHash64A([]byte(item), cuckooHashSeed)
+	fp := uint16(h >> 48)
+	if fp == 0 {
+		fp = 1
+	}
+	return h, fp
+}
@@ -0,0 +1,93 @@
+package kv
+
+import (
+	"fmt"
+	"testing"
+)
+
+/*
+添加的元素都存在，删除后不再存在，超过容量时扩容
+*/
+func TestCuckooAddRemove(t *testing.T) {
+	tests := []struct {
+		capacity uint64
+		items    int
+		remove   int
+		expand   bool
+	}{
+		{1000, 500, 250, false},
+		{1024, 700, 700, false},
+		{100, 2000, 1000, true},
+	}
+
+	for _, tt := range tests {
+		c, err := NewCuckooContent(tt.capacity)
+		if err != nil {
+			t.Fatal(err)
+		}
+		for i := 0; i < tt.items; i++ {
+			if c.Add(fmt.Sprintf("in%d", i), false) == false {
+				t.Fatalf("%+v: add item %d failed", tt, i)
+			}
+		}
+		for i := 0; i < tt.items; i++ {
+			if c.Exists(fmt.Sprintf("in%d", i)) == false {
+				t.Fatalf("%+v: item %d not found", tt, i)
+			}
+		}
+		if info := c.Info(); (info.Filters > 1) != tt.expand || info.Items != uint64(tt.items) {
+			t.Fatalf("%+v: %d filters and %d items", tt, info.Filters, info.Items)
+		}
+
+		for i := 0; i < tt.remove; i++ {
+			if c.Remove(fmt.Sprintf("in%d", i)) == false {
+				t.Fatalf("%+v: remove item %d failed", tt, i)
+			}
+		}
+		//删除后只会因为指纹冲突误判
+		fp := 0
+		for i := 0; i < tt.remove; i++ {
+			if c.Exists(fmt.Sprintf("in%d", i)) {
+				fp++
+			}
+		}
+		for i := tt.remove; i < tt.items; i++ {
+			if c.Exists(fmt.Sprintf("in%d", i)) == false {
+				t.Fatalf("%+v: item %d lost after removing others", tt, i)
+			}
+		}
+		info := c.Info()
+		if fp > tt.remove/100 || info.Items != uint64(tt.items-tt.remove) || info.Deleted != uint64(tt.remove) {
+			t.Fatalf("%+v: %d removed items still found, info %+v", tt, fp, info)
+		}
+	}
+}
+
+func TestCuckooAddNX(t *testing.T) {
+	c, _ := NewCuckooContent(100)
+	tests := []struct {
+		item string
+		nx   bool
+		want bool
+	}{
+		{"a", true, true},
+		{"a", true, false},
+		{"a", false, true},
+		{"b", true, true},
+	}
+
+	for _, tt := range tests {
+		if got := c.Add(tt.item, tt.nx); got != tt.want {
+			t.Fatalf("Add(%s, %v) = %v, want %v", tt.item, tt.nx, got, tt.want)
+		}
+	}
+	//重复添加的元素需要删除两次
+	c.Remove("a")
+	if c.Exists("a") == false {
+		t.Fatal("item added twice is gone after one remove")
+	}
+	c.Remove("a")
+	if c.Exists("a") {
+		t.Fatal("item still exists after removing twice")
+	}
+}
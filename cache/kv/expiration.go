@@ -0,0 +1,79 @@
+package kv
+
+import (
+	"errors"
+	"fmt"
+	"time"
+)
+
+type ExpireMode int32
+
+const (
+	ExpireRelative ExpireMode = 0 //Value 为相对当前时间的毫秒数
+	ExpireAbsolute ExpireMode = 1 //Value 为unix毫秒时间戳
+	ExpireKeep     ExpireMode = 2 //保留key原有的过期时间
+)
+
+/*
+写入时的过期设置，Value 为0时不过期，零值表示不过期
+*/
+type Expiration struct {
+	Mode  ExpireMode
+	Value int64
+}
+
+func ExpireSeconds(seconds int64) Expiration {
+	return Expiration{Mode: ExpireRelative, Value: seconds * 1000}
+}
+
+func ExpireMillis(milliseconds int64) Expiration {
+	return Expiration{Mode: ExpireRelative, Value: milliseconds}
+}
+
+func ExpireAtMillis(timestamp int64) Expiration {
+	return Expiration{Mode: ExpireAbsolute, Value: timestamp}
+}
+
+func KeepTTL() Expiration {
+	return Expiration{Mode: ExpireKeep}
+}
+
+/*
+seconds 为0时保留key原有的过期时间，key不存在时不过期，用于默认不修改过期时间的写入
+*/
+func KeepOrExpireSeconds(seconds int64) Expiration {
+	if seconds == ExpireForever {
+		return KeepTTL()
+	}
+	return ExpireSeconds(seconds)
+}
+
+func (s Expiration) Check() error {
+	switch s.Mode {
+	case ExpireRelative, ExpireAbsolute, ExpireKeep:
+	default:
+		str := fmt.Sprintf("invalid expire mode:%d", s.Mode)
+		return errors.New(str)
+	}
+	if s.Value < 0 {
+		str := fmt.Sprintf("invalid expire:%d", s.Value)
+		return errors.New(str)
+	}
+	return nil
+}
+
+/*
+转换成纳秒的过期时间点，old 为key原有的过期时间点，key不存在时为 ExpireForever
+*/
+func (s Expiration) Deadline(old int64) int64 {
+	if s.Mode == ExpireKeep {
+		return old
+	}
+	if s.Value == ExpireForever {
+		return ExpireForever
+	}
+	if s.Mode == ExpireAbsolute {
+		return s.Value * int64(time.Millisecond)
+	}
+	return time.Now().UnixNano() + s.Value*int64(time.Millisecond)
+}
@@ -0,0 +1,43 @@
+package kv
+
+import (
+	"testing"
+	"time"
+)
+
+func TestExpirationDeadline(t *testing.T) {
+	now := time.Now().UnixNano()
+	old := now + int64(time.Hour)
+	ms := int64(time.Millisecond)
+
+	tests := []struct {
+		name     string
+		e        Expiration
+		old      int64
+		min, max int64
+		err      bool
+	}{
+		{"zero value", Expiration{}, old, ExpireForever, ExpireForever, false},
+		{"seconds", ExpireSeconds(10), old, now + 10000*ms, now + 11000*ms, false},
+		{"millis", ExpireMillis(1500), ExpireForever, now + 1500*ms, now + 2500*ms, false},
+		{"absolute", ExpireAtMillis(now/ms + 5000), old, (now/ms + 5000) * ms, (now/ms + 5000) * ms, false},
+		{"keep", KeepTTL(), old, old, old, false},
+		{"keep missing key", KeepTTL(), ExpireForever, ExpireForever, ExpireForever, false},
+		{"keep or expire 0", KeepOrExpireSeconds(0), old, old, old, false},
+		{"keep or expire 3", KeepOrExpireSeconds(3), old, now + 3000*ms, now + 4000*ms, false},
+		{"negative", ExpireMillis(-1), old, 0, 0, true},
+		{"bad mode", Expiration{Mode: 9}, old, 0, 0, true},
+	}
+
+	for _, tt := range tests {
+		if err := tt.e.Check(); (err != nil) != tt.err {
+			t.Fatalf("%s: check error %v, want error %v", tt.name, err, tt.err)
+		}
+		if tt.err {
+			continue
+		}
+		if d := tt.e.Deadline(tt.old); d < tt.min || d > tt.max {
+			t.Fatalf("%s: deadline %d, want [%d, %d]", tt.name, d, tt.min, tt.max)
+		}
+	}
+}
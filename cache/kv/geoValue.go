@@ -0,0 +1,377 @@
+package kv
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"sort"
+	"time"
+	"unsafe"
+)
+
+const (
+	GeoLongitudeMin = -180.0
+	GeoLongitudeMax = 180.0
+	GeoLatitudeMin  = -85.05112878
+	GeoLatitudeMax  = 85.05112878
+
+	geoEarthRadius = 6372797.560856 //米，与redis一致
+)
+
+const (
+	GeoSortNone = ""
+	GeoSortAsc  = "asc"
+	GeoSortDesc = "desc"
+)
+
+type GeoPoint struct {
+	Longitude float64 `json:"longitude"`
+	Latitude  float64 `json:"latitude"`
+}
+
+/*
+geo 成员，查询结果中Dist为到中心点的距离，单位与查询单位一致
+*/
+type GeoMember struct {
+	Name      string  `json:"name"`
+	Longitude float64 `json:"longitude"`
+	Latitude  float64 `json:"latitude"`
+	Dist      float64 `json:"dist"`
+}
+
+/*
+Member 不为空时以该成员为中心，否则以Longitude、Latitude为中心
+Radius 大于0时按半径查询，否则按 Width*Height 的矩形查询
+Count 为0时不限制数量，指定了Count但没指定排序时默认升序
+*/
+type GeoSearchOption struct {
+	Member    string
+	Longitude float64
+	Latitude  float64
+	Radius    float64
+	Width     float64
+	Height    float64
+	Unit      string
+	Sort      string
+	Count     int
+}
+
+type GeoContent map[string]GeoPoint
+
+func NewGeoContent() GeoContent{
+	return make(GeoContent)
+}
+
+func CopyGeo(m GeoContent) GeoContent{
+	r := make(GeoContent)
+	for k, v := range m {
+		r[k] = v
+	}
+	return r
+}
+
+/*
+index 是按geohash排序的成员，和 Data 一样写入时复制，修改时生成新的索引
+*/
+type GeoValue struct {
+	Key    string       		`json:"key"`
+	Expire int64				`json:"expire"`
+	Data   GeoContent			`json:"data"`
+	index  geoIndex
+}
+
+func (s GeoValue) ToString() string{
+	data, _ := json.MarshalIndent(s.Data, "", "    ")
+	return string(data)
+}
+
+func (s GeoValue) Size() int {
+	t := boxSize(unsafe.Sizeof(s)) + StringSize(s.Key)
+	t += MapSize(len(s.Data), StringHeader, int(unsafe.Sizeof(GeoPoint{})))
+	for k := range s.Data {
+		t += StringSize(k)
+	}
+	return t + s.index.size()
+}
+
+func (s GeoValue) GetKey() string{
+	return s.Key
+}
+
+func (s GeoValue) IsExpire() bool{
+	t := time.Now().UnixNano()
+	if s.Expire != ExpireForever && s.Expire <= t{
+		return true
+	}
+	return false
+}
+
+func (s GeoValue) GetExpire() int64{
+	return s.Expire
+}
+
+func (s GeoValue) WithExpire(expire int64) ValueCache{
+	s.Expire = expire
+	return s
+}
+
+func (s GeoValue) WithKey(key string) ValueCache{
+	s.Key = key
+	return s
+}
+
+/*
+添加或更新成员，返回新增的成员数，Data 需要已经复制
+*/
+func (s *GeoValue) Add(members []GeoMember) int{
+	stale := len(s.index) != len(s.Data)
+	n := 0
+	changed := make(map[string]bool, len(members))
+	for _, m := range members {
+		if _, ok := s.Data[m.Name]; !ok {
+			n++
+		}
+		s.Data[m.Name] = GeoPoint{Longitude: m.Longitude, Latitude: m.Latitude}
+		changed[m.Name] = true
+	}
+
+	if stale {
+		s.Reindex()
+		return n
+	}
+
+	//未修改的成员已经有序，和修改的成员归并
+	added := make(geoIndex, 0, len(changed))
+	for name := range changed {
+		added = append(added, geoIndexItem{hash: geoHash(s.Data[name]), name: name})
+	}
+	sort.Slice(added, added.less)
+
+	index := make(geoIndex, 0, len(s.Data))
+	for _, item := range s.index {
+		if changed[item.name] {
+			continue
+		}
+		for len(added) > 0 && (added[0].hash < item.hash || (added[0].hash == item.hash && added[0].name < item.name)) {
+			index = append(index, added[0])
+			added = added[1:]
+		}
+		index = append(index, item)
+	}
+	s.index = append(index, added...)
+	return n
+}
+
+/*
+按 Data 重建索引，从文件加载后调用
+*/
+func (s *GeoValue) Reindex() {
+	index := make(geoIndex, 0, len(s.Data))
+	for name, p := range s.Data {
+		index = append(index, geoIndexItem{hash: geoHash(p), name: name})
+	}
+	sort.Slice(index, index.less)
+	s.index = index
+}
+
+func (s GeoValue) Get(member string) (GeoPoint, bool) {
+	v, ok := s.Data[member]
+	return v, ok
+}
+
+/*
+Data 需要已经复制
+*/
+func (s *GeoValue) Remove(member string) {
+	p, ok := s.Data[member]
+	if !ok {
+		return
+	}
+	delete(s.Data, member)
+
+	if len(s.index) != len(s.Data)+1 {
+		s.Reindex()
+		return
+	}
+	item := geoIndexItem{hash: geoHash(p), name: member}
+	i := sort.Search(len(s.index), func(i int) bool {
+		return s.index[i].hash > item.hash || (s.index[i].hash == item.hash && s.index[i].name >= item.name)
+	})
+	index := make(geoIndex, 0, len(s.Data))
+	index = append(index, s.index[:i]...)
+	if i < len(s.index) {
+		index = append(index, s.index[i+1:]...)
+	}
+	s.index = index
+}
+
+/*
+两个成员之间的距离
+*/
+func (s GeoValue) Dist(member1 string, member2 string, unit string) (float64, error) {
+	f, err := GeoUnitFactor(unit)
+	if err != nil {
+		return 0, err
+	}
+
+	p1, ok1 := s.Data[member1]
+	p2, ok2 := s.Data[member2]
+	if !ok1 || !ok2 {
+		str := fmt.Sprintf("geo key:%s not have member:%s or %s", s.Key, member1, member2)
+		return 0, errors.New(str)
+	}
+
+	return geoDistance(p1, p2) / f, nil
+}
+
+func (s GeoValue) Search(opt GeoSearchOption) ([]GeoMember, error) {
+	f, err := GeoUnitFactor(opt.Unit)
+	if err != nil {
+		return nil, err
+	}
+
+	center := GeoPoint{Longitude: opt.Longitude, Latitude: opt.Latitude}
+	if opt.Member != "" {
+		p, ok := s.Data[opt.Member]
+		if !ok {
+			str := fmt.Sprintf("geo key:%s not have member:%s", s.Key, opt.Member)
+			return nil, errors.New(str)
+		}
+		center = p
+	}else if err := CheckGeoPoint(opt.Longitude, opt.Latitude); err != nil {
+		return nil, err
+	}
+
+	byRadius := opt.Radius > 0
+	if !byRadius && (opt.Width <= 0 || opt.Height <= 0) {
+		return nil, errors.New("geo search need radius or width and height")
+	}
+
+	radius := opt.Radius * f
+	halfWidth := opt.Width * f / 2
+	halfHeight := opt.Height * f / 2
+
+	r := make([]GeoMember, 0)
+	s.near(center, byRadius, radius, halfWidth, halfHeight, func(name string) {
+		p := s.Data[name]
+		var d float64
+		if byRadius {
+			d = geoDistance(center, p)
+			if d > radius {
+				return
+			}
+		}else{
+			if geoLatDistance(center.Latitude, p.Latitude) > halfHeight {
+				return
+			}
+			lonDist := geoDistance(GeoPoint{Longitude: center.Longitude, Latitude: p.Latitude}, p)
+			if lonDist > halfWidth {
+				return
+			}
+			d = geoDistance(center, p)
+		}
+		r = append(r, GeoMember{Name: name, Longitude: p.Longitude, Latitude: p.Latitude, Dist: d / f})
+	})
+
+	sortType := opt.Sort
+	if sortType == GeoSortNone && opt.Count > 0 {
+		sortType = GeoSortAsc
+	}
+
+	if sortType == GeoSortAsc {
+		sort.Slice(r, func(i, j int) bool { return r[i].Dist < r[j].Dist })
+	}else if sortType == GeoSortDesc {
+		sort.Slice(r, func(i, j int) bool { return r[i].Dist > r[j].Dist })
+	}else if sortType != GeoSortNone {
+		str := fmt.Sprintf("geo search unsupported sort:%s", opt.Sort)
+		return nil, errors.New(str)
+	}
+
+	if opt.Count > 0 && len(r) > opt.Count {
+		r = r[:opt.Count]
+	}
+	return r, nil
+}
+
+/*
+可能在查询范围内的成员，先按范围算出纬度、经度的最大差值，在索引中查找中心点附近的格子
+之后还需要按距离精确过滤，索引没有建立时遍历所有成员
+*/
+func (s GeoValue) near(center GeoPoint, byRadius bool, radius float64, halfWidth float64, halfHeight float64,
+	f func(name string)) {
+	if len(s.index) != len(s.Data) {
+		for name := range s.Data {
+			f(name)
+		}
+		return
+	}
+
+	dLat, dLon := 0.0, 360.0
+	if byRadius {
+		dLat = radius / geoEarthRadius
+		//圆上经度差最大的点，圆包含极点时经度不限
+		if x := math.Sin(dLat) / math.Cos(geoDegRad(center.Latitude)); dLat < math.Pi/2 && x < 1 {
+			dLon = geoRadDeg(math.Asin(x))
+		}
+	}else{
+		dLat = halfHeight / geoEarthRadius
+		//同一纬度上经度差相同时纬度越高距离越近，按范围内纬度最高处计算
+		lat := math.Min(math.Abs(center.Latitude)+geoRadDeg(dLat), GeoLatitudeMax)
+		if x := math.Sin(halfWidth/geoEarthRadius/2) / math.Cos(geoDegRad(lat)); halfWidth/geoEarthRadius < math.Pi && x < 1 {
+			dLon = geoRadDeg(2 * math.Asin(x))
+		}
+	}
+	s.index.near(center, geoRadDeg(dLat), dLon, f)
+}
+
+func CheckGeoPoint(longitude float64, latitude float64) error {
+	if longitude < GeoLongitudeMin || longitude > GeoLongitudeMax ||
+		latitude < GeoLatitudeMin || latitude > GeoLatitudeMax {
+		str := fmt.Sprintf("invalid longitude,latitude pair %f,%f", longitude, latitude)
+		return errors.New(str)
+	}
+	return nil
+}
+
+/*
+距离单位换算成米的系数，支持 m、km、mi、ft，默认为m
+*/
+func GeoUnitFactor(unit string) (float64, error) {
+	switch unit {
+	case "", "m":
+		return 1, nil
+	case "km":
+		return 1000, nil
+	case "mi":
+		return 1609.34, nil
+	case "ft":
+		return 0.3048, nil
+	}
+	str := fmt.Sprintf("unsupported unit:%s, please use m, km, ft, mi", unit)
+	return 0, errors.New(str)
+}
+
+func geoDistance(p1 GeoPoint, p2 GeoPoint) float64 {
+	lat1r := geoDegRad(p1.Latitude)
+	lon1r := geoDegRad(p1.Longitude)
+	lat2r := geoDegRad(p2.Latitude)
+	lon2r := geoDegRad(p2.Longitude)
+
+	u := math.Sin((lat2r - lat1r) / 2)
+	v := math.Sin((lon2r - lon1r) / 2)
+	a := u*u + math.Cos(lat1r)*math.Cos(lat2r)*v*v
+	return 2.0 * geoEarthRadius * math.Asin(math.Sqrt(a))
+}
+
+func geoLatDistance(lat1 float64, lat2 float64) float64 {
+	return geoEarthRadius * math.Abs(geoDegRad(lat2)-geoDegRad(lat1))
+}
+
+func geoDegRad(deg float64) float64 {
+	return deg * math.Pi / 180.0
+}
+
+func geoRadDeg(rad float64) float64 {
+	return rad * 180.0 / math.Pi
+}
@@ -0,0 +1,89 @@
+package kv
+
+import (
+	"fmt"
+	"math/rand"
+	"sort"
+	"testing"
+)
+
+func searchNames(t *testing.T, g GeoValue, opt GeoSearchOption) []string {
+	r, err := g.Search(opt)
+	if err != nil {
+		t.Fatal(err)
+	}
+	names := make([]string, 0, len(r))
+	for _, m := range r {
+		names = append(names, m.Name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+/*
+按索引查询和遍历所有成员的结果一致
+*/
+func TestGeoSearchIndex(t *testing.T) {
+	rnd := rand.New(rand.NewSource(1))
+	g := GeoValue{Key: "geo", Data: NewGeoContent()}
+	members := make([]GeoMember, 0)
+	for i := 0; i < 3000; i++ {
+		members = append(members, GeoMember{Name: fmt.Sprint(i),
+			Longitude: rnd.Float64()*360 - 180, Latitude: rnd.Float64()*170 - 85})
+	}
+	//经度首尾、高纬度附近的成员
+	for i := 0; i < 200; i++ {
+		members = append(members, GeoMember{Name: fmt.Sprintf("e%d", i),
+			Longitude: 179.9 - rnd.Float64()*0.2, Latitude: rnd.Float64()*2 - 1})
+		members = append(members, GeoMember{Name: fmt.Sprintf("p%d", i),
+			Longitude: rnd.Float64()*360 - 180, Latitude: 84 + rnd.Float64()})
+	}
+	g.Add(members)
+	for i := 0; i < 100; i++ {
+		g.Remove(fmt.Sprint(i))
+	}
+	if len(g.index) != len(g.Data) {
+		t.Fatalf("index has %d members, want %d", len(g.index), len(g.Data))
+	}
+
+	scan := GeoValue{Key: "geo", Data: g.Data}
+	tests := []GeoSearchOption{
+		{Longitude: 0, Latitude: 0, Radius: 500, Unit: "km"},
+		{Longitude: 116.4, Latitude: 39.9, Radius: 10, Unit: "m"},
+		{Longitude: 180, Latitude: 0, Radius: 50, Unit: "km"},
+		{Longitude: -179.95, Latitude: 0.5, Width: 40, Height: 40, Unit: "km"},
+		{Longitude: 10, Latitude: 84.5, Radius: 300, Unit: "km"},
+		{Longitude: 10, Latitude: 84.5, Width: 2000, Height: 100, Unit: "km"},
+		{Longitude: 0, Latitude: 0, Radius: 15000, Unit: "km"},
+		{Longitude: 30, Latitude: -60, Width: 30000, Height: 5000, Unit: "km"},
+		{Member: "e1", Radius: 5, Unit: "km"},
+	}
+
+	for _, opt := range tests {
+		got := searchNames(t, g, opt)
+		want := searchNames(t, scan, opt)
+		if fmt.Sprint(got) != fmt.Sprint(want) {
+			t.Fatalf("search %+v got %d members, want %d", opt, len(got), len(want))
+		}
+	}
+}
+
+/*
+修改时生成新的索引，复制前的值不受影响
+*/
+func TestGeoIndexCopyOnWrite(t *testing.T) {
+	g := GeoValue{Key: "geo", Data: NewGeoContent()}
+	g.Add([]GeoMember{{Name: "a", Longitude: 1, Latitude: 1}, {Name: "b", Longitude: 2, Latitude: 2}})
+
+	old := g
+	g.Data = CopyGeo(old.Data)
+	g.Add([]GeoMember{{Name: "a", Longitude: 3, Latitude: 3}, {Name: "c", Longitude: 4, Latitude: 4}})
+	g.Remove("b")
+
+	if len(old.index) != 2 || old.index[0].name != "a" || old.index[1].name != "b" {
+		t.Fatalf("old index changed: %v", old.index)
+	}
+	if len(g.index) != 2 || g.index[0].name != "a" || g.index[1].name != "c" {
+		t.Fatalf("new index is %v, want a, c", g.index)
+	}
+}
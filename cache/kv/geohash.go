@@ -0,0 +1,120 @@
+package kv
+
+import (
+	"math"
+	"sort"
+	"unsafe"
+)
+
+/*
+与redis一致，经纬度各26位，交错成52位的geohash，纬度在偶数位
+*/
+const geoStepMax = 26
+
+/*
+成员按geohash排序，附近的成员在索引中也相邻
+*/
+type geoIndexItem struct {
+	hash uint64
+	name string
+}
+
+type geoIndex []geoIndexItem
+
+func (s geoIndex) less(i, j int) bool {
+	return s[i].hash < s[j].hash || (s[i].hash == s[j].hash && s[i].name < s[j].name)
+}
+
+func (s geoIndex) size() int {
+	return SliceSize(cap(s), int(unsafe.Sizeof(geoIndexItem{})))
+}
+
+/*
+[min, max) 范围内的成员
+*/
+func (s geoIndex) rangeOf(min uint64, max uint64, f func(name string)) {
+	i := sort.Search(len(s), func(i int) bool { return s[i].hash >= min })
+	for ; i < len(s) && s[i].hash < max; i++ {
+		f(s[i].name)
+	}
+}
+
+/*
+step 位精度下的格子坐标
+*/
+func geoCell(p GeoPoint, step uint) (uint64, uint64) {
+	n := float64(uint64(1) << step)
+	lat := (p.Latitude - GeoLatitudeMin) / (GeoLatitudeMax - GeoLatitudeMin) * n
+	lon := (p.Longitude - GeoLongitudeMin) / (GeoLongitudeMax - GeoLongitudeMin) * n
+	return geoClamp(lat, step), geoClamp(lon, step)
+}
+
+func geoClamp(v float64, step uint) uint64 {
+	max := uint64(1)<<step - 1
+	if v <= 0 {
+		return 0
+	}
+	if v >= float64(max) {
+		return max
+	}
+	return uint64(v)
+}
+
+func geoInterleave(lat uint64, lon uint64, step uint) uint64 {
+	var r uint64
+	for i := uint(0); i < step; i++ {
+		r |= (lat>>i&1)<<(2*i) | (lon>>i&1)<<(2*i+1)
+	}
+	return r
+}
+
+func geoHash(p GeoPoint) uint64 {
+	lat, lon := geoCell(p, geoStepMax)
+	return geoInterleave(lat, lon, geoStepMax)
+}
+
+/*
+格子宽高都不小于 dLat、dLon(度) 的最大精度，为0时只有一个格子
+*/
+func geoStep(dLat float64, dLon float64) uint {
+	step := uint(geoStepMax)
+	for step > 0 {
+		n := float64(uint64(1) << step)
+		if (GeoLatitudeMax-GeoLatitudeMin)/n > dLat && (GeoLongitudeMax-GeoLongitudeMin)/n > dLon {
+			break
+		}
+		step--
+	}
+	return step
+}
+
+/*
+中心点所在格子和周围8个格子覆盖的成员，距离中心点纬度差不超过 dLat、经度差不超过 dLon 的成员一定在其中
+*/
+func (s geoIndex) near(center GeoPoint, dLat float64, dLon float64, f func(name string)) {
+	step := geoStep(dLat, dLon)
+	if step == 0 {
+		s.rangeOf(0, math.MaxUint64, f)
+		return
+	}
+
+	n := uint64(1) << step
+	shift := 2 * (geoStepMax - step)
+	lat, lon := geoCell(center, step)
+	seen := make(map[uint64]bool, 9)
+	for _, i := range []int64{-1, 0, 1} {
+		la := int64(lat) + i
+		if la < 0 || la >= int64(n) {
+			continue
+		}
+		for _, j := range []uint64{n - 1, 0, 1} {
+			//经度首尾相连
+			h := geoInterleave(uint64(la), (lon+j)%n, step)
+			if seen[h] {
+				continue
+			}
+			seen[h] = true
+			s.rangeOf(h<<shift, (h+1)<<shift, f)
+		}
+	}
+}
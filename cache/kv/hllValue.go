@@ -0,0 +1,249 @@
+package kv
+
+import (
+	"encoding/binary"
+	"encoding/json"
+	"math"
+	"math/bits"
+	"strconv"
+	"time"
+	"unsafe"
+)
+
+const (
+	hllP         = 14
+	hllQ         = 64 - hllP
+	hllRegisters = 1 << hllP
+	hllBits      = 6
+	hllMask      = 1<<hllBits - 1
+	hllBytes     = hllRegisters*hllBits/8 + 1 //多一个字节，读写最后一个寄存器时不越界
+	hllAlphaInf  = 0.721347520444481703680   // 0.5/ln(2)
+	hllSeed      = 0xadc83b19
+)
+
+/*
+HyperLogLog 16384个6bit寄存器，固定占用12k左右内存
+*/
+type HLLContent []byte
+
+func NewHLLContent() HLLContent{
+	return make(HLLContent, hllBytes)
+}
+
+type HLLValue struct {
+	Key    string       		`json:"key"`
+	Expire int64				`json:"expire"`
+	Data   HLLContent			`json:"data"`
+}
+
+func (s HLLValue) ToString() string{
+	return strconv.FormatUint(s.Count(), 10)
+}
+
+func (s HLLValue) Size() int {
+	return boxSize(unsafe.Sizeof(s)) + StringSize(s.Key) + SliceSize(cap(s.Data), 1)
+}
+
+func (s HLLValue) GetKey() string{
+	return s.Key
+}
+
+func (s HLLValue) IsExpire() bool{
+	t := time.Now().UnixNano()
+	if s.Expire != ExpireForever && s.Expire <= t{
+		return true
+	}
+	return false
+}
+
+func (s HLLValue) GetExpire() int64{
+	return s.Expire
+}
+
+func (s HLLValue) WithExpire(expire int64) ValueCache{
+	s.Expire = expire
+	return s
+}
+
+func (s HLLValue) WithKey(key string) ValueCache{
+	s.Key = key
+	return s
+}
+
+//dump 时只输出估算值，不输出寄存器
+func (s HLLValue) MarshalJSON() ([]byte, error) {
+	return json.Marshal(struct {
+		Key    string `json:"key"`
+		Expire int64  `json:"expire"`
+		Count  uint64 `json:"count"`
+	}{s.Key, s.Expire, s.Count()})
+}
+
+/*
+添加元素，有寄存器发生变化返回true
+*/
+func (s HLLValue) Add(elements ...string) bool{
+	changed := false
+	for _, e := range elements {
+		index, rank := hllPatLen([]byte(e))
+		if rank > s.register(index) {
+			s.setRegister(index, rank)
+			changed = true
+		}
+	}
+	return changed
+}
+
+/*
+合并，每个寄存器取最大值
+*/
+func (s HLLValue) Merge(o HLLValue) {
+	if len(o.Data) != hllBytes {
+		return
+	}
+	for i := 0; i < hllRegisters; i++ {
+		if r := o.register(i); r > s.register(i) {
+			s.setRegister(i, r)
+		}
+	}
+}
+
+/*
+基数估算，使用 Otmar Ertl 改进的估算算法，小基数时不需要额外的偏差修正
+*/
+func (s HLLValue) Count() uint64{
+	if len(s.Data) != hllBytes {
+		return 0
+	}
+
+	var histo [hllQ + 2]int
+	for i := 0; i < hllRegisters; i++ {
+		histo[s.register(i)]++
+	}
+
+	m := float64(hllRegisters)
+	z := m * hllTau((m-float64(histo[hllQ+1]))/m)
+	for k := hllQ; k >= 1; k-- {
+		z += float64(histo[k])
+		z *= 0.5
+	}
+	z += m * hllSigma(float64(histo[0])/m)
+
+	return uint64(math.Round(hllAlphaInf * m * m / z))
+}
+
+func (s HLLValue) register(i int) uint8 {
+	b := i * hllBits / 8
+	fb := uint(i*hllBits) & 7
+	v := uint(s.Data[b]) >> fb
+	if fb > 8-hllBits {
+		v |= uint(s.Data[b+1]) << (8 - fb)
+	}
+	return uint8(v & hllMask)
+}
+
+func (s HLLValue) setRegister(i int, val uint8) {
+	b := i * hllBits / 8
+	fb := uint(i*hllBits) & 7
+	v := uint(val)
+
+	s.Data[b] &^= byte(hllMask << fb)
+	s.Data[b] |= byte(v << fb)
+	if fb > 8-hllBits {
+		s.Data[b+1] &^= byte(hllMask >> (8 - fb))
+		s.Data[b+1] |= byte(v >> (8 - fb))
+	}
+}
+
+/*
+返回元素对应的寄存器下标，以及剩余hash位中第一个1出现的位置
+*/
+func hllPatLen(e []byte) (int, uint8) {
+	h := murmurHash64A(e, hllSeed)
+	index := int(h & (hllRegisters - 1))
+	h >>= hllP
+	h |= 1 << hllQ
+	return index, uint8(bits.TrailingZeros64(h) + 1)
+}
+
+func hllSigma(x float64) float64 {
+	if x == 1.0 {
+		return math.Inf(1)
+	}
+	y := 1.0
+	z := x
+	for {
+		x *= x
+		zPrime := z
+		z += x * y
+		y += y
+		if zPrime == z {
+			return z
+		}
+	}
+}
+
+func hllTau(x float64) float64 {
+	if x == 0.0 || x == 1.0 {
+		return 0.0
+	}
+	y := 1.0
+	z := 1 - x
+	for {
+		x = math.Sqrt(x)
+		zPrime := z
+		y *= 0.5
+		z -= math.Pow(1-x, 2) * y
+		if zPrime == z {
+			return z / 3
+		}
+	}
+}
+
+func murmurHash64A(key []byte, seed uint64) uint64 {
+	const m = 0xc6a4a7935bd1e995
+	const r = 47
+
+	l := len(key)
+	h := seed ^ (uint64(l) * m)
+
+	for len(key) >= 8 {
+		k := binary.LittleEndian.Uint64(key)
+		k *= m
+		k ^= k >> r
+		k *= m
+
+		h ^= k
+		h *= m
+		key = key[8:]
+	}
+
+	switch len(key) {
+	case 7:
+		h ^= uint64(key[6]) << 48
+		fallthrough
+	case 6:
+		h ^= uint64(key[5]) << 40
+		fallthrough
+	case 5:
+		h ^= uint64(key[4]) << 32
+		fallthrough
+	case 4:
+		h ^= uint64(key[3]) << 24
+		fallthrough
+	case 3:
+		h ^= uint64(key[2]) << 16
+		fallthrough
+	case 2:
+		h ^= uint64(key[1]) << 8
+		fallthrough
+	case 1:
+		h ^= uint64(key[0])
+		h *= m
+	}
+
+	h ^= h >> r
+	h *= m
+	h ^= h >> r
+	return h
+}
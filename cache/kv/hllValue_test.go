@@ -0,0 +1,60 @@
+package kv
+
+import (
+	"fmt"
+	"math"
+	"testing"
+)
+
+func newHLL(from int, to int) HLLValue {
+	h := HLLValue{Key: "hll", Data: NewHLLContent(), Expire: ExpireForever}
+	for i := from; i < to; i++ {
+		h.Add(fmt.Sprintf("e%d", i))
+	}
+	return h
+}
+
+/*
+p=14 时标准误差约0.81%，按3倍标准误差检查，小基数时误差不超过1
+*/
+func checkHLLCount(t *testing.T, name string, got uint64, want int) {
+	diff := math.Abs(float64(got) - float64(want))
+	if diff > 1 && diff > float64(want)*0.025 {
+		t.Fatalf("%s: count %d, want %d", name, got, want)
+	}
+}
+
+func TestHLLCount(t *testing.T) {
+	tests := []int{0, 1, 10, 100, 1000, 10000, 100000, 1000000}
+	for _, n := range tests {
+		h := newHLL(0, n)
+		checkHLLCount(t, fmt.Sprint(n), h.Count(), n)
+
+		//重复添加不改变寄存器和估算值
+		if n > 0 && h.Add("e0") {
+			t.Fatalf("%d: add existing element changed registers", n)
+		}
+		checkHLLCount(t, fmt.Sprint(n), h.Count(), n)
+	}
+}
+
+func TestHLLMerge(t *testing.T) {
+	tests := []struct {
+		name string
+		a, b [2]int
+		want int
+	}{
+		{"disjoint", [2]int{0, 5000}, [2]int{5000, 10000}, 10000},
+		{"overlap", [2]int{0, 10000}, [2]int{5000, 15000}, 15000},
+		{"subset", [2]int{0, 20000}, [2]int{100, 200}, 20000},
+		{"empty", [2]int{0, 0}, [2]int{0, 3000}, 3000},
+	}
+
+	for _, tt := range tests {
+		a := newHLL(tt.a[0], tt.a[1])
+		b := newHLL(tt.b[0], tt.b[1])
+		a.Merge(b)
+		checkHLLCount(t, tt.name, a.Count(), tt.want)
+		checkHLLCount(t, tt.name, b.Count(), tt.b[1]-tt.b[0])
+	}
+}
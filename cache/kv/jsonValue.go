@@ -0,0 +1,410 @@
+package kv
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+	"unsafe"
+)
+
+const JSONRootPath = "$"
+
+/*
+路径的一段，IsIndex 为true时表示数组下标，负数下标从末尾开始计算
+*/
+type JSONPathToken struct {
+	Key     string
+	Index   int
+	IsIndex bool
+}
+
+/*
+json文档路径，支持 $.a.b[0]、a.b[-1]、$["a.b"] 这几种写法，空字符串和 $ 表示根节点
+*/
+type JSONPath []JSONPathToken
+
+func ParseJSONPath(str string) (JSONPath, error) {
+	p := JSONPath{}
+	s := strings.TrimSpace(str)
+	s = strings.TrimPrefix(s, JSONRootPath)
+
+	for i := 0; i < len(s); {
+		c := s[i]
+		if c == '.' {
+			i++
+			j := i
+			for j < len(s) && s[j] != '.' && s[j] != '[' {
+				j++
+			}
+			if j == i {
+				if j == len(s) && len(p) == 0 {
+					break
+				}
+				return nil, errInvalidJSONPath(str)
+			}
+			p = append(p, JSONPathToken{Key: s[i:j]})
+			i = j
+		}else if c == '[' {
+			end := strings.IndexByte(s[i:], ']')
+			if end < 0 {
+				return nil, errInvalidJSONPath(str)
+			}
+			inner := s[i+1 : i+end]
+			if len(inner) >= 2 && (inner[0] == '"' || inner[0] == '\'') && inner[len(inner)-1] == inner[0] {
+				p = append(p, JSONPathToken{Key: inner[1 : len(inner)-1]})
+			}else{
+				idx, err := strconv.Atoi(inner)
+				if err != nil {
+					return nil, errInvalidJSONPath(str)
+				}
+				p = append(p, JSONPathToken{Index: idx, IsIndex: true})
+			}
+			i += end + 1
+		}else if i == 0 {
+			//允许省略开头的 $.
+			s = "." + s
+		}else{
+			return nil, errInvalidJSONPath(str)
+		}
+	}
+	return p, nil
+}
+
+func (s JSONPath) IsRoot() bool {
+	return len(s) == 0
+}
+
+/*
+规范化的路径，用于事件通知
+*/
+func (s JSONPath) String() string {
+	b := strings.Builder{}
+	b.WriteString(JSONRootPath)
+	for _, t := range s {
+		if t.IsIndex {
+			b.WriteString("[" + strconv.Itoa(t.Index) + "]")
+		}else if t.Key == "" || strings.ContainsAny(t.Key, ".[]\"'") {
+			b.WriteString("[" + strconv.Quote(t.Key) + "]")
+		}else{
+			b.WriteString("." + t.Key)
+		}
+	}
+	return b.String()
+}
+
+/*
+json文档，Data 为解析后的树，数字保存为 json.Number 避免精度丢失
+修改操作不会改动原来的树，只复制路径上的节点，所以读取时不需要加锁
+Path 只在事件通知时使用，表示发生变化的路径，不持久化
+*/
+type JSONValue struct {
+	Key    string       		`json:"key"`
+	Expire int64				`json:"expire"`
+	Data   interface{}			`json:"data"`
+	Path   string				`json:"-"`
+}
+
+func DecodeJSON(str string) (interface{}, error) {
+	d := json.NewDecoder(bytes.NewBufferString(str))
+	d.UseNumber()
+
+	var v interface{}
+	if err := d.Decode(&v); err != nil {
+		s := fmt.Sprintf("invalid json:%s", err.Error())
+		return nil, errors.New(s)
+	}
+	if d.More() {
+		return nil, errors.New("invalid json: more than one value")
+	}
+	return v, nil
+}
+
+func (s JSONValue) ToString() string{
+	data, _ := json.Marshal(s.Data)
+	return string(data)
+}
+
+func (s JSONValue) Size() int {
+	return boxSize(unsafe.Sizeof(s)) + StringSize(s.Key) + StringSize(s.Path) + jsonSize(s.Data)
+}
+
+func (s JSONValue) GetKey() string{
+	return s.Key
+}
+
+func (s JSONValue) IsExpire() bool{
+	t := time.Now().UnixNano()
+	if s.Expire != ExpireForever && s.Expire <= t{
+		return true
+	}
+	return false
+}
+
+func (s JSONValue) GetExpire() int64{
+	return s.Expire
+}
+
+func (s JSONValue) WithExpire(expire int64) ValueCache{
+	s.Expire = expire
+	return s
+}
+
+func (s JSONValue) WithKey(key string) ValueCache{
+	s.Key = key
+	return s
+}
+
+/*
+查找路径上的节点，路径不存在时返回false
+*/
+func (s JSONValue) Find(path JSONPath) (interface{}, bool) {
+	node := s.Data
+	for _, t := range path {
+		if t.IsIndex {
+			arr, ok := node.([]interface{})
+			if !ok {
+				return nil, false
+			}
+			idx, ok := jsonIndex(arr, t.Index)
+			if !ok {
+				return nil, false
+			}
+			node = arr[idx]
+		}else{
+			m, ok := node.(map[string]interface{})
+			if !ok {
+				return nil, false
+			}
+			v, ok := m[t.Key]
+			if !ok {
+				return nil, false
+			}
+			node = v
+		}
+	}
+	return node, true
+}
+
+/*
+设置路径上的值，父节点必须存在，对象中不存在的字段会新建，数组下标必须在范围内
+*/
+func (s JSONValue) Set(path JSONPath, v interface{}) (JSONValue, error) {
+	data, err := jsonUpdate(s.Data, path, func(old interface{}, exist bool) (interface{}, error) {
+		return v, nil
+	})
+	if err != nil {
+		return s, s.pathError(path, err)
+	}
+	s.Data = data
+	return s, nil
+}
+
+/*
+删除路径上的值，返回删除的个数
+*/
+func (s JSONValue) Delete(path JSONPath) (JSONValue, int, error) {
+	n := 0
+	data, err := jsonUpdate(s.Data, path, func(old interface{}, exist bool) (interface{}, error) {
+		if exist {
+			n = 1
+		}
+		return jsonDeleted{}, nil
+	})
+	if err != nil {
+		if err == errJSONPathNotFound {
+			return s, 0, nil
+		}
+		return s, 0, s.pathError(path, err)
+	}
+	if _, ok := data.(jsonDeleted); ok {
+		data = nil
+	}
+	s.Data = data
+	return s, n, nil
+}
+
+/*
+往路径上的数组末尾追加，返回追加后的长度
+*/
+func (s JSONValue) ArrAppend(path JSONPath, values []interface{}) (JSONValue, int, error) {
+	n := 0
+	data, err := jsonUpdate(s.Data, path, func(old interface{}, exist bool) (interface{}, error) {
+		arr, ok := old.([]interface{})
+		if !exist || !ok {
+			return nil, errors.New("not an array")
+		}
+		r := make([]interface{}, len(arr), len(arr)+len(values))
+		copy(r, arr)
+		r = append(r, values...)
+		n = len(r)
+		return r, nil
+	})
+	if err != nil {
+		return s, 0, s.pathError(path, err)
+	}
+	s.Data = data
+	return s, n, nil
+}
+
+/*
+路径上的数字加上by，两者都是整数时按整数计算，否则按浮点数计算
+*/
+func (s JSONValue) NumIncrBy(path JSONPath, by json.Number) (JSONValue, json.Number, error) {
+	var r json.Number
+	data, err := jsonUpdate(s.Data, path, func(old interface{}, exist bool) (interface{}, error) {
+		num, ok := old.(json.Number)
+		if !exist || !ok {
+			return nil, errors.New("not a number")
+		}
+
+		i1, e1 := num.Int64()
+		i2, e2 := by.Int64()
+		if e1 == nil && e2 == nil {
+			sum := i1 + i2
+			if (i2 > 0 && sum < i1) || (i2 < 0 && sum > i1) {
+				return nil, errors.New("increment would overflow")
+			}
+			r = json.Number(strconv.FormatInt(sum, 10))
+			return r, nil
+		}
+
+		f1, e1 := num.Float64()
+		f2, e2 := by.Float64()
+		if e1 != nil || e2 != nil {
+			return nil, errors.New("not a number")
+		}
+		f := f1 + f2
+		if math.IsInf(f, 0) || math.IsNaN(f) {
+			return nil, errors.New("increment would produce NaN or Infinity")
+		}
+		r = json.Number(strconv.FormatFloat(f, 'g', -1, 64))
+		return r, nil
+	})
+	if err != nil {
+		return s, "", s.pathError(path, err)
+	}
+	s.Data = data
+	return s, r, nil
+}
+
+func (s JSONValue) pathError(path JSONPath, err error) error {
+	str := fmt.Sprintf("json key:%s path:%s %s", s.Key, path.String(), err.Error())
+	return errors.New(str)
+}
+
+type jsonDeleted struct {}
+
+var errJSONPathNotFound = errors.New("path not found")
+
+/*
+按路径修改，只复制路径上经过的对象和数组，返回新的根节点
+*/
+func jsonUpdate(node interface{}, path JSONPath, f func(old interface{}, exist bool) (interface{}, error)) (interface{}, error) {
+	if len(path) == 0 {
+		return f(node, true)
+	}
+
+	t := path[0]
+	if t.IsIndex {
+		arr, ok := node.([]interface{})
+		if !ok {
+			return nil, errJSONPathNotFound
+		}
+		idx, ok := jsonIndex(arr, t.Index)
+		if !ok {
+			return nil, errJSONPathNotFound
+		}
+
+		v, err := jsonUpdate(arr[idx], path[1:], f)
+		if err != nil {
+			return nil, err
+		}
+
+		if _, ok := v.(jsonDeleted); ok {
+			r := make([]interface{}, 0, len(arr)-1)
+			r = append(r, arr[:idx]...)
+			return append(r, arr[idx+1:]...), nil
+		}
+		r := make([]interface{}, len(arr))
+		copy(r, arr)
+		r[idx] = v
+		return r, nil
+	}
+
+	m, ok := node.(map[string]interface{})
+	if !ok {
+		return nil, errJSONPathNotFound
+	}
+
+	var v interface{}
+	var err error
+	old, exist := m[t.Key]
+	if len(path) == 1 {
+		v, err = f(old, exist)
+	}else if exist {
+		v, err = jsonUpdate(old, path[1:], f)
+	}else{
+		err = errJSONPathNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	r := make(map[string]interface{}, len(m)+1)
+	for k, e := range m {
+		r[k] = e
+	}
+	if _, ok := v.(jsonDeleted); ok {
+		if !exist {
+			return nil, errJSONPathNotFound
+		}
+		delete(r, t.Key)
+	}else{
+		r[t.Key] = v
+	}
+	return r, nil
+}
+
+func jsonIndex(arr []interface{}, idx int) (int, bool) {
+	if idx < 0 {
+		idx += len(arr)
+	}
+	if idx < 0 || idx >= len(arr) {
+		return 0, false
+	}
+	return idx, true
+}
+
+func jsonSize(node interface{}) int {
+	switch v := node.(type) {
+	case map[string]interface{}:
+		t := MapSize(len(v), StringHeader, InterfaceSize)
+		for k, e := range v {
+			t += StringSize(k) + jsonSize(e)
+		}
+		return t
+	case []interface{}:
+		t := SliceSize(cap(v), InterfaceSize)
+		for _, e := range v {
+			t += jsonSize(e)
+		}
+		return t
+	case string:
+		return AllocSize(StringHeader) + StringSize(v)
+	case json.Number:
+		return AllocSize(StringHeader) + StringSize(string(v))
+	case float64:
+		return AllocSize(8)
+	}
+	return 0
+}
+
+func errInvalidJSONPath(str string) error {
+	s := fmt.Sprintf("invalid json path:%s", str)
+	return errors.New(s)
+}
@@ -0,0 +1,138 @@
+package kv
+
+import (
+	"encoding/json"
+	"strconv"
+	"testing"
+)
+
+func TestParseJSONPath(t *testing.T) {
+	tests := []struct {
+		path string
+		want string
+		err  bool
+	}{
+		{"", "$", false},
+		{"$", "$", false},
+		{"$.a.b[0]", "$.a.b[0]", false},
+		{"a.b[-1]", "$.a.b[-1]", false},
+		{`$["a.b"].c`, `$["a.b"].c`, false},
+		{"$['x']", "$.x", false},
+		{"$.a..b", "", true},
+		{"$.a[", "", true},
+		{"$.a[x]", "", true},
+		{`$.a["b]`, "", true},
+	}
+
+	for _, tt := range tests {
+		p, err := ParseJSONPath(tt.path)
+		if (err != nil) != tt.err {
+			t.Fatalf("%q: error %v, want error %v", tt.path, err, tt.err)
+		}
+		if err == nil && p.String() != tt.want {
+			t.Fatalf("%q: parsed as %s, want %s", tt.path, p.String(), tt.want)
+		}
+	}
+}
+
+func newJSON(t *testing.T, doc string) JSONValue {
+	v, err := DecodeJSON(doc)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return JSONValue{Key: "j", Data: v, Expire: ExpireForever}
+}
+
+func jsonString(v JSONValue) string {
+	b, _ := json.Marshal(v.Data)
+	return string(b)
+}
+
+/*
+修改返回新的文档，原来的文档不变
+*/
+func TestJSONUpdate(t *testing.T) {
+	const doc = `{"a":{"b":[1,2,3],"n":1.5},"s":"x","i":9223372036854775806}`
+	tests := []struct {
+		name string
+		path string
+		op   func(j JSONValue, p JSONPath) (JSONValue, string, error)
+		want string
+		r    string
+		err  bool
+	}{
+		{"set field", "$.a.c", jsonSet(`{"d":true}`), `{"a":{"b":[1,2,3],"c":{"d":true},"n":1.5},"i":9223372036854775806,"s":"x"}`, "", false},
+		{"set index", "$.a.b[-1]", jsonSet(`"z"`), `{"a":{"b":[1,2,"z"],"n":1.5},"i":9223372036854775806,"s":"x"}`, "", false},
+		{"set root", "$", jsonSet(`[1]`), `[1]`, "", false},
+		{"set missing parent", "$.x.y", jsonSet(`1`), doc, "", true},
+		{"set index out of range", "$.a.b[3]", jsonSet(`1`), doc, "", true},
+		{"delete field", "$.a.n", jsonDelete, `{"a":{"b":[1,2,3]},"i":9223372036854775806,"s":"x"}`, "1", false},
+		{"delete index", "$.a.b[0]", jsonDelete, `{"a":{"b":[2,3],"n":1.5},"i":9223372036854775806,"s":"x"}`, "1", false},
+		{"delete missing", "$.q", jsonDelete, doc, "0", false},
+		{"append", "$.a.b", jsonAppend(`4`, `{"e":1}`), `{"a":{"b":[1,2,3,4,{"e":1}],"n":1.5},"i":9223372036854775806,"s":"x"}`, "5", false},
+		{"append not array", "$.s", jsonAppend(`1`), doc, "", true},
+		{"incr int", "$.a.b[1]", jsonIncr("5"), `{"a":{"b":[1,7,3],"n":1.5},"i":9223372036854775806,"s":"x"}`, "7", false},
+		{"incr float", "$.a.n", jsonIncr("0.25"), `{"a":{"b":[1,2,3],"n":1.75},"i":9223372036854775806,"s":"x"}`, "1.75", false},
+		{"incr keeps int64 precision", "$.i", jsonIncr("1"), `{"a":{"b":[1,2,3],"n":1.5},"i":9223372036854775807,"s":"x"}`, "9223372036854775807", false},
+		{"incr overflow", "$.i", jsonIncr("2"), doc, "", true},
+		{"incr not number", "$.s", jsonIncr("1"), doc, "", true},
+	}
+
+	for _, tt := range tests {
+		j := newJSON(t, doc)
+		before := jsonString(j)
+		p, err := ParseJSONPath(tt.path)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		n, r, err := tt.op(j, p)
+		if (err != nil) != tt.err {
+			t.Fatalf("%s: error %v, want error %v", tt.name, err, tt.err)
+		}
+		if jsonString(j) != before {
+			t.Fatalf("%s: original document changed to %s", tt.name, jsonString(j))
+		}
+		if err != nil {
+			continue
+		}
+		if got := jsonString(n); got != jsonString(newJSON(t, tt.want)) {
+			t.Fatalf("%s: document %s, want %s", tt.name, got, tt.want)
+		}
+		if r != tt.r {
+			t.Fatalf("%s: result %s, want %s", tt.name, r, tt.r)
+		}
+	}
+}
+
+func jsonSet(value string) func(j JSONValue, p JSONPath) (JSONValue, string, error) {
+	return func(j JSONValue, p JSONPath) (JSONValue, string, error) {
+		v, _ := DecodeJSON(value)
+		n, err := j.Set(p, v)
+		return n, "", err
+	}
+}
+
+func jsonDelete(j JSONValue, p JSONPath) (JSONValue, string, error) {
+	n, r, err := j.Delete(p)
+	return n, strconv.Itoa(r), err
+}
+
+func jsonAppend(values ...string) func(j JSONValue, p JSONPath) (JSONValue, string, error) {
+	return func(j JSONValue, p JSONPath) (JSONValue, string, error) {
+		vs := make([]interface{}, 0, len(values))
+		for _, value := range values {
+			v, _ := DecodeJSON(value)
+			vs = append(vs, v)
+		}
+		n, r, err := j.ArrAppend(p, vs)
+		return n, strconv.Itoa(r), err
+	}
+}
+
+func jsonIncr(by string) func(j JSONValue, p JSONPath) (JSONValue, string, error) {
+	return func(j JSONValue, p JSONPath) (JSONValue, string, error) {
+		n, r, err := j.NumIncrBy(p, json.Number(by))
+		return n, string(r), err
+	}
+}
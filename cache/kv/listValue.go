@@ -17,13 +17,41 @@ func (s ListValue) ToString() string{
 	return string(data)
 }
 
+/*
+list的所有元素，直接返回内部的切片，调用者不要修改
+写入时会复制出新的切片，已经返回的切片不会被改变
+*/
+func (s ListValue) Items() []string {
+	if s.Data == nil {
+		return []string{}
+	}
+	return s.Data[:len(s.Data):len(s.Data)]
+}
+
+/*
+下标 [beg, end) 的元素，超出范围的部分被忽略，直接返回内部切片的一段，调用者不要修改
+容量限制在 end，调用者追加时会分配新的数组，不会覆盖后面的元素
+*/
+func (s ListValue) Range(beg int, end int) []string {
+	if end > len(s.Data) {
+		end = len(s.Data)
+	}
+	if beg < 0 {
+		beg = 0
+	}
+	if beg >= end {
+		return []string{}
+	}
+	return s.Data[beg:end:end]
+}
+
 func (s ListValue) Size() int {
-	l := int(unsafe.Sizeof(s.Expire))
-	t := l
+	t := boxSize(unsafe.Sizeof(s)) + StringSize(s.Key)
+	t += SliceSize(cap(s.Data), StringHeader)
 	for _, v := range s.Data {
-		t += len(v)
+		t += StringSize(v)
 	}
-	return t + len(s.Key)
+	return t
 }
 
 func (s ListValue) GetKey() string{
@@ -37,3 +65,17 @@ func (s ListValue) IsExpire() bool{
 	}
 	return false
 }
+
+func (s ListValue) GetExpire() int64{
+	return s.Expire
+}
+
+func (s ListValue) WithExpire(expire int64) ValueCache{
+	s.Expire = expire
+	return s
+}
+
+func (s ListValue) WithKey(key string) ValueCache{
+	s.Key = key
+	return s
+}
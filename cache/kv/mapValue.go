@@ -24,13 +24,7 @@ func (s MapValue) ToString() string{
 }
 
 func (s MapValue) Size() int {
-	l := int(unsafe.Sizeof(s.Expire))
-	t := l
-	for k, v := range s.Data {
-		t += len(k)
-		t += len(v)
-	}
-	return t + len(s.Key)
+	return boxSize(unsafe.Sizeof(s)) + StringSize(s.Key) + stringMapSize(s.Data)
 }
 
 func (s MapValue) GetKey() string{
@@ -45,12 +39,43 @@ func (s MapValue) IsExpire() bool{
 	return false
 }
 
+func (s MapValue) GetExpire() int64{
+	return s.Expire
+}
+
+func (s MapValue) WithExpire(expire int64) ValueCache{
+	s.Expire = expire
+	return s
+}
+
+func (s MapValue) WithKey(key string) ValueCache{
+	s.Key = key
+	return s
+}
+
 func (s MapValue) Add(keys [] string,  fields [] string) {
 	for i:=0; i<len(keys); i++ {
 		s.Data[keys[i]] = fields[i]
 	}
 }
 
+/*
+map的所有字段，直接返回内部的map，调用者不要修改
+写入时会复制出新的map，已经返回的map不会被改变
+*/
+func (s MapValue) Fields() map[string]string {
+	if s.Data == nil {
+		return map[string]string{}
+	}
+	return s.Data
+}
+
+func (s MapValue) EachField(f func(field string, value string)) {
+	for k, v := range s.Data {
+		f(k, v)
+	}
+}
+
 func (s MapValue) Get(key string) (string, bool) {
 	v, ok := s.Data[key]
 	return v, ok
@@ -0,0 +1,252 @@
+package kv
+
+import (
+	"encoding/binary"
+	"time"
+	"unsafe"
+)
+
+/*
+小的 map、list、set 在lru中的紧凑编码，类似 redis 的 listpack
+所有元素按 长度(uvarint)+数据 连续保存在一个字符串中，map 按 field、value 交替保存
+只保存在lru中，读取时解码为 MapValue、ListValue、SetValue，Type 为原来的数据类型
+*/
+type PackedValue struct {
+	Key    string
+	Expire int64
+	Type   int32
+	Count  int
+	Data   string
+}
+
+func packStrings(count int, f func(add func(string))) string {
+	buf := make([]byte, 0, count*8)
+	var n [binary.MaxVarintLen64]byte
+	f(func(e string) {
+		buf = append(buf, n[:binary.PutUvarint(n[:], uint64(len(e)))]...)
+		buf = append(buf, e...)
+	})
+	return string(buf)
+}
+
+/*
+按顺序遍历编码中的元素
+*/
+func (s PackedValue) each(f func(e string)) {
+	data := s.Data
+	for len(data) > 0 {
+		l, n := uvarint(data)
+		data = data[n:]
+		f(data[:l])
+		data = data[l:]
+	}
+}
+
+/*
+和 binary.Uvarint 相同，直接从字符串中读取，不需要转换成 []byte
+*/
+func uvarint(s string) (uint64, int) {
+	var x uint64
+	var shift uint
+	for i := 0; i < len(s); i++ {
+		b := s[i]
+		if b < 0x80 {
+			return x | uint64(b)<<shift, i + 1
+		}
+		x |= uint64(b&0x7f) << shift
+		shift += 7
+	}
+	return 0, len(s)
+}
+
+func PackMap(v MapValue) PackedValue {
+	data := packStrings(len(v.Data)*2, func(add func(string)) {
+		for k, e := range v.Data {
+			add(k)
+			add(e)
+		}
+	})
+	return PackedValue{Key: v.Key, Expire: v.Expire, Type: MapData, Count: len(v.Data), Data: data}
+}
+
+func PackList(v ListValue) PackedValue {
+	data := packStrings(len(v.Data), func(add func(string)) {
+		for _, e := range v.Data {
+			add(e)
+		}
+	})
+	return PackedValue{Key: v.Key, Expire: v.Expire, Type: ListData, Count: len(v.Data), Data: data}
+}
+
+func PackSet(v SetValue) PackedValue {
+	data := packStrings(len(v.Data), func(add func(string)) {
+		for k := range v.Data {
+			add(k)
+		}
+	})
+	return PackedValue{Key: v.Key, Expire: v.Expire, Type: SetData, Count: len(v.Data), Data: data}
+}
+
+/*
+解码为完整的结构，每次解码都是新的map或者切片，修改不影响lru中的编码
+*/
+func (s PackedValue) Unpack() ValueCache {
+	switch s.Type {
+	case MapData:
+		m := make(MapContent, s.Count)
+		field, isValue := "", false
+		s.each(func(e string) {
+			if isValue {
+				m[field] = e
+			}else{
+				field = e
+			}
+			isValue = !isValue
+		})
+		return MapValue{Key: s.Key, Expire: s.Expire, Data: m}
+	case ListData:
+		l := make([]string, 0, s.Count)
+		s.each(func(e string) {
+			l = append(l, e)
+		})
+		return ListValue{Key: s.Key, Expire: s.Expire, Data: l}
+	}
+
+	m := make(SetContent, s.Count)
+	s.each(func(e string) {
+		m[e] = e
+	})
+	return SetValue{Key: s.Key, Expire: s.Expire, Data: m}
+}
+
+/*
+下面的方法直接在编码上读取，不解码出完整的结构，方法名和 MapValue、ListValue、SetValue 相同
+*/
+
+/*
+map中field的值，按顺序查找，找到后不再继续
+*/
+func (s PackedValue) Get(field string) (string, bool) {
+	data := s.Data
+	for len(data) > 0 {
+		l, n := uvarint(data)
+		k := data[n:n+int(l)]
+		data = data[n+int(l):]
+		l, n = uvarint(data)
+		if k == field {
+			return data[n:n+int(l)], true
+		}
+		data = data[n+int(l):]
+	}
+	return "", false
+}
+
+func (s PackedValue) Fields() map[string]string {
+	return s.Unpack().(MapValue).Data
+}
+
+/*
+按编码中的顺序遍历map的字段
+*/
+func (s PackedValue) EachField(f func(field string, value string)) {
+	field, isValue := "", false
+	s.each(func(e string) {
+		if isValue {
+			f(field, e)
+		}else{
+			field = e
+		}
+		isValue = !isValue
+	})
+}
+
+func (s PackedValue) EachMember(f func(member string)) {
+	s.each(f)
+}
+
+/*
+set是否包含v
+*/
+func (s PackedValue) IsExist(v string) bool {
+	data := s.Data
+	for len(data) > 0 {
+		l, n := uvarint(data)
+		if data[n:n+int(l)] == v {
+			return true
+		}
+		data = data[n+int(l):]
+	}
+	return false
+}
+
+func (s PackedValue) Members() []string {
+	arr := make([]string, 0, s.Count)
+	s.each(func(e string) {
+		arr = append(arr, e)
+	})
+	return arr
+}
+
+func (s PackedValue) Items() []string {
+	return s.Range(0, s.Count)
+}
+
+/*
+list下标 [beg, end) 的元素，和 ListValue.Range 相同，读到 end 之后不再继续
+*/
+func (s PackedValue) Range(beg int, end int) []string {
+	if end > s.Count {
+		end = s.Count
+	}
+	if beg < 0 {
+		beg = 0
+	}
+	if beg >= end {
+		return []string{}
+	}
+
+	arr := make([]string, 0, end-beg)
+	data := s.Data
+	for i := 0; i < end; i++ {
+		l, n := uvarint(data)
+		if i >= beg {
+			arr = append(arr, data[n:n+int(l)])
+		}
+		data = data[n+int(l):]
+	}
+	return arr
+}
+
+func (s PackedValue) ToString() string{
+	return s.Unpack().ToString()
+}
+
+func (s PackedValue) Size() int {
+	return boxSize(unsafe.Sizeof(s)) + StringSize(s.Key) + StringSize(s.Data)
+}
+
+func (s PackedValue) GetKey() string{
+	return s.Key
+}
+
+func (s PackedValue) IsExpire() bool{
+	t := time.Now().UnixNano()
+	if s.Expire != ExpireForever && s.Expire <= t{
+		return true
+	}
+	return false
+}
+
+func (s PackedValue) GetExpire() int64{
+	return s.Expire
+}
+
+func (s PackedValue) WithExpire(expire int64) ValueCache{
+	s.Expire = expire
+	return s
+}
+
+func (s PackedValue) WithKey(key string) ValueCache{
+	s.Key = key
+	return s
+}
@@ -0,0 +1,133 @@
+package kv
+
+import (
+	"encoding/binary"
+	"fmt"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestUvarint(t *testing.T) {
+	tests := []uint64{0, 1, 127, 128, 300, 16383, 16384, 1 << 40}
+	for _, n := range tests {
+		b := make([]byte, binary.MaxVarintLen64)
+		l := binary.PutUvarint(b, n)
+		if got, size := uvarint(string(b[:l]) + "rest"); got != n || size != l {
+			t.Fatalf("uvarint(%d) = %d %d, want %d %d", n, got, size, n, l)
+		}
+	}
+}
+
+/*
+编码后解码得到相同的值，编码的占用比完整的结构小
+*/
+func TestPackRoundTrip(t *testing.T) {
+	long := strings.Repeat("x", 300)
+	tests := []struct {
+		name string
+		v    ValueCache
+		pack func(v ValueCache) PackedValue
+	}{
+		{"map", MapValue{Key: "m", Expire: 10, Data: MapContent{"a": "1", "b": "", "": "empty field", "long": long}},
+			func(v ValueCache) PackedValue { return PackMap(v.(MapValue)) }},
+		{"empty map", MapValue{Key: "m", Expire: ExpireForever, Data: MapContent{}},
+			func(v ValueCache) PackedValue { return PackMap(v.(MapValue)) }},
+		{"list", ListValue{Key: "l", Expire: ExpireForever, Data: []string{"a", "", "a", long, "\x00\xff"}},
+			func(v ValueCache) PackedValue { return PackList(v.(ListValue)) }},
+		{"empty list", ListValue{Key: "l", Expire: ExpireForever, Data: []string{}},
+			func(v ValueCache) PackedValue { return PackList(v.(ListValue)) }},
+		{"set", SetValue{Key: "s", Expire: 10, Data: SetContent{"a": "a", "": "", long: long}},
+			func(v ValueCache) PackedValue { return PackSet(v.(SetValue)) }},
+	}
+
+	for _, tt := range tests {
+		p := tt.pack(tt.v)
+		if p.GetKey() != tt.v.GetKey() || p.GetExpire() != tt.v.GetExpire() {
+			t.Fatalf("%s: packed key %s expire %d", tt.name, p.GetKey(), p.GetExpire())
+		}
+		got := p.Unpack()
+		if reflect.DeepEqual(got, tt.v) == false {
+			t.Fatalf("%s: unpacked %v, want %v", tt.name, got, tt.v)
+		}
+	}
+}
+
+func TestPackedSize(t *testing.T) {
+	m := MapValue{Key: "m", Expire: ExpireForever, Data: MapContent{}}
+	l := ListValue{Key: "l", Expire: ExpireForever}
+	s := SetValue{Key: "s", Expire: ExpireForever, Data: SetContent{}}
+	for i := 0; i < 100; i++ {
+		e := fmt.Sprintf("e%d", i)
+		m.Data[e] = e
+		l.Data = append(l.Data, e)
+		s.Data[e] = e
+	}
+
+	tests := []struct {
+		name   string
+		v      ValueCache
+		packed PackedValue
+	}{
+		{"map", m, PackMap(m)},
+		{"list", l, PackList(l)},
+		{"set", s, PackSet(s)},
+	}
+	for _, tt := range tests {
+		if tt.packed.Size()*2 > tt.v.Size() {
+			t.Fatalf("%s: packed size %d, full size %d", tt.name, tt.packed.Size(), tt.v.Size())
+		}
+	}
+}
+
+/*
+每次解码都是新的结构，修改不影响编码
+*/
+func TestUnpackCopy(t *testing.T) {
+	p := PackMap(MapValue{Key: "m", Data: MapContent{"a": "1"}})
+	p.Unpack().(MapValue).Data["a"] = "2"
+	if v := p.Unpack().(MapValue).Data["a"]; v != "1" {
+		t.Fatalf("packed value changed to %s", v)
+	}
+}
+
+/*
+直接在编码上读取和解码后读取的结果相同
+*/
+func TestPackedRead(t *testing.T) {
+	long := strings.Repeat("x", 300)
+	m := MapValue{Key: "m", Expire: ExpireForever, Data: MapContent{"a": "1", "": "empty field", "b": "", "long": long}}
+	l := ListValue{Key: "l", Expire: ExpireForever, Data: []string{"a", "", long, "b", "a"}}
+	s := SetValue{Key: "s", Expire: ExpireForever, Data: SetContent{"a": "a", "": "", long: long}}
+	pm, pl, ps := PackMap(m), PackList(l), PackSet(s)
+
+	for _, field := range []string{"a", "", "b", "long", "none", "1"} {
+		v, ok := pm.Get(field)
+		want, wantOK := m.Get(field)
+		if v != want || ok != wantOK {
+			t.Fatalf("map Get(%q) = %q %v, want %q %v", field, v, ok, want, wantOK)
+		}
+	}
+	if reflect.DeepEqual(MapContent(pm.Fields()), m.Data) == false {
+		t.Fatalf("map Fields() = %v, want %v", pm.Fields(), m.Data)
+	}
+
+	for _, member := range []string{"a", "", long, "b", "x"} {
+		if ps.IsExist(member) != s.IsExist(member) {
+			t.Fatalf("set IsExist(%q) = %v, want %v", member, ps.IsExist(member), s.IsExist(member))
+		}
+	}
+	if len(ps.Members()) != len(s.Data) {
+		t.Fatalf("set Members() = %v, want %d members", ps.Members(), len(s.Data))
+	}
+
+	ranges := [][2]int{{0, 5}, {1, 3}, {-2, 2}, {3, 100}, {4, 2}, {5, 6}, {0, 0}}
+	for _, r := range ranges {
+		if got, want := pl.Range(r[0], r[1]), l.Range(r[0], r[1]); reflect.DeepEqual(got, want) == false {
+			t.Fatalf("list Range(%d, %d) = %q, want %q", r[0], r[1], got, want)
+		}
+	}
+	if reflect.DeepEqual(pl.Items(), l.Items()) == false {
+		t.Fatalf("list Items() = %q, want %q", pl.Items(), l.Items())
+	}
+}
@@ -40,35 +40,32 @@ func (s SetValue) IsExist(v string) bool{
 	return ok
 }
 
-func (s SetValue) all() []string{
-	if s.Data == nil{
-		s.Data = make(map[string]string)
+/*
+set的所有成员，顺序不固定
+*/
+func (s SetValue) Members() []string{
+	arr := make([]string, 0, len(s.Data))
+	for k := range s.Data {
+		arr = append(arr, k)
 	}
+	return arr
+}
 
-	l := len(s.Data)
-	arr := make([]string, l)
-	i := 0
-	for k,_ := range s.Data {
-		arr[i] = k
-		i++
+
+func (s SetValue) EachMember(f func(member string)) {
+	for k := range s.Data {
+		f(k)
 	}
-	return arr
 }
 
 
 func (s SetValue) ToString() string{
-	data, _ := json.MarshalIndent(s.all(), "", "    ")
+	data, _ := json.MarshalIndent(s.Members(), "", "    ")
 	return string(data)
 }
 
 func (s SetValue) Size() int {
-	l := int(unsafe.Sizeof(s.Expire))
-	t := l
-	for k, v := range s.Data {
-		t += len(k)
-		t += len(v)
-	}
-	return t + len(s.Key)
+	return boxSize(unsafe.Sizeof(s)) + StringSize(s.Key) + stringMapSize(s.Data)
 }
 
 func (s SetValue) GetKey() string{
@@ -83,3 +80,17 @@ func (s SetValue) IsExpire() bool{
 	return false
 }
 
+func (s SetValue) GetExpire() int64{
+	return s.Expire
+}
+
+func (s SetValue) WithExpire(expire int64) ValueCache{
+	s.Expire = expire
+	return s
+}
+
+func (s SetValue) WithKey(key string) ValueCache{
+	s.Key = key
+	return s
+}
+
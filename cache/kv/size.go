@@ -0,0 +1,88 @@
+package kv
+
+import (
+	"sort"
+	"unsafe"
+)
+
+/*
+按go运行时的内存布局估算占用，包括字符串头、切片头、map的桶、指针指向的结构体和分配时按大小等级的对齐
+*/
+const (
+	WordSize      = int(unsafe.Sizeof(uintptr(0)))
+	StringHeader  = int(unsafe.Sizeof(""))
+	SliceHeader   = int(unsafe.Sizeof([]byte{}))
+	InterfaceSize = int(unsafe.Sizeof(interface{}(nil)))
+
+	mapHeader      = 48
+	mapBucketCount = 8
+	mapLoadFactor  = 6.5
+	pageSize       = 8192
+	maxSmallSize   = 32768
+)
+
+/*
+go内存分配器的大小等级，小于32K的对象按等级向上取整
+*/
+var sizeClasses = []int{0, 8, 16, 24, 32, 48, 64, 80, 96, 112, 128, 144, 160, 176, 192, 208, 224, 240, 256,
+	288, 320, 352, 384, 416, 448, 480, 512, 576, 640, 704, 768, 896, 1024, 1152, 1280, 1408, 1536, 1792,
+	2048, 2304, 2688, 3072, 3200, 3456, 4096, 4864, 5376, 6144, 6528, 6784, 6912, 8192, 9472, 9728, 10240,
+	10880, 12288, 13568, 14336, 16384, 18432, 19072, 20480, 21760, 24576, 27264, 28672, 32768}
+
+/*
+申请n个字节实际占用的大小，超过32K按页对齐
+*/
+func AllocSize(n int) int {
+	if n <= 0 {
+		return 0
+	}
+	if n > maxSmallSize {
+		return (n + pageSize - 1) / pageSize * pageSize
+	}
+	return sizeClasses[sort.SearchInts(sizeClasses, n)]
+}
+
+/*
+字符串数据占用的大小，不包括字符串头，字符串头计入所在的结构体或者map桶
+*/
+func StringSize(s string) int {
+	return AllocSize(len(s))
+}
+
+/*
+切片底层数组占用的大小，不包括切片头
+*/
+func SliceSize(capacity int, elemSize int) int {
+	return AllocSize(capacity * elemSize)
+}
+
+/*
+n个元素的map的头和桶占用的大小，不包括key和value指向的数据
+每个桶有8个元素，加上8个字节的tophash和溢出桶指针，平均装载因子为6.5时扩容，桶的个数是2的幂
+*/
+func MapSize(n int, keySize int, valueSize int) int {
+	buckets := 1
+	for float64(n) > mapLoadFactor*float64(buckets) {
+		buckets <<= 1
+	}
+	bucket := mapBucketCount + mapBucketCount*(keySize+valueSize) + WordSize
+	return AllocSize(mapHeader) + AllocSize(buckets*bucket)
+}
+
+/*
+值保存在接口中时复制到堆上的结构体占用的大小
+*/
+func boxSize(size uintptr) int {
+	return AllocSize(int(size))
+}
+
+/*
+map[string]string 的占用，包括桶和key、value的数据
+*/
+func stringMapSize(m map[string]string) int {
+	t := MapSize(len(m), StringHeader, StringHeader)
+	for k, v := range m {
+		t += StringSize(k) + StringSize(v)
+	}
+	return t
+}
@@ -0,0 +1,99 @@
+package kv
+
+import (
+	"fmt"
+	"runtime"
+	"testing"
+)
+
+func TestAllocSize(t *testing.T) {
+	tests := []struct {
+		n, want int
+	}{
+		{0, 0},
+		{1, 8},
+		{8, 8},
+		{9, 16},
+		{33, 48},
+		{1025, 1152},
+		{32768, 32768},
+		{32769, 40960},
+	}
+
+	for _, tt := range tests {
+		if got := AllocSize(tt.n); got != tt.want {
+			t.Fatalf("AllocSize(%d) = %d, want %d", tt.n, got, tt.want)
+		}
+	}
+}
+
+func TestMapSize(t *testing.T) {
+	bucket := func(n int) int { return AllocSize(mapHeader) + AllocSize(n*(8+8*2*StringHeader+WordSize)) }
+	tests := []struct {
+		n, want int
+	}{
+		{0, bucket(1)},
+		{6, bucket(1)},
+		{7, bucket(2)},
+		{13, bucket(2)},
+		{14, bucket(4)},
+		{100, bucket(16)},
+	}
+
+	for _, tt := range tests {
+		if got := MapSize(tt.n, StringHeader, StringHeader); got != tt.want {
+			t.Fatalf("MapSize(%d) = %d, want %d", tt.n, got, tt.want)
+		}
+	}
+}
+
+/*
+估算的占用和go运行时实际分配的堆内存相差不超过20%
+*/
+func TestSizeMatchesHeap(t *testing.T) {
+	const n = 20000
+	tests := []struct {
+		name string
+		new  func(i int) ValueCache
+	}{
+		{"string", func(i int) ValueCache {
+			return StringValue{Key: fmt.Sprintf("key%08d", i), Data: fmt.Sprintf("value%020d", i)}
+		}},
+		{"map", func(i int) ValueCache {
+			m := MapValue{Key: fmt.Sprintf("key%08d", i), Data: make(map[string]string)}
+			for j := 0; j < 10; j++ {
+				m.Data[fmt.Sprintf("field%d", j)] = fmt.Sprintf("value%08d", i)
+			}
+			return m
+		}},
+		{"list", func(i int) ValueCache {
+			l := ListValue{Key: fmt.Sprintf("key%08d", i)}
+			for j := 0; j < 10; j++ {
+				l.Data = append(l.Data, fmt.Sprintf("value%08d", i))
+			}
+			return l
+		}},
+	}
+
+	for _, tt := range tests {
+		values := make([]ValueCache, n)
+		runtime.GC()
+		var before, after runtime.MemStats
+		runtime.ReadMemStats(&before)
+
+		size := 0
+		for i := range values {
+			values[i] = tt.new(i)
+			size += values[i].Size()
+		}
+
+		runtime.GC()
+		runtime.ReadMemStats(&after)
+		heap := int(after.HeapAlloc) - int(before.HeapAlloc)
+		runtime.KeepAlive(values)
+
+		if ratio := float64(size) / float64(heap); ratio < 0.8 || ratio > 1.2 {
+			t.Fatalf("%s: estimated %d bytes, heap grows %d bytes", tt.name, size, heap)
+		}
+	}
+}
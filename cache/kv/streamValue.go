@@ -0,0 +1,782 @@
+package kv
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+	"unsafe"
+)
+
+const (
+	StreamAutoID     = "*"
+	StreamMinID      = "-"
+	StreamMaxID      = "+"
+	StreamLastID     = "$"
+	StreamNewEntries = ">"
+)
+
+/*
+消息id，格式为 毫秒时间戳-序号
+*/
+type StreamID struct {
+	Ms  uint64
+	Seq uint64
+}
+
+func (s StreamID) String() string {
+	return fmt.Sprintf("%d-%d", s.Ms, s.Seq)
+}
+
+func (s StreamID) Less(o StreamID) bool {
+	return s.Ms < o.Ms || (s.Ms == o.Ms && s.Seq < o.Seq)
+}
+
+func (s StreamID) MarshalText() ([]byte, error) {
+	return []byte(s.String()), nil
+}
+
+func (s *StreamID) UnmarshalText(b []byte) error {
+	id, err := ParseStreamID(string(b), 0)
+	if err != nil {
+		return err
+	}
+	*s = id
+	return nil
+}
+
+/*
+解析id，只有毫秒部分时序号取 missingSeq
+*/
+func ParseStreamID(str string, missingSeq uint64) (StreamID, error) {
+	parts := strings.SplitN(str, "-", 2)
+	ms, err := strconv.ParseUint(parts[0], 10, 64)
+	if err != nil {
+		return StreamID{}, errInvalidStreamID(str)
+	}
+
+	if len(parts) == 1 {
+		return StreamID{Ms: ms, Seq: missingSeq}, nil
+	}
+
+	seq, err := strconv.ParseUint(parts[1], 10, 64)
+	if err != nil {
+		return StreamID{}, errInvalidStreamID(str)
+	}
+	return StreamID{Ms: ms, Seq: seq}, nil
+}
+
+/*
+解析范围查询的起止id，支持 - 和 +
+*/
+func ParseStreamRangeID(str string, isEnd bool) (StreamID, error) {
+	if str == StreamMinID {
+		return StreamID{}, nil
+	}
+	if str == StreamMaxID {
+		return StreamID{Ms: math.MaxUint64, Seq: math.MaxUint64}, nil
+	}
+	if isEnd {
+		return ParseStreamID(str, math.MaxUint64)
+	}
+	return ParseStreamID(str, 0)
+}
+
+type StreamEntry struct {
+	ID     StreamID          `json:"id"`
+	Fields map[string]string `json:"fields"`
+}
+
+type StreamPendingEntry struct {
+	ID            StreamID `json:"id"`
+	Consumer      string   `json:"consumer"`
+	DeliveryTime  int64    `json:"deliveryTime"`
+	DeliveryCount int64    `json:"deliveryCount"`
+}
+
+type StreamConsumer struct {
+	Name     string `json:"name"`
+	SeenTime int64  `json:"seenTime"`
+}
+
+type StreamGroup struct {
+	Name          string                              `json:"name"`
+	LastDelivered StreamID                            `json:"lastDelivered"`
+	Pending       map[StreamID]*StreamPendingEntry    `json:"pending"`
+	Consumers     map[string]*StreamConsumer          `json:"consumers"`
+}
+
+/*
+按长度或者时间裁剪，MaxLen 为0不按长度裁剪，MaxAge(毫秒) 为0不按时间裁剪
+*/
+type StreamTrim struct {
+	MaxLen int64
+	MaxAge int64
+}
+
+type StreamContent struct {
+	Entries []StreamEntry           `json:"entries"`
+	LastID  StreamID                `json:"lastId"`
+	Groups  map[string]*StreamGroup `json:"groups"`
+
+	size    int            //消息内容和消费组的内存占用，修改时增量计算
+	changes []StreamChange //上次调用 Changes 之后的修改
+	logged  int            //文件中快照之后追加的修改数
+	saved   bool           //文件中已经有快照，之后的修改可以直接追加
+}
+
+func NewStreamContent() *StreamContent{
+	return &StreamContent{Entries: []StreamEntry{}, Groups: make(map[string]*StreamGroup)}
+}
+
+/*
+从文件加载的数据需要重新计算内存占用，文件中已经有快照
+*/
+func (s *StreamContent) UnmarshalJSON(b []byte) error {
+	type content StreamContent
+	c := content{Entries: []StreamEntry{}, Groups: make(map[string]*StreamGroup)}
+	if err := json.Unmarshal(b, &c); err != nil {
+		return err
+	}
+	*s = StreamContent(c)
+
+	for _, e := range s.Entries {
+		s.size += stringMapSize(e.Fields)
+	}
+	for name, g := range s.Groups {
+		if g.Pending == nil {
+			g.Pending = make(map[StreamID]*StreamPendingEntry)
+		}
+		if g.Consumers == nil {
+			g.Consumers = make(map[string]*StreamConsumer)
+		}
+		s.size += groupSize(name)
+		for _, p := range g.Pending {
+			s.size += pendingSize(p)
+		}
+		for k := range g.Consumers {
+			s.size += consumerSize(k)
+		}
+	}
+	s.saved = true
+	return nil
+}
+
+/*
+Data 为指针，消费组的读取、确认等操作直接修改原数据，调用方需要自己加锁
+*/
+type StreamValue struct {
+	Key    string       		`json:"key"`
+	Expire int64				`json:"expire"`
+	Data   *StreamContent		`json:"data"`
+}
+
+func (s StreamValue) ToString() string{
+	data, _ := json.MarshalIndent(s.Data, "", "    ")
+	return string(data)
+}
+
+/*
+消息内容和待确认列表的大小在修改时增量计算，这里只遍历消费组
+*/
+func (s StreamValue) Size() int {
+	t := boxSize(unsafe.Sizeof(s)) + StringSize(s.Key)
+	if s.Data == nil {
+		return t
+	}
+
+	t += AllocSize(int(unsafe.Sizeof(*s.Data))) + s.Data.size
+	t += SliceSize(cap(s.Data.Entries), int(unsafe.Sizeof(StreamEntry{})))
+	t += MapSize(len(s.Data.Groups), StringHeader, WordSize)
+
+	idSize := int(unsafe.Sizeof(StreamID{}))
+	for _, g := range s.Data.Groups {
+		t += MapSize(len(g.Pending), idSize, WordSize)
+		t += MapSize(len(g.Consumers), StringHeader, WordSize)
+	}
+	return t
+}
+
+func groupSize(name string) int {
+	return StringSize(name) + AllocSize(int(unsafe.Sizeof(StreamGroup{})))
+}
+
+func pendingSize(p *StreamPendingEntry) int {
+	return AllocSize(int(unsafe.Sizeof(StreamPendingEntry{}))) + StringSize(p.Consumer)
+}
+
+func consumerSize(name string) int {
+	return StringSize(name) + AllocSize(int(unsafe.Sizeof(StreamConsumer{})))
+}
+
+func (s StreamValue) GetKey() string{
+	return s.Key
+}
+
+func (s StreamValue) IsExpire() bool{
+	t := time.Now().UnixNano()
+	if s.Expire != ExpireForever && s.Expire <= t{
+		return true
+	}
+	return false
+}
+
+func (s StreamValue) GetExpire() int64{
+	return s.Expire
+}
+
+func (s StreamValue) WithExpire(expire int64) ValueCache{
+	s.Expire = expire
+	return s
+}
+
+func (s StreamValue) WithKey(key string) ValueCache{
+	s.Key = key
+	return s
+}
+
+func (s *StreamContent) Len() int {
+	return len(s.Entries)
+}
+
+/*
+根据 XAdd 传入的id生成新id，支持 *、毫秒-*、毫秒-序号，新id必须大于最后一条消息的id
+*/
+func (s *StreamContent) NextID(str string) (StreamID, error) {
+	if str == StreamAutoID || str == "" {
+		ms := uint64(time.Now().UnixNano() / int64(time.Millisecond))
+		if ms > s.LastID.Ms {
+			return StreamID{Ms: ms}, nil
+		}
+		return s.nextSeq(s.LastID.Ms)
+	}
+
+	if strings.HasSuffix(str, "-*") {
+		ms, err := strconv.ParseUint(strings.TrimSuffix(str, "-*"), 10, 64)
+		if err != nil {
+			return StreamID{}, errInvalidStreamID(str)
+		}
+		if ms > s.LastID.Ms {
+			return StreamID{Ms: ms}, nil
+		}else if ms == s.LastID.Ms {
+			return s.nextSeq(ms)
+		}
+		return StreamID{}, errors.New("the id specified is equal or smaller than the target stream top item")
+	}
+
+	id, err := ParseStreamID(str, 0)
+	if err != nil {
+		return StreamID{}, err
+	}
+	if id == (StreamID{}) || !s.LastID.Less(id) {
+		return StreamID{}, errors.New("the id specified is equal or smaller than the target stream top item")
+	}
+	return id, nil
+}
+
+func (s *StreamContent) nextSeq(ms uint64) (StreamID, error) {
+	if s.LastID.Seq == math.MaxUint64 {
+		return StreamID{}, errors.New("stream id sequence overflow")
+	}
+	return StreamID{Ms: ms, Seq: s.LastID.Seq + 1}, nil
+}
+
+func (s *StreamContent) Append(id StreamID, fields map[string]string) {
+	e := StreamEntry{ID: id, Fields: fields}
+	s.appendEntry(e)
+	s.changes = append(s.changes, StreamChange{Op: streamAppend, Entry: &e})
+}
+
+func (s *StreamContent) appendEntry(e StreamEntry) {
+	s.Entries = append(s.Entries, e)
+	s.LastID = e.ID
+	s.size += stringMapSize(e.Fields)
+}
+
+/*
+[start, end] 范围内的消息，count 为0不限制数量
+*/
+func (s *StreamContent) Range(start StreamID, end StreamID, count int, rev bool) []StreamEntry {
+	r := make([]StreamEntry, 0)
+	if end.Less(start) {
+		return r
+	}
+
+	beg := s.search(start)
+	stop := sort.Search(len(s.Entries), func(i int) bool { return end.Less(s.Entries[i].ID) })
+
+	if rev {
+		for i := stop - 1; i >= beg; i-- {
+			if count > 0 && len(r) >= count {
+				break
+			}
+			r = append(r, s.Entries[i])
+		}
+	}else{
+		for i := beg; i < stop; i++ {
+			if count > 0 && len(r) >= count {
+				break
+			}
+			r = append(r, s.Entries[i])
+		}
+	}
+	return r
+}
+
+/*
+id 之后的消息，不包含 id
+*/
+func (s *StreamContent) After(id StreamID, count int) []StreamEntry {
+	r := make([]StreamEntry, 0)
+	i := sort.Search(len(s.Entries), func(i int) bool { return id.Less(s.Entries[i].ID) })
+	for ; i < len(s.Entries); i++ {
+		if count > 0 && len(r) >= count {
+			break
+		}
+		r = append(r, s.Entries[i])
+	}
+	return r
+}
+
+/*
+裁剪，返回删除的消息数
+*/
+func (s *StreamContent) Trim(trim StreamTrim) int {
+	n := 0
+	if trim.MaxLen > 0 && int64(len(s.Entries)) > trim.MaxLen {
+		n = len(s.Entries) - int(trim.MaxLen)
+	}
+
+	if trim.MaxAge > 0 {
+		now := time.Now().UnixNano() / int64(time.Millisecond)
+		minMs := now - trim.MaxAge
+		if minMs > 0 {
+			i := s.search(StreamID{Ms: uint64(minMs)})
+			if i > n {
+				n = i
+			}
+		}
+	}
+
+	if n > 0 {
+		s.trimFront(n)
+		s.changes = append(s.changes, StreamChange{Op: streamTrim, N: n})
+	}
+	return n
+}
+
+/*
+删除最早的n条消息，不复制剩下的消息，底层数组在之后追加扩容时才释放
+*/
+func (s *StreamContent) trimFront(n int) {
+	if n > len(s.Entries) {
+		n = len(s.Entries)
+	}
+	for i := 0; i < n; i++ {
+		s.size -= stringMapSize(s.Entries[i].Fields)
+		s.Entries[i] = StreamEntry{}
+	}
+	s.Entries = s.Entries[n:]
+}
+
+/*
+删除指定的消息，返回删除的消息数
+*/
+func (s *StreamContent) Remove(ids []StreamID) int {
+	removed := s.remove(ids)
+	if len(removed) > 0 {
+		s.changes = append(s.changes, StreamChange{Op: streamRemove, IDs: removed})
+	}
+	return len(removed)
+}
+
+func (s *StreamContent) remove(ids []StreamID) []StreamID {
+	removed := make([]StreamID, 0)
+	for _, id := range ids {
+		i := s.search(id)
+		if i < len(s.Entries) && s.Entries[i].ID == id {
+			s.size -= stringMapSize(s.Entries[i].Fields)
+			s.Entries = append(s.Entries[:i], s.Entries[i+1:]...)
+			removed = append(removed, id)
+		}
+	}
+	return removed
+}
+
+func (s *StreamContent) Find(id StreamID) (StreamEntry, bool) {
+	i := s.search(id)
+	if i < len(s.Entries) && s.Entries[i].ID == id {
+		return s.Entries[i], true
+	}
+	return StreamEntry{}, false
+}
+
+func (s *StreamContent) search(id StreamID) int {
+	return sort.Search(len(s.Entries), func(i int) bool { return !s.Entries[i].ID.Less(id) })
+}
+
+/*
+创建消费组，id 为 $ 时只消费之后新增的消息
+*/
+func (s *StreamContent) CreateGroup(name string, id string) error {
+	if _, ok := s.Groups[name]; ok {
+		str := fmt.Sprintf("consumer group:%s already exists", name)
+		return errors.New(str)
+	}
+
+	var lastDelivered StreamID
+	if id == StreamLastID {
+		lastDelivered = s.LastID
+	}else{
+		var err error
+		if lastDelivered, err = ParseStreamID(id, 0); err != nil {
+			return err
+		}
+	}
+
+	s.createGroup(name, lastDelivered)
+	s.changes = append(s.changes, StreamChange{Op: streamCreateGroup, Group: name, LastDelivered: lastDelivered})
+	return nil
+}
+
+func (s *StreamContent) createGroup(name string, lastDelivered StreamID) {
+	s.destroyGroup(name)
+	s.Groups[name] = &StreamGroup{Name: name, LastDelivered: lastDelivered,
+		Pending: make(map[StreamID]*StreamPendingEntry), Consumers: make(map[string]*StreamConsumer)}
+	s.size += groupSize(name)
+}
+
+func (s *StreamContent) DestroyGroup(name string) bool {
+	ok := s.destroyGroup(name)
+	if ok {
+		s.changes = append(s.changes, StreamChange{Op: streamDestroyGroup, Group: name})
+	}
+	return ok
+}
+
+func (s *StreamContent) destroyGroup(name string) bool {
+	g, ok := s.Groups[name]
+	if !ok {
+		return false
+	}
+
+	s.size -= groupSize(name)
+	for _, p := range g.Pending {
+		s.size -= pendingSize(p)
+	}
+	for k := range g.Consumers {
+		s.size -= consumerSize(k)
+	}
+	delete(s.Groups, name)
+	return true
+}
+
+/*
+消费组读取，id 为 > 时读取新消息并加入待确认列表，否则返回该消费者 id 之后的待确认消息
+*/
+func (s *StreamContent) ReadGroup(group string, consumer string, id string, count int, noAck bool) ([]StreamEntry, error) {
+	g, err := s.group(group)
+	if err != nil {
+		return nil, err
+	}
+
+	start := g.LastDelivered
+	if id != StreamNewEntries {
+		if start, err = ParseStreamID(id, 0); err != nil {
+			return nil, err
+		}
+	}
+
+	now := time.Now().UnixNano() / int64(time.Millisecond)
+	change := StreamChange{Op: streamGroup, Group: group, Consumer: s.touchConsumer(g, consumer, now)}
+	defer func() {
+		change.LastDelivered = g.LastDelivered
+		s.changes = append(s.changes, change)
+	}()
+
+	if id == StreamNewEntries {
+		r := s.After(start, count)
+		for _, e := range r {
+			g.LastDelivered = e.ID
+			if !noAck {
+				p := StreamPendingEntry{ID: e.ID, Consumer: consumer, DeliveryTime: now, DeliveryCount: 1}
+				s.setPending(g, p)
+				change.Pending = append(change.Pending, p)
+			}
+		}
+		return r, nil
+	}
+
+	r := make([]StreamEntry, 0)
+	for _, p := range g.sortedPending() {
+		if p.Consumer != consumer || !start.Less(p.ID) {
+			continue
+		}
+		if count > 0 && len(r) >= count {
+			break
+		}
+		e, ok := s.Find(p.ID)
+		if !ok {
+			e = StreamEntry{ID: p.ID}
+		}
+		r = append(r, e)
+	}
+	return r, nil
+}
+
+/*
+确认消息，返回成功确认的数量
+*/
+func (s *StreamContent) Ack(group string, ids []StreamID) (int, error) {
+	g, err := s.group(group)
+	if err != nil {
+		return 0, err
+	}
+
+	acked := make([]StreamID, 0)
+	for _, id := range ids {
+		if s.delPending(g, id) {
+			acked = append(acked, id)
+		}
+	}
+	if len(acked) > 0 {
+		s.changes = append(s.changes, StreamChange{Op: streamGroup, Group: group,
+			LastDelivered: g.LastDelivered, IDs: acked})
+	}
+	return len(acked), nil
+}
+
+/*
+待确认列表，consumer 为空时返回所有消费者的，按id升序
+*/
+func (s *StreamContent) PendingList(group string, consumer string, count int) ([]StreamPendingEntry, error) {
+	g, err := s.group(group)
+	if err != nil {
+		return nil, err
+	}
+
+	r := make([]StreamPendingEntry, 0)
+	for _, p := range g.sortedPending() {
+		if consumer != "" && p.Consumer != consumer {
+			continue
+		}
+		if count > 0 && len(r) >= count {
+			break
+		}
+		r = append(r, *p)
+	}
+	return r, nil
+}
+
+/*
+把空闲时间超过 minIdle(毫秒) 的待确认消息转给 consumer，已被删除的消息直接从待确认列表移除
+*/
+func (s *StreamContent) Claim(group string, consumer string, minIdle int64, ids []StreamID) ([]StreamEntry, error) {
+	g, err := s.group(group)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now().UnixNano() / int64(time.Millisecond)
+	change := StreamChange{Op: streamGroup, Group: group, LastDelivered: g.LastDelivered,
+		Consumer: s.touchConsumer(g, consumer, now)}
+
+	r := make([]StreamEntry, 0)
+	for _, id := range ids {
+		p, ok := g.Pending[id]
+		if !ok || now-p.DeliveryTime < minIdle {
+			continue
+		}
+
+		e, ok := s.Find(id)
+		if !ok {
+			s.delPending(g, id)
+			change.IDs = append(change.IDs, id)
+			continue
+		}
+
+		np := StreamPendingEntry{ID: id, Consumer: consumer, DeliveryTime: now, DeliveryCount: p.DeliveryCount + 1}
+		s.setPending(g, np)
+		change.Pending = append(change.Pending, np)
+		r = append(r, e)
+	}
+	s.changes = append(s.changes, change)
+	return r, nil
+}
+
+/*
+更新消费者的活跃时间，不存在时新建，返回修改后的副本用于持久化
+*/
+func (s *StreamContent) touchConsumer(g *StreamGroup, name string, now int64) *StreamConsumer {
+	c, ok := g.Consumers[name]
+	if !ok {
+		c = &StreamConsumer{Name: name}
+		g.Consumers[name] = c
+		s.size += consumerSize(name)
+	}
+	c.SeenTime = now
+
+	r := *c
+	return &r
+}
+
+func (s *StreamContent) setPending(g *StreamGroup, p StreamPendingEntry) {
+	if old, ok := g.Pending[p.ID]; ok {
+		s.size -= pendingSize(old)
+	}
+	g.Pending[p.ID] = &p
+	s.size += pendingSize(&p)
+}
+
+func (s *StreamContent) delPending(g *StreamGroup, id StreamID) bool {
+	p, ok := g.Pending[id]
+	if !ok {
+		return false
+	}
+	s.size -= pendingSize(p)
+	delete(g.Pending, id)
+	return true
+}
+
+func (s *StreamContent) group(name string) (*StreamGroup, error) {
+	g, ok := s.Groups[name]
+	if !ok {
+		str := fmt.Sprintf("consumer group:%s not exists", name)
+		return nil, errors.New(str)
+	}
+	return g, nil
+}
+
+func (g *StreamGroup) sortedPending() []*StreamPendingEntry {
+	arr := make([]*StreamPendingEntry, 0, len(g.Pending))
+	for _, p := range g.Pending {
+		arr = append(arr, p)
+	}
+	sort.Slice(arr, func(i, j int) bool { return arr[i].ID.Less(arr[j].ID) })
+	return arr
+}
+
+/*
+XRead、XReadGroup 的返回结果
+*/
+type StreamRead struct {
+	Key     string        `json:"key"`
+	Entries []StreamEntry `json:"entries"`
+}
+
+/*
+深拷贝，消息内容写入后不会再修改，所以不拷贝 Fields
+*/
+func (s *StreamContent) Copy() *StreamContent {
+	c := &StreamContent{Entries: append([]StreamEntry{}, s.Entries...), LastID: s.LastID,
+		Groups: make(map[string]*StreamGroup, len(s.Groups)), size: s.size}
+
+	for name, g := range s.Groups {
+		ng := &StreamGroup{Name: g.Name, LastDelivered: g.LastDelivered,
+			Pending: make(map[StreamID]*StreamPendingEntry, len(g.Pending)),
+			Consumers: make(map[string]*StreamConsumer, len(g.Consumers))}
+		for id, p := range g.Pending {
+			np := *p
+			ng.Pending[id] = &np
+		}
+		for k, v := range g.Consumers {
+			nv := *v
+			ng.Consumers[k] = &nv
+		}
+		c.Groups[name] = ng
+	}
+	return c
+}
+
+const (
+	streamAppend       = 1 //Entry 追加的消息
+	streamTrim         = 2 //N 删除最早的N条消息
+	streamRemove       = 3 //IDs 删除的消息
+	streamCreateGroup  = 4 //Group、LastDelivered 新建的消费组
+	streamDestroyGroup = 5 //Group 删除的消费组
+	streamGroup        = 6 //Group 的 LastDelivered、Consumer 更新，Pending 新增或者更新，IDs 从待确认列表删除
+)
+
+/*
+快照之后追加的修改数超过 消息数+待确认数+streamLogMin 时重写快照，重写的开销分摊到每次修改上
+*/
+const streamLogMin = 1024
+
+/*
+stream的一次修改，持久化时追加到文件中快照的后面，加载时按顺序重放
+*/
+type StreamChange struct {
+	Op            int32                `json:"op"`
+	Entry         *StreamEntry         `json:"entry,omitempty"`
+	N             int                  `json:"n,omitempty"`
+	IDs           []StreamID           `json:"ids,omitempty"`
+	Group         string               `json:"group,omitempty"`
+	LastDelivered StreamID             `json:"lastDelivered"`
+	Consumer      *StreamConsumer      `json:"consumer,omitempty"`
+	Pending       []StreamPendingEntry `json:"pending,omitempty"`
+}
+
+/*
+取出上次调用之后的修改，full 为true时需要重写整个快照：文件中还没有快照，或者追加的修改已经太多
+*/
+func (s *StreamContent) Changes() ([]StreamChange, bool) {
+	changes := s.changes
+	s.changes = nil
+
+	limit := len(s.Entries) + streamLogMin
+	for _, g := range s.Groups {
+		limit += len(g.Pending)
+	}
+	if !s.saved || s.logged+len(changes) > limit {
+		s.saved = true
+		s.logged = 0
+		return changes, true
+	}
+	s.logged += len(changes)
+	return changes, false
+}
+
+/*
+加载时重放快照之后追加的修改
+*/
+func (s *StreamContent) Apply(c StreamChange) {
+	s.logged++
+	switch c.Op {
+	case streamAppend:
+		if c.Entry != nil {
+			s.appendEntry(*c.Entry)
+		}
+	case streamTrim:
+		s.trimFront(c.N)
+	case streamRemove:
+		s.remove(c.IDs)
+	case streamCreateGroup:
+		s.createGroup(c.Group, c.LastDelivered)
+	case streamDestroyGroup:
+		s.destroyGroup(c.Group)
+	case streamGroup:
+		g, ok := s.Groups[c.Group]
+		if !ok {
+			return
+		}
+		g.LastDelivered = c.LastDelivered
+		if c.Consumer != nil {
+			s.touchConsumer(g, c.Consumer.Name, c.Consumer.SeenTime)
+		}
+		for _, p := range c.Pending {
+			s.setPending(g, p)
+		}
+		for _, id := range c.IDs {
+			s.delPending(g, id)
+		}
+	}
+}
+
+func errInvalidStreamID(str string) error {
+	s := fmt.Sprintf("invalid stream id:%s", str)
+	return errors.New(s)
+}
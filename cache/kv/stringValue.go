@@ -16,8 +16,7 @@ func (s StringValue) ToString() string{
 }
 
 func (s StringValue) Size() int {
-	l := int(unsafe.Sizeof(s.Expire))
-	return l + len(s.Key) + len(s.Data)
+	return boxSize(unsafe.Sizeof(s)) + StringSize(s.Key) + StringSize(s.Data)
 }
 
 func (s StringValue) GetKey() string{
@@ -30,4 +29,18 @@ func (s StringValue) IsExpire() bool{
 		return true
 	}
 	return false
+}
+
+func (s StringValue) GetExpire() int64{
+	return s.Expire
+}
+
+func (s StringValue) WithExpire(expire int64) ValueCache{
+	s.Expire = expire
+	return s
+}
+
+func (s StringValue) WithKey(key string) ValueCache{
+	s.Key = key
+	return s
 }
\ No newline at end of file
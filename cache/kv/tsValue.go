@@ -0,0 +1,381 @@
+package kv
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"sort"
+	"time"
+	"unsafe"
+)
+
+const (
+	TSAggAvg   = "avg"
+	TSAggMin   = "min"
+	TSAggMax   = "max"
+	TSAggSum   = "sum"
+	TSAggCount = "count"
+)
+
+/*
+时间序列的样本，Time 为毫秒时间戳
+*/
+type TSSample struct {
+	Time  int64   `json:"t"`
+	Value float64 `json:"v"`
+}
+
+/*
+聚合器，记录一个时间桶内的统计值
+*/
+type TSAggregator struct {
+	Type  string  `json:"type"`
+	Count int64   `json:"count"`
+	Sum   float64 `json:"sum"`
+	Min   float64 `json:"min"`
+	Max   float64 `json:"max"`
+}
+
+func CheckTSAggregation(agg string) error {
+	switch agg {
+	case TSAggAvg, TSAggMin, TSAggMax, TSAggSum, TSAggCount:
+		return nil
+	}
+	str := fmt.Sprintf("unsupported aggregation:%s, please use avg, min, max, sum, count", agg)
+	return errors.New(str)
+}
+
+func (s *TSAggregator) Add(v float64) {
+	if s.Count == 0 || v < s.Min {
+		s.Min = v
+	}
+	if s.Count == 0 || v > s.Max {
+		s.Max = v
+	}
+	s.Sum += v
+	s.Count++
+}
+
+func (s *TSAggregator) Value() float64 {
+	switch s.Type {
+	case TSAggAvg:
+		return s.Sum / float64(s.Count)
+	case TSAggMin:
+		return s.Min
+	case TSAggMax:
+		return s.Max
+	case TSAggSum:
+		return s.Sum
+	case TSAggCount:
+		return float64(s.Count)
+	}
+	return math.NaN()
+}
+
+func (s *TSAggregator) Reset() {
+	s.Count = 0
+	s.Sum = 0
+	s.Min = 0
+	s.Max = 0
+}
+
+/*
+降采样规则，源key按 Bucket 毫秒的时间桶聚合后写入 DestKey
+BucketStart、Current 为当前还没结束的时间桶
+*/
+type TSRule struct {
+	DestKey     string       `json:"destKey"`
+	Aggregation string       `json:"aggregation"`
+	Bucket      int64        `json:"bucket"`
+	BucketStart int64        `json:"bucketStart"`
+	Current     TSAggregator `json:"current"`
+}
+
+/*
+降采样产生的样本，需要写入 DestKey
+*/
+type TSDownsample struct {
+	DestKey string
+	Sample  TSSample
+}
+
+/*
+Retention 为保留的毫秒数，相对于最新的样本计算，0表示永久保留
+SourceKey 不为空时表示该key是SourceKey的降采样结果
+*/
+type TSContent struct {
+	Samples   []TSSample `json:"samples"`
+	Retention int64      `json:"retention"`
+	Rules     []*TSRule  `json:"rules"`
+	SourceKey string     `json:"sourceKey"`
+}
+
+func NewTSContent(retention int64) *TSContent {
+	return &TSContent{Samples: []TSSample{}, Retention: retention, Rules: []*TSRule{}}
+}
+
+/*
+添加样本，时间戳相同时覆盖，返回降采样产生的样本
+只有按时间顺序追加的样本参与降采样
+*/
+func (s *TSContent) Add(sample TSSample) ([]TSDownsample, error) {
+	if math.IsNaN(sample.Value) || math.IsInf(sample.Value, 0) {
+		return nil, errors.New("sample value should not be NaN or Infinity")
+	}
+
+	n := len(s.Samples)
+	if s.Retention > 0 && n > 0 && sample.Time < s.Samples[n-1].Time-s.Retention {
+		str := fmt.Sprintf("timestamp:%d is older than retention", sample.Time)
+		return nil, errors.New(str)
+	}
+
+	if n == 0 || sample.Time > s.Samples[n-1].Time {
+		s.Samples = append(s.Samples, sample)
+	}else{
+		i := s.search(sample.Time)
+		if i < n && s.Samples[i].Time == sample.Time {
+			s.Samples[i] = sample
+		}else{
+			s.Samples = append(s.Samples, TSSample{})
+			copy(s.Samples[i+1:], s.Samples[i:])
+			s.Samples[i] = sample
+		}
+	}
+
+	r := make([]TSDownsample, 0)
+	for _, rule := range s.Rules {
+		start := tsBucketStart(sample.Time, rule.Bucket)
+		if rule.Current.Count > 0 && start < rule.BucketStart {
+			continue
+		}
+		if rule.Current.Count > 0 && start > rule.BucketStart {
+			r = append(r, TSDownsample{DestKey: rule.DestKey, Sample: TSSample{Time: rule.BucketStart, Value: rule.Current.Value()}})
+			rule.Current.Reset()
+		}
+		rule.BucketStart = start
+		rule.Current.Add(sample.Value)
+	}
+
+	s.trim()
+	return r, nil
+}
+
+/*
+按时间范围查询，agg 不为空时按 bucket 毫秒的时间桶聚合，count 为0时不限制数量
+*/
+func (s *TSContent) Range(from int64, to int64, agg string, bucket int64, count int) ([]TSSample, error) {
+	r := make([]TSSample, 0)
+	i := s.search(from)
+
+	if agg == "" {
+		for ; i < len(s.Samples) && s.Samples[i].Time <= to; i++ {
+			if count > 0 && len(r) >= count {
+				break
+			}
+			r = append(r, s.Samples[i])
+		}
+		return r, nil
+	}
+
+	if err := CheckTSAggregation(agg); err != nil {
+		return nil, err
+	}
+	if bucket <= 0 {
+		return nil, errors.New("aggregation bucket should be greater than 0")
+	}
+
+	a := TSAggregator{Type: agg}
+	var start int64
+	for ; i < len(s.Samples) && s.Samples[i].Time <= to; i++ {
+		t := tsBucketStart(s.Samples[i].Time, bucket)
+		if a.Count > 0 && t != start {
+			r = append(r, TSSample{Time: start, Value: a.Value()})
+			a.Reset()
+			if count > 0 && len(r) >= count {
+				return r, nil
+			}
+		}
+		start = t
+		a.Add(s.Samples[i].Value)
+	}
+	if a.Count > 0 {
+		r = append(r, TSSample{Time: start, Value: a.Value()})
+	}
+	return r, nil
+}
+
+/*
+删除时间范围内的样本，返回删除的个数
+*/
+func (s *TSContent) DelRange(from int64, to int64) int {
+	i := s.search(from)
+	j := i
+	for j < len(s.Samples) && s.Samples[j].Time <= to {
+		j++
+	}
+	s.Samples = append(s.Samples[:i], s.Samples[j:]...)
+	return j - i
+}
+
+func (s *TSContent) Last() (TSSample, bool) {
+	if len(s.Samples) == 0 {
+		return TSSample{}, false
+	}
+	return s.Samples[len(s.Samples)-1], true
+}
+
+func (s *TSContent) AddRule(destKey string, agg string, bucket int64) error {
+	if err := CheckTSAggregation(agg); err != nil {
+		return err
+	}
+	if bucket <= 0 {
+		return errors.New("aggregation bucket should be greater than 0")
+	}
+	for _, r := range s.Rules {
+		if r.DestKey == destKey {
+			str := fmt.Sprintf("rule to key:%s already exists", destKey)
+			return errors.New(str)
+		}
+	}
+	s.Rules = append(s.Rules, &TSRule{DestKey: destKey, Aggregation: agg, Bucket: bucket, Current: TSAggregator{Type: agg}})
+	return nil
+}
+
+func (s *TSContent) DelRule(destKey string) bool {
+	for i, r := range s.Rules {
+		if r.DestKey == destKey {
+			s.Rules = append(s.Rules[:i], s.Rules[i+1:]...)
+			return true
+		}
+	}
+	return false
+}
+
+func (s *TSContent) Info() TSInfo {
+	if s == nil {
+		return TSInfo{}
+	}
+	r := TSInfo{TotalSamples: len(s.Samples), Retention: s.Retention, SourceKey: s.SourceKey, Rules: make([]TSRuleInfo, len(s.Rules))}
+	if len(s.Samples) > 0 {
+		r.FirstTimestamp = s.Samples[0].Time
+		r.LastTimestamp = s.Samples[len(s.Samples)-1].Time
+	}
+	for i, rule := range s.Rules {
+		r.Rules[i] = TSRuleInfo{DestKey: rule.DestKey, Aggregation: rule.Aggregation, Bucket: rule.Bucket}
+	}
+	return r
+}
+
+func (s *TSContent) Copy() *TSContent {
+	c := &TSContent{Retention: s.Retention, SourceKey: s.SourceKey}
+	c.Samples = append(make([]TSSample, 0, len(s.Samples)), s.Samples...)
+	c.Rules = make([]*TSRule, len(s.Rules))
+	for i, r := range s.Rules {
+		n := *r
+		c.Rules[i] = &n
+	}
+	return c
+}
+
+func (s *TSContent) search(t int64) int {
+	return sort.Search(len(s.Samples), func(i int) bool {
+		return s.Samples[i].Time >= t
+	})
+}
+
+func (s *TSContent) trim() {
+	n := len(s.Samples)
+	if s.Retention <= 0 || n == 0 {
+		return
+	}
+	i := s.search(s.Samples[n-1].Time - s.Retention)
+	if i > 0 {
+		s.Samples = append(s.Samples[:0], s.Samples[i:]...)
+	}
+}
+
+type TSRuleInfo struct {
+	DestKey     string `json:"destKey"`
+	Aggregation string `json:"aggregation"`
+	Bucket      int64  `json:"bucket"`
+}
+
+type TSInfo struct {
+	TotalSamples   int          `json:"totalSamples"`
+	FirstTimestamp int64        `json:"firstTimestamp"`
+	LastTimestamp  int64        `json:"lastTimestamp"`
+	Retention      int64        `json:"retention"`
+	SourceKey      string       `json:"sourceKey"`
+	Rules          []TSRuleInfo `json:"rules"`
+}
+
+type TSValue struct {
+	Key    string       		`json:"key"`
+	Expire int64				`json:"expire"`
+	Data   *TSContent			`json:"data"`
+}
+
+func (s TSValue) ToString() string{
+	data, _ := json.Marshal(s.Data.Info())
+	return string(data)
+}
+
+func (s TSValue) Size() int {
+	t := boxSize(unsafe.Sizeof(s)) + StringSize(s.Key)
+	if s.Data == nil {
+		return t
+	}
+	t += AllocSize(int(unsafe.Sizeof(*s.Data))) + StringSize(s.Data.SourceKey)
+	t += SliceSize(cap(s.Data.Samples), int(unsafe.Sizeof(TSSample{})))
+	t += SliceSize(cap(s.Data.Rules), WordSize)
+	for _, r := range s.Data.Rules {
+		t += AllocSize(int(unsafe.Sizeof(*r))) + StringSize(r.DestKey) + StringSize(r.Aggregation)
+	}
+	return t
+}
+
+func (s TSValue) GetKey() string{
+	return s.Key
+}
+
+func (s TSValue) IsExpire() bool{
+	t := time.Now().UnixNano()
+	if s.Expire != ExpireForever && s.Expire <= t{
+		return true
+	}
+	return false
+}
+
+func (s TSValue) GetExpire() int64{
+	return s.Expire
+}
+
+func (s TSValue) WithExpire(expire int64) ValueCache{
+	s.Expire = expire
+	return s
+}
+
+func (s TSValue) WithKey(key string) ValueCache{
+	s.Key = key
+	return s
+}
+
+/*
+dump时只输出统计信息，不输出全部样本
+*/
+func (s TSValue) MarshalJSON() ([]byte, error) {
+	return json.Marshal(struct {
+		Key    string `json:"key"`
+		Expire int64  `json:"expire"`
+		Info   TSInfo `json:"info"`
+	}{s.Key, s.Expire, s.Data.Info()})
+}
+
+func tsBucketStart(t int64, bucket int64) int64 {
+	m := t % bucket
+	if m < 0 {
+		m += bucket
+	}
+	return t - m
+}
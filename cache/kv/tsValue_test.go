@@ -0,0 +1,122 @@
+package kv
+
+import (
+	"fmt"
+	"testing"
+)
+
+func sampleTimes(samples []TSSample) string {
+	times := make([]int64, 0, len(samples))
+	for _, s := range samples {
+		times = append(times, s.Time)
+	}
+	return fmt.Sprint(times)
+}
+
+/*
+保留时间相对于最新的样本计算，超出保留时间的样本被删除，写入太旧的样本返回错误
+*/
+func TestTSRetention(t *testing.T) {
+	tests := []struct {
+		name      string
+		retention int64
+		times     []int64
+		want      string
+		errs      int
+	}{
+		{"forever", 0, []int64{1, 500, 1000}, "[1 500 1000]", 0},
+		{"trim", 100, []int64{1, 50, 100, 150, 200}, "[100 150 200]", 0},
+		{"out of order", 100, []int64{100, 300, 250, 210, 199}, "[210 250 300]", 1},
+		{"overwrite", 100, []int64{10, 20, 10}, "[10 20]", 0},
+		{"too old", 10, []int64{100, 89, 90}, "[90 100]", 1},
+	}
+
+	for _, tt := range tests {
+		c := NewTSContent(tt.retention)
+		errs := 0
+		for _, ts := range tt.times {
+			if _, err := c.Add(TSSample{Time: ts, Value: 1}); err != nil {
+				errs++
+			}
+		}
+		if got := sampleTimes(c.Samples); got != tt.want || errs != tt.errs {
+			t.Fatalf("%s: samples %s with %d errors, want %s with %d errors", tt.name, got, errs, tt.want, tt.errs)
+		}
+	}
+}
+
+/*
+时间桶结束后才产生降采样的样本，写入更早的时间桶的样本不参与降采样
+*/
+func TestTSDownsample(t *testing.T) {
+	c := NewTSContent(0)
+	for _, agg := range []string{TSAggAvg, TSAggMin, TSAggMax, TSAggSum, TSAggCount} {
+		if err := c.AddRule(agg, agg, 10); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	samples := []TSSample{{1, 1}, {5, 3}, {3, 100}, {12, 10}, {15, 20}, {8, 100}, {31, 5}}
+	got := make(map[string][]TSSample)
+	for _, s := range samples {
+		ds, err := c.Add(s)
+		if err != nil {
+			t.Fatal(err)
+		}
+		for _, d := range ds {
+			got[d.DestKey] = append(got[d.DestKey], d.Sample)
+		}
+	}
+
+	//第三个样本写入当前时间桶，参与降采样
+	want := map[string][]TSSample{
+		TSAggAvg:   {{0, 104.0 / 3}, {10, 15}},
+		TSAggMin:   {{0, 1}, {10, 10}},
+		TSAggMax:   {{0, 100}, {10, 20}},
+		TSAggSum:   {{0, 104}, {10, 30}},
+		TSAggCount: {{0, 3}, {10, 2}},
+	}
+	for agg, w := range want {
+		if fmt.Sprint(got[agg]) != fmt.Sprint(w) {
+			t.Fatalf("%s: downsampled %v, want %v", agg, got[agg], w)
+		}
+	}
+
+	if err := c.AddRule(TSAggAvg, TSAggAvg, 10); err == nil {
+		t.Fatal("duplicated rule is added")
+	}
+	if err := c.AddRule("x", "median", 10); err == nil {
+		t.Fatal("rule with unsupported aggregation is added")
+	}
+}
+
+func TestTSRange(t *testing.T) {
+	c := NewTSContent(0)
+	for i := int64(0); i < 20; i++ {
+		c.Add(TSSample{Time: i, Value: float64(i)})
+	}
+
+	tests := []struct {
+		from, to int64
+		agg      string
+		bucket   int64
+		count    int
+		want     string
+	}{
+		{0, 4, "", 0, 0, "[{0 0} {1 1} {2 2} {3 3} {4 4}]"},
+		{5, 100, "", 0, 2, "[{5 5} {6 6}]"},
+		{3, 12, TSAggSum, 5, 0, "[{0 7} {5 35} {10 33}]"},
+		{0, 19, TSAggMax, 10, 1, "[{0 9}]"},
+		{50, 60, TSAggAvg, 10, 0, "[]"},
+	}
+
+	for _, tt := range tests {
+		r, err := c.Range(tt.from, tt.to, tt.agg, tt.bucket, tt.count)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if fmt.Sprint(r) != tt.want {
+			t.Fatalf("%+v: got %v", tt, r)
+		}
+	}
+}
@@ -7,6 +7,9 @@ const (
 	Add = 0
 	Del = 1
 	Clear = 2
+	Expire = 3 //修改过期时间
+	Expired = 4 //过期被删除
+	Append = 5 //只用于stream的持久化，把 Changes 追加到文件末尾
 )
 
 const ExpireForever = 0
@@ -31,12 +34,51 @@ type PersistentSetOp struct {
 	OpType OpType
 }
 
+type PersistentHLLOp struct {
+	Item   HLLValue
+	OpType OpType
+}
+
+type PersistentGeoOp struct {
+	Item   GeoValue
+	OpType OpType
+}
+
+type PersistentStreamOp struct {
+	Item    StreamValue
+	Changes []StreamChange
+	OpType  OpType
+}
+
+type PersistentJSONOp struct {
+	Item   JSONValue
+	OpType OpType
+}
+
+type PersistentBloomOp struct {
+	Item   BloomValue
+	OpType OpType
+}
+
+type PersistentCuckooOp struct {
+	Item   CuckooValue
+	OpType OpType
+}
+
+type PersistentTSOp struct {
+	Item   TSValue
+	OpType OpType
+}
+
 
 type ValueCache interface {
 	ToString() string
 	Size() int
 	GetKey() string
 	IsExpire() bool
+	GetExpire() int64
+	WithExpire(expire int64) ValueCache
+	WithKey(key string) ValueCache
 }
 
 const (
@@ -44,8 +86,111 @@ const (
 	MapData   int32 = 1
 	ListData  int32 = 2
 	SetData   int32 = 3
+	HLLData   int32 = 4
+	GeoData   int32 = 5
+	StreamData int32 = 6
+	JSONData  int32 = 7
+	BloomData int32 = 8
+	CuckooData int32 = 9
+	TSData    int32 = 10
 )
 
+/*
+数据类型的名称，scan 时作为类型过滤条件和返回的类型
+*/
+var DataTypeNames = []string{"string", "map", "list", "set", "hll", "geo", "stream", "json", "bloom", "cuckoo", "timeseries"}
+
+/*
+scan 返回的key和它的类型
+*/
+type ScanKey struct {
+	Key  string `json:"key"`
+	Type string `json:"type"`
+}
+
+/*
+key的元数据，时间为unix毫秒时间戳，Idle 为没有被读取的毫秒数，Hits 为读取次数
+Freq 为淘汰策略记录的访问频率，lfu 为对数计数，tinylfu 为估计的访问次数，lru 时为0
+TTL 为剩余的毫秒数，没有过期时间时为-1
+*/
+type KeyInfo struct {
+	Key      string `json:"key"`
+	Type     string `json:"type"`
+	Encoding string `json:"encoding"`
+	Size     int    `json:"size"`
+	Created  int64  `json:"created"`
+	Modified int64  `json:"modified"`
+	Accessed int64  `json:"accessed"`
+	Idle     int64  `json:"idle"`
+	Hits     uint64 `json:"hits"`
+	Freq     int    `json:"freq"`
+	TTL      int64  `json:"ttl"`
+}
+
+/*
+一种类型的汇总信息，Volatile 为有过期时间的key的个数，Evictions 为超过缓存大小被淘汰的key的个数
+HitRatio 为读取命中的比例，Rejected 为 tinylfu 没有进入主lru直接被淘汰的新key的个数
+*/
+type TypeStats struct {
+	Type      string  `json:"type"`
+	Policy    string  `json:"policy"`
+	Keys      int     `json:"keys"`
+	Size      int     `json:"size"`
+	MaxSize   int     `json:"maxSize"`
+	Volatile  int     `json:"volatile"`
+	Hits      uint64  `json:"hits"`
+	Misses    uint64  `json:"misses"`
+	HitRatio  float64 `json:"hitRatio"`
+	Evictions uint64  `json:"evictions"`
+	Rejected  uint64  `json:"rejected"`
+	AvgIdle   int64   `json:"avgIdle"`
+	MaxIdle   int64   `json:"maxIdle"`
+}
+
+/*
+所有数据库计入的内存和go运行时统计的对比，Accounted 为计入缓存大小的字节数之和，
+HeapRatio 为 HeapAlloc/Accounted，大于1的部分是没有计入的占用，包括还没有被gc回收的对象
+*/
+type MemoryStats struct {
+	Accounted   int64   `json:"accounted"`
+	Keys        int     `json:"keys"`
+	MaxMemory   int64   `json:"maxMemory"`
+	Policy      string  `json:"policy"`
+	HeapAlloc   uint64  `json:"heapAlloc"`
+	HeapInuse   uint64  `json:"heapInuse"`
+	HeapSys     uint64  `json:"heapSys"`
+	HeapObjects uint64  `json:"heapObjects"`
+	Sys         uint64  `json:"sys"`
+	NumGC       uint32  `json:"numGC"`
+	HeapRatio   float64 `json:"heapRatio"`
+}
+
+/*
+事务中的一条命令，Args 为命令的参数，写入的命令使用 Expire 作为过期的秒数，0为不过期
+*/
+type TxCommand struct {
+	Cmd    string   `json:"cmd"`
+	Key    string   `json:"key"`
+	Args   []string `json:"args"`
+	Expire int64    `json:"expire"`
+}
+
+/*
+事务中一条命令的结果，按命令返回的数据填入 Value、Values 或者 Fields
+*/
+type TxResult struct {
+	Value  string            `json:"value,omitempty"`
+	Values []string          `json:"values,omitempty"`
+	Fields map[string]string `json:"fields,omitempty"`
+}
+
+/*
+批量操作中一条命令的结果，Err 不为空时表示这条命令失败，不影响其他命令
+*/
+type BatchResult struct {
+	TxResult
+	Err string `json:"error,omitempty"`
+}
 
 func Copy(m map[string]string) map[string]string{
 	r := make(map[string]string)
@@ -7,152 +7,925 @@ import (
 	"fmt"
 	"github.com/llr104/lightkv/cache/kv"
 	"log"
+	"math/rand"
 	"sync"
+	"sync/atomic"
 	"time"
+	"unsafe"
 )
 
 type expireTrigger  func(key string, v kv.ValueCache)
 
+/*
+key按哈希分到固定个数的槽中，槽的个数不变，scan时按槽遍历，游标就是槽的下标
+*/
+const lruSlots = 1024
+
+/*
+分片的个数需要是2的幂并且不超过槽的个数，槽按下标分到各个分片
+*/
+func checkShards(n int) bool {
+	return n > 0 && n <= lruSlots && n & (n - 1) == 0
+}
+
+/*
+读取时距离上次移动到链表头部超过这个时间才再次移动，其他读取只在读锁下原子更新访问时间，
+淘汰时被读取过但是没有移动的key会被移动到头部，再给一次机会
+*/
+const lruPromoteInterval = int64(time.Second)
+
+/*
+lru中保存的值和它的元数据，时间为纳秒时间戳
+created 为key第一次写入或者加载的时间，accessed、hits、lfu 在读取时原子更新，读取不需要额外加锁
+promoted 为上次移动到链表头部的时间
+window 为true时在 tinylfu 的窗口lru中，size 为写入时计算的值和索引的占用
+revision 为写入时分配的全局递增的修改版本，用于事务的 watch 和 CAS 写入
+*/
+type lruEntry struct {
+	value    kv.ValueCache
+	revision uint64
+	size     int
+	created  int64
+	modified int64
+	accessed int64
+	promoted int64
+	hits     uint64
+	lfu      uint32
+	window   bool
+}
+
+/*
+每个key在lru中额外的占用：链表元素、lruEntry、caches中map桶里的key和指针，
+map的桶平均按装载因子的3/4计算
+*/
+var lruEntryOverhead = kv.AllocSize(int(unsafe.Sizeof(list.Element{}))) + kv.AllocSize(int(unsafe.Sizeof(lruEntry{}))) +
+	mapEntrySize(kv.StringHeader, kv.WordSize)
+
+/*
+有过期时间的key在过期调度器中的占用：堆中的元素和指针、items中map桶里的key和指针
+*/
+var expireEntryOverhead = kv.AllocSize(int(unsafe.Sizeof(expireItem{}))) + kv.WordSize +
+	mapEntrySize(int(unsafe.Sizeof(expireKey{})), kv.WordSize)
+
+/*
+统一键空间模式下key在全局索引中的占用
+*/
+var keyIndexEntryOverhead = mapEntrySize(kv.StringHeader, int(unsafe.Sizeof(int32(0))))
+
+/*
+所有lru共享的修改版本，每次写入加1
+从启动时的纳秒时间戳开始，重启后加载的值和新的写入也比重启前的版本大
+*/
+var lruRevision = uint64(time.Now().UnixNano())
+
+func nextRevision() uint64 {
+	return atomic.AddUint64(&lruRevision, 1)
+}
+
+func mapEntrySize(keySize int, valueSize int) int {
+	bucket := 8 + 8*(keySize+valueSize) + kv.WordSize
+	return bucket * 8 / 39
+}
+
+func (s *lruEntry) touch(now int64) {
+	atomic.StoreInt64(&s.accessed, now)
+	atomic.AddUint64(&s.hits, 1)
+}
+
+/*
+lru的一个分片，槽按下标分到各个分片，每个分片有自己的锁和链表，
+caches 中属于这个分片的槽只在持有这个分片的锁时访问
+hits、misses 按分片计数，避免所有读取原子更新同一个计数
+*/
+type lruShard struct {
+	hits       uint64
+	misses     uint64
+	rwMutex    sync.RWMutex
+	l          *list.List
+	window     *list.List
+	windowSize int
+	count      int
+	sketch     *cmSketch
+}
+
+func (s *lruShard) reset(policy string, shards int) {
+	s.l = list.New()
+	s.window = list.New()
+	s.windowSize = 0
+	s.count = 0
+	if policy == PolicyTinyLFU {
+		s.sketch = newSketch(sketchWidth / shards)
+	}
+}
+
+func (s *lruShard) listOf(entry *lruEntry) *list.List {
+	if entry.window {
+		return s.window
+	}
+	return s.l
+}
+
+/*
+maxSize、cacheSize、count 是所有分片的总和，超过 maxSize 时在写入之后淘汰，
+淘汰时不持有写入的分片的锁，同一时间只有一个写操作在淘汰
+*/
 type lru struct {
 	cacheType 		int32
-	l				*list.List
-	caches 			map[string]*list.Element
-	cacheSize 		int
-	rwMutex 		sync.RWMutex
+	policy          string
+	shards          []*lruShard
+	caches 			[lruSlots]map[string]*list.Element
+	arena           *arenaStore
+	count           int64
+	cacheSize 		int64
+	evictMutex      sync.Mutex
+	evictCursor     int
 	expireTrigger   expireTrigger
+	expires         *expireQueue
+	keys            *keyIndex
+	budget          *memoryBudget
 	maxSize         int
+	evictions       uint64
+	rejected        uint64
 }
 
-func newLRU(cacheType int32, maxSize int) *lru{
-	return &lru{
+func newLRU(cacheType int32, maxSize int, policy string) *lru{
+	s := &lru{
 		cacheType:		cacheType,
-		l:              list.New(),
-		caches:         make(map[string]*list.Element),
+		policy:         policy,
+		shards:         make([]*lruShard, Conf.LRUShards),
 		expireTrigger:  nil,
 		maxSize:		maxSize,
 	}
+	for i := range s.shards {
+		s.shards[i] = &lruShard{}
+		s.shards[i].reset(policy, len(s.shards))
+	}
+	if Conf.MaxMemory > 0 {
+		//所有类型共享 maxmemory，不再单独限制每种类型的大小，淘汰的key由 maxmemoryPolicy 选择
+		s.maxSize = 0
+		if policy != PolicyLRU {
+			log.Printf("type:%s policy %s is not used with maxmemory, keys are evicted by %s",
+				kv.DataTypeNames[cacheType], policy, Conf.MaxMemoryPolicy)
+		}
+	}
+	if cacheType == kv.ValueData && Conf.StringStorage == StorageArena {
+		if policy == PolicyTinyLFU {
+			log.Printf("arena storage not support %s, use %s", policy, PolicyLRU)
+			s.policy = PolicyLRU
+		}
+		s.arena = newArenaStore(s)
+	}
+	s.budget = memory
+	memory.register(s)
+	s.resetCaches()
+	return s
 }
 
 func (s* lru) PushFront(v kv.ValueCache) {
+	s.pushFront(v, nextRevision())
+}
+
+/*
+写回之前的值和它原来的修改版本，用于事务回滚，watch 了这个key的事务不会因为回滚而失败
+*/
+func (s *lru) Restore(v kv.ValueCache, revision uint64) {
+	s.pushFront(v, revision)
+}
+
+func (s* lru) pushFront(v kv.ValueCache, revision uint64) {
 	if v == nil{
 		return
 	}
+	if s.arena != nil {
+		s.arena.pushFront(v, revision)
+		return
+	}
 
-	s.rwMutex.Lock()
-	defer s.rwMutex.Unlock()
-
-	if s.cacheSize >= s.maxSize{
-
-		//lru 删除
-		e := s.l.Back()
-		val := e.Value.(kv.ValueCache)
+	v = packValue(v)
+	key := v.GetKey()
+	sh := s.shard(key)
+	sh.rwMutex.Lock()
 
-		if s.expireTrigger != nil{
-			s.expireTrigger(val.GetKey(), val)
+	now := time.Now().UnixNano()
+	entry := &lruEntry{value: v, revision: revision, size: s.entrySize(v), created: now, modified: now, accessed: now,
+		promoted: now, lfu: newLFU(now), window: s.policy == PolicyTinyLFU}
+	if e, ok := s.element(key); ok {
+		//修改也算一次访问频率，但不计入读取次数
+		old := e.Value.(*lruEntry)
+		entry.created = old.created
+		entry.hits = atomic.LoadUint64(&old.hits)
+		entry.lfu = atomic.LoadUint32(&old.lfu)
+		entry.window = old.window
+		if s.countLFU() {
+			entry.lfuTouch(now)
 		}
-
-		log.Printf("type:%d lru remove key:%s", s.cacheType, val.GetKey())
-		s.remove(val.GetKey())
+	}
+	if sh.sketch != nil {
+		sh.sketch.Increment(key)
 	}
 
 	//删除原有的
-	s.remove(v.GetKey())
+	s.remove(sh, key)
 
 	//添加
-	e := s.l.PushFront(v)
-	s.caches[v.GetKey()] = e
+	var e *list.Element
+	if entry.window {
+		e = sh.window.PushFront(entry)
+		sh.windowSize += entry.size
+	}else{
+		e = sh.l.PushFront(entry)
+	}
+	s.caches[lruSlot(key)][key] = e
+	sh.count++
+	atomic.AddInt64(&s.count, 1)
 
-	s.cacheSize += v.Size()
+	s.addSize(entry.size)
+	s.balanceWindow(sh)
+
+	if s.expires != nil{
+		s.expires.Set(s.cacheType, v.GetKey(), v.GetExpire())
+	}
+	if s.keys != nil{
+		s.keys.Set(v.GetKey(), s.cacheType)
+	}
+	sh.rwMutex.Unlock()
+
+	s.evict(key)
 }
 
+/*
+读取只加分片的读锁，距离上次移动超过 lruPromoteInterval 时才加写锁移动到链表头部
+*/
 func (s *lru) Value(key string) (kv.ValueCache, error) {
-	s.rwMutex.Lock()
-	defer s.rwMutex.Unlock()
+	v, _, err := s.ValueRevision(key)
+	return v, err
+}
 
-	v, ok := s.caches[key]
-	if ok{
-		s.moveToFront(key)
-		return v.Value.(kv.ValueCache), nil
-	}else{
+/*
+和 Value 一样，同时返回值写入时的修改版本，两者在同一个读锁内读取
+*/
+func (s *lru) ValueRevision(key string) (kv.ValueCache, uint64, error) {
+	v, revision, err := s.PackedValue(key)
+	if err != nil {
+		return nil, 0, err
+	}
+	return unpackValue(v), revision, nil
+}
+
+/*
+和 ValueRevision 一样，紧凑编码的值不解码，只读的读取通过 mapReader、listReader、setReader 直接访问编码
+*/
+func (s *lru) PackedValue(key string) (kv.ValueCache, uint64, error) {
+	if s.arena != nil {
+		if v, revision, ok := s.arena.Value(key); ok {
+			return v, revision, nil
+		}
+		str := fmt.Sprintf("data type: %d not have key:%s ValueCache", s.cacheType, key)
+		return nil, 0, errors.New(str)
+	}
+
+	sh := s.shard(key)
+	if sh.sketch != nil {
+		sh.sketch.Increment(key)
+	}
+
+	sh.rwMutex.RLock()
+	v, ok := s.element(key)
+	if ok == false {
+		sh.rwMutex.RUnlock()
+		atomic.AddUint64(&sh.misses, 1)
 		str := fmt.Sprintf("data type: %d not have key:%s ValueCache", s.cacheType, key)
-		return nil, errors.New(str)
+		return nil, 0, errors.New(str)
+	}
+
+	entry := v.Value.(*lruEntry)
+	now := time.Now().UnixNano()
+	entry.touch(now)
+	if s.countLFU() {
+		entry.lfuTouch(now)
+	}
+	atomic.AddUint64(&sh.hits, 1)
+	promote := now - atomic.LoadInt64(&entry.promoted) > lruPromoteInterval
+	sh.rwMutex.RUnlock()
+
+	if promote {
+		s.promote(sh, entry, now)
+	}
+	return entry.value, entry.revision, nil
+}
+
+func (s *lru) promote(sh *lruShard, entry *lruEntry, now int64) {
+	sh.rwMutex.Lock()
+	defer sh.rwMutex.Unlock()
+
+	e, ok := s.element(entry.value.GetKey())
+	if ok && e.Value.(*lruEntry) == entry {
+		sh.listOf(entry).MoveToFront(e)
+		atomic.StoreInt64(&entry.promoted, now)
+	}
+}
+
+/*
+写操作读取原有的值，不改变lru的顺序，不计入命中率
+*/
+func (s *lru) Lookup(key string) (kv.ValueCache, error) {
+	if v, ok := s.Peek(key); ok {
+		return v, nil
+	}
+	str := fmt.Sprintf("data type: %d not have key:%s ValueCache", s.cacheType, key)
+	return nil, errors.New(str)
+}
+
+/*
+读取值但不改变lru的顺序
+*/
+func (s *lru) Peek(key string) (kv.ValueCache, bool) {
+	v, ok := s.PeekPacked(key)
+	if ok == false {
+		return nil, false
+	}
+	return unpackValue(v), true
+}
+
+/*
+和 Peek 一样，紧凑编码的值不解码
+*/
+func (s *lru) PeekPacked(key string) (kv.ValueCache, bool) {
+	if s.arena != nil {
+		return s.arena.Peek(key)
+	}
+
+	sh := s.shard(key)
+	sh.rwMutex.RLock()
+	defer sh.rwMutex.RUnlock()
+
+	v, ok := s.element(key)
+	if ok{
+		return v.Value.(*lruEntry).value, true
+	}
+	return nil, false
+}
+
+/*
+key最后一次写入时的修改版本，key不存在或者已经过期时返回0
+*/
+func (s *lru) Revision(key string) uint64 {
+	if s.arena != nil {
+		return s.arena.Revision(key)
+	}
+
+	sh := s.shard(key)
+	sh.rwMutex.RLock()
+	defer sh.rwMutex.RUnlock()
+
+	v, ok := s.element(key)
+	if ok == false || v.Value.(*lruEntry).value.IsExpire() {
+		return 0
+	}
+	return v.Value.(*lruEntry).revision
+}
+
+/*
+key的元数据，不改变lru的顺序和访问次数，key已经过期时返回false
+*/
+func (s *lru) Info(key string) (kv.KeyInfo, bool) {
+	if s.arena != nil {
+		return s.arena.Info(key)
+	}
+
+	sh := s.shard(key)
+	sh.rwMutex.RLock()
+	defer sh.rwMutex.RUnlock()
+
+	v, ok := s.element(key)
+	if ok == false || v.Value.(*lruEntry).value.IsExpire() {
+		return kv.KeyInfo{}, false
+	}
+	return s.info(sh, v.Value.(*lruEntry), time.Now().UnixNano()), true
+}
+
+func (s *lru) info(sh *lruShard, entry *lruEntry, now int64) kv.KeyInfo {
+	v := entry.value
+	ttl := int64(TTLForever)
+	if v.GetExpire() != kv.ExpireForever {
+		ttl = (v.GetExpire() - now) / int64(time.Millisecond)
+	}
+	accessed := atomic.LoadInt64(&entry.accessed)
+	freq := uint32(0)
+	if s.countLFU() {
+		freq = entry.lfuCount(now)
+	}else if sh.sketch != nil {
+		freq = sh.sketch.Estimate(v.GetKey())
+	}
+	return kv.KeyInfo{
+		Key:      v.GetKey(),
+		Type:     kv.DataTypeNames[s.cacheType],
+		Encoding: valueEncoding(v),
+		Size:     entry.size,
+		Created:  entry.created / int64(time.Millisecond),
+		Modified: entry.modified / int64(time.Millisecond),
+		Accessed: accessed / int64(time.Millisecond),
+		Idle:     (now - accessed) / int64(time.Millisecond),
+		Hits:     atomic.LoadUint64(&entry.hits),
+		Freq:     int(freq),
+		TTL:      ttl,
+	}
+}
+
+/*
+按槽统计，每次只在统计一个槽时加所在分片的读锁
+*/
+func (s *lru) Stats() kv.TypeStats {
+	r := kv.TypeStats{Type: kv.DataTypeNames[s.cacheType], Policy: s.policy}
+	for _, sh := range s.shards {
+		r.Hits += atomic.LoadUint64(&sh.hits)
+		r.Misses += atomic.LoadUint64(&sh.misses)
+	}
+	now := time.Now().UnixNano()
+	idle := int64(0)
+	if s.arena != nil {
+		idle = s.arena.stats(&r, now)
+	}
+	if r.Hits + r.Misses > 0 {
+		r.HitRatio = float64(r.Hits) / float64(r.Hits + r.Misses)
+	}
+	for slot := 0; slot < lruSlots && s.arena == nil; slot++ {
+		sh := s.slotShard(slot)
+		sh.rwMutex.RLock()
+		for _, e := range s.caches[slot] {
+			entry := e.Value.(*lruEntry)
+			if entry.value.IsExpire() {
+				continue
+			}
+			i := (now - atomic.LoadInt64(&entry.accessed)) / int64(time.Millisecond)
+			idle += i
+			if i > r.MaxIdle {
+				r.MaxIdle = i
+			}
+			if entry.value.GetExpire() != kv.ExpireForever {
+				r.Volatile++
+			}
+			r.Keys++
+		}
+		sh.rwMutex.RUnlock()
+	}
+	if r.Keys > 0 {
+		r.AvgIdle = idle / int64(r.Keys)
 	}
+
+	r.Size = s.Size()
+	r.MaxSize = s.maxSize
+	r.Evictions = atomic.LoadUint64(&s.evictions)
+	r.Rejected = atomic.LoadUint64(&s.rejected)
+	return r
 }
 
+/*
+按分片的顺序加锁，清空之后一起解锁
+*/
 func (s *lru) Clear()  {
-	s.rwMutex.Lock()
-	defer s.rwMutex.Unlock()
+	if s.arena != nil {
+		s.arena.lockAll()
+		defer s.arena.unlockAll()
+		s.arena.reset()
+		atomic.StoreInt64(&s.count, 0)
+	}else{
+		for _, sh := range s.shards {
+			sh.rwMutex.Lock()
+		}
+		defer func() {
+			for _, sh := range s.shards {
+				sh.rwMutex.Unlock()
+			}
+		}()
 
-	s.l = list.New()
-	s.caches = make(map[string]*list.Element)
-	s.cacheSize = 0
+		for _, sh := range s.shards {
+			sh.reset(s.policy, len(s.shards))
+		}
+		s.resetCaches()
+	}
+	s.addSize(-int(atomic.LoadInt64(&s.cacheSize)))
+
+	if s.expires != nil{
+		s.expires.RemoveType(s.cacheType)
+	}
+	if s.keys != nil{
+		s.keys.RemoveType(s.cacheType)
+	}
 }
 
 func (s *lru) CacheToString() ([]byte, error)  {
-	s.rwMutex.Lock()
-	defer s.rwMutex.Unlock()
-
 	m := make(map[string]kv.ValueCache)
-	for k,v:=range s.caches{
-		m[k] = v.Value.(kv.ValueCache)
+	for slot := 0; slot < lruSlots; slot++ {
+		s.ScanSlot(slot, func(key string, v kv.ValueCache) {
+			m[key] = v
+		})
 	}
 	return json.MarshalIndent(m, "", "    ")
 }
 
 func (s *lru) Size() int{
-	s.rwMutex.RLock()
-	defer s.rwMutex.RUnlock()
-
-	return s.cacheSize
+	return int(atomic.LoadInt64(&s.cacheSize))
 }
 
 func (s *lru) Len() int{
-	s.rwMutex.RLock()
-	defer s.rwMutex.RUnlock()
-	return len(s.caches)
+	return int(atomic.LoadInt64(&s.count))
+}
+
+/*
+遍历一个槽中的key，只在遍历这个槽时加读锁，不改变lru的顺序
+*/
+func (s *lru) ScanSlot(slot int, f func(key string, v kv.ValueCache)) {
+	if s.arena != nil {
+		s.arena.ScanSlot(slot, f)
+		return
+	}
+
+	sh := s.slotShard(slot)
+	sh.rwMutex.RLock()
+	defer sh.rwMutex.RUnlock()
+
+	for k, v := range s.caches[slot] {
+		f(k, unpackValue(v.Value.(*lruEntry).value))
+	}
 }
 
 func (s* lru) Remove(key string) {
-	s.rwMutex.Lock()
-	defer s.rwMutex.Unlock()
-	s.remove(key)
+	if s.arena != nil {
+		s.arena.Remove(key)
+		return
+	}
+
+	sh := s.shard(key)
+	sh.rwMutex.Lock()
+	defer sh.rwMutex.Unlock()
+	s.remove(sh, key)
 }
 
 func (s* lru) SetExpireTrigger(trigger expireTrigger)  {
 	s.expireTrigger = trigger
 }
 
-func (s* lru) moveToFront(key string) {
-	v, ok := s.caches[key]
+/*
+设置过期调度器，有过期时间的key到期后由调度器删除
+*/
+func (s* lru) SetExpireQueue(expires *expireQueue)  {
+	s.expires = expires
+}
+
+/*
+设置全局的key索引，只在统一键空间模式下使用
+*/
+func (s* lru) SetKeyIndex(keys *keyIndex)  {
+	s.keys = keys
+}
+
+func (s* lru) shard(key string) *lruShard {
+	return s.slotShard(lruSlot(key))
+}
+
+func (s* lru) slotShard(slot int) *lruShard {
+	return s.shards[slot & (len(s.shards) - 1)]
+}
+
+/*
+需要持有key所在分片的锁
+*/
+func (s* lru) element(key string) (*list.Element, bool) {
+	v, ok := s.caches[lruSlot(key)][key]
+	return v, ok
+}
+
+/*
+需要持有key所在分片的写锁
+*/
+func (s* lru) remove(sh *lruShard, key string) {
+
+	v, ok := s.element(key)
 	if ok {
-		s.l.MoveToFront(v)
+		entry := v.Value.(*lruEntry)
+		s.addSize(-entry.size)
+		if entry.window {
+			sh.windowSize -= entry.size
+		}
+		sh.listOf(entry).Remove(v)
+		delete(s.caches[lruSlot(key)], key)
+		sh.count--
+		atomic.AddInt64(&s.count, -1)
+
+		if s.expires != nil{
+			s.expires.Remove(s.cacheType, key)
+		}
+		if s.keys != nil{
+			s.keys.Remove(key, s.cacheType)
+		}
+	}
+}
+
+/*
+值的占用加上lru和索引中的额外占用
+*/
+func (s* lru) entrySize(v kv.ValueCache) int {
+	t := v.Size() + lruEntryOverhead
+	if s.expires != nil && v.GetExpire() != kv.ExpireForever {
+		t += expireEntryOverhead
 	}
+	if s.keys != nil {
+		t += keyIndexEntryOverhead
+	}
+	return t
 }
 
-func (s* lru) remove(key string) {
+func (s* lru) addSize(n int) {
+	atomic.AddInt64(&s.cacheSize, int64(n))
+	if s.budget != nil {
+		s.budget.add(n)
+	}
+}
 
-	v, ok := s.caches[key]
-	if ok {
-		s.cacheSize -= v.Value.(kv.ValueCache).Size()
-		s.l.Remove(v)
-		delete(s.caches, key)
+/*
+lfu 策略或者 maxmemory 按lfu淘汰时需要记录访问频率
+*/
+func (s* lru) countLFU() bool {
+	if s.policy == PolicyLFU {
+		return true
+	}
+	return Conf.MaxMemory > 0 && (Conf.MaxMemoryPolicy == MaxMemoryAllKeysLFU || Conf.MaxMemoryPolicy == MaxMemoryVolatileLFU)
+}
+
+/*
+超过缓存大小时按淘汰策略删除key直到不超过，except 为刚写入的key
+*/
+func (s* lru) evict(except string) {
+	if s.maxSize <= 0 || s.Size() <= s.maxSize {
+		return
+	}
+
+	s.evictMutex.Lock()
+	defer s.evictMutex.Unlock()
+
+	for s.Size() > s.maxSize {
+		if s.evictOne(except, time.Now().UnixNano()) == false {
+			return
+		}
 	}
 }
 
-func (s *lru) checkExpire() {
-	for {
-		time.Sleep(time.Duration(Conf.CheckExpireInterval) * time.Second)
-		s.rwMutex.Lock()
+/*
+淘汰一个key，没有可以淘汰的key时返回false
+*/
+func (s* lru) evictOne(except string, now int64) bool {
+	if s.arena != nil {
+		return s.arena.evictOne(except, now)
+	}
+
+	switch s.policy {
+	case PolicyLFU:
+		entry := s.lfuVictim(except, now)
+		if entry == nil {
+			return false
+		}
+		s.evictEntry(entry, s.policy, now)
+		return true
+	case PolicyTinyLFU:
+		sh := s.nextShard()
+		if sh == nil {
+			return false
+		}
+		sh.rwMutex.Lock()
+		defer sh.rwMutex.Unlock()
+		entry := s.tinyLFUVictim(sh, except)
+		if entry == nil {
+			return s.Len() > 1
+		}
+		s.drop(sh, entry, s.policy, now)
+		return true
+	}
 
-		for k, v := range s.caches{
-			ok := v.Value.(kv.ValueCache).IsExpire()
-			if ok{
-				if s.expireTrigger != nil{
-					s.expireTrigger(k, v.Value.(kv.ValueCache))
-				}
-				s.remove(k)
+	//尾部的key上次移动之后又被读取过时移动到头部再给一次机会，最多检查所有key一次
+	for i := s.Len(); i >= 0; i-- {
+		sh := s.oldestShard(except)
+		if sh == nil {
+			return false
+		}
+		if s.dropBack(sh, except, i > 0, now) {
+			return true
+		}
+	}
+	return false
+}
+
+/*
+淘汰分片链表尾部的key，second 为true并且尾部的key在上次移动之后又被读取过时移动到头部，返回false
+*/
+func (s* lru) dropBack(sh *lruShard, except string, second bool, now int64) bool {
+	sh.rwMutex.Lock()
+	defer sh.rwMutex.Unlock()
+
+	e := sh.l.Back()
+	if e != nil && e.Value.(*lruEntry).value.GetKey() == except {
+		e = e.Prev()
+	}
+	if e == nil {
+		return false
+	}
+	entry := e.Value.(*lruEntry)
+	accessed := atomic.LoadInt64(&entry.accessed)
+	if second && accessed > atomic.LoadInt64(&entry.promoted) {
+		sh.l.MoveToFront(e)
+		atomic.StoreInt64(&entry.promoted, accessed)
+		return false
+	}
+	s.drop(sh, entry, s.policy, now)
+	return true
+}
+
+/*
+淘汰一个key，需要持有key所在分片的写锁，policy 为淘汰的策略，只用于日志
+*/
+func (s* lru) drop(sh *lruShard, entry *lruEntry, policy string, now int64) {
+	val := entry.value
+	if s.expireTrigger != nil{
+		s.expireTrigger(val.GetKey(), unpackValue(val))
+	}
+
+	log.Printf("type:%d %s remove key:%s, idle:%dms, hits:%d", s.cacheType, policy, val.GetKey(),
+		(now - atomic.LoadInt64(&entry.accessed)) / int64(time.Millisecond), atomic.LoadUint64(&entry.hits))
+	s.remove(sh, val.GetKey())
+	atomic.AddUint64(&s.evictions, 1)
+}
+
+/*
+链表尾部的key最早移动到头部的分片，相当于把所有分片的链表合并后的尾部，每次只加一个分片的读锁
+*/
+func (s* lru) oldestShard(except string) *lruShard {
+	var r *lruShard
+	oldest := int64(0)
+	for _, sh := range s.shards {
+		sh.rwMutex.RLock()
+		if entry := back(sh.l, except); entry != nil {
+			if p := atomic.LoadInt64(&entry.promoted); r == nil || p < oldest {
+				r, oldest = sh, p
+			}
+		}
+		sh.rwMutex.RUnlock()
+	}
+	return r
+}
+
+/*
+轮流从有key的分片中淘汰
+*/
+func (s* lru) nextShard() *lruShard {
+	for i := 0; i < len(s.shards); i++ {
+		s.evictCursor = (s.evictCursor + 1) % len(s.shards)
+		sh := s.shards[s.evictCursor]
+		sh.rwMutex.RLock()
+		n := sh.count
+		sh.rwMutex.RUnlock()
+		if n > 0 {
+			return sh
+		}
+	}
+	return nil
+}
+
+/*
+list中最久没有被访问的key，跳过正在写入的key
+*/
+func back(l *list.List, except string) *lruEntry {
+	e := l.Back()
+	if e != nil && e.Value.(*lruEntry).value.GetKey() == except {
+		e = e.Prev()
+	}
+	if e == nil {
+		return nil
+	}
+	return e.Value.(*lruEntry)
+}
+
+/*
+从链表尾部找要淘汰的key，上次移动之后又被读取过的key移动到头部再给一次机会，
+所有key都被读取过时淘汰尾部的key，需要持有分片的写锁
+*/
+func lruBack(l *list.List, except string) *lruEntry {
+	e := l.Back()
+	for i := l.Len(); e != nil && i > 0; i-- {
+		entry := e.Value.(*lruEntry)
+		prev := e.Prev()
+		if entry.value.GetKey() != except {
+			accessed := atomic.LoadInt64(&entry.accessed)
+			if accessed <= atomic.LoadInt64(&entry.promoted) {
+				return entry
 			}
+			l.MoveToFront(e)
+			atomic.StoreInt64(&entry.promoted, accessed)
 		}
-		s.rwMutex.Unlock()
+		e = prev
 	}
-}
\ No newline at end of file
+	return back(l, except)
+}
+
+/*
+从随机的槽开始抽样，选择访问频率最低的key，频率相同时选择最久没有被访问的，
+每次只加一个槽所在分片的读锁
+*/
+func (s* lru) lfuVictim(except string, now int64) *lruEntry {
+	var r *lruEntry
+	n := 0
+	start := rand.Intn(lruSlots)
+	for i := 0; i < lruSlots && n < lfuSamples; i++ {
+		slot := (start + i) & (lruSlots - 1)
+		sh := s.slotShard(slot)
+		sh.rwMutex.RLock()
+		for k, e := range s.caches[slot] {
+			if k == except {
+				continue
+			}
+			entry := e.Value.(*lruEntry)
+			if r == nil || entry.lfuCount(now) < r.lfuCount(now) ||
+				(entry.lfuCount(now) == r.lfuCount(now) && atomic.LoadInt64(&entry.accessed) < atomic.LoadInt64(&r.accessed)) {
+				r = entry
+			}
+			n++
+			if n >= lfuSamples {
+				break
+			}
+		}
+		sh.rwMutex.RUnlock()
+	}
+	return r
+}
+
+/*
+窗口超过大小时窗口中最久没有被访问的key和主lru中要淘汰的key比较访问频率，
+频率高的进入主lru，另一个被淘汰，需要持有分片的写锁
+*/
+func (s* lru) tinyLFUVictim(sh *lruShard, except string) *lruEntry {
+	victim := lruBack(sh.l, except)
+	if victim == nil {
+		return lruBack(sh.window, except)
+	}
+
+	candidate := lruBack(sh.window, except)
+	if sh.windowSize <= s.windowMaxSize() || candidate == nil {
+		return victim
+	}
+
+	if sh.sketch.Estimate(candidate.value.GetKey()) > sh.sketch.Estimate(victim.value.GetKey()) {
+		s.admit(sh, s.caches[lruSlot(candidate.value.GetKey())][candidate.value.GetKey()])
+		return victim
+	}
+	atomic.AddUint64(&s.rejected, 1)
+	return candidate
+}
+
+/*
+每个分片窗口的大小
+*/
+func (s* lru) windowMaxSize() int {
+	return s.maxSize / 100 / len(s.shards)
+}
+
+/*
+把窗口中的key移到主lru
+*/
+func (s* lru) admit(sh *lruShard, e *list.Element) {
+	entry := e.Value.(*lruEntry)
+	sh.window.Remove(e)
+	sh.windowSize -= entry.size
+	entry.window = false
+	s.caches[lruSlot(entry.value.GetKey())][entry.value.GetKey()] = sh.l.PushFront(entry)
+}
+
+/*
+缓存没有满时窗口中超出大小的key直接进入主lru，满了之后在淘汰时比较频率
+*/
+func (s* lru) balanceWindow(sh *lruShard) {
+	for sh.window.Len() > 1 && sh.windowSize > s.windowMaxSize() && (s.maxSize == 0 || s.Size() < s.maxSize) {
+		s.admit(sh, sh.window.Back())
+	}
+}
+
+func (s* lru) resetCaches() {
+	for i := range s.caches {
+		s.caches[i] = make(map[string]*list.Element)
+	}
+	atomic.StoreInt64(&s.count, 0)
+}
+
+func lruSlot(key string) int {
+	return int(fnv32a(key) & (lruSlots - 1))
+}
+
+/*
+fnv-1a 哈希
+*/
+func fnv32a(key string) uint32 {
+	h := uint32(2166136261)
+	for i := 0; i < len(key); i++ {
+		h ^= uint32(key[i])
+		h *= 16777619
+	}
+	return h
+}
@@ -0,0 +1,118 @@
+package cache
+
+import (
+	"fmt"
+	"github.com/llr104/lightkv/cache/kv"
+	"sync/atomic"
+	"testing"
+)
+
+func TestCheckShards(t *testing.T) {
+	tests := []struct {
+		n  int
+		ok bool
+	}{
+		{0, false},
+		{1, true},
+		{3, false},
+		{16, true},
+		{lruSlots, true},
+		{lruSlots * 2, false},
+	}
+
+	for _, tt := range tests {
+		if checkShards(tt.n) != tt.ok {
+			t.Fatalf("checkShards(%d) = %v, want %v", tt.n, !tt.ok, tt.ok)
+		}
+	}
+}
+
+/*
+n 个key的lru，每个key的占用相同
+*/
+func newTestLRU(t *testing.T, shards int, n int) *lru {
+	old := Conf.LRUShards
+	defer func() { Conf.LRUShards = old }()
+	Conf.LRUShards = shards
+
+	one := newLRU(kv.ValueData, 0, PolicyLRU)
+	one.PushFront(stringValue(0))
+	return newLRU(kv.ValueData, one.Size()*n, PolicyLRU)
+}
+
+func stringValue(i int) kv.StringValue {
+	return kv.StringValue{Key: fmt.Sprintf("k%04d", i), Data: "v", Expire: kv.ExpireForever}
+}
+
+/*
+分片的个数不影响淘汰的顺序，所有分片合起来按最久没有被访问的顺序淘汰，读取过的key多给一次机会
+*/
+func TestShardedLRUOrder(t *testing.T) {
+	for _, shards := range []int{1, 4, 16} {
+		s := newTestLRU(t, shards, 100)
+		for i := 0; i < 100; i++ {
+			s.PushFront(stringValue(i))
+		}
+		s.Value("k0000")
+		for i := 100; i < 150; i++ {
+			s.PushFront(stringValue(i))
+		}
+
+		tests := []struct {
+			from, to int
+			exists   bool
+		}{
+			{0, 1, true},
+			{1, 51, false},
+			{51, 150, true},
+		}
+		for _, tt := range tests {
+			for i := tt.from; i < tt.to; i++ {
+				if _, ok := s.Peek(stringValue(i).Key); ok != tt.exists {
+					t.Fatalf("%d shards: Key:%s exists %v, want %v", shards, stringValue(i).Key, ok, tt.exists)
+				}
+			}
+		}
+
+		count := 0
+		for _, sh := range s.shards {
+			count += sh.count
+		}
+		if s.Len() != 100 || count != 100 || s.Size() > s.maxSize {
+			t.Fatalf("%d shards: len %d, shard count %d, size %d, max size %d", shards, s.Len(), count, s.Size(), s.maxSize)
+		}
+	}
+}
+
+/*
+多个协程同时读写不同分片，缓存大小不超过上限，各个分片的计数和总数一致
+*/
+func TestShardedLRUConcurrent(t *testing.T) {
+	s := newTestLRU(t, 16, 200)
+	var reads uint64
+	parallel(testWriters, 4, func(g int) {
+		for i := 0; i < testRounds*10; i++ {
+			s.PushFront(stringValue(g*testRounds*10 + i))
+		}
+	}, func() {
+		for i := 0; i < 100; i++ {
+			if _, err := s.Value(stringValue(i).Key); err == nil {
+				atomic.AddUint64(&reads, 1)
+			}
+		}
+	})
+
+	count := 0
+	for _, sh := range s.shards {
+		sh.rwMutex.RLock()
+		count += sh.count
+		sh.rwMutex.RUnlock()
+	}
+	stats := s.Stats()
+	if count != s.Len() || stats.Keys != s.Len() || s.Size() > s.maxSize {
+		t.Fatalf("len %d, shard count %d, stats keys %d, size %d, max size %d", s.Len(), count, stats.Keys, s.Size(), s.maxSize)
+	}
+	if stats.Hits != atomic.LoadUint64(&reads) {
+		t.Fatalf("stats hits %d, want %d", stats.Hits, atomic.LoadUint64(&reads))
+	}
+}
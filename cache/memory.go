@@ -0,0 +1,273 @@
+package cache
+
+import (
+	"errors"
+	"fmt"
+	"github.com/llr104/lightkv/cache/kv"
+	"math/rand"
+	"runtime"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+/*
+maxmemory 淘汰策略，超过 maxmemory 时写操作之前按策略淘汰key
+allkeys 从所有key中选择，volatile 只从有过期时间的key中选择
+volatile-ttl 选择最快过期的key，noeviction 不淘汰，写操作返回 OOMError
+*/
+const (
+	MaxMemoryAllKeysLRU    = "allkeys-lru"
+	MaxMemoryAllKeysLFU    = "allkeys-lfu"
+	MaxMemoryAllKeysRandom = "allkeys-random"
+	MaxMemoryVolatileLRU   = "volatile-lru"
+	MaxMemoryVolatileLFU   = "volatile-lfu"
+	MaxMemoryVolatileRandom = "volatile-random"
+	MaxMemoryVolatileTTL   = "volatile-ttl"
+	MaxMemoryNoEviction    = "noeviction"
+)
+
+func checkMaxMemoryPolicy(policy string) bool {
+	switch policy {
+	case MaxMemoryAllKeysLRU, MaxMemoryAllKeysLFU, MaxMemoryAllKeysRandom,
+		MaxMemoryVolatileLRU, MaxMemoryVolatileLFU, MaxMemoryVolatileRandom,
+		MaxMemoryVolatileTTL, MaxMemoryNoEviction:
+		return true
+	}
+	return false
+}
+
+/*
+超过 maxmemory 并且没有可以淘汰的key时，写操作返回的错误
+*/
+type OOMError struct {
+	Used int64
+	Max  int64
+}
+
+func (e OOMError) Error() string {
+	return fmt.Sprintf("OOM command not allowed when used memory %d > maxmemory %d", e.Used, e.Max)
+}
+
+func IsOOM(err error) bool {
+	var e OOMError
+	return errors.As(err, &e)
+}
+
+/*
+所有数据库、所有类型共享的内存上限，used 为所有lru的缓存大小之和，由lru原子更新
+*/
+type memoryBudget struct {
+	used   int64
+	mutex  sync.Mutex
+	lrus   []*lru
+}
+
+var memory = &memoryBudget{}
+
+func (s *memoryBudget) register(l *lru) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	s.lrus = append(s.lrus, l)
+}
+
+func (s *memoryBudget) add(n int) {
+	atomic.AddInt64(&s.used, int64(n))
+}
+
+func (s *memoryBudget) Used() int64 {
+	return atomic.LoadInt64(&s.used)
+}
+
+/*
+写操作之前调用，超过 maxmemory 时按策略淘汰key直到低于上限，
+noeviction 或者没有可以淘汰的key时返回 OOMError
+同一时间只有一个写操作在淘汰，其他写操作等待淘汰完成
+*/
+func (s *memoryBudget) reserve() error {
+	if Conf.MaxMemory <= 0 || s.Used() <= Conf.MaxMemory {
+		return nil
+	}
+	if Conf.MaxMemoryPolicy == MaxMemoryNoEviction {
+		return OOMError{Used: s.Used(), Max: Conf.MaxMemory}
+	}
+
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	for s.Used() > Conf.MaxMemory {
+		if s.evict() == false {
+			return OOMError{Used: s.Used(), Max: Conf.MaxMemory}
+		}
+	}
+	return nil
+}
+
+/*
+从每个lru中抽样，在所有样本中选择最应该被淘汰的key，没有可以淘汰的key时返回false
+*/
+func (s *memoryBudget) evict() bool {
+	policy := Conf.MaxMemoryPolicy
+	now := time.Now().UnixNano()
+	random := randomPolicy(policy)
+
+	if len(s.lrus) == 0 {
+		return false
+	}
+
+	var best *lruEntry
+	var from *lru
+	start := rand.Intn(len(s.lrus))
+	for i := range s.lrus {
+		l := s.lrus[(start + i) % len(s.lrus)]
+		entry := l.sample(policy, now)
+		if entry == nil {
+			continue
+		}
+		if best == nil || evictBefore(policy, entry, best, now) {
+			best, from = entry, l
+		}
+		if random {
+			break
+		}
+	}
+
+	if best == nil {
+		return false
+	}
+	from.evictEntry(best, policy, now)
+	return true
+}
+
+/*
+a 是否比 b 更应该被淘汰
+*/
+func evictBefore(policy string, a *lruEntry, b *lruEntry, now int64) bool {
+	switch policy {
+	case MaxMemoryAllKeysLFU, MaxMemoryVolatileLFU:
+		if a.lfuCount(now) != b.lfuCount(now) {
+			return a.lfuCount(now) < b.lfuCount(now)
+		}
+	case MaxMemoryVolatileTTL:
+		return a.value.GetExpire() < b.value.GetExpire()
+	}
+	return atomic.LoadInt64(&a.accessed) < atomic.LoadInt64(&b.accessed)
+}
+
+func randomPolicy(policy string) bool {
+	return policy == MaxMemoryAllKeysRandom || policy == MaxMemoryVolatileRandom
+}
+
+func volatilePolicy(policy string) bool {
+	return policy == MaxMemoryVolatileLRU || policy == MaxMemoryVolatileLFU ||
+		policy == MaxMemoryVolatileRandom || policy == MaxMemoryVolatileTTL
+}
+
+/*
+会增加内存占用的写操作，在keys的锁内记录类型后检查是否超过 maxmemory
+*/
+func (s *Cache) checkWrite(dataType int32, keys ...string) error {
+	if err := s.claimType(dataType, keys...); err != nil {
+		return err
+	}
+	return memory.reserve()
+}
+
+/*
+所有数据库计入的内存和go运行时统计的内存对比，ReadMemStats 会短暂暂停所有goroutine，不要频繁调用
+gc 为true时先执行一次gc，HeapAlloc 只包括存活的对象，对比更准确
+*/
+func MemoryStats(gc bool) kv.MemoryStats {
+	memory.mutex.Lock()
+	keys := 0
+	for _, l := range memory.lrus {
+		keys += l.Len()
+	}
+	memory.mutex.Unlock()
+
+	if gc {
+		runtime.GC()
+	}
+	var m runtime.MemStats
+	runtime.ReadMemStats(&m)
+
+	r := kv.MemoryStats{
+		Accounted:   memory.Used(),
+		Keys:        keys,
+		MaxMemory:   Conf.MaxMemory,
+		Policy:      Conf.MaxMemoryPolicy,
+		HeapAlloc:   m.HeapAlloc,
+		HeapInuse:   m.HeapInuse,
+		HeapSys:     m.HeapSys,
+		HeapObjects: m.HeapObjects,
+		Sys:         m.Sys,
+		NumGC:       m.NumGC,
+	}
+	if r.Accounted > 0 {
+		r.HeapRatio = float64(r.HeapAlloc) / float64(r.Accounted)
+	}
+	return r
+}
+
+/*
+从随机的槽开始抽样 maxmemorySamples 个key，返回其中最应该被淘汰的
+每次只加一个槽所在分片的读锁
+*/
+func (s *lru) sample(policy string, now int64) *lruEntry {
+	if s.Len() == 0 {
+		return nil
+	}
+	if s.arena != nil {
+		return s.arena.sample(policy, Conf.MaxMemorySamples, now, "")
+	}
+
+	volatile := volatilePolicy(policy)
+	random := randomPolicy(policy)
+	var r *lruEntry
+	n := 0
+	start := rand.Intn(lruSlots)
+	for i := 0; i < lruSlots && n < Conf.MaxMemorySamples; i++ {
+		slot := (start + i) & (lruSlots - 1)
+		sh := s.slotShard(slot)
+		sh.rwMutex.RLock()
+		for _, e := range s.caches[slot] {
+			entry := e.Value.(*lruEntry)
+			if volatile && entry.value.GetExpire() == kv.ExpireForever {
+				continue
+			}
+			if random {
+				sh.rwMutex.RUnlock()
+				return entry
+			}
+			if r == nil || evictBefore(policy, entry, r, now) {
+				r = entry
+			}
+			n++
+			if n >= Conf.MaxMemorySamples {
+				break
+			}
+		}
+		sh.rwMutex.RUnlock()
+	}
+	return r
+}
+
+/*
+淘汰抽样选中的key，抽样之后key已经被修改或者删除时不淘汰
+*/
+func (s *lru) evictEntry(entry *lruEntry, policy string, now int64) {
+	if s.arena != nil {
+		s.arena.evictEntry(entry, policy, now)
+		return
+	}
+
+	sh := s.shard(entry.value.GetKey())
+	sh.rwMutex.Lock()
+	defer sh.rwMutex.Unlock()
+
+	e, ok := s.element(entry.value.GetKey())
+	if ok == false || e.Value.(*lruEntry) != entry {
+		return
+	}
+	s.drop(sh, entry, policy, now)
+}
@@ -0,0 +1,158 @@
+package cache
+
+import (
+	"fmt"
+	"testing"
+)
+
+/*
+使用单独的内存预算和 maxmemory 配置，结束后恢复
+*/
+func withMaxMemory(policy string) func() {
+	old, max, oldPolicy, samples := memory, Conf.MaxMemory, Conf.MaxMemoryPolicy, Conf.MaxMemorySamples
+	memory = &memoryBudget{}
+	Conf.MaxMemory = 1 << 40
+	Conf.MaxMemoryPolicy = policy
+	//抽样所有key，按策略淘汰的顺序是确定的
+	Conf.MaxMemorySamples = 32
+	return func() {
+		memory, Conf.MaxMemory, Conf.MaxMemoryPolicy, Conf.MaxMemorySamples = old, max, oldPolicy, samples
+	}
+}
+
+/*
+写入10个没有过期时间的key和10个过期时间递增的key后，maxmemory 设置为当前占用，再写入5个没有过期时间的key，
+超过 maxmemory 后写入之前淘汰key直到不超过，有过期时间的key占用更大，淘汰的个数不固定
+*/
+func TestMaxMemoryPolicy(t *testing.T) {
+	tests := []struct {
+		policy   string
+		volatile bool
+		//写入新key失败的次数，剩下的没有过期时间的key个数，-1 为不检查
+		oom        int
+		persistent int
+	}{
+		{MaxMemoryNoEviction, true, 4, 11},
+		{MaxMemoryAllKeysLRU, true, 0, 15},
+		{MaxMemoryAllKeysLFU, true, 0, 15},
+		{MaxMemoryAllKeysRandom, true, 0, -1},
+		{MaxMemoryVolatileLRU, true, 0, 15},
+		{MaxMemoryVolatileLFU, true, 0, 15},
+		{MaxMemoryVolatileRandom, true, 0, 15},
+		{MaxMemoryVolatileTTL, true, 0, 15},
+		{MaxMemoryVolatileLRU, false, 4, 11},
+	}
+
+	for _, tt := range tests {
+		restore := withMaxMemory(tt.policy)
+		c, clean := newTestCache(t)
+
+		for i := 0; i < 10; i++ {
+			c.Put(fmt.Sprintf("p%02d", i), "v", 0)
+		}
+		for i := 0; i < 10; i++ {
+			expire := int64(0)
+			if tt.volatile {
+				expire = int64(100 * (i + 1))
+			}
+			c.Put(fmt.Sprintf("v%02d", i), "v", expire)
+		}
+		Conf.MaxMemory = memory.Used()
+		//读取没有过期时间的key，lru、lfu 优先淘汰没有被读取的key
+		for i := 0; i < 10; i++ {
+			c.Get(fmt.Sprintf("p%02d", i))
+		}
+
+		oom := 0
+		for i := 0; i < 5; i++ {
+			err := c.Put(fmt.Sprintf("n%02d", i), "v", 0)
+			if IsOOM(err) {
+				oom++
+			}else if err != nil {
+				t.Fatalf("%s: %s", tt.policy, err)
+			}
+		}
+		if oom != tt.oom {
+			t.Fatalf("%s: %d writes failed, want %d", tt.policy, oom, tt.oom)
+		}
+		if oom == 0 && memory.Used() > Conf.MaxMemory*21/20 {
+			t.Fatalf("%s: used memory %d, maxmemory %d", tt.policy, memory.Used(), Conf.MaxMemory)
+		}
+
+		persistent := 0
+		for _, prefix := range []string{"p", "n"} {
+			for i := 0; i < 10; i++ {
+				persistent += c.Exists([]string{fmt.Sprintf("%s%02d", prefix, i)})
+			}
+		}
+		if tt.persistent >= 0 && persistent != tt.persistent {
+			t.Fatalf("%s: %d persistent keys left, want %d", tt.policy, persistent, tt.persistent)
+		}
+
+		//volatile-ttl 先淘汰最快过期的key，剩下的是过期时间最长的几个
+		if tt.policy == MaxMemoryVolatileTTL {
+			left := false
+			for i := 0; i < 10; i++ {
+				exists := c.Exists([]string{fmt.Sprintf("v%02d", i)}) != 0
+				if left && exists == false {
+					t.Fatalf("%s: Key:v%02d is evicted before keys with shorter ttl", tt.policy, i)
+				}
+				left = left || exists
+			}
+			if c.Exists([]string{"v00"}) != 0 {
+				t.Fatalf("%s: Key:v00 with shortest ttl is not evicted", tt.policy)
+			}
+		}
+
+		clean()
+		restore()
+	}
+}
+
+/*
+所有类型的占用之和计入内存预算，删除和清空后减去
+*/
+func TestMemoryStats(t *testing.T) {
+	defer withMaxMemory(MaxMemoryAllKeysLRU)()
+	c, clean := newTestCache(t)
+	defer clean()
+
+	accounted := func() (int64, int) {
+		size, keys := int64(0), 0
+		for _, s := range c.KeyStats() {
+			size += int64(s.Size)
+			keys += s.Keys
+		}
+		return size, keys
+	}
+
+	tests := []struct {
+		name string
+		op   func()
+		keys int
+	}{
+		{"put", func() { c.Put("a", "v", 0) }, 1},
+		{"put expire", func() { c.Put("b", "v", 100) }, 2},
+		{"map", func() { c.HMPut("m", []string{"f", "g"}, []string{"v", "w"}, 0) }, 3},
+		{"overwrite", func() { c.Put("a", "longer value", 0) }, 3},
+		{"del", func() { c.Delete("b") }, 2},
+		{"clear", func() { c.ClearString() }, 1},
+		{"clear map", func() { c.ClearMap() }, 0},
+	}
+
+	for _, tt := range tests {
+		tt.op()
+		stats := MemoryStats(false)
+		size, keys := accounted()
+		if stats.Accounted != size || stats.Keys != keys || keys != tt.keys {
+			t.Fatalf("%s: accounted %d bytes %d keys, types have %d bytes %d keys, want %d keys",
+				tt.name, stats.Accounted, stats.Keys, size, keys, tt.keys)
+		}
+		if info, err := c.KeyInfo("a", ""); err == nil && int64(info.Size) > stats.Accounted {
+			t.Fatalf("%s: Key:a size %d is larger than accounted %d", tt.name, info.Size, stats.Accounted)
+		}
+	}
+	if stats := MemoryStats(true); stats.HeapAlloc == 0 || stats.Accounted != 0 {
+		t.Fatalf("memory stats after clear %+v", stats)
+	}
+}
@@ -0,0 +1,74 @@
+package cache
+
+import (
+	"errors"
+	"fmt"
+	"github.com/llr104/lightkv/cache/kv"
+)
+
+/*
+key的元数据，dataType 为空时key只能存在于一种类型中，查询不会改变key的访问时间和次数
+*/
+func (s *Cache) KeyInfo(key string, dataType string) (kv.KeyInfo, error){
+	h, ok, err := s.keyHandle(key, dataType)
+	if err != nil {
+		return kv.KeyInfo{}, err
+	}
+	if ok {
+		if info, ok := h.lru.Info(key); ok {
+			return info, nil
+		}
+	}
+	str := fmt.Sprintf("KeyInfo Key:%s, not found", key)
+	return kv.KeyInfo{}, errors.New(str)
+}
+
+/*
+key占用的内存字节数，包括值、lru和索引中的额外占用，和计入缓存大小、maxmemory 的一致
+*/
+func (s *Cache) MemoryUsage(key string, dataType string) (int, error){
+	info, err := s.KeyInfo(key, dataType)
+	if err != nil {
+		return 0, err
+	}
+	return info.Size, nil
+}
+
+/*
+各类型的key个数、占用大小、读取次数、淘汰次数、空闲时间
+*/
+func (s *Cache) KeyStats() []kv.TypeStats{
+	handles := s.typeHandles()
+	r := make([]kv.TypeStats, len(handles))
+	for i, h := range handles {
+		r[i] = h.lru.Stats()
+	}
+	return r
+}
+
+/*
+值在内存中的存储方式
+*/
+func valueEncoding(v kv.ValueCache) string {
+	switch v.(type) {
+	case kv.MapValue, kv.SetValue, kv.GeoValue:
+		return "hashtable"
+	case kv.ListValue:
+		return "slice"
+	case kv.HLLValue:
+		return "dense"
+	case kv.StreamValue:
+		return "stream"
+	case kv.JSONValue:
+		return "tree"
+	case kv.BloomValue:
+		return "scalable"
+	case kv.CuckooValue:
+		return "cuckoo"
+	case kv.TSValue:
+		return "samples"
+	case kv.PackedValue:
+		return "listpack"
+	}
+	return "raw"
+}
@@ -0,0 +1,130 @@
+package cache
+
+import (
+	"github.com/llr104/lightkv/cache/kv"
+	"testing"
+	"time"
+)
+
+/*
+读取增加访问次数、更新访问时间，修改更新修改和访问时间但不增加访问次数，查询元数据不改变访问信息
+*/
+func TestKeyInfo(t *testing.T) {
+	c, clean := newTestCache(t)
+	defer clean()
+
+	c.Put("a", "v", 100)
+	created, _ := c.KeyInfo("a", "")
+
+	tests := []struct {
+		name     string
+		op       func()
+		hits     uint64
+		modified bool
+		accessed bool
+	}{
+		{"info", func() { c.KeyInfo("a", "") }, 0, false, false},
+		{"get", func() { c.Get("a") }, 1, false, true},
+		{"get again", func() { c.Get("a") }, 2, false, true},
+		{"ttl", func() { c.TTL("a", "") }, 2, false, false},
+		{"put", func() { c.Put("a", "w", 100) }, 2, true, true},
+	}
+
+	last := created
+	for _, tt := range tests {
+		time.Sleep(2 * time.Millisecond)
+		tt.op()
+		info, err := c.KeyInfo("a", "")
+		if err != nil {
+			t.Fatalf("%s: %s", tt.name, err)
+		}
+		if info.Hits != tt.hits {
+			t.Fatalf("%s: hits %d, want %d", tt.name, info.Hits, tt.hits)
+		}
+		if info.Created != created.Created {
+			t.Fatalf("%s: created changed from %d to %d", tt.name, created.Created, info.Created)
+		}
+		if (info.Modified != last.Modified) != tt.modified {
+			t.Fatalf("%s: modified %d, was %d", tt.name, info.Modified, last.Modified)
+		}
+		if (info.Accessed != last.Accessed) != tt.accessed {
+			t.Fatalf("%s: accessed %d, was %d", tt.name, info.Accessed, last.Accessed)
+		}
+		if info.TTL <= 0 || info.TTL > 100*1000 {
+			t.Fatalf("%s: ttl %d, want (0, 100000]", tt.name, info.TTL)
+		}
+		last = info
+	}
+
+	if _, err := c.KeyInfo("none", ""); err == nil {
+		t.Fatal("KeyInfo of missing key succeeded")
+	}
+}
+
+func TestKeyInfoEncoding(t *testing.T) {
+	c, clean := newTestCache(t)
+	defer clean()
+
+	c.Put("s", "v", 0)
+	c.HMPut("m", []string{"f"}, []string{"v"}, 0)
+	c.TSAdd("ts", []kv.TSSample{{Time: 1, Value: 1}}, 0)
+
+	tests := []struct {
+		key      string
+		dataType string
+		want     string
+	}{
+		{"s", "", "string"},
+		{"m", "map", "map"},
+		{"ts", "", "timeseries"},
+	}
+
+	for _, tt := range tests {
+		info, err := c.KeyInfo(tt.key, tt.dataType)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if info.Type != tt.want || info.Encoding == "" || info.Size <= 0 || info.TTL != TTLForever {
+			t.Fatalf("Key:%s info %+v, want type %s", tt.key, info, tt.want)
+		}
+		if size, _ := c.MemoryUsage(tt.key, tt.dataType); size != info.Size {
+			t.Fatalf("Key:%s memory usage %d, want %d", tt.key, size, info.Size)
+		}
+	}
+}
+
+func TestKeyStats(t *testing.T) {
+	c, clean := newTestCache(t)
+	defer clean()
+
+	c.Put("a", "v", 0)
+	c.Put("b", "v", 100)
+	c.Get("a")
+	c.Get("a")
+	c.Get("none")
+
+	var stats kv.TypeStats
+	for _, s := range c.KeyStats() {
+		if s.Type == "string" {
+			stats = s
+		}
+	}
+	tests := []struct {
+		name      string
+		got, want float64
+	}{
+		{"keys", float64(stats.Keys), 2},
+		{"volatile", float64(stats.Volatile), 1},
+		{"hits", float64(stats.Hits), 2},
+		{"misses", float64(stats.Misses), 1},
+		{"hit ratio", stats.HitRatio, 2.0 / 3},
+	}
+	for _, tt := range tests {
+		if tt.got != tt.want {
+			t.Fatalf("%s is %v, want %v", tt.name, tt.got, tt.want)
+		}
+	}
+	if stats.Size <= 0 {
+		t.Fatalf("size is %d", stats.Size)
+	}
+}
@@ -0,0 +1,94 @@
+package cache
+
+import (
+	"github.com/llr104/lightkv/cache/kv"
+)
+
+/*
+元素个数不超过 xxxMaxPackEntries 并且每个元素都不超过 xxxMaxPackValue 字节的 map、list、set 在lru中按紧凑编码保存，
+超过时按完整的结构保存，每次写入时重新判断，maxEntries 为0时不使用紧凑编码
+*/
+func packable(n int, maxEntries int, maxValue int, each func(f func(e string) bool) bool) bool {
+	if maxEntries == 0 || n > maxEntries {
+		return false
+	}
+	return each(func(e string) bool {
+		return len(e) <= maxValue
+	})
+}
+
+/*
+写入lru之前调用，满足条件时转换为紧凑编码
+*/
+func packValue(v kv.ValueCache) kv.ValueCache {
+	switch t := v.(type) {
+	case kv.MapValue:
+		if packable(len(t.Data), Conf.MapMaxPackEntries, Conf.MapMaxPackValue, func(f func(e string) bool) bool {
+			for k, e := range t.Data {
+				if f(k) == false || f(e) == false {
+					return false
+				}
+			}
+			return true
+		}) {
+			return kv.PackMap(t)
+		}
+	case kv.ListValue:
+		if packable(len(t.Data), Conf.ListMaxPackEntries, Conf.ListMaxPackValue, func(f func(e string) bool) bool {
+			for _, e := range t.Data {
+				if f(e) == false {
+					return false
+				}
+			}
+			return true
+		}) {
+			return kv.PackList(t)
+		}
+	case kv.SetValue:
+		if packable(len(t.Data), Conf.SetMaxPackEntries, Conf.SetMaxPackValue, func(f func(e string) bool) bool {
+			for k := range t.Data {
+				if f(k) == false {
+					return false
+				}
+			}
+			return true
+		}) {
+			return kv.PackSet(t)
+		}
+	}
+	return v
+}
+
+/*
+从lru中读取之后调用，紧凑编码解码为完整的结构
+*/
+func unpackValue(v kv.ValueCache) kv.ValueCache {
+	if p, ok := v.(kv.PackedValue); ok {
+		return p.Unpack()
+	}
+	return v
+}
+
+/*
+map、list、set 和它们的紧凑编码共同的只读方法，读取时不需要解码，写入时才用 unpackValue 解码
+返回的map、切片只读，调用者不要修改
+*/
+type mapReader interface {
+	kv.ValueCache
+	Get(field string) (string, bool)
+	Fields() map[string]string
+	EachField(f func(field string, value string))
+}
+
+type listReader interface {
+	kv.ValueCache
+	Items() []string
+	Range(beg int, end int) []string
+}
+
+type setReader interface {
+	kv.ValueCache
+	IsExist(member string) bool
+	Members() []string
+	EachMember(f func(member string))
+}
@@ -0,0 +1,139 @@
+package cache
+
+import (
+	"fmt"
+	"github.com/llr104/lightkv/cache/kv"
+	"testing"
+)
+
+func withPackLimits(entries int, value int) func() {
+	old := [6]int{Conf.MapMaxPackEntries, Conf.MapMaxPackValue, Conf.ListMaxPackEntries, Conf.ListMaxPackValue,
+		Conf.SetMaxPackEntries, Conf.SetMaxPackValue}
+	Conf.MapMaxPackEntries, Conf.ListMaxPackEntries, Conf.SetMaxPackEntries = entries, entries, entries
+	Conf.MapMaxPackValue, Conf.ListMaxPackValue, Conf.SetMaxPackValue = value, value, value
+	return func() {
+		Conf.MapMaxPackEntries, Conf.MapMaxPackValue, Conf.ListMaxPackEntries, Conf.ListMaxPackValue,
+			Conf.SetMaxPackEntries, Conf.SetMaxPackValue = old[0], old[1], old[2], old[3], old[4], old[5]
+	}
+}
+
+func TestPackValue(t *testing.T) {
+	defer withPackLimits(3, 4)()
+
+	tests := []struct {
+		name   string
+		v      kv.ValueCache
+		packed bool
+	}{
+		{"map", kv.MapValue{Key: "m", Data: kv.MapContent{"a": "1", "b": "2", "c": "3"}}, true},
+		{"map too many entries", kv.MapValue{Key: "m", Data: kv.MapContent{"a": "1", "b": "2", "c": "3", "d": "4"}}, false},
+		{"map long field", kv.MapValue{Key: "m", Data: kv.MapContent{"abcde": "1"}}, false},
+		{"map long value", kv.MapValue{Key: "m", Data: kv.MapContent{"a": "12345"}}, false},
+		{"map long key", kv.MapValue{Key: "a long key", Data: kv.MapContent{"abcd": "1234"}}, true},
+		{"list", kv.ListValue{Key: "l", Data: []string{"a", "a", "abcd"}}, true},
+		{"list too many entries", kv.ListValue{Key: "l", Data: []string{"a", "a", "a", "a"}}, false},
+		{"list long value", kv.ListValue{Key: "l", Data: []string{"abcde"}}, false},
+		{"set", kv.SetValue{Key: "s", Data: kv.SetContent{"a": "a"}}, true},
+		{"set long value", kv.SetValue{Key: "s", Data: kv.SetContent{"abcde": "abcde"}}, false},
+		{"string", kv.StringValue{Key: "s", Data: "v"}, false},
+	}
+
+	for _, tt := range tests {
+		p := packValue(tt.v)
+		if _, ok := p.(kv.PackedValue); ok != tt.packed {
+			t.Fatalf("%s: packed %v, want %v", tt.name, ok, tt.packed)
+		}
+		if unpackValue(p).ToString() != tt.v.ToString() {
+			t.Fatalf("%s: unpacked %s, want %s", tt.name, unpackValue(p).ToString(), tt.v.ToString())
+		}
+	}
+
+	Conf.MapMaxPackEntries = 0
+	if _, ok := packValue(kv.MapValue{Key: "m", Data: kv.MapContent{}}).(kv.PackedValue); ok {
+		t.Fatal("map is packed when mapMaxPackEntries is 0")
+	}
+}
+
+/*
+每次写入时重新判断编码，超过限制时转换为完整的结构，删除元素后又转换为紧凑编码
+*/
+func TestPackEncoding(t *testing.T) {
+	defer withPackLimits(2, 4)()
+	c, clean := newTestCache(t)
+	defer clean()
+
+	tests := []struct {
+		name     string
+		op       func() error
+		key      string
+		dataType string
+		want     string
+	}{
+		{"hmput", func() error { return c.HMPut("m", []string{"a"}, []string{"1"}, 0) }, "m", "map", "listpack"},
+		{"hmput more", func() error { return c.HMPut("m", []string{"b", "c"}, []string{"2", "3"}, 0) }, "m", "map", "hashtable"},
+		{"hmdel", func() error { return c.HMDelMember("m", "c") }, "m", "map", "listpack"},
+		{"lput", func() error { return c.LPut("l", []string{"a"}, 0) }, "l", "list", "listpack"},
+		{"lput long", func() error { return c.LPut("l", []string{"abcde"}, 0) }, "l", "list", "slice"},
+		{"sput", func() error { return c.SPut("s", []string{"a", "b"}, 0) }, "s", "set", "listpack"},
+		{"sput more", func() error { return c.SPut("s", []string{"c"}, 0) }, "s", "set", "hashtable"},
+		{"sdel", func() error { return c.SDelMember("s", "a") }, "s", "set", "listpack"},
+	}
+
+	for _, tt := range tests {
+		if err := tt.op(); err != nil {
+			t.Fatalf("%s: %s", tt.name, err)
+		}
+		info, err := c.KeyInfo(tt.key, tt.dataType)
+		if err != nil || info.Encoding != tt.want {
+			t.Fatalf("%s: encoding %s %v, want %s", tt.name, info.Encoding, err, tt.want)
+		}
+	}
+
+	if m, _ := c.HMGet("m"); len(m) != 2 || m["a"] != "1" || m["b"] != "2" {
+		t.Fatalf("Key:m is %v", m)
+	}
+	if l, _ := c.LGet("l"); len(l) != 2 || l[1] != "abcde" {
+		t.Fatalf("Key:l is %v", l)
+	}
+}
+
+/*
+紧凑编码的值直接读取，读取后编码不变
+*/
+func TestPackedRead(t *testing.T) {
+	defer withPackLimits(8, 8)()
+	c, clean := newTestCache(t)
+	defer clean()
+
+	c.HMPut("m", []string{"a", "b"}, []string{"1", "2"}, 0)
+	c.LPut("l", []string{"a", "b", "c"}, 0)
+	c.SPut("s", []string{"a", "b"}, 0)
+
+	tests := []struct {
+		name string
+		read func() (string, error)
+		want string
+		err  bool
+	}{
+		{"hmgetmember", func() (string, error) { return c.HMGetMember("m", "b") }, "2", false},
+		{"hmgetmember missing", func() (string, error) { return c.HMGetMember("m", "c") }, "", true},
+		{"hmget", func() (string, error) { m, err := c.HMGet("m"); return fmt.Sprint(m), err }, "map[a:1 b:2]", false},
+		{"lgetrange", func() (string, error) { l, err := c.LGetRange("l", 1, 5); return fmt.Sprint(l), err }, "[b c]", false},
+		{"lget", func() (string, error) { l, err := c.LGet("l"); return fmt.Sprint(l), err }, "[a b c]", false},
+		{"sismember", func() (string, error) { ok, err := c.SIsMember("s", "b"); return fmt.Sprint(ok), err }, "true", false},
+		{"sismember missing", func() (string, error) { ok, err := c.SIsMember("s", "c"); return fmt.Sprint(ok), err }, "false", false},
+		{"sismember no key", func() (string, error) { ok, err := c.SIsMember("none", "a"); return fmt.Sprint(ok), err }, "false", true},
+	}
+	for _, tt := range tests {
+		got, err := tt.read()
+		if (err != nil) != tt.err || got != tt.want {
+			t.Fatalf("%s: got %q %v, want %q error %v", tt.name, got, err, tt.want, tt.err)
+		}
+	}
+
+	for key, dataType := range map[string]string{"m": "map", "l": "list", "s": "set"} {
+		if info, _ := c.KeyInfo(key, dataType); info.Encoding != "listpack" {
+			t.Fatalf("Key:%s encoding %s after read, want listpack", key, info.Encoding)
+		}
+	}
+}
@@ -0,0 +1,179 @@
+package cache
+
+import (
+	"math/rand"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+/*
+淘汰策略
+lru 淘汰最久没有被访问的key
+lfu 淘汰访问频率最低的key，频率随时间衰减，从随机抽样的key中选择
+tinylfu 新key先进入占缓存大小1%的窗口lru，离开窗口时和主lru中要淘汰的key比较访问频率，频率高的留下
+*/
+const (
+	PolicyLRU     = "lru"
+	PolicyLFU     = "lfu"
+	PolicyTinyLFU = "tinylfu"
+)
+
+func checkPolicy(policy string) bool {
+	return policy == PolicyLRU || policy == PolicyLFU || policy == PolicyTinyLFU
+}
+
+/*
+lfu 每次淘汰时抽样的key个数
+*/
+const lfuSamples = 5
+
+/*
+新key的访问频率，避免刚写入就被淘汰
+*/
+const lfuInitVal = 5
+
+/*
+lfu计数高16位为上次衰减的分钟数，低8位为对数计数
+*/
+func lfuMinutes(now int64) uint32 {
+	return uint32(now/int64(time.Minute)) & 0xffff
+}
+
+func newLFU(now int64) uint32 {
+	return lfuMinutes(now)<<8 | lfuInitVal
+}
+
+/*
+按经过的时间衰减后的计数，每 lfuDecayTime 分钟减1
+*/
+func lfuDecr(v uint32, now int64) uint32 {
+	counter := v & 0xff
+	if Conf.LFUDecayTime <= 0 {
+		return counter
+	}
+
+	ldt, m := v>>8, lfuMinutes(now)
+	elapsed := m - ldt
+	if m < ldt {
+		elapsed = 0xffff - ldt + m
+	}
+	periods := elapsed / uint32(Conf.LFUDecayTime)
+	if periods >= counter {
+		return 0
+	}
+	return counter - periods
+}
+
+/*
+计数越大增加的概率越小，lfuLogFactor 为10时大约一百万次访问计数达到255
+*/
+func lfuLogIncr(counter uint32) uint32 {
+	if counter >= 255 {
+		return counter
+	}
+	base := float64(counter) - lfuInitVal
+	if base < 0 {
+		base = 0
+	}
+	if rand.Float64() < 1/(base*float64(Conf.LFULogFactor)+1) {
+		counter++
+	}
+	return counter
+}
+
+/*
+访问时更新lfu计数，原子更新不需要加锁
+*/
+func (s *lruEntry) lfuTouch(now int64) {
+	lfuIncr(&s.lfu, now)
+}
+
+func lfuIncr(lfu *uint32, now int64) {
+	for {
+		old := atomic.LoadUint32(lfu)
+		n := lfuMinutes(now)<<8 | lfuLogIncr(lfuDecr(old, now))
+		if atomic.CompareAndSwapUint32(lfu, old, n) {
+			return
+		}
+	}
+}
+
+func (s *lruEntry) lfuCount(now int64) uint32 {
+	return lfuDecr(atomic.LoadUint32(&s.lfu), now)
+}
+
+/*
+tinylfu 使用的 count-min sketch，每个计数最大为15，
+计数的总次数达到计数器个数的10倍后所有计数减半，让频率随时间衰减
+每个lru分片一个，sketchWidth 按分片个数平分，读取时不持有分片的锁，由自己的锁保护
+*/
+const (
+	sketchDepth = 4
+	sketchWidth = 1 << 16
+	sketchMax   = 15
+)
+
+type cmSketch struct {
+	mutex     sync.Mutex
+	rows      [sketchDepth][]uint8
+	width     int
+	additions int
+}
+
+/*
+width 需要是2的幂
+*/
+func newSketch(width int) *cmSketch {
+	s := &cmSketch{width: width}
+	for i := range s.rows {
+		s.rows[i] = make([]uint8, width)
+	}
+	return s
+}
+
+func (s *cmSketch) index(h uint32, i int) uint32 {
+	h2 := h>>16 | h<<16
+	return (h + uint32(i)*h2) & uint32(s.width - 1)
+}
+
+func (s *cmSketch) Increment(key string) {
+	h := fnv32a(key)
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	for i := range s.rows {
+		idx := s.index(h, i)
+		if s.rows[i][idx] < sketchMax {
+			s.rows[i][idx]++
+		}
+	}
+
+	s.additions++
+	if s.additions >= s.width*10 {
+		s.reset()
+	}
+}
+
+func (s *cmSketch) Estimate(key string) uint32 {
+	h := fnv32a(key)
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	min := uint8(sketchMax)
+	for i := range s.rows {
+		if v := s.rows[i][s.index(h, i)]; v < min {
+			min = v
+		}
+	}
+	return uint32(min)
+}
+
+func (s *cmSketch) reset() {
+	for i := range s.rows {
+		for j := range s.rows[i] {
+			s.rows[i][j] >>= 1
+		}
+	}
+	s.additions /= 2
+}
@@ -0,0 +1,126 @@
+package cache
+
+import (
+	"fmt"
+	"github.com/llr104/lightkv/cache/kv"
+	"testing"
+	"time"
+)
+
+func TestLFUDecr(t *testing.T) {
+	decay := Conf.LFUDecayTime
+	defer func() { Conf.LFUDecayTime = decay }()
+
+	now := time.Unix(1600000000, 0).UnixNano()
+	minutes := func(m int) int64 { return now + int64(m)*int64(time.Minute) }
+	tests := []struct {
+		decay   int
+		counter uint32
+		now     int64
+		want    uint32
+	}{
+		{1, 10, now, 10},
+		{1, 10, minutes(3), 7},
+		{2, 10, minutes(3), 9},
+		{1, 10, minutes(20), 0},
+		{0, 10, minutes(20), 10},
+	}
+
+	for _, tt := range tests {
+		Conf.LFUDecayTime = tt.decay
+		v := lfuMinutes(now)<<8 | tt.counter
+		if got := lfuDecr(v, tt.now); got != tt.want {
+			t.Fatalf("decay %d counter %d after %dms: got %d, want %d", tt.decay, tt.counter,
+				(tt.now-now)/int64(time.Millisecond), got, tt.want)
+		}
+	}
+
+	//分钟数回绕
+	Conf.LFUDecayTime = 1
+	v := uint32(0xffff)<<8 | 10
+	if got := lfuDecr(v, 2*int64(time.Minute)); got != 8 {
+		t.Fatalf("counter after minutes wrap around: got %d, want 8", got)
+	}
+}
+
+func TestSketch(t *testing.T) {
+	s := newSketch(64)
+	tests := []struct {
+		key  string
+		adds int
+		want uint32
+	}{
+		{"a", 3, 3},
+		{"b", 20, sketchMax},
+		{"c", 0, 0},
+	}
+
+	for _, tt := range tests {
+		for i := 0; i < tt.adds; i++ {
+			s.Increment(tt.key)
+		}
+	}
+	for _, tt := range tests {
+		if got := s.Estimate(tt.key); got < tt.want {
+			t.Fatalf("Key:%s estimate %d, want at least %d", tt.key, got, tt.want)
+		}
+	}
+
+	//计数总次数达到上限后减半
+	for n := s.additions; n < s.width*10; n++ {
+		s.Increment("d")
+	}
+	if got := s.Estimate("b"); got > sketchMax/2+1 {
+		t.Fatalf("Key:b estimate %d after reset, want at most %d", got, sketchMax/2+1)
+	}
+}
+
+/*
+热点key被读取多次后，写入一批只访问一次的key，lfu 和 tinylfu 留下大部分热点key，lru 全部淘汰
+lfu 每次从5个抽样的key中淘汰，热点key占缓存的1/5，抽样全是热点key的概率很小
+*/
+func TestEvictionPolicy(t *testing.T) {
+	const hot, scan = 20, 500
+	tests := []struct {
+		policy string
+		min    int
+		max    int
+	}{
+		{PolicyLRU, 0, 0},
+		{PolicyLFU, hot * 8 / 10, hot},
+		{PolicyTinyLFU, hot * 8 / 10, hot},
+	}
+
+	for _, tt := range tests {
+		one := newLRU(kv.ValueData, 0, tt.policy)
+		one.PushFront(kv.StringValue{Key: "h0000", Data: "v", Expire: kv.ExpireForever})
+		s := newLRU(kv.ValueData, one.Size()*hot*5, tt.policy)
+
+		for i := 0; i < hot; i++ {
+			key := fmt.Sprintf("h%04d", i)
+			s.PushFront(kv.StringValue{Key: key, Data: "v", Expire: kv.ExpireForever})
+			for j := 0; j < 20; j++ {
+				s.Value(key)
+			}
+		}
+		for i := 0; i < scan; i++ {
+			s.PushFront(kv.StringValue{Key: fmt.Sprintf("c%04d", i), Data: "v", Expire: kv.ExpireForever})
+		}
+
+		left := 0
+		for i := 0; i < hot; i++ {
+			if _, ok := s.Peek(fmt.Sprintf("h%04d", i)); ok {
+				left++
+			}
+		}
+		if left < tt.min || left > tt.max {
+			t.Fatalf("%s: %d hot keys left, want [%d, %d]", tt.policy, left, tt.min, tt.max)
+		}
+		if s.Size() > s.maxSize {
+			t.Fatalf("%s: size %d exceeds max size %d", tt.policy, s.Size(), s.maxSize)
+		}
+		if stats := s.Stats(); stats.Evictions == 0 || stats.Hits != hot*20 {
+			t.Fatalf("%s: stats %+v", tt.policy, stats)
+		}
+	}
+}
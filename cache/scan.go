@@ -0,0 +1,300 @@
+package cache
+
+import (
+	"container/heap"
+	"errors"
+	"fmt"
+	"github.com/llr104/lightkv/cache/kv"
+	"math"
+)
+
+const DefaultScanCount = 10
+
+/*
+基于游标遍历所有类型的key，游标为 类型下标*lruSlots+槽下标，0表示开始，返回的游标为0时表示遍历结束
+count 为每次大约检查的key个数，match 为glob模式，dataType 不为空时只遍历该类型
+每次只在遍历一个槽时加锁，遍历过程中一直存在的key至少返回一次
+*/
+func (s *Cache) Scan(cursor uint64, match string, count int, dataType string) ([]kv.ScanKey, uint64, error){
+
+	lrus := s.typedLRUs()
+	beg, end := 0, len(lrus)
+	if dataType != "" {
+		beg = -1
+		for i, name := range kv.DataTypeNames {
+			if name == dataType {
+				beg, end = i, i+1
+			}
+		}
+		if beg < 0 {
+			str := fmt.Sprintf("Scan unknown type:%s", dataType)
+			return []kv.ScanKey{}, 0, errors.New(str)
+		}
+	}
+
+	idx, slot := int(cursor/lruSlots), int(cursor%lruSlots)
+	if cursor == 0 {
+		idx = beg
+	}
+	if idx < beg || idx >= end {
+		str := fmt.Sprintf("Scan invalid cursor:%d", cursor)
+		return []kv.ScanKey{}, 0, errors.New(str)
+	}
+
+	if count <= 0 {
+		count = DefaultScanCount
+	}
+
+	r := make([]kv.ScanKey, 0)
+	n := 0
+	for ; idx < end; idx++ {
+		for ; slot < lruSlots; slot++ {
+			lrus[idx].ScanSlot(slot, func(key string, v kv.ValueCache) {
+				n++
+				if v.IsExpire() == false && globMatch(match, key) {
+					r = append(r, kv.ScanKey{Key: key, Type: kv.DataTypeNames[idx]})
+				}
+			})
+
+			if n >= count {
+				next := uint64(idx*lruSlots + slot + 1)
+				if slot+1 == lruSlots && idx+1 == end {
+					next = 0
+				}
+				return r, next, nil
+			}
+		}
+		slot = 0
+	}
+	return r, 0, nil
+}
+
+/*
+遍历map的字段，游标为字段哈希值的下界，返回的游标为0时表示遍历结束
+读取不计入命中率，不改变lru的顺序，紧凑编码的值直接在编码上遍历
+*/
+func (s *Cache) HScan(hmKey string, cursor uint64, match string, count int) (map[string]string, uint64, error){
+	if err := s.checkType(kv.MapData, hmKey); err != nil {
+		return nil, 0, err
+	}
+
+	val, ok := s.mapLRU.PeekPacked(hmKey)
+	if ok == false || val.IsExpire() {
+		str := fmt.Sprintf("HScan Key:%s, not found", hmKey)
+		return map[string]string{}, 0, errors.New(str)
+	}
+
+	r := make(map[string]string)
+	next := scanFields(val.(mapReader).EachField, cursor, count, func(k string, v string) {
+		if globMatch(match, k) {
+			r[k] = v
+		}
+	})
+	return r, next, nil
+}
+
+/*
+遍历set的成员，游标的含义和 HScan 相同
+*/
+func (s *Cache) SScan(key string, cursor uint64, match string, count int) ([]string, uint64, error){
+	if err := s.checkType(kv.SetData, key); err != nil {
+		return nil, 0, err
+	}
+
+	val, ok := s.setLRU.PeekPacked(key)
+	if ok == false || val.IsExpire() {
+		str := fmt.Sprintf("SScan Key:%s, not found", key)
+		return []string{}, 0, errors.New(str)
+	}
+
+	r := make([]string, 0)
+	each := func(f func(string, string)) {
+		val.(setReader).EachMember(func(m string) {
+			f(m, "")
+		})
+	}
+	next := scanFields(each, cursor, count, func(k string, v string) {
+		if globMatch(match, k) {
+			r = append(r, k)
+		}
+	})
+	return r, next, nil
+}
+
+func (s *Cache) typedLRUs() []*lru {
+	return []*lru{s.stringLRU, s.mapLRU, s.listLRU, s.setLRU, s.hllLRU, s.geoLRU,
+		s.streamLRU, s.jsonLRU, s.bloomLRU, s.cuckooLRU, s.tsLRU}
+}
+
+/*
+按字段名的哈希值从小到大返回不小于cursor的大约count个字段，哈希值相同的字段一起返回
+下一次的游标为最后一个哈希值加1，这样遍历过程中一直存在的字段至少返回一次
+遍历两次，第一次用大小为count的堆找出第count小的哈希值，第二次返回不超过它的字段，不需要排序所有字段
+*/
+func scanFields(each func(func(name string, value string)), cursor uint64, count int, add func(name string, value string)) uint64 {
+	if count <= 0 {
+		count = DefaultScanCount
+	}
+
+	h := make(hashHeap, 0, count)
+	each(func(name string, value string) {
+		v := uint64(fnv32a(name))
+		if v < cursor {
+			return
+		}
+		if len(h) < count {
+			heap.Push(&h, v)
+		}else if v < h[0] {
+			h[0] = v
+			heap.Fix(&h, 0)
+		}
+	})
+
+	limit := uint64(math.MaxUint64)
+	if len(h) == count {
+		limit = h[0]
+	}
+
+	more := false
+	each(func(name string, value string) {
+		v := uint64(fnv32a(name))
+		if v > limit {
+			more = true
+		}else if v >= cursor {
+			add(name, value)
+		}
+	})
+	if more == false {
+		return 0
+	}
+	return limit + 1
+}
+
+/*
+哈希值的大顶堆
+*/
+type hashHeap []uint64
+
+func (h hashHeap) Len() int           { return len(h) }
+func (h hashHeap) Less(i, j int) bool { return h[i] > h[j] }
+func (h hashHeap) Swap(i, j int)      { h[i], h[j] = h[j], h[i] }
+
+func (h *hashHeap) Push(x interface{}) {
+	*h = append(*h, x.(uint64))
+}
+
+func (h *hashHeap) Pop() interface{} {
+	old := *h
+	n := len(old)
+	x := old[n-1]
+	*h = old[:n-1]
+	return x
+}
+
+/*
+glob 匹配，支持 *、?、[abc]、[^a]、[a-z] 和 \ 转义，pattern 为空时匹配所有
+*/
+func globMatch(pattern string, str string) bool {
+	if pattern == "" {
+		return true
+	}
+
+	p, s := 0, 0
+	starP, starS := -1, 0
+	for s < len(str) {
+		if p < len(pattern) {
+			switch pattern[p] {
+			case '*':
+				starP, starS = p, s
+				p++
+				continue
+			case '?':
+				p++
+				s++
+				continue
+			case '[':
+				if end, ok := globClass(pattern, p, str[s]); end > 0 {
+					if ok {
+						p = end
+						s++
+						continue
+					}
+					break
+				}
+				if str[s] == '[' {
+					p++
+					s++
+					continue
+				}
+			case '\\':
+				if p+1 < len(pattern) && pattern[p+1] == str[s] {
+					p += 2
+					s++
+					continue
+				}
+			default:
+				if pattern[p] == str[s] {
+					p++
+					s++
+					continue
+				}
+			}
+		}
+
+		//不匹配时回到上一个 * 多匹配一个字符
+		if starP < 0 {
+			return false
+		}
+		starS++
+		p, s = starP+1, starS
+	}
+
+	for p < len(pattern) && pattern[p] == '*' {
+		p++
+	}
+	return p == len(pattern)
+}
+
+/*
+匹配 [...] 字符集合，返回集合结束后的下标和是否匹配，集合没有结束的 ] 时返回0
+*/
+func globClass(pattern string, p int, c byte) (int, bool) {
+	i := p + 1
+	not := false
+	if i < len(pattern) && pattern[i] == '^' {
+		not = true
+		i++
+	}
+
+	match := false
+	first := true
+	for i < len(pattern) && (pattern[i] != ']' || first) {
+		first = false
+		lo := pattern[i]
+		if lo == '\\' && i+1 < len(pattern) {
+			i++
+			lo = pattern[i]
+		}
+		hi := lo
+		if i+2 < len(pattern) && pattern[i+1] == '-' && pattern[i+2] != ']' {
+			hi = pattern[i+2]
+			if hi == '\\' && i+3 < len(pattern) {
+				i++
+				hi = pattern[i+2]
+			}
+			i += 2
+		}
+		if lo > hi {
+			lo, hi = hi, lo
+		}
+		if c >= lo && c <= hi {
+			match = true
+		}
+		i++
+	}
+
+	if i >= len(pattern) {
+		return 0, false
+	}
+	return i + 1, match != not
+}
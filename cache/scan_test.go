@@ -0,0 +1,276 @@
+package cache
+
+import (
+	"fmt"
+	"github.com/llr104/lightkv/cache/kv"
+	"sort"
+	"testing"
+)
+
+func TestGlobMatch(t *testing.T) {
+	tests := []struct {
+		pattern string
+		str     string
+		want    bool
+	}{
+		{"", "abc", true},
+		{"*", "", true},
+		{"a*", "abc", true},
+		{"a*c", "abbbc", true},
+		{"a*c", "abcd", false},
+		{"*b*", "abc", true},
+		{"a?c", "abc", true},
+		{"a?c", "ac", false},
+		{"[ab]x", "bx", true},
+		{"[ab]x", "cx", false},
+		{"[^ab]x", "cx", true},
+		{"[a-c]", "b", true},
+		{"[a-c]", "d", false},
+		{`a\*`, "a*", true},
+		{`a\*`, "ab", false},
+		{"[abc", "[abc", true},
+		{"user:*:name", "user:1:name", true},
+		{"user:*:name", "user:1:age", false},
+	}
+
+	for _, tt := range tests {
+		if got := globMatch(tt.pattern, tt.str); got != tt.want {
+			t.Fatalf("globMatch(%q, %q) = %v, want %v", tt.pattern, tt.str, got, tt.want)
+		}
+	}
+}
+
+/*
+按游标遍历到结束，返回所有key
+*/
+func scanAll(t *testing.T, c *Cache, match string, count int, dataType string, each func()) []string {
+	r := make([]string, 0)
+	cursor := uint64(0)
+	for i := 0; ; i++ {
+		keys, next, err := c.Scan(cursor, match, count, dataType)
+		if err != nil {
+			t.Fatal(err)
+		}
+		for _, k := range keys {
+			r = append(r, k.Type+":"+k.Key)
+		}
+		if each != nil {
+			each()
+		}
+		if next == 0 {
+			break
+		}
+		cursor = next
+	}
+	sort.Strings(r)
+	return r
+}
+
+func TestScan(t *testing.T) {
+	c, clean := newTestCache(t)
+	defer clean()
+
+	want := make(map[string][]string)
+	for i := 0; i < 50; i++ {
+		c.Put(fmt.Sprintf("s%d", i), "v", 0)
+		c.SPut(fmt.Sprintf("set%d", i), []string{"v"}, 0)
+	}
+	c.PFAdd("hll", []string{"v"}, 0)
+	for i := 0; i < 50; i++ {
+		want[""] = append(want[""], fmt.Sprintf("string:s%d", i), fmt.Sprintf("set:set%d", i))
+		want[kv.DataTypeNames[kv.SetData]] = append(want[kv.DataTypeNames[kv.SetData]], fmt.Sprintf("set:set%d", i))
+		if i < 10 {
+			want["s?"] = append(want["s?"], fmt.Sprintf("string:s%d", i))
+		}
+	}
+	want[""] = append(want[""], "hll:hll")
+
+	tests := []struct {
+		match    string
+		count    int
+		dataType string
+		want     []string
+	}{
+		{"", 0, "", want[""]},
+		{"", 1, "", want[""]},
+		{"", 1000, "", want[""]},
+		{"s?", 7, "", want["s?"]},
+		{"", 3, "set", want["set"]},
+		{"h*", 0, "", []string{"hll:hll"}},
+		{"nothing*", 0, "", []string{}},
+	}
+
+	for _, tt := range tests {
+		sort.Strings(tt.want)
+		got := scanAll(t, c, tt.match, tt.count, tt.dataType, nil)
+		if fmt.Sprint(got) != fmt.Sprint(tt.want) {
+			t.Fatalf("scan %+v got %d keys %v", tt, len(got), got)
+		}
+	}
+
+	if _, _, err := c.Scan(0, "", 0, "unknown"); err == nil {
+		t.Fatal("scan unknown type")
+	}
+	if _, _, err := c.Scan(lruSlots*uint64(len(c.typedLRUs())), "", 0, ""); err == nil {
+		t.Fatal("scan invalid cursor")
+	}
+}
+
+/*
+遍历过程中一直存在的key至少返回一次
+*/
+func TestScanWhileWriting(t *testing.T) {
+	c, clean := newTestCache(t)
+	defer clean()
+
+	for i := 0; i < 200; i++ {
+		c.Put(fmt.Sprintf("keep%d", i), "v", 0)
+		c.Put(fmt.Sprintf("del%d", i), "v", 0)
+	}
+	round := 0
+	got := scanAll(t, c, "", 10, "", func() {
+		for i := 0; i < 10; i++ {
+			c.Put(fmt.Sprintf("new%d-%d", round, i), "v", 0)
+			c.Delete(fmt.Sprintf("del%d", round*10+i))
+		}
+		round++
+	})
+
+	seen := make(map[string]bool)
+	for _, k := range got {
+		seen[k] = true
+	}
+	for i := 0; i < 200; i++ {
+		if seen[fmt.Sprintf("string:keep%d", i)] == false {
+			t.Fatalf("Key:keep%d not returned", i)
+		}
+	}
+}
+
+func TestHScanSScan(t *testing.T) {
+	c, clean := newTestCache(t)
+	defer clean()
+
+	fields := make([]string, 0)
+	for i := 0; i < 100; i++ {
+		fields = append(fields, fmt.Sprintf("f%d", i))
+	}
+	c.HMPut("m", fields, fields, 0)
+	c.SPut("s", fields, 0)
+
+	tests := []struct {
+		match string
+		count int
+		want  int
+	}{
+		{"", 0, 100},
+		{"", 1, 100},
+		{"", 1000, 100},
+		{"f1*", 3, 11},
+	}
+
+	for _, tt := range tests {
+		hm, set := make(map[string]bool), make(map[string]bool)
+		for cursor := uint64(0); ; {
+			r, next, err := c.HScan("m", cursor, tt.match, tt.count)
+			if err != nil {
+				t.Fatal(err)
+			}
+			for k, v := range r {
+				if k != v {
+					t.Fatalf("field %s has value %s", k, v)
+				}
+				hm[k] = true
+			}
+			if next == 0 {
+				break
+			}
+			cursor = next
+		}
+		for cursor := uint64(0); ; {
+			r, next, err := c.SScan("s", cursor, tt.match, tt.count)
+			if err != nil {
+				t.Fatal(err)
+			}
+			for _, k := range r {
+				set[k] = true
+			}
+			if next == 0 {
+				break
+			}
+			cursor = next
+		}
+		if len(hm) != tt.want || len(set) != tt.want {
+			t.Fatalf("%+v: hscan got %d fields, sscan got %d members, want %d", tt, len(hm), len(set), tt.want)
+		}
+	}
+}
+
+/*
+每次返回大约count个字段，所有字段按哈希值从小到大恰好返回一次，紧凑编码和完整的结构结果相同
+HScan、SScan 不计入命中率
+*/
+func TestScanFields(t *testing.T) {
+	for _, entries := range []int{0, 512} {
+		restore := withPackLimits(entries, 64)
+		c, clean := newTestCache(t)
+
+		fields := make([]string, 0)
+		for i := 0; i < 300; i++ {
+			fields = append(fields, fmt.Sprintf("f%d", i))
+		}
+		c.HMPut("m", fields, fields, 0)
+		c.SPut("s", fields, 0)
+
+		scans := []struct {
+			name string
+			scan func(cursor uint64) ([]string, uint64, error)
+		}{
+			{"hscan", func(cursor uint64) ([]string, uint64, error) {
+				m, next, err := c.HScan("m", cursor, "", 7)
+				r := make([]string, 0, len(m))
+				for k := range m {
+					r = append(r, k)
+				}
+				return r, next, err
+			}},
+			{"sscan", func(cursor uint64) ([]string, uint64, error) { return c.SScan("s", cursor, "", 7) }},
+		}
+		for _, sc := range scans {
+			seen := make(map[string]bool)
+			last := uint64(0)
+			for cursor := uint64(0); ; {
+				r, next, err := sc.scan(cursor)
+				if err != nil {
+					t.Fatalf("pack %d %s: %s", entries, sc.name, err)
+				}
+				if len(r) < 7 && next != 0 || len(r) > 7+2 {
+					t.Fatalf("pack %d %s: got %d fields, cursor %d", entries, sc.name, len(r), next)
+				}
+				for _, k := range r {
+					h := uint64(fnv32a(k))
+					if seen[k] || h < last || h < cursor {
+						t.Fatalf("pack %d %s: field %s returned again or out of order", entries, sc.name, k)
+					}
+					seen[k] = true
+				}
+				if next == 0 {
+					break
+				}
+				last, cursor = next, next
+			}
+			if len(seen) != len(fields) {
+				t.Fatalf("pack %d %s: got %d fields, want %d", entries, sc.name, len(seen), len(fields))
+			}
+		}
+
+		for key, dataType := range map[string]string{"m": "map", "s": "set"} {
+			if info, _ := c.KeyInfo(key, dataType); info.Hits != 0 {
+				t.Fatalf("pack %d Key:%s has %d hits after scan", entries, key, info.Hits)
+			}
+		}
+
+		clean()
+		restore()
+	}
+}
@@ -0,0 +1,588 @@
+package cache
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/llr104/lightkv/cache/kv"
+	"io/ioutil"
+	"log"
+	"os"
+	"path/filepath"
+	"time"
+)
+
+/*
+stream
+stream的数据原地修改，读写都在key的锁内完成，持久化时只追加这次的修改
+*/
+func (s *Cache) XAdd(key string, id string, keys []string, values []string, trim kv.StreamTrim) (string, error){
+	defer s.keyLocks.lock(key)()
+
+	if err := s.checkWrite(kv.StreamData, key); err != nil {
+		return "", err
+	}
+
+	if len(keys) != len(values){
+		return "", errors.New("stream fields len not equal values len")
+	}
+
+	if len(keys) == 0 {
+		return "", errors.New("stream entry need at least one field")
+	}
+
+	v, err := s.streamValue("XAdd", key)
+	if err != nil {
+		v = kv.StreamValue{Key: key, Expire: kv.ExpireForever, Data: kv.NewStreamContent()}
+	}
+
+	newID, err := v.Data.NextID(id)
+	if err != nil {
+		return "", err
+	}
+
+	fields := make(map[string]string, len(keys))
+	for i := 0; i < len(keys); i++ {
+		fields[keys[i]] = values[i]
+	}
+	v.Data.Append(newID, fields)
+	v.Data.Trim(trim)
+
+	s.streamLRU.PushFront(v)
+	s.notifyStream(key)
+	s.persistStream(v)
+	return newID.String(), nil
+}
+
+func (s *Cache) XLen(key string) (int, error){
+	defer s.keyLocks.lock(key)()
+
+	if err := s.checkType(kv.StreamData, key); err != nil {
+		return 0, err
+	}
+
+	v, err := s.streamValue("XLen", key)
+	if err != nil {
+		return 0, nil
+	}
+	return v.Data.Len(), nil
+}
+
+/*
+start、end 支持 - 和 +，count 为0不限制数量
+*/
+func (s *Cache) XRange(key string, start string, end string, count int) ([]kv.StreamEntry, error){
+	if err := s.checkType(kv.StreamData, key); err != nil {
+		return nil, err
+	}
+
+	return s.xRange("XRange", key, start, end, count, false)
+}
+
+func (s *Cache) XRevRange(key string, end string, start string, count int) ([]kv.StreamEntry, error){
+	if err := s.checkType(kv.StreamData, key); err != nil {
+		return nil, err
+	}
+
+	return s.xRange("XRevRange", key, start, end, count, true)
+}
+
+/*
+读取 ids 之后的消息，id 为 $ 时只读取调用之后新增的消息
+block 单位毫秒，0 不阻塞，小于0 一直阻塞直到有新消息或者 ctx 结束
+*/
+func (s *Cache) XRead(ctx context.Context, keys []string, ids []string, count int, block int64) ([]kv.StreamRead, error){
+	if err := s.checkType(kv.StreamData, keys...); err != nil {
+		return nil, err
+	}
+
+	if len(keys) != len(ids) || len(keys) == 0 {
+		return nil, errors.New("XRead keys len not equal ids len")
+	}
+
+	unlock := s.keyLocks.lock(keys...)
+	from := make([]kv.StreamID, len(ids))
+	for i, id := range ids {
+		if id == kv.StreamLastID {
+			if v, err := s.streamValue("XRead", keys[i]); err == nil {
+				from[i] = v.Data.LastID
+			}
+			continue
+		}
+
+		f, err := kv.ParseStreamID(id, 0)
+		if err != nil {
+			unlock()
+			return nil, err
+		}
+		from[i] = f
+	}
+	unlock()
+
+	return s.waitStream(ctx, keys, block, func() ([]kv.StreamRead, error) {
+		r := make([]kv.StreamRead, 0)
+		for i, k := range keys {
+			v, err := s.streamValue("XRead", k)
+			if err != nil {
+				continue
+			}
+			if arr := v.Data.After(from[i], count); len(arr) > 0 {
+				r = append(r, kv.StreamRead{Key: k, Entries: arr})
+			}
+		}
+		return r, nil
+	})
+}
+
+/*
+创建消费组，id 为 $ 时只消费之后新增的消息，mkStream 为true时stream不存在则新建
+*/
+func (s *Cache) XGroupCreate(key string, group string, id string, mkStream bool) error{
+	defer s.keyLocks.lock(key)()
+
+	if err := s.checkWrite(kv.StreamData, key); err != nil {
+		return err
+	}
+
+	v, err := s.streamValue("XGroupCreate", key)
+	if err != nil {
+		if !mkStream {
+			return err
+		}
+		v = kv.StreamValue{Key: key, Expire: kv.ExpireForever, Data: kv.NewStreamContent()}
+	}
+
+	if err := v.Data.CreateGroup(group, id); err != nil {
+		return err
+	}
+	s.streamLRU.PushFront(v)
+	s.persistStream(v)
+	return nil
+}
+
+func (s *Cache) XGroupDestroy(key string, group string) (bool, error){
+	defer s.keyLocks.lock(key)()
+
+	if err := s.checkType(kv.StreamData, key); err != nil {
+		return false, err
+	}
+
+	v, err := s.streamValue("XGroupDestroy", key)
+	if err != nil {
+		return false, err
+	}
+
+	ok := v.Data.DestroyGroup(group)
+	s.streamLRU.PushFront(v)
+	s.persistStream(v)
+	return ok, nil
+}
+
+/*
+消费组读取，id 为 > 时读取新消息并加入待确认列表(noAck 为true时不加入)，可以阻塞
+否则返回该消费者在 id 之后的待确认消息，不阻塞
+*/
+func (s *Cache) XReadGroup(ctx context.Context, group string, consumer string, keys []string, ids []string,
+	count int, block int64, noAck bool) ([]kv.StreamRead, error){
+	if err := s.checkType(kv.StreamData, keys...); err != nil {
+		return nil, err
+	}
+
+	if len(keys) != len(ids) || len(keys) == 0 {
+		return nil, errors.New("XReadGroup keys len not equal ids len")
+	}
+
+	for _, id := range ids {
+		if id != kv.StreamNewEntries {
+			block = 0
+		}
+	}
+
+	return s.waitStream(ctx, keys, block, func() ([]kv.StreamRead, error) {
+		r := make([]kv.StreamRead, 0)
+		for i, k := range keys {
+			v, err := s.streamValue("XReadGroup", k)
+			if err != nil {
+				return nil, err
+			}
+
+			arr, err := v.Data.ReadGroup(group, consumer, ids[i], count, noAck)
+			if err != nil {
+				return nil, err
+			}
+
+			//消费者的活跃时间也会修改，没有读到消息时同样需要持久化
+			if len(arr) > 0 {
+				r = append(r, kv.StreamRead{Key: k, Entries: arr})
+				s.streamLRU.PushFront(v)
+			}
+			s.persistStream(v)
+		}
+		return r, nil
+	})
+}
+
+/*
+确认消息，返回成功确认的数量
+*/
+func (s *Cache) XAck(key string, group string, ids []string) (int, error){
+	arr, err := parseStreamIDs(ids)
+	if err != nil {
+		return 0, err
+	}
+
+	defer s.keyLocks.lock(key)()
+
+	if err := s.checkType(kv.StreamData, key); err != nil {
+		return 0, err
+	}
+
+	v, err := s.streamValue("XAck", key)
+	if err != nil {
+		return 0, err
+	}
+
+	n, err := v.Data.Ack(group, arr)
+	if err != nil || n == 0 {
+		return n, err
+	}
+	s.streamLRU.PushFront(v)
+	s.persistStream(v)
+	return n, nil
+}
+
+/*
+待确认列表，consumer 为空时返回所有消费者的
+*/
+func (s *Cache) XPending(key string, group string, consumer string, count int) ([]kv.StreamPendingEntry, error){
+	defer s.keyLocks.lock(key)()
+
+	if err := s.checkType(kv.StreamData, key); err != nil {
+		return nil, err
+	}
+
+	v, err := s.streamValue("XPending", key)
+	if err != nil {
+		return nil, err
+	}
+	return v.Data.PendingList(group, consumer, count)
+}
+
+/*
+把空闲时间超过 minIdle(毫秒) 的待确认消息转给 consumer
+*/
+func (s *Cache) XClaim(key string, group string, consumer string, minIdle int64, ids []string) ([]kv.StreamEntry, error){
+	arr, err := parseStreamIDs(ids)
+	if err != nil {
+		return nil, err
+	}
+
+	defer s.keyLocks.lock(key)()
+
+	if err := s.checkType(kv.StreamData, key); err != nil {
+		return nil, err
+	}
+
+	v, err := s.streamValue("XClaim", key)
+	if err != nil {
+		return nil, err
+	}
+
+	r, err := v.Data.Claim(group, consumer, minIdle, arr)
+	if err != nil {
+		return nil, err
+	}
+	s.streamLRU.PushFront(v)
+	s.persistStream(v)
+	return r, nil
+}
+
+/*
+按长度或者时间裁剪，返回删除的消息数
+*/
+func (s *Cache) XTrim(key string, trim kv.StreamTrim) (int, error){
+	defer s.keyLocks.lock(key)()
+
+	if err := s.checkType(kv.StreamData, key); err != nil {
+		return 0, err
+	}
+
+	v, err := s.streamValue("XTrim", key)
+	if err != nil {
+		return 0, err
+	}
+
+	n := v.Data.Trim(trim)
+	if n == 0 {
+		return 0, nil
+	}
+	s.streamLRU.PushFront(v)
+	s.persistStream(v)
+	return n, nil
+}
+
+/*
+删除stream中指定id的消息
+*/
+func (s *Cache) XDelMember(key string, ids []string) (int, error){
+	arr, err := parseStreamIDs(ids)
+	if err != nil {
+		return 0, err
+	}
+
+	defer s.keyLocks.lock(key)()
+
+	if err := s.checkType(kv.StreamData, key); err != nil {
+		return 0, err
+	}
+
+	v, err := s.streamValue("XDelMember", key)
+	if err != nil {
+		return 0, err
+	}
+
+	n := v.Data.Remove(arr)
+	if n == 0 {
+		return 0, nil
+	}
+	s.streamLRU.PushFront(v)
+	s.persistStream(v)
+	return n, nil
+}
+
+func (s *Cache) XDel(key string) error{
+	defer s.keyLocks.lock(key)()
+
+	if err := s.checkType(kv.StreamData, key); err != nil {
+		return err
+	}
+
+	return s.xDel(key)
+}
+
+/*
+锁住所有key，清空的持久化在之后的修改之前写入
+*/
+func (s *Cache) ClearStream()  {
+	defer s.keyLocks.lockAll()()
+
+	s.streamLRU.Clear()
+	op := kv.PersistentStreamOp{OpType: kv.Clear}
+	s.persistentStreamChan <- op
+}
+
+/*
+stream的数据原地修改，序列化期间锁住所有key
+*/
+func (s *Cache) StreamCaches() ([]byte, error) {
+	defer s.keyLocks.lockAll()()
+	return s.streamLRU.CacheToString()
+}
+
+/*
+调用前需要持有key的锁
+*/
+func (s *Cache) streamValue(opName string, key string) (kv.StreamValue, error){
+	v, err := s.streamLRU.Value(key)
+	if err != nil {
+		str := fmt.Sprintf("%s Key:%s, not found", opName, key)
+		return kv.StreamValue{}, errors.New(str)
+	}
+
+	if v.IsExpire() {
+		str := fmt.Sprintf("%s Key:%s, is expire ", opName, key)
+		return kv.StreamValue{}, errors.New(str)
+	}
+	return v.(kv.StreamValue), nil
+}
+
+func (s *Cache) xRange(opName string, key string, start string, end string, count int, rev bool) ([]kv.StreamEntry, error){
+	startID, err := kv.ParseStreamRangeID(start, false)
+	if err != nil {
+		return nil, err
+	}
+
+	endID, err := kv.ParseStreamRangeID(end, true)
+	if err != nil {
+		return nil, err
+	}
+
+	defer s.keyLocks.lock(key)()
+
+	v, err := s.streamValue(opName, key)
+	if err != nil {
+		return []kv.StreamEntry{}, nil
+	}
+	return v.Data.Range(startID, endID, count, rev), nil
+}
+
+/*
+在 keys 的锁内执行 read，没有结果时等待 keys 上的新消息，直到超时或者 ctx 结束
+等待者在解锁之前注册，解锁之后追加的消息一定能唤醒它
+*/
+func (s *Cache) waitStream(ctx context.Context, keys []string, block int64,
+	read func() ([]kv.StreamRead, error)) ([]kv.StreamRead, error){
+
+	var timeout <-chan time.Time
+	if block > 0 {
+		timer := time.NewTimer(time.Duration(block) * time.Millisecond)
+		defer timer.Stop()
+		timeout = timer.C
+	}
+
+	for {
+		unlock := s.keyLocks.lock(keys...)
+		r, err := read()
+		if err != nil || len(r) > 0 || block == 0 {
+			unlock()
+			return r, err
+		}
+
+		ch := make(chan struct{}, 1)
+		s.streamWaitMutex.Lock()
+		for _, k := range keys {
+			if s.streamWaiters[k] == nil {
+				s.streamWaiters[k] = make(map[chan struct{}]bool)
+			}
+			s.streamWaiters[k][ch] = true
+		}
+		s.streamWaitMutex.Unlock()
+		unlock()
+
+		done := false
+		select {
+		case <-ch:
+		case <-timeout:
+			done = true
+		case <-ctx.Done():
+			done = true
+		}
+
+		s.streamWaitMutex.Lock()
+		for _, k := range keys {
+			delete(s.streamWaiters[k], ch)
+			if len(s.streamWaiters[k]) == 0 {
+				delete(s.streamWaiters, k)
+			}
+		}
+		s.streamWaitMutex.Unlock()
+
+		if done {
+			return []kv.StreamRead{}, nil
+		}
+	}
+}
+
+/*
+唤醒等待 key 的读取，调用前需要持有key的锁
+*/
+func (s *Cache) notifyStream(key string) {
+	s.streamWaitMutex.Lock()
+	defer s.streamWaitMutex.Unlock()
+
+	for ch := range s.streamWaiters[key] {
+		select {
+		case ch <- struct{}{}:
+		default:
+		}
+	}
+}
+
+/*
+在key的锁内调用，同一个key的修改按内存中的顺序写入磁盘
+只追加上次之后的修改，新建的stream或者追加的修改太多时复制一份重写整个文件
+*/
+func (s *Cache) persistStream(v kv.StreamValue) {
+	changes, full := v.Data.Changes()
+	if full {
+		snapshot := kv.StreamValue{Key: v.Key, Expire: v.Expire, Data: v.Data.Copy()}
+		s.persistentStreamChan <- kv.PersistentStreamOp{Item: snapshot, OpType: kv.Add}
+	}else if len(changes) > 0 {
+		item := kv.StreamValue{Key: v.Key, Expire: v.Expire}
+		s.persistentStreamChan <- kv.PersistentStreamOp{Item: item, Changes: changes, OpType: kv.Append}
+	}else{
+		return
+	}
+
+	if s.opFunction != nil{
+		s.opFunction(kv.Add, kv.StreamValue{Key: v.Key}, v)
+	}
+}
+
+func parseStreamIDs(ids []string) ([]kv.StreamID, error){
+	arr := make([]kv.StreamID, len(ids))
+	for i, id := range ids {
+		a, err := kv.ParseStreamID(id, 0)
+		if err != nil {
+			return nil, err
+		}
+		arr[i] = a
+	}
+	return arr, nil
+}
+
+func (s *Cache) xDel(key string) error{
+	oldVal, err := s.streamLRU.Lookup(key)
+	if err != nil{
+		return err
+	}
+
+	log.Printf("xDel Key:%s", key)
+	s.streamLRU.Remove(key)
+
+	s.streamExpire(key, oldVal)
+
+	return nil
+}
+
+func (s *Cache) streamExpire(key string, v kv.ValueCache){
+
+	val := kv.StreamValue{Key: key, Expire: kv.ExpireForever}
+	op := kv.PersistentStreamOp{Item: val, OpType: kv.Del}
+	s.persistentStreamChan <- op
+
+	if s.opFunction != nil{
+		s.opFunction(kv.Del, v, nil)
+	}
+}
+
+func (s *Cache) saveStream(key string, v kv.StreamValue) {
+	b := encodeStream(v)
+
+	fullPath := filepath.Join(s.paths.StreamDBPath, key)
+	path, _ := filepath.Split(fullPath)
+
+	createDir(path)
+
+	err := ioutil.WriteFile(fullPath, b, os.ModePerm)
+	if err != nil{
+		log.Printf("saveStream error:%s", err.Error())
+	}
+}
+
+/*
+文件不存在时没有快照，只写入修改无法加载，跳过
+*/
+func (s *Cache) appendStream(key string, changes []kv.StreamChange) {
+	fullPath := filepath.Join(s.paths.StreamDBPath, key)
+	f, err := os.OpenFile(fullPath, os.O_WRONLY|os.O_APPEND, os.ModePerm)
+	if err != nil{
+		log.Printf("appendStream error:%s", err.Error())
+		return
+	}
+	defer f.Close()
+
+	if _, err := f.Write(encodeStreamChanges(changes)); err != nil{
+		log.Printf("appendStream error:%s", err.Error())
+	}
+}
+
+func (s *Cache) delStream(key string)  {
+	fullPath := filepath.Join(s.paths.StreamDBPath, key)
+	os.Remove(fullPath)
+}
+
+func (s *Cache) clearStream()  {
+	os.RemoveAll(s.paths.StreamDBPath)
+	createDir(s.paths.StreamDBPath)
+}
@@ -0,0 +1,154 @@
+package cache
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"github.com/llr104/lightkv/cache/kv"
+	"io/ioutil"
+	"path/filepath"
+	"testing"
+	"unsafe"
+)
+
+/*
+等待之前发送的stream持久化操作写完，持久化协程处理完上一个操作才会接收下一个
+*/
+func syncStream(c *Cache) {
+	c.persistentStreamChan <- kv.PersistentStreamOp{Item: kv.StreamValue{Key: "sync"}, OpType: kv.Del}
+}
+
+/*
+从文件加载key，快照之后追加的修改按顺序重放
+*/
+func loadStream(t *testing.T, c *Cache, key string) kv.StreamValue {
+	syncStream(c)
+	data, err := ioutil.ReadFile(filepath.Join(c.paths.StreamDBPath, key))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return decodeStream(data)
+}
+
+/*
+不含底层数组容量的大小，加载的数据容量和内存中的不同
+*/
+func streamSize(v kv.StreamValue) int {
+	return v.Size() - kv.SliceSize(cap(v.Data.Entries), int(unsafe.Sizeof(kv.StreamEntry{})))
+}
+
+func checkStreamLoad(t *testing.T, c *Cache, key string) {
+	v, err := c.streamValue("test", key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	got := loadStream(t, c, key)
+
+	want, _ := json.Marshal(v.Data)
+	b, _ := json.Marshal(got.Data)
+	if string(b) != string(want) {
+		t.Fatalf("loaded stream\n%s\nwant\n%s", b, want)
+	}
+	if streamSize(got) != streamSize(v) {
+		t.Fatalf("loaded stream size %d, want %d", streamSize(got), streamSize(v))
+	}
+}
+
+func TestStreamAppendPersist(t *testing.T) {
+	c, clean := newTestCache(t)
+	defer clean()
+
+	ctx := context.Background()
+	tests := []struct {
+		name string
+		op   func() error
+	}{
+		{"add", func() error {
+			for i := 1; i <= 5; i++ {
+				id := fmt.Sprintf("%d-0", i)
+				if _, err := c.XAdd("s", id, []string{"f"}, []string{id}, kv.StreamTrim{}); err != nil {
+					return err
+				}
+			}
+			return nil
+		}},
+		{"group create", func() error { return c.XGroupCreate("s", "g", "0", false) }},
+		{"read group", func() error {
+			_, err := c.XReadGroup(ctx, "g", "alice", []string{"s"}, []string{">"}, 3, 0, false)
+			return err
+		}},
+		{"ack", func() error {
+			_, err := c.XAck("s", "g", []string{"1-0"})
+			return err
+		}},
+		{"claim", func() error {
+			_, err := c.XClaim("s", "g", "bob", 0, []string{"2-0"})
+			return err
+		}},
+		{"del member", func() error {
+			_, err := c.XDelMember("s", []string{"3-0", "4-0"})
+			return err
+		}},
+		{"trim", func() error {
+			_, err := c.XTrim("s", kv.StreamTrim{MaxLen: 1})
+			return err
+		}},
+		{"claim deleted", func() error {
+			_, err := c.XClaim("s", "g", "bob", 0, []string{"3-0"})
+			return err
+		}},
+		{"group destroy", func() error {
+			_, err := c.XGroupDestroy("s", "g")
+			return err
+		}},
+	}
+
+	for _, tt := range tests {
+		if err := tt.op(); err != nil {
+			t.Fatalf("%s: %s", tt.name, err)
+		}
+		checkStreamLoad(t, c, "s")
+	}
+}
+
+/*
+追加的修改太多时重写快照，文件大小不随写入次数一直增长
+*/
+func TestStreamCompact(t *testing.T) {
+	c, clean := newTestCache(t)
+	defer clean()
+
+	for i := 0; i < 3000; i++ {
+		if _, err := c.XAdd("s", "*", []string{"f"}, []string{"v"}, kv.StreamTrim{MaxLen: 10}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	checkStreamLoad(t, c, "s")
+
+	data, _ := ioutil.ReadFile(filepath.Join(c.paths.StreamDBPath, "s"))
+	if len(data) > 2000*60 {
+		t.Fatalf("stream file has %d bytes, snapshot is not rewritten", len(data))
+	}
+}
+
+func TestConcurrentXAdd(t *testing.T) {
+	c, clean := newTestCache(t)
+	defer clean()
+
+	ctx := context.Background()
+	c.XGroupCreate("s", "g", "0", true)
+	parallel(testWriters, 2, func(g int) {
+		for i := 0; i < testRounds; i++ {
+			c.XAdd("s", "*", []string{"g"}, []string{fmt.Sprint(g)}, kv.StreamTrim{})
+			c.XAdd(fmt.Sprintf("s%d", g), "*", []string{"i"}, []string{fmt.Sprint(i)}, kv.StreamTrim{})
+		}
+	}, func() {
+		c.XRange("s", "-", "+", 10)
+		c.XReadGroup(ctx, "g", "c", []string{"s"}, []string{">"}, 10, 0, true)
+	})
+
+	if n, _ := c.XLen("s"); n != testWriters*testRounds {
+		t.Fatalf("stream has %d entries, want %d", n, testWriters*testRounds)
+	}
+	checkStreamLoad(t, c, "s")
+}
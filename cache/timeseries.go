@@ -0,0 +1,485 @@
+package cache
+
+import (
+	"errors"
+	"fmt"
+	"github.com/llr104/lightkv/cache/kv"
+	"io/ioutil"
+	"log"
+	"os"
+	"path/filepath"
+	"time"
+)
+
+/*
+时间序列
+写操作修改的是lru中的序列，读写都在tsMutex内完成，持久化时使用修改后的副本
+降采样规则产生的样本在同一次加锁中写入目标key
+写操作先锁住涉及的key再获取tsMutex，新建的key在key锁内记录类型
+*/
+func (s *Cache) TSCreate(key string, retention int64, expire int64) error{
+	defer s.keyLocks.lock(key)()
+
+	if err := s.checkWrite(kv.TSData, key); err != nil {
+		return err
+	}
+
+	if retention < 0 {
+		str := fmt.Sprintf("TSCreate Key:%s, retention:%d should not be negative", key, retention)
+		return errors.New(str)
+	}
+
+	s.tsMutex.Lock()
+	if _, ok := s.tsValue(key); ok {
+		s.tsMutex.Unlock()
+		str := fmt.Sprintf("TSCreate Key:%s, already exists", key)
+		return errors.New(str)
+	}
+
+	t := kv.TSValue{Key: key, Data: kv.NewTSContent(retention), Expire: expireTime(expire)}
+	s.tsLRU.PushFront(t)
+	snapshot := kv.TSValue{Key: key, Expire: t.Expire, Data: t.Data.Copy()}
+	s.tsMutex.Unlock()
+
+	s.persistTS(kv.TSValue{Key: key}, snapshot)
+	return nil
+}
+
+/*
+添加多个样本，时间戳小于等于0时使用当前的毫秒时间，不存在的key按配置中的默认保留时间创建
+返回每个样本实际使用的时间戳，遇到超出保留时间的样本时返回错误，之前的样本已经写入
+*/
+func (s *Cache) TSAdd(key string, samples []kv.TSSample, expire int64) ([]int64, error){
+	defer s.tsLockRules(key)()
+
+	if err := s.checkWrite(kv.TSData, key); err != nil {
+		return nil, err
+	}
+
+	s.tsMutex.Lock()
+
+	t, ok := s.tsValue(key)
+	old := kv.TSValue{Key: key, Expire: t.Expire}
+	if ok == false {
+		t = kv.TSValue{Key: key, Data: kv.NewTSContent(Conf.TSRetention)}
+	}
+
+	r := make([]int64, 0, len(samples))
+	changed := make(map[string]kv.TSValue)
+	var err error
+	for _, sample := range samples {
+		if sample.Time <= 0 {
+			sample.Time = time.Now().UnixNano() / int64(time.Millisecond)
+		}
+
+		var ds []kv.TSDownsample
+		if ds, err = t.Data.Add(sample); err != nil {
+			break
+		}
+		r = append(r, sample.Time)
+
+		for _, d := range ds {
+			if dest, ok := s.tsDownsample(key, d); ok {
+				changed[dest.Key] = dest
+			}
+		}
+	}
+
+	if len(r) == 0 && ok == false {
+		s.tsMutex.Unlock()
+		return r, err
+	}
+
+	t.Expire = expireTime(expire)
+	s.tsLRU.PushFront(t)
+	snapshot := kv.TSValue{Key: key, Expire: t.Expire, Data: t.Data.Copy()}
+	for k, v := range changed {
+		changed[k] = kv.TSValue{Key: k, Expire: v.Expire, Data: v.Data.Copy()}
+	}
+	s.tsMutex.Unlock()
+
+	s.persistTS(old, snapshot)
+	for _, v := range changed {
+		s.persistTS(kv.TSValue{Key: v.Key, Expire: v.Expire}, v)
+	}
+	return r, err
+}
+
+/*
+查询 [from, to] 时间范围内的样本，aggregation 不为空时按 bucket 毫秒的时间桶聚合
+count 为0时不限制返回的数量
+*/
+func (s *Cache) TSRange(key string, from int64, to int64, aggregation string, bucket int64, count int) ([]kv.TSSample, error){
+	if err := s.checkType(kv.TSData, key); err != nil {
+		return nil, err
+	}
+
+	s.tsMutex.RLock()
+	defer s.tsMutex.RUnlock()
+
+	t, ok := s.tsValue(key)
+	if ok == false {
+		str := fmt.Sprintf("TSRange Key:%s, not found", key)
+		return []kv.TSSample{}, errors.New(str)
+	}
+	return t.Data.Range(from, to, aggregation, bucket, count)
+}
+
+/*
+返回最新的样本
+*/
+func (s *Cache) TSGet(key string) (kv.TSSample, error){
+	if err := s.checkType(kv.TSData, key); err != nil {
+		return kv.TSSample{}, err
+	}
+
+	s.tsMutex.RLock()
+	defer s.tsMutex.RUnlock()
+
+	t, ok := s.tsValue(key)
+	if ok == false {
+		str := fmt.Sprintf("TSGet Key:%s, not found", key)
+		return kv.TSSample{}, errors.New(str)
+	}
+
+	sample, ok := t.Data.Last()
+	if ok == false {
+		str := fmt.Sprintf("TSGet Key:%s, is empty", key)
+		return kv.TSSample{}, errors.New(str)
+	}
+	return sample, nil
+}
+
+/*
+删除 [from, to] 时间范围内的样本，返回删除的个数
+*/
+func (s *Cache) TSDelRange(key string, from int64, to int64) (int, error){
+	defer s.keyLocks.lock(key)()
+
+	if err := s.checkType(kv.TSData, key); err != nil {
+		return 0, err
+	}
+
+	s.tsMutex.Lock()
+
+	t, ok := s.tsValue(key)
+	if ok == false {
+		s.tsMutex.Unlock()
+		str := fmt.Sprintf("TSDelRange Key:%s, not found", key)
+		return 0, errors.New(str)
+	}
+
+	n := t.Data.DelRange(from, to)
+	if n == 0 {
+		s.tsMutex.Unlock()
+		return 0, nil
+	}
+
+	s.tsLRU.PushFront(t)
+	snapshot := kv.TSValue{Key: key, Expire: t.Expire, Data: t.Data.Copy()}
+	s.tsMutex.Unlock()
+
+	s.persistTS(kv.TSValue{Key: key, Expire: t.Expire}, snapshot)
+	return n, nil
+}
+
+/*
+创建降采样规则，sourceKey 的样本按 bucket 毫秒的时间桶聚合后写入 destKey
+destKey 不存在时按配置中的默认保留时间创建，一个key不能既是规则的源又是规则的目标
+*/
+func (s *Cache) TSCreateRule(sourceKey string, destKey string, aggregation string, bucket int64) error{
+	if sourceKey == destKey {
+		str := fmt.Sprintf("TSCreateRule Key:%s, source and destination should be different", sourceKey)
+		return errors.New(str)
+	}
+
+	defer s.keyLocks.lock(sourceKey, destKey)()
+
+	if err := s.checkType(kv.TSData, sourceKey); err != nil {
+		return err
+	}
+	if err := s.checkWrite(kv.TSData, destKey); err != nil {
+		return err
+	}
+
+	s.tsMutex.Lock()
+
+	src, ok := s.tsValue(sourceKey)
+	if ok == false {
+		s.tsMutex.Unlock()
+		str := fmt.Sprintf("TSCreateRule Key:%s, not found", sourceKey)
+		return errors.New(str)
+	}
+	if src.Data.SourceKey != "" {
+		s.tsMutex.Unlock()
+		str := fmt.Sprintf("TSCreateRule Key:%s, is already the destination of key:%s", sourceKey, src.Data.SourceKey)
+		return errors.New(str)
+	}
+
+	dest, ok := s.tsValue(destKey)
+	if ok == false {
+		dest = kv.TSValue{Key: destKey, Data: kv.NewTSContent(Conf.TSRetention), Expire: kv.ExpireForever}
+	}else if dest.Data.SourceKey != "" || len(dest.Data.Rules) > 0 {
+		s.tsMutex.Unlock()
+		str := fmt.Sprintf("TSCreateRule Key:%s, is already used by another rule", destKey)
+		return errors.New(str)
+	}
+
+	if err := src.Data.AddRule(destKey, aggregation, bucket); err != nil {
+		s.tsMutex.Unlock()
+		return err
+	}
+	dest.Data.SourceKey = sourceKey
+
+	s.tsLRU.PushFront(src)
+	s.tsLRU.PushFront(dest)
+	srcSnapshot := kv.TSValue{Key: sourceKey, Expire: src.Expire, Data: src.Data.Copy()}
+	destSnapshot := kv.TSValue{Key: destKey, Expire: dest.Expire, Data: dest.Data.Copy()}
+	s.tsMutex.Unlock()
+
+	s.persistTS(kv.TSValue{Key: sourceKey, Expire: src.Expire}, srcSnapshot)
+	s.persistTS(kv.TSValue{Key: destKey, Expire: dest.Expire}, destSnapshot)
+	return nil
+}
+
+/*
+删除降采样规则，目标key中已经写入的数据保留
+*/
+func (s *Cache) TSDeleteRule(sourceKey string, destKey string) error{
+	defer s.keyLocks.lock(sourceKey, destKey)()
+
+	if err := s.checkType(kv.TSData, sourceKey, destKey); err != nil {
+		return err
+	}
+
+	s.tsMutex.Lock()
+
+	src, ok := s.tsValue(sourceKey)
+	if ok == false || src.Data.DelRule(destKey) == false {
+		s.tsMutex.Unlock()
+		str := fmt.Sprintf("TSDeleteRule Key:%s, rule to key:%s not found", sourceKey, destKey)
+		return errors.New(str)
+	}
+	s.tsLRU.PushFront(src)
+	changed := []kv.TSValue{{Key: sourceKey, Expire: src.Expire, Data: src.Data.Copy()}}
+
+	if dest, ok := s.tsValue(destKey); ok && dest.Data.SourceKey == sourceKey {
+		dest.Data.SourceKey = ""
+		s.tsLRU.PushFront(dest)
+		changed = append(changed, kv.TSValue{Key: destKey, Expire: dest.Expire, Data: dest.Data.Copy()})
+	}
+	s.tsMutex.Unlock()
+
+	for _, v := range changed {
+		s.persistTS(kv.TSValue{Key: v.Key, Expire: v.Expire}, v)
+	}
+	return nil
+}
+
+func (s *Cache) TSInfo(key string) (kv.TSInfo, error){
+	if err := s.checkType(kv.TSData, key); err != nil {
+		return kv.TSInfo{}, err
+	}
+
+	s.tsMutex.RLock()
+	defer s.tsMutex.RUnlock()
+
+	t, ok := s.tsValue(key)
+	if ok == false {
+		str := fmt.Sprintf("TSInfo Key:%s, not found", key)
+		return kv.TSInfo{}, errors.New(str)
+	}
+	return t.Data.Info(), nil
+}
+
+func (s *Cache) TSDel(key string) error{
+	if err := s.checkType(kv.TSData, key); err != nil {
+		return err
+	}
+
+	return s.typeHandles()[kv.TSData].delete(key)
+}
+
+func (s *Cache) ClearTS()  {
+	s.tsMutex.Lock()
+	s.tsLRU.Clear()
+	s.tsMutex.Unlock()
+
+	op := kv.PersistentTSOp{OpType: kv.Clear}
+	s.persistentTSChan <- op
+}
+
+func (s *Cache) TSCaches() ([]byte, error) {
+	s.tsMutex.RLock()
+	defer s.tsMutex.RUnlock()
+
+	return s.tsLRU.CacheToString()
+}
+
+func (s *Cache) tsValue(key string) (kv.TSValue, bool){
+	v, err := s.tsLRU.Value(key)
+	if err != nil || v.IsExpire() {
+		return kv.TSValue{}, false
+	}
+	return v.(kv.TSValue), true
+}
+
+/*
+锁住key和它的降采样规则的所有目标key，返回解锁函数
+其他key重命名时会修改规则的目标key，加锁后规则的目标key有变化时重新加锁
+*/
+func (s *Cache) tsLockRules(key string) func() {
+	for {
+		keys := s.tsRuleKeys(key)
+		unlock := s.keyLocks.lock(keys...)
+		if fmt.Sprint(s.tsRuleKeys(key)) == fmt.Sprint(keys) {
+			return unlock
+		}
+		unlock()
+	}
+}
+
+func (s *Cache) tsRuleKeys(key string) []string {
+	s.tsMutex.RLock()
+	defer s.tsMutex.RUnlock()
+
+	keys := []string{key}
+	if t, ok := s.tsValue(key); ok {
+		for _, r := range t.Data.Rules {
+			keys = append(keys, r.DestKey)
+		}
+	}
+	return keys
+}
+
+/*
+把降采样的样本写入目标key，需要在目标key的锁和tsMutex内调用
+目标key被淘汰后重新创建，已经被其他类型使用时不再写入
+*/
+func (s *Cache) tsDownsample(sourceKey string, d kv.TSDownsample) (kv.TSValue, bool){
+	dest, ok := s.tsValue(d.DestKey)
+	if ok == false {
+		if err := s.claimType(kv.TSData, d.DestKey); err != nil {
+			log.Printf("tsDownsample Key:%s, error:%s", d.DestKey, err.Error())
+			return dest, false
+		}
+		dest = kv.TSValue{Key: d.DestKey, Data: kv.NewTSContent(Conf.TSRetention), Expire: kv.ExpireForever}
+		dest.Data.SourceKey = sourceKey
+	}
+
+	if _, err := dest.Data.Add(d.Sample); err != nil {
+		log.Printf("tsDownsample Key:%s, error:%s", d.DestKey, err.Error())
+		return dest, false
+	}
+	s.tsLRU.PushFront(dest)
+	return dest, true
+}
+
+func (s *Cache) persistTS(old kv.TSValue, v kv.TSValue) {
+	op := kv.PersistentTSOp{Item: v, OpType: kv.Add}
+	s.persistentTSChan <- op
+
+	if s.opFunction != nil{
+		s.opFunction(kv.Add, old, v)
+	}
+}
+
+/*
+删除key时同时解除它和其他key的降采样关系，需要在key的锁和tsMutex内调用
+*/
+func (s *Cache) tsDel(key string) error{
+	oldVal, err := s.tsLRU.Lookup(key)
+	if err != nil{
+		return err
+	}
+
+	log.Printf("tsDel Key:%s", key)
+	s.tsLRU.Remove(key)
+	changed := s.tsUnlink(oldVal.(kv.TSValue))
+
+	s.tsExpire(key, oldVal)
+	for _, v := range changed {
+		s.persistTS(kv.TSValue{Key: v.Key, Expire: v.Expire}, v)
+	}
+
+	return nil
+}
+
+/*
+key重命名为t.Key后更新它和其他key的降采样关系，返回被修改的key的副本，需要在tsMutex内调用
+*/
+func (s *Cache) tsRelink(oldKey string, t kv.TSValue) []kv.TSValue {
+	changed := make([]kv.TSValue, 0)
+	if src, ok := s.tsValue(t.Data.SourceKey); ok {
+		for _, r := range src.Data.Rules {
+			if r.DestKey == oldKey {
+				r.DestKey = t.Key
+			}
+		}
+		s.tsLRU.PushFront(src)
+		changed = append(changed, kv.TSValue{Key: src.Key, Expire: src.Expire, Data: src.Data.Copy()})
+	}
+	for _, rule := range t.Data.Rules {
+		if dest, ok := s.tsValue(rule.DestKey); ok && dest.Data.SourceKey == oldKey {
+			dest.Data.SourceKey = t.Key
+			s.tsLRU.PushFront(dest)
+			changed = append(changed, kv.TSValue{Key: dest.Key, Expire: dest.Expire, Data: dest.Data.Copy()})
+		}
+	}
+	return changed
+}
+
+/*
+解除已删除的key和其他key的降采样关系，返回被修改的key的副本，需要在tsMutex内调用
+*/
+func (s *Cache) tsUnlink(t kv.TSValue) []kv.TSValue {
+	key := t.Key
+	changed := make([]kv.TSValue, 0)
+	if src, ok := s.tsValue(t.Data.SourceKey); ok && src.Data.DelRule(key) {
+		s.tsLRU.PushFront(src)
+		changed = append(changed, kv.TSValue{Key: src.Key, Expire: src.Expire, Data: src.Data.Copy()})
+	}
+	for _, rule := range t.Data.Rules {
+		if dest, ok := s.tsValue(rule.DestKey); ok && dest.Data.SourceKey == key {
+			dest.Data.SourceKey = ""
+			s.tsLRU.PushFront(dest)
+			changed = append(changed, kv.TSValue{Key: dest.Key, Expire: dest.Expire, Data: dest.Data.Copy()})
+		}
+	}
+	return changed
+}
+
+func (s *Cache) tsExpire(key string, v kv.ValueCache){
+
+	val := kv.TSValue{Key: key, Expire: kv.ExpireForever}
+	op := kv.PersistentTSOp{Item: val, OpType: kv.Del}
+	s.persistentTSChan <- op
+
+	if s.opFunction != nil{
+		s.opFunction(kv.Del, v, nil)
+	}
+}
+
+func (s *Cache) saveTS(key string, v kv.TSValue) {
+	b := encodeTS(v)
+
+	fullPath := filepath.Join(s.paths.TSDBPath, key)
+	path, _ := filepath.Split(fullPath)
+
+	createDir(path)
+
+	err := ioutil.WriteFile(fullPath, b, os.ModePerm)
+	if err != nil{
+		log.Printf("saveTS error:%s", err.Error())
+	}
+}
+
+func (s *Cache) delTS(key string)  {
+	fullPath := filepath.Join(s.paths.TSDBPath, key)
+	os.Remove(fullPath)
+}
+
+func (s *Cache) clearTS()  {
+	os.RemoveAll(s.paths.TSDBPath)
+	createDir(s.paths.TSDBPath)
+}
@@ -0,0 +1,66 @@
+package cache
+
+import (
+	"fmt"
+	"github.com/llr104/lightkv/cache/kv"
+	"testing"
+)
+
+/*
+降采样的样本写入规则的目标key，目标key重命名或者删除后规则跟着更新
+*/
+func TestTSRule(t *testing.T) {
+	c, clean := newTestCache(t)
+	defer clean()
+
+	if err := c.TSCreate("src", 0, 0); err != nil {
+		t.Fatal(err)
+	}
+	if err := c.TSCreateRule("src", "dst", kv.TSAggSum, 10); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name  string
+		op    func() error
+		dest  string
+		want  string
+		rules int
+	}{
+		{"add", func() error {
+			_, err := c.TSAdd("src", []kv.TSSample{{Time: 1, Value: 1}, {Time: 2, Value: 2}, {Time: 11, Value: 3}}, 0)
+			return err
+		}, "dst", "[{0 3}]", 1},
+		{"rename destination", func() error {
+			if err := c.Rename("dst", "dst2"); err != nil {
+				return err
+			}
+			_, err := c.TSAdd("src", []kv.TSSample{{Time: 25, Value: 4}}, 0)
+			return err
+		}, "dst2", "[{0 3} {10 3}]", 1},
+		{"delete destination", func() error {
+			if err := c.TSDel("dst2"); err != nil {
+				return err
+			}
+			_, err := c.TSAdd("src", []kv.TSSample{{Time: 35, Value: 5}}, 0)
+			return err
+		}, "dst2", "[]", 0},
+	}
+
+	for _, tt := range tests {
+		if err := tt.op(); err != nil {
+			t.Fatalf("%s: %s", tt.name, err)
+		}
+		r, _ := c.TSRange(tt.dest, 0, 100, "", 0, 0)
+		if fmt.Sprint(r) != tt.want {
+			t.Fatalf("%s: Key:%s has %v, want %s", tt.name, tt.dest, r, tt.want)
+		}
+		if info, _ := c.TSInfo("src"); len(info.Rules) != tt.rules {
+			t.Fatalf("%s: source has %d rules, want %d", tt.name, len(info.Rules), tt.rules)
+		}
+	}
+
+	if err := c.TSCreateRule("src", "src", kv.TSAggSum, 10); err == nil {
+		t.Fatal("rule to the source key is created")
+	}
+}
@@ -0,0 +1,218 @@
+package cache
+
+import (
+	"errors"
+	"fmt"
+	"github.com/llr104/lightkv/cache/kv"
+	"log"
+	"sync"
+)
+
+/*
+复制key到newKey，保留过期时间，newKey 已经存在且 replace 为false时不复制，返回是否复制
+*/
+func (s *Cache) Copy(key string, newKey string, replace bool) (bool, error){
+	return s.CopyTo(s, key, newKey, replace)
+}
+
+/*
+复制key到dest数据库的newKey
+*/
+func (s *Cache) CopyTo(dest *Cache, key string, newKey string, replace bool) (bool, error){
+	if s == dest && key == newKey {
+		str := fmt.Sprintf("Copy Key:%s, source and destination are the same", key)
+		return false, errors.New(str)
+	}
+	if err := memory.reserve(); err != nil {
+		return false, err
+	}
+	return s.transfer(dest, key, newKey, true, replace)
+}
+
+/*
+把key移动到dest数据库，dest中已经存在同名key时不移动，返回是否移动
+*/
+func (s *Cache) MoveTo(dest *Cache, key string) (bool, error){
+	if s == dest {
+		str := fmt.Sprintf("Move Key:%s, source and destination db are the same", key)
+		return false, errors.New(str)
+	}
+	return s.transfer(dest, key, key, false, false)
+}
+
+/*
+把key转移到dest数据库的newKey，保留过期时间，keep 为true时保留源key
+两个key的锁同时持有，时间序列还要持有两个数据库的类型写锁，转移过程中不会被其他写操作打断
+持久化时先写入newKey再删除源key，源key的监听者收到删除通知，newKey的监听者收到新增通知
+*/
+func (s *Cache) transfer(dest *Cache, key string, newKey string, keep bool, replace bool) (bool, error){
+	defer lockPair(s, s.txMutex.RLocker(), dest, dest.txMutex.RLocker())()
+
+	h, ok, err := s.keyHandle(key, "")
+	if err != nil || ok == false {
+		return false, err
+	}
+
+	d := dest.typeHandles()[h.dataType]
+	var unlock func()
+	if s == dest {
+		unlock = s.keyLocks.lock(key, newKey)
+	}else{
+		unlock = lockPair(s, s.keyLocks.locker(key), dest, dest.keyLocks.locker(newKey))
+	}
+
+	if h.lock != nil {
+		unlockKeys := unlock
+		unlockType := lockPair(s, h.lock, dest, d.lock)
+		unlock = func() {
+			unlockType()
+			unlockKeys()
+		}
+	}
+
+	v, ok := h.lru.Peek(key)
+	if ok == false || v.IsExpire() {
+		unlock()
+		return false, nil
+	}
+
+	old, exist := d.lru.Peek(newKey)
+	if exist && old.IsExpire() == false && replace == false {
+		unlock()
+		return false, nil
+	}
+
+	//统一键空间模式下 newKey 是其他类型时，replace 为true才删除，检查都通过后才记录newKey的类型
+	if err := dest.checkType(h.dataType, newKey); err != nil {
+		if replace == false {
+			unlock()
+			return false, nil
+		}
+		t, _ := dest.keys.Type(newKey)
+		other := dest.typeHandles()[t]
+		unlockType := other.lockType()
+		other.del(newKey)
+		unlockType()
+	}
+	if err := dest.claimType(h.dataType, newKey); err != nil {
+		unlock()
+		return false, err
+	}
+
+	changed := make([]kv.TSValue, 0)
+	destChanged := make([]kv.TSValue, 0)
+	if exist {
+		d.lru.Remove(newKey)
+		if t, ok := old.(kv.TSValue); ok {
+			destChanged = append(destChanged, dest.tsUnlink(t)...)
+		}
+	}else{
+		old = emptyValue(h.dataType, newKey)
+	}
+
+	n := v.WithKey(newKey)
+	if keep {
+		n = cloneValue(n)
+	}else{
+		h.lru.Remove(key)
+	}
+
+	t, isTS := n.(kv.TSValue)
+	if isTS && keep == false && s != dest {
+		changed = append(changed, s.tsUnlink(v.(kv.TSValue))...)
+	}
+	if isTS && (keep || s != dest) {
+		//降采样关系只在同一个数据库中的原key上保留
+		t.Data.Rules = []*kv.TSRule{}
+		t.Data.SourceKey = ""
+		n = t
+	}
+	d.lru.PushFront(n)
+	if isTS && keep == false && s == dest {
+		destChanged = append(destChanged, s.tsRelink(key, t)...)
+	}
+
+	//在锁内持久化，stream之后对两个key追加的修改一定在快照和删除之后写入
+	dest.persistValue(snapshotValue(n))
+	if keep == false {
+		s.persistDel(v)
+	}
+	unlock()
+
+	if keep {
+		log.Printf("copy Key:%s to db:%s Key:%s", key, dest.name, newKey)
+	}else{
+		log.Printf("move Key:%s to db:%s Key:%s", key, dest.name, newKey)
+	}
+
+	if keep == false {
+		if s.opFunction != nil{
+			s.opFunction(kv.Del, v, nil)
+		}
+	}
+	if dest.opFunction != nil{
+		dest.opFunction(kv.Add, old, n)
+	}
+
+	for _, t := range changed {
+		s.persistTS(kv.TSValue{Key: t.Key, Expire: t.Expire}, t)
+	}
+	for _, t := range destChanged {
+		//newKey已经在snapshot中保存，源key已经删除
+		if t.Key != newKey && (t.Key != key || s != dest || keep) {
+			dest.persistTS(kv.TSValue{Key: t.Key, Expire: t.Expire}, t)
+		}
+	}
+	return true, nil
+}
+
+/*
+同时锁住两个数据库中的写锁，按数据库名的顺序加锁避免死锁，返回解锁函数
+*/
+func lockPair(s *Cache, a sync.Locker, dest *Cache, b sync.Locker) func() {
+	if s == dest {
+		b = nil
+	}else if dest.name < s.name {
+		a, b = b, a
+	}
+
+	if a != nil {
+		a.Lock()
+	}
+	if b != nil {
+		b.Lock()
+	}
+	return func() {
+		if b != nil {
+			b.Unlock()
+		}
+		if a != nil {
+			a.Unlock()
+		}
+	}
+}
+
+/*
+复制出一份独立的值，修改副本不会影响原值，需要在该类型的写锁内调用
+json 修改时只复制路径上的节点，可以共享
+*/
+func cloneValue(v kv.ValueCache) kv.ValueCache {
+	switch t := v.(type) {
+	case kv.MapValue:
+		t.Data = kv.Copy(t.Data)
+		return t
+	case kv.ListValue:
+		t.Data = append([]string{}, t.Data...)
+		return t
+	case kv.SetValue:
+		t.Data = kv.Copy(t.Data)
+		return t
+	case kv.HLLValue:
+		t.Data = append(kv.HLLContent{}, t.Data...)
+		return t
+	case kv.GeoValue:
+		t.Data = kv.CopyGeo(t.Data)
+		return t
+	}
+	return snapshotValue(v)
+}
@@ -0,0 +1,155 @@
+package cache
+
+import (
+	"fmt"
+	"github.com/llr104/lightkv/cache/kv"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+/*
+key在各个类型中的值，不存在时为空
+*/
+func describe(c *Cache, key string) string {
+	r := make([]string, 0)
+	for _, t := range keyTypes(c, key) {
+		switch t {
+		case "string":
+			v, _ := c.Get(key)
+			r = append(r, "string:"+v)
+		case "map":
+			v, _ := c.HMGet(key)
+			r = append(r, fmt.Sprint("map:", v))
+		case "timeseries":
+			v, _ := c.TSGet(key)
+			r = append(r, fmt.Sprint("timeseries:", v.Value))
+		}
+	}
+	return strings.Join(r, " ")
+}
+
+func TestTransfer(t *testing.T) {
+	tests := []struct {
+		name    string
+		unified bool
+		op      func(c *Cache, d *Cache) (bool, error)
+		ok      bool
+		err     bool
+		//操作后 c 中 src、dst 和 d 中 src 的值
+		src, dst, moved string
+	}{
+		{"rename", false, func(c *Cache, d *Cache) (bool, error) { return true, c.Rename("src", "new") },
+			true, false, "", "map:map[f:v]", ""},
+		{"rename over other type", false, func(c *Cache, d *Cache) (bool, error) { return true, c.Rename("src", "dst") },
+			true, false, "", "string:v map:map[f:v]", ""},
+		{"rename over other type unified", true, func(c *Cache, d *Cache) (bool, error) { return true, c.Rename("src", "dst") },
+			true, false, "", "string:v", ""},
+		{"rename missing", false, func(c *Cache, d *Cache) (bool, error) { return true, c.Rename("none", "dst") },
+			true, true, "string:v", "map:map[f:v]", ""},
+		{"rename to itself", false, func(c *Cache, d *Cache) (bool, error) { return true, c.Rename("src", "src") },
+			true, false, "string:v", "map:map[f:v]", ""},
+		{"copy beside other type", false, func(c *Cache, d *Cache) (bool, error) { return c.Copy("src", "dst", false) },
+			true, false, "string:v", "string:v map:map[f:v]", ""},
+		{"copy existing", true, func(c *Cache, d *Cache) (bool, error) { return c.Copy("src", "dst", false) },
+			false, false, "string:v", "map:map[f:v]", ""},
+		{"copy replace", true, func(c *Cache, d *Cache) (bool, error) { return c.Copy("dst", "src", true) },
+			true, false, "map:map[f:v]", "map:map[f:v]", ""},
+		{"copy timeseries", false, func(c *Cache, d *Cache) (bool, error) { return c.Copy("ts", "dst", true) },
+			true, false, "string:v", "map:map[f:v] timeseries:1", ""},
+		{"copy timeseries unified", true, func(c *Cache, d *Cache) (bool, error) { return c.Copy("ts", "dst", true) },
+			true, false, "string:v", "timeseries:1", ""},
+		{"copy to other db", false, func(c *Cache, d *Cache) (bool, error) { return c.CopyTo(d, "src", "src", false) },
+			true, false, "string:v", "map:map[f:v]", "string:v"},
+		{"move", false, func(c *Cache, d *Cache) (bool, error) { return c.MoveTo(d, "src") },
+			true, false, "", "map:map[f:v]", "string:v"},
+		{"move existing", false, func(c *Cache, d *Cache) (bool, error) {
+			d.Put("src", "d", 0)
+			return c.MoveTo(d, "src")
+		}, false, false, "string:v", "map:map[f:v]", "string:d"},
+		{"move onto other type unified", true, func(c *Cache, d *Cache) (bool, error) {
+			d.HMPut("src", []string{"f"}, []string{"d"}, 0)
+			return c.MoveTo(d, "src")
+		}, false, false, "string:v", "map:map[f:v]", "map:map[f:d]"},
+		{"move same db", false, func(c *Cache, d *Cache) (bool, error) { return c.MoveTo(c, "src") },
+			false, true, "string:v", "map:map[f:v]", ""},
+	}
+
+	unified := Conf.UnifiedKeyspace
+	defer func() { Conf.UnifiedKeyspace = unified }()
+
+	for _, tt := range tests {
+		Conf.UnifiedKeyspace = tt.unified
+		c, clean := newTestCache(t)
+		d, cleanDest := newTestCache(t)
+		c.Put("src", "v", 100)
+		c.HMPut("dst", []string{"f"}, []string{"v"}, 0)
+		c.TSAdd("ts", []kv.TSSample{{Time: 1, Value: 1}}, 0)
+
+		ok, err := tt.op(c, d)
+		if (err != nil) != tt.err || (err == nil && ok != tt.ok) {
+			t.Fatalf("%s: got %v %v, want %v, error %v", tt.name, ok, err, tt.ok, tt.err)
+		}
+		if got := describe(c, "src"); got != tt.src {
+			t.Fatalf("%s: Key:src is %q, want %q", tt.name, got, tt.src)
+		}
+		if got := describe(c, "dst"); got != tt.dst {
+			t.Fatalf("%s: Key:dst is %q, want %q", tt.name, got, tt.dst)
+		}
+		if got := describe(d, "src"); got != tt.moved {
+			t.Fatalf("%s: Key:src in dest db is %q, want %q", tt.name, got, tt.moved)
+		}
+		if tt.name == "rename" {
+			if got := describe(c, "new"); got != "string:v" {
+				t.Fatalf("%s: Key:new is %q, want string:v", tt.name, got)
+			}
+			if ttl, _ := c.TTL("new", ""); ttl <= 0 || ttl > 100 {
+				t.Fatalf("%s: TTL of Key:new is %d, want 100", tt.name, ttl)
+			}
+		}
+		clean()
+		cleanDest()
+	}
+}
+
+/*
+复制后修改新key不影响源key，转移后持久化文件跟着移动
+*/
+func TestTransferPersist(t *testing.T) {
+	c, clean := newTestCache(t)
+	defer clean()
+	d, cleanDest := newTestCache(t)
+	defer cleanDest()
+
+	c.HMPut("m", []string{"f"}, []string{"v"}, 0)
+	if _, err := c.Copy("m", "m2", false); err != nil {
+		t.Fatal(err)
+	}
+	c.HMPut("m2", []string{"f"}, []string{"changed"}, 0)
+	if v, _ := c.HMGetMember("m", "f"); v != "v" {
+		t.Fatalf("changing copy changed Key:m to %q", v)
+	}
+
+	c.Put("s", "v", 0)
+	c.Rename("s", "s2")
+	c.MoveTo(d, "m")
+	syncTx(c)
+	syncTx(d)
+
+	tests := []struct {
+		path   string
+		exists bool
+	}{
+		{filepath.Join(c.paths.ValueDBPath, "s"), false},
+		{filepath.Join(c.paths.ValueDBPath, "s2"), true},
+		{filepath.Join(c.paths.MapDBPath, "m"), false},
+		{filepath.Join(c.paths.MapDBPath, "m2"), true},
+		{filepath.Join(d.paths.MapDBPath, "m"), true},
+	}
+	for _, tt := range tests {
+		if _, err := os.Stat(tt.path); (err == nil) != tt.exists {
+			t.Fatalf("%s exists %v, want %v", tt.path, err == nil, tt.exists)
+		}
+	}
+}
@@ -0,0 +1,347 @@
+package cache
+
+import (
+	"errors"
+	"fmt"
+	"github.com/llr104/lightkv/cache/kv"
+	"sync"
+	"time"
+)
+
+const (
+	TTLNotFound = -2 //key不存在
+	TTLForever  = -1 //key没有设置过期时间
+)
+
+/*
+过期时间点是纳秒时间戳，小于这个值的只可能是旧版本list、set直接保存的过期秒数
+*/
+const legacyExpireLimit = int64(1e15)
+
+/*
+一种类型的lru和它的删除函数，所有类型修改key时都要持有 stripes 中key所在分段的锁
+时间序列的降采样会同时修改多个key，还需要再持有类型写锁 lock，其他类型 lock 为nil
+del 需要在这些锁内调用
+*/
+type typeHandle struct {
+	dataType int32
+	lru      *lru
+	lock     sync.Locker
+	del      func(key string) error
+	stripes  *keyLocks
+}
+
+/*
+先锁key所在的分段，再锁类型写锁，返回解锁函数
+*/
+func (h typeHandle) lockKey(key string) func() {
+	unlock := h.stripes.lock(key)
+	unlockType := h.lockType()
+	return func() {
+		unlockType()
+		unlock()
+	}
+}
+
+/*
+已经持有key锁时再锁类型写锁
+*/
+func (h typeHandle) lockType() func() {
+	if h.lock == nil {
+		return func() {}
+	}
+	h.lock.Lock()
+	return h.lock.Unlock
+}
+
+/*
+不持有任何写锁时删除key
+*/
+func (h typeHandle) delete(key string) error {
+	defer h.lockKey(key)()
+	return h.del(key)
+}
+
+/*
+剩余的过期时间，单位秒，key不存在时返回 TTLNotFound，没有过期时间时返回 TTLForever
+dataType 为空时key只能存在于一种类型中
+*/
+func (s *Cache) TTL(key string, dataType string) (int64, error){
+	t, err := s.PTTL(key, dataType)
+	if err != nil || t < 0 {
+		return t, err
+	}
+	return (t + 500) / 1000, nil
+}
+
+/*
+剩余的过期时间，单位毫秒
+*/
+func (s *Cache) PTTL(key string, dataType string) (int64, error){
+	h, ok, err := s.keyHandle(key, dataType)
+	if err != nil {
+		return TTLNotFound, err
+	}
+	if ok == false {
+		return TTLNotFound, nil
+	}
+
+	v, ok := h.lru.Peek(key)
+	if ok == false || v.IsExpire() {
+		return TTLNotFound, nil
+	}
+	if v.GetExpire() == kv.ExpireForever {
+		return TTLForever, nil
+	}
+	return (v.GetExpire() - time.Now().UnixNano()) / int64(time.Millisecond), nil
+}
+
+/*
+设置过期时间为seconds秒之后，seconds 小于等于0时直接删除key，key不存在时返回false
+*/
+func (s *Cache) Expire(key string, dataType string, seconds int64) (bool, error){
+	return s.PExpire(key, dataType, seconds*1000)
+}
+
+/*
+设置过期时间为milliseconds毫秒之后
+*/
+func (s *Cache) PExpire(key string, dataType string, milliseconds int64) (bool, error){
+	deadline := time.Now().UnixNano() + milliseconds*int64(time.Millisecond)
+	return s.changeExpire(key, dataType, func(kv.ValueCache) (int64, bool) {
+		return deadline, true
+	})
+}
+
+/*
+设置过期时间为unix时间戳timestamp，单位秒，时间已经过去时直接删除key
+*/
+func (s *Cache) ExpireAt(key string, dataType string, timestamp int64) (bool, error){
+	return s.PExpireAt(key, dataType, timestamp*1000)
+}
+
+/*
+设置过期时间为unix时间戳timestamp，单位毫秒
+*/
+func (s *Cache) PExpireAt(key string, dataType string, timestamp int64) (bool, error){
+	deadline := timestamp * int64(time.Millisecond)
+	return s.changeExpire(key, dataType, func(kv.ValueCache) (int64, bool) {
+		return deadline, true
+	})
+}
+
+/*
+去掉过期时间，key不存在或者没有过期时间时返回false
+*/
+func (s *Cache) Persist(key string, dataType string) (bool, error){
+	return s.changeExpire(key, dataType, func(v kv.ValueCache) (int64, bool) {
+		return kv.ExpireForever, v.GetExpire() != kv.ExpireForever
+	})
+}
+
+/*
+f 返回新的过期时间和是否需要修改，修改后在key的锁内持久化并通知监听者
+*/
+func (s *Cache) changeExpire(key string, dataType string, f func(kv.ValueCache) (int64, bool)) (bool, error){
+	s.txMutex.RLock()
+	defer s.txMutex.RUnlock()
+
+	h, ok, err := s.keyHandle(key, dataType)
+	if err != nil || ok == false {
+		return false, err
+	}
+
+	unlock := h.lockKey(key)
+	defer unlock()
+
+	v, ok := h.lru.Peek(key)
+	expire, change := int64(0), false
+	if ok && v.IsExpire() == false {
+		expire, change = f(v)
+	}
+	if change == false {
+		return false, nil
+	}
+
+	if expire != kv.ExpireForever && expire <= time.Now().UnixNano() {
+		return true, h.del(key)
+	}
+
+	//和 PutEx 一样在锁内持久化，同一个key的修改按内存中的顺序写入磁盘
+	n := v.WithExpire(expire)
+	h.lru.PushFront(n)
+	s.persistValue(snapshotValue(n))
+	if s.opFunction != nil{
+		s.opFunction(kv.Expire, v, n)
+	}
+	return true, nil
+}
+
+/*
+key原有的过期时间点，key不存在或者已经过期时返回 ExpireForever
+*/
+func liveExpire(v kv.ValueCache) int64 {
+	if v == nil || v.IsExpire() {
+		return kv.ExpireForever
+	}
+	return v.GetExpire()
+}
+
+/*
+旧版本的list、set把过期秒数当成过期时间点保存，按文件的修改时间换算成过期时间点
+返回是否需要迁移
+*/
+func migrateExpire(expire int64, modTime time.Time) (int64, bool) {
+	if expire == kv.ExpireForever || expire >= legacyExpireLimit {
+		return expire, false
+	}
+	if expire < 0 {
+		return modTime.UnixNano(), true
+	}
+	return modTime.UnixNano() + expire*int64(time.Second), true
+}
+
+/*
+dataType 为空时在所有类型中查找key，key存在于多种类型时返回错误
+统一键空间模式下直接从key索引中查找，dataType 和key的类型不一致时返回 WrongTypeError
+*/
+func (s *Cache) keyHandle(key string, dataType string) (typeHandle, bool, error){
+	handles := s.typeHandles()
+	if dataType != "" {
+		for i, name := range kv.DataTypeNames {
+			if name == dataType {
+				if err := s.checkType(int32(i), key); err != nil {
+					return typeHandle{}, false, err
+				}
+				return handles[i], true, nil
+			}
+		}
+		str := fmt.Sprintf("unknown type:%s", dataType)
+		return typeHandle{}, false, errors.New(str)
+	}
+
+	if s.keys != nil {
+		t, ok := s.keys.Type(key)
+		if ok == false {
+			return typeHandle{}, false, nil
+		}
+		if v, ok := handles[t].lru.Peek(key); ok == false || v.IsExpire() {
+			return typeHandle{}, false, nil
+		}
+		return handles[t], true, nil
+	}
+
+	found := -1
+	for i, h := range handles {
+		if v, ok := h.lru.Peek(key); ok && v.IsExpire() == false {
+			if found >= 0 {
+				str := fmt.Sprintf("Key:%s exists in type %s and %s, please specify the type", key,
+					kv.DataTypeNames[found], kv.DataTypeNames[i])
+				return typeHandle{}, false, errors.New(str)
+			}
+			found = i
+		}
+	}
+	if found < 0 {
+		return typeHandle{}, false, nil
+	}
+	return handles[found], true, nil
+}
+
+func (s *Cache) typeHandles() []typeHandle {
+	return []typeHandle{
+		{kv.ValueData, s.stringLRU, nil, s.del, &s.keyLocks},
+		{kv.MapData, s.mapLRU, nil, s.hDel, &s.keyLocks},
+		{kv.ListData, s.listLRU, nil, s.lDel, &s.keyLocks},
+		{kv.SetData, s.setLRU, nil, s.sDel, &s.keyLocks},
+		{kv.HLLData, s.hllLRU, nil, s.pfDel, &s.keyLocks},
+		{kv.GeoData, s.geoLRU, nil, s.geoDel, &s.keyLocks},
+		{kv.StreamData, s.streamLRU, nil, s.xDel, &s.keyLocks},
+		{kv.JSONData, s.jsonLRU, nil, s.jsonDel, &s.keyLocks},
+		{kv.BloomData, s.bloomLRU, nil, s.bfDel, &s.keyLocks},
+		{kv.CuckooData, s.cuckooLRU, nil, s.cfDel, &s.keyLocks},
+		{kv.TSData, s.tsLRU, &s.tsMutex, s.tsDel, &s.keyLocks},
+	}
+}
+
+/*
+原地修改的类型持久化时需要复制一份，需要在该类型的写锁内调用
+*/
+func snapshotValue(v kv.ValueCache) kv.ValueCache {
+	switch t := v.(type) {
+	case kv.StreamValue:
+		t.Data = t.Data.Copy()
+		return t
+	case kv.BloomValue:
+		t.Data = t.Data.Copy()
+		return t
+	case kv.CuckooValue:
+		t.Data = t.Data.Copy()
+		return t
+	case kv.TSValue:
+		t.Data = t.Data.Copy()
+		return t
+	}
+	return v
+}
+
+/*
+把值发送到对应类型的持久化通道
+*/
+func (s *Cache) persistValue(v kv.ValueCache) {
+	switch t := v.(type) {
+	case kv.StringValue:
+		s.persistentStringChan <- kv.PersistentStringOp{Item: t, OpType: kv.Add}
+	case kv.MapValue:
+		s.persistentMapChan <- kv.PersistentMapOp{Item: t, OpType: kv.Add}
+	case kv.ListValue:
+		s.persistentListChan <- kv.PersistentListOp{Item: t, OpType: kv.Add}
+	case kv.SetValue:
+		s.persistentSetChan <- kv.PersistentSetOp{Item: t, OpType: kv.Add}
+	case kv.HLLValue:
+		s.persistentHLLChan <- kv.PersistentHLLOp{Item: t, OpType: kv.Add}
+	case kv.GeoValue:
+		s.persistentGeoChan <- kv.PersistentGeoOp{Item: t, OpType: kv.Add}
+	case kv.StreamValue:
+		s.persistentStreamChan <- kv.PersistentStreamOp{Item: t, OpType: kv.Add}
+	case kv.JSONValue:
+		s.persistentJSONChan <- kv.PersistentJSONOp{Item: t, OpType: kv.Add}
+	case kv.BloomValue:
+		s.persistentBloomChan <- kv.PersistentBloomOp{Item: t, OpType: kv.Add}
+	case kv.CuckooValue:
+		s.persistentCuckooChan <- kv.PersistentCuckooOp{Item: t, OpType: kv.Add}
+	case kv.TSValue:
+		s.persistentTSChan <- kv.PersistentTSOp{Item: t, OpType: kv.Add}
+	}
+}
+
+/*
+把删除操作发送到对应类型的持久化通道
+*/
+func (s *Cache) persistDel(v kv.ValueCache) {
+	key := v.GetKey()
+	switch v.(type) {
+	case kv.StringValue:
+		s.persistentStringChan <- kv.PersistentStringOp{Item: kv.StringValue{Key: key}, OpType: kv.Del}
+	case kv.MapValue:
+		s.persistentMapChan <- kv.PersistentMapOp{Item: kv.MapValue{Key: key}, OpType: kv.Del}
+	case kv.ListValue:
+		s.persistentListChan <- kv.PersistentListOp{Item: kv.ListValue{Key: key}, OpType: kv.Del}
+	case kv.SetValue:
+		s.persistentSetChan <- kv.PersistentSetOp{Item: kv.SetValue{Key: key}, OpType: kv.Del}
+	case kv.HLLValue:
+		s.persistentHLLChan <- kv.PersistentHLLOp{Item: kv.HLLValue{Key: key}, OpType: kv.Del}
+	case kv.GeoValue:
+		s.persistentGeoChan <- kv.PersistentGeoOp{Item: kv.GeoValue{Key: key}, OpType: kv.Del}
+	case kv.StreamValue:
+		s.persistentStreamChan <- kv.PersistentStreamOp{Item: kv.StreamValue{Key: key}, OpType: kv.Del}
+	case kv.JSONValue:
+		s.persistentJSONChan <- kv.PersistentJSONOp{Item: kv.JSONValue{Key: key}, OpType: kv.Del}
+	case kv.BloomValue:
+		s.persistentBloomChan <- kv.PersistentBloomOp{Item: kv.BloomValue{Key: key}, OpType: kv.Del}
+	case kv.CuckooValue:
+		s.persistentCuckooChan <- kv.PersistentCuckooOp{Item: kv.CuckooValue{Key: key}, OpType: kv.Del}
+	case kv.TSValue:
+		s.persistentTSChan <- kv.PersistentTSOp{Item: kv.TSValue{Key: key}, OpType: kv.Del}
+	}
+}
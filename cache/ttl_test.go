@@ -0,0 +1,140 @@
+package cache
+
+import (
+	"github.com/llr104/lightkv/cache/kv"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func TestTTLCommands(t *testing.T) {
+	c, clean := newTestCache(t)
+	defer clean()
+
+	c.Put("s", "v", 0)
+	c.HMPut("m", []string{"f"}, []string{"v"}, 100)
+	c.SPut("set", []string{"v"}, 0)
+	c.JSet("j", "$", `{"a":1}`, 0)
+	c.XAdd("x", "*", []string{"f"}, []string{"v"}, kv.StreamTrim{})
+	now := time.Now().Unix()
+
+	tests := []struct {
+		name     string
+		op       func() (bool, error)
+		key      string
+		dataType string
+		changed  bool
+		min, max int64 //op之后 PTTL 的范围
+	}{
+		{"forever", nil, "s", "", false, TTLForever, TTLForever},
+		{"put with expire", nil, "m", "", false, 99000, 100000},
+		{"not found", nil, "none", "", false, TTLNotFound, TTLNotFound},
+		{"expire", func() (bool, error) { return c.Expire("s", "", 50) }, "s", "", true, 49000, 50000},
+		{"persist", func() (bool, error) { return c.Persist("s", "") }, "s", "", true, TTLForever, TTLForever},
+		{"persist again", func() (bool, error) { return c.Persist("s", "") }, "s", "", false, TTLForever, TTLForever},
+		{"pexpire", func() (bool, error) { return c.PExpire("set", "", 1500) }, "set", "", true, 1400, 1500},
+		{"expireat", func() (bool, error) { return c.ExpireAt("j", "", now+100) }, "j", "", true, 98000, 100000},
+		{"pexpireat", func() (bool, error) { return c.PExpireAt("x", "stream", (now+10)*1000) }, "x", "stream", true, 8000, 10000},
+		{"expire deletes", func() (bool, error) { return c.Expire("m", "", 0) }, "m", "", true, TTLNotFound, TTLNotFound},
+		{"expireat in the past", func() (bool, error) { return c.ExpireAt("j", "json", now-1) }, "j", "", true, TTLNotFound, TTLNotFound},
+		{"expire not found", func() (bool, error) { return c.Expire("none", "", 10) }, "none", "", false, TTLNotFound, TTLNotFound},
+		{"expire other type", func() (bool, error) { return c.Expire("s", "map", 10) }, "s", "", false, TTLForever, TTLForever},
+	}
+
+	for _, tt := range tests {
+		if tt.op != nil {
+			changed, err := tt.op()
+			if err != nil || changed != tt.changed {
+				t.Fatalf("%s: got %v %v, want %v", tt.name, changed, err, tt.changed)
+			}
+		}
+		ttl, err := c.PTTL(tt.key, tt.dataType)
+		if err != nil || ttl < tt.min || ttl > tt.max {
+			t.Fatalf("%s: PTTL of Key:%s is %d %v, want [%d, %d]", tt.name, tt.key, ttl, err, tt.min, tt.max)
+		}
+	}
+
+	if _, err := c.Expire("s", "unknown", 10); err == nil {
+		t.Fatal("expire unknown type")
+	}
+	if ttl, _ := c.TTL("set", ""); ttl != 1 && ttl != 2 {
+		t.Fatalf("TTL of Key:set is %d, want rounded to 1 or 2", ttl)
+	}
+}
+
+func TestMigrateExpire(t *testing.T) {
+	mod := time.Unix(1600000000, 0)
+	tests := []struct {
+		expire  int64
+		want    int64
+		migrate bool
+	}{
+		{kv.ExpireForever, kv.ExpireForever, false},
+		{60, mod.UnixNano() + 60*int64(time.Second), true},
+		{-1, mod.UnixNano(), true},
+		{legacyExpireLimit, legacyExpireLimit, false},
+		{mod.UnixNano(), mod.UnixNano(), false},
+	}
+
+	for _, tt := range tests {
+		if got, ok := migrateExpire(tt.expire, mod); got != tt.want || ok != tt.migrate {
+			t.Fatalf("migrateExpire(%d) = %d %v, want %d %v", tt.expire, got, ok, tt.want, tt.migrate)
+		}
+	}
+}
+
+/*
+旧版本list、set保存的过期秒数按文件的修改时间换算成过期时间点，换算后重新保存
+*/
+func TestLoadLegacyExpire(t *testing.T) {
+	dir, err := ioutil.TempDir("", "lightkv")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	paths := newDBPaths(dir)
+	createDir(paths.ListDBPath)
+	createDir(paths.SetDBPath)
+	now := time.Now()
+
+	tests := []struct {
+		name     string
+		file     string
+		data     []byte
+		mod      time.Time
+		min, max int64
+	}{
+		{"list", filepath.Join(paths.ListDBPath, "l"),
+			encodeList(kv.ListValue{Key: "l", Expire: 60, Data: []string{"v"}}), now.Add(-30 * time.Second), 29000, 30000},
+		{"list expired", filepath.Join(paths.ListDBPath, "old"),
+			encodeList(kv.ListValue{Key: "old", Expire: 10, Data: []string{"v"}}), now.Add(-time.Minute), TTLNotFound, TTLNotFound},
+		{"list forever", filepath.Join(paths.ListDBPath, "f"),
+			encodeList(kv.ListValue{Key: "f", Expire: kv.ExpireForever, Data: []string{"v"}}), now.Add(-time.Hour), TTLForever, TTLForever},
+		{"set", filepath.Join(paths.SetDBPath, "s"),
+			encodeSet(kv.SetValue{Key: "s", Expire: 100, Data: kv.SetContent{"v": "v"}}), now, 99000, 100000},
+	}
+
+	for _, tt := range tests {
+		if err := ioutil.WriteFile(tt.file, tt.data, os.ModePerm); err != nil {
+			t.Fatal(err)
+		}
+		os.Chtimes(tt.file, tt.mod, tt.mod)
+	}
+
+	c := newCache("test", paths)
+	for _, tt := range tests {
+		key := filepath.Base(tt.file)
+		ttl, _ := c.PTTL(key, "")
+		if ttl < tt.min || ttl > tt.max {
+			t.Fatalf("%s: PTTL %d, want [%d, %d]", tt.name, ttl, tt.min, tt.max)
+		}
+	}
+
+	data, _ := ioutil.ReadFile(filepath.Join(paths.ListDBPath, "l"))
+	if e := decodeList(data).Expire; e < legacyExpireLimit {
+		t.Fatalf("migrated expire is not saved, file has %d", e)
+	}
+}
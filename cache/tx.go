@@ -0,0 +1,442 @@
+package cache
+
+import (
+	"errors"
+	"fmt"
+	"github.com/llr104/lightkv/cache/kv"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+/*
+事务中可以使用的命令，只支持 string、map、list、set 四种类型
+*/
+const (
+	TxPut         = "put"         //args: value
+	TxGet         = "get"
+	TxIncrBy      = "incrby"      //args: delta，key不存在时从0开始，保留原有的过期时间
+	TxDel         = "del"         //删除string、map、list、set中的key，Value 为删除的个数
+	TxHMPut       = "hmput"       //args: field value field value ...
+	TxHMGet       = "hmget"
+	TxHMGetMember = "hmgetmember" //args: field
+	TxHMDelMember = "hmdelmember" //args: field
+	TxHMDel       = "hmdel"
+	TxLPut        = "lput"        //args: value ...
+	TxLGet        = "lget"
+	TxLGetRange   = "lgetrange"   //args: beg end
+	TxLDelRange   = "ldelrange"   //args: beg end
+	TxLDel        = "ldel"
+	TxSPut        = "sput"        //args: member ...
+	TxSGet        = "sget"
+	TxSDelMember  = "sdelmember"  //args: member
+	TxSDel        = "sdel"
+)
+
+/*
+会修改key的命令，执行前保存key原来的值用于回滚
+*/
+var txWrites = map[string]bool{
+	TxPut: true, TxIncrBy: true, TxDel: true,
+	TxHMPut: true, TxHMDelMember: true, TxHMDel: true,
+	TxLPut: true, TxLDelRange: true, TxLDel: true,
+	TxSPut: true, TxSDelMember: true, TxSDel: true,
+}
+
+/*
+watch的key在执行事务前被修改过，事务中的命令都没有执行
+*/
+type WatchError struct {
+	Key string
+}
+
+func (e WatchError) Error() string {
+	return fmt.Sprintf("EXECABORT watched Key:%s has been modified", e.Key)
+}
+
+func IsWatchError(err error) bool {
+	var e WatchError
+	return errors.As(err, &e)
+}
+
+/*
+事务中第 Index 条命令检查或者执行失败，已经执行的命令全部回滚
+*/
+type TxError struct {
+	Index int
+	Cmd   string
+	Err   error
+}
+
+func (e TxError) Error() string {
+	return fmt.Sprintf("EXECABORT command %d %s failed: %s", e.Index, e.Cmd, e.Err.Error())
+}
+
+func (e TxError) Unwrap() error {
+	return e.Err
+}
+
+/*
+key当前的修改版本，watch时记录，执行事务时用来检查key有没有被修改过
+key不存在时为0，非统一键空间模式下同名key存在于多种类型时取最大的版本
+*/
+func (s *Cache) Revisions(keys []string) map[string]uint64 {
+	s.txMutex.RLock()
+	defer s.txMutex.RUnlock()
+
+	r := make(map[string]uint64, len(keys))
+	for _, key := range keys {
+		r[key] = s.revision(key)
+	}
+	return r
+}
+
+func (s *Cache) revision(key string) uint64 {
+	r := uint64(0)
+	for _, h := range s.typeHandles() {
+		if v := h.lru.Revision(key); v > r {
+			r = v
+		}
+	}
+	return r
+}
+
+/*
+按顺序原子地执行事务中的命令，执行期间string、map、list、set的其他读写操作等待事务结束
+watch 为 Revisions 返回的版本，任意一个key的版本改变时不执行，返回 WatchError
+任意一条命令失败时回滚已经执行的所有修改，返回 TxError
+执行期间的持久化和通知先缓存，全部命令成功后在锁内按顺序执行，回滚时丢弃，磁盘和监听者看不到回滚的修改
+执行期间被淘汰的key不管事务是否成功都会删除
+*/
+func (s *Cache) Exec(cmds []kv.TxCommand, watch map[string]uint64) ([]kv.TxResult, error) {
+	for i, cmd := range cmds {
+		if err := checkTxCommand(cmd); err != nil {
+			return nil, TxError{Index: i, Cmd: cmd.Cmd, Err: err}
+		}
+	}
+
+	s.txMutex.Lock()
+	defer s.txMutex.Unlock()
+
+	//过期删除不经过 txMutex，还需要锁住事务中修改的key
+	keys := make([]string, len(cmds))
+	for i, cmd := range cmds {
+		keys[i] = cmd.Key
+	}
+	defer s.keyLocks.lock(keys...)()
+
+	for key, rev := range watch {
+		if s.revision(key) != rev {
+			return nil, WatchError{Key: key}
+		}
+	}
+
+	buf := &txBuffer{keys: make(map[string]bool, len(keys)), evicted: make(map[txKey]bool)}
+	for _, key := range keys {
+		buf.keys[key] = true
+	}
+	s.txBuffer.Store(buf)
+	defer s.txBuffer.Store((*txBuffer)(nil))
+
+	undo := txUndo{c: s, buf: buf, saved: make(map[string]bool)}
+	results := make([]kv.TxResult, len(cmds))
+	for i, cmd := range cmds {
+		if txWrites[strings.ToLower(cmd.Cmd)] {
+			undo.save(cmd.Key)
+		}
+		r, err := s.execCommand(cmd)
+		if err != nil {
+			undo.rollback()
+			buf.finish(false)
+			return nil, TxError{Index: i, Cmd: cmd.Cmd, Err: err}
+		}
+		results[i] = r
+	}
+
+	buf.finish(true)
+	return results, nil
+}
+
+/*
+事务执行期间事务中的key的持久化和通知，结束时按顺序执行
+淘汰不持有key的锁，其他db的写入超过 maxmemory 时也会淘汰这个db的key，ops 和 evicted 需要持有 mutex
+*/
+type txBuffer struct {
+	keys    map[string]bool
+	mutex   sync.Mutex
+	ops     []txOp
+	evicted map[txKey]bool
+	done    bool
+}
+
+type txKey struct {
+	dataType int32
+	key      string
+}
+
+type txOp struct {
+	f     func()
+	evict bool
+}
+
+/*
+key在执行中的事务里时缓存op返回true，事务已经结束或者key不在事务中时返回false
+*/
+func (s *txBuffer) add(k txKey, op txOp) bool {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	if s.done || s.keys[k.key] == false {
+		return false
+	}
+	s.ops = append(s.ops, op)
+	if op.evict {
+		s.evicted[k] = true
+	}
+	return true
+}
+
+func (s *txBuffer) isEvicted(dataType int32, key string) bool {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	return s.evicted[txKey{dataType: dataType, key: key}]
+}
+
+/*
+提交时执行所有缓存的操作，回滚时只执行淘汰，之后的淘汰不再缓存
+在锁内执行，同一个key的淘汰不会在缓存的操作之前执行
+*/
+func (s *txBuffer) finish(commit bool) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	s.done = true
+	for _, op := range s.ops {
+		if commit || op.evict {
+			op.f()
+		}
+	}
+	s.ops = nil
+}
+
+/*
+key的持久化和通知，key在执行中的事务里时缓存到提交时执行，否则立即执行，需要在key的锁内调用
+*/
+func (s *Cache) afterCommit(key string, f func()) {
+	if buf, ok := s.txBuffer.Load().(*txBuffer); ok && buf != nil && buf.add(txKey{key: key}, txOp{f: f}) {
+		return
+	}
+	f()
+}
+
+/*
+淘汰的key的持久化和通知，不持有key的锁，key在执行中的事务里时和事务的操作一起按顺序执行，回滚时也执行
+*/
+func (s *Cache) afterEvict(dataType int32, key string, f func()) {
+	k := txKey{dataType: dataType, key: key}
+	if buf, ok := s.txBuffer.Load().(*txBuffer); ok && buf != nil && buf.add(k, txOp{f: f, evict: true}) {
+		return
+	}
+	f()
+}
+
+/*
+lru淘汰key时的回调，op 返回删除key的持久化和通知
+*/
+func (s *Cache) evictTrigger(dataType int32, op func(key string, v kv.ValueCache) func()) expireTrigger {
+	return func(key string, v kv.ValueCache) {
+		s.afterEvict(dataType, key, op(key, v))
+	}
+}
+
+func checkTxCommand(cmd kv.TxCommand) error {
+	if cmd.Key == "" {
+		return errors.New("key is empty")
+	}
+
+	n := len(cmd.Args)
+	ok := false
+	switch strings.ToLower(cmd.Cmd) {
+	case TxGet, TxDel, TxHMGet, TxHMDel, TxLGet, TxLDel, TxSGet, TxSDel:
+		ok = n == 0
+	case TxPut, TxIncrBy, TxHMGetMember, TxHMDelMember, TxSDelMember:
+		ok = n == 1
+	case TxHMPut:
+		ok = n > 0 && n % 2 == 0
+	case TxLPut, TxSPut:
+		ok = n > 0
+	case TxLGetRange, TxLDelRange:
+		ok = n == 2
+	default:
+		str := fmt.Sprintf("unknown command:%s", cmd.Cmd)
+		return errors.New(str)
+	}
+	if ok == false {
+		str := fmt.Sprintf("wrong number of arguments for %s", cmd.Cmd)
+		return errors.New(str)
+	}
+	return nil
+}
+
+/*
+需要持有 txMutex 的写锁和命令中key的锁，调用不加锁的内部方法
+*/
+func (s *Cache) execCommand(cmd kv.TxCommand) (kv.TxResult, error) {
+	r := kv.TxResult{}
+	var err error
+	key, args := cmd.Key, cmd.Args
+	e := kv.ExpireSeconds(cmd.Expire)
+
+	switch strings.ToLower(cmd.Cmd) {
+	case TxPut:
+		err = s.putEx(key, args[0], e)
+	case TxGet:
+		r.Value, _, err = s.get(key)
+	case TxIncrBy:
+		r.Value, err = s.incrBy(key, args[0])
+	case TxDel:
+		n := 0
+		for _, h := range s.txHandles() {
+			if v, ok := h.lru.Peek(key); ok && v.IsExpire() == false && h.del(key) == nil {
+				n = 1
+			}
+		}
+		r.Value = strconv.Itoa(n)
+	case TxHMPut:
+		fields := make([]string, 0, len(args)/2)
+		values := make([]string, 0, len(args)/2)
+		for i := 0; i < len(args); i += 2 {
+			fields = append(fields, args[i])
+			values = append(values, args[i+1])
+		}
+		err = s.hmPutEx(key, fields, values, e)
+	case TxHMGet:
+		r.Fields, _, err = s.hmGet(key)
+	case TxHMGetMember:
+		r.Value, err = s.hmGetMember(key, args[0])
+	case TxHMDelMember:
+		err = s.hmDelMember(key, args[0])
+	case TxHMDel:
+		if err = s.checkType(kv.MapData, key); err == nil {
+			err = s.hDel(key)
+		}
+	case TxLPut:
+		err = s.lPutEx(key, args, e)
+	case TxLGet:
+		r.Values, _, err = s.lGet(key)
+	case TxLGetRange, TxLDelRange:
+		beg, err1 := strconv.ParseInt(args[0], 10, 32)
+		end, err2 := strconv.ParseInt(args[1], 10, 32)
+		if err1 != nil || err2 != nil {
+			str := fmt.Sprintf("%s Key:%s, invalid range %s %s", cmd.Cmd, key, args[0], args[1])
+			return r, errors.New(str)
+		}
+		if strings.ToLower(cmd.Cmd) == TxLDelRange {
+			err = s.lDelRange(key, int32(beg), int32(end))
+		}else{
+			r.Values, err = s.lGetRange(key, int32(beg), int32(end))
+		}
+	case TxLDel:
+		if err = s.checkType(kv.ListData, key); err == nil {
+			err = s.lDel(key)
+		}
+	case TxSPut:
+		err = s.sPutEx(key, args, e)
+	case TxSGet:
+		r.Values, _, err = s.sGet(key)
+	case TxSDelMember:
+		err = s.sDelMember(key, args[0])
+	case TxSDel:
+		if err = s.checkType(kv.SetData, key); err == nil {
+			err = s.sDel(key)
+		}
+	}
+	return r, err
+}
+
+/*
+把string的值当成整数加上delta，返回加之后的值
+*/
+func (s *Cache) incrBy(key string, delta string) (string, error) {
+	d, err := strconv.ParseInt(delta, 10, 64)
+	if err != nil {
+		str := fmt.Sprintf("IncrBy Key:%s, invalid delta:%s", key, delta)
+		return "", errors.New(str)
+	}
+
+	n := int64(0)
+	if v, ok := s.stringLRU.Peek(key); ok && v.IsExpire() == false {
+		if n, err = strconv.ParseInt(v.ToString(), 10, 64); err != nil {
+			str := fmt.Sprintf("IncrBy Key:%s, value is not an integer", key)
+			return "", errors.New(str)
+		}
+	}
+
+	str := strconv.FormatInt(n + d, 10)
+	return str, s.putEx(key, str, kv.KeepTTL())
+}
+
+func (s *Cache) txHandles() []typeHandle {
+	return s.typeHandles()[kv.ValueData:kv.SetData+1]
+}
+
+/*
+事务中被修改的key在第一次修改前每种类型中的值和修改版本，v 为nil表示key原来不存在
+*/
+type txSaved struct {
+	h        typeHandle
+	key      string
+	v        kv.ValueCache
+	revision uint64
+}
+
+type txUndo struct {
+	c      *Cache
+	buf    *txBuffer
+	saved  map[string]bool
+	values []txSaved
+}
+
+/*
+map、set 会被原地修改，保存的是复制出来的值
+*/
+func (s *txUndo) save(key string) {
+	if s.saved[key] {
+		return
+	}
+	s.saved[key] = true
+
+	for _, h := range s.c.txHandles() {
+		t := txSaved{h: h, key: key, revision: h.lru.Revision(key)}
+		if v, ok := h.lru.Peek(key); ok && v.IsExpire() == false {
+			t.v = cloneValue(v)
+		}
+		s.values = append(s.values, t)
+	}
+}
+
+/*
+修改版本没有变化的key不需要恢复，原来不存在的key直接删除，其他key写回原来的值和修改版本
+执行期间的持久化和通知还在缓存中，回滚只恢复内存中的值
+执行期间被淘汰过的key磁盘上会被删除，恢复之后再从内存中删除，淘汰在恢复之前记录，恢复之后检查不会漏掉
+*/
+func (s *txUndo) rollback() {
+	for i := len(s.values) - 1; i >= 0; i-- {
+		t := s.values[i]
+		if t.h.lru.Revision(t.key) == t.revision {
+			continue
+		}
+
+		_, ok := t.h.lru.Peek(t.key)
+		if t.v == nil {
+			if ok {
+				t.h.del(t.key)
+			}
+			continue
+		}
+		t.h.lru.Restore(t.v, t.revision)
+		if s.buf.isEvicted(t.h.dataType, t.key) {
+			t.h.lru.Remove(t.key)
+		}
+	}
+}
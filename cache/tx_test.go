@@ -0,0 +1,204 @@
+package cache
+
+import (
+	"fmt"
+	"github.com/llr104/lightkv/cache/kv"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+)
+
+/*
+等待之前发送的string、map持久化操作写完
+*/
+func syncTx(c *Cache) {
+	c.persistentStringChan <- kv.PersistentStringOp{Item: kv.StringValue{Key: "sync"}, OpType: kv.Del}
+	c.persistentMapChan <- kv.PersistentMapOp{Item: kv.MapValue{Key: "sync"}, OpType: kv.Del}
+}
+
+func TestExecRollback(t *testing.T) {
+	c, clean := newTestCache(t)
+	defer clean()
+
+	var ops []kv.OpType
+	var mutex sync.Mutex
+	c.SetOnOP(func(op kv.OpType, before kv.ValueCache, after kv.ValueCache) {
+		mutex.Lock()
+		ops = append(ops, op)
+		mutex.Unlock()
+	})
+	notified := func() int {
+		mutex.Lock()
+		defer mutex.Unlock()
+		return len(ops)
+	}
+
+	c.Put("a", "1", 0)
+	c.Put("n", "x", 0)
+	watch := c.Revisions([]string{"a", "b", "n"})
+
+	tests := []struct {
+		name   string
+		cmds   []kv.TxCommand
+		index  int
+		a      string
+		b      bool
+		notify int
+	}{
+		{"abort", []kv.TxCommand{
+			{Cmd: TxPut, Key: "a", Args: []string{"2"}},
+			{Cmd: TxHMPut, Key: "b", Args: []string{"f", "v"}},
+			{Cmd: TxDel, Key: "a"},
+			{Cmd: TxIncrBy, Key: "n", Args: []string{"1"}},
+		}, 3, "1", false, 2},
+		{"commit", []kv.TxCommand{
+			{Cmd: TxPut, Key: "a", Args: []string{"2"}},
+			{Cmd: TxHMPut, Key: "b", Args: []string{"f", "v"}},
+		}, -1, "2", true, 4},
+	}
+
+	for _, tt := range tests {
+		_, err := c.Exec(tt.cmds, watch)
+		if tt.index >= 0 {
+			e, ok := err.(TxError)
+			if ok == false || e.Index != tt.index {
+				t.Fatalf("%s: got error %v, want command %d failed", tt.name, err, tt.index)
+			}
+			//回滚后版本不变，之前的watch仍然有效
+			if r := c.Revisions([]string{"a", "b", "n"}); r["a"] != watch["a"] || r["b"] != watch["b"] || r["n"] != watch["n"] {
+				t.Fatalf("%s: revisions %v, want %v", tt.name, r, watch)
+			}
+		}else if err != nil {
+			t.Fatalf("%s: %s", tt.name, err)
+		}
+
+		if v, _ := c.Get("a"); v != tt.a {
+			t.Fatalf("%s: Key:a is %q, want %q", tt.name, v, tt.a)
+		}
+		if _, err := c.HMGet("b"); (err == nil) != tt.b {
+			t.Fatalf("%s: Key:b exists %v, want %v", tt.name, err == nil, tt.b)
+		}
+		if n := notified(); n != tt.notify {
+			t.Fatalf("%s: got %d notifications, want %d", tt.name, n, tt.notify)
+		}
+
+		syncTx(c)
+		data, err := ioutil.ReadFile(filepath.Join(c.paths.ValueDBPath, "a"))
+		if err != nil || decodeValue(data).Data != tt.a {
+			t.Fatalf("%s: persisted Key:a is %q, want %q", tt.name, decodeValue(data).Data, tt.a)
+		}
+		if _, err := os.Stat(filepath.Join(c.paths.MapDBPath, "b")); (err == nil) != tt.b {
+			t.Fatalf("%s: persisted Key:b exists %v, want %v", tt.name, err == nil, tt.b)
+		}
+	}
+}
+
+/*
+事务执行期间另一个db的写入超过 maxmemory 淘汰事务中的key，淘汰不管事务成功还是回滚都会删除，
+结束后内存、磁盘、最后一次通知三者一致
+*/
+func TestExecEviction(t *testing.T) {
+	//随机淘汰，两个db的key都会被淘汰
+	defer withMaxMemory(MaxMemoryAllKeysRandom)()
+	c, clean := newTestCache(t)
+	defer clean()
+	other, cleanOther := newTestCache(t)
+	defer cleanOther()
+
+	const keys = 20
+	last := make(map[string]kv.OpType)
+	var mutex sync.Mutex
+	c.SetOnOP(func(op kv.OpType, before kv.ValueCache, after kv.ValueCache) {
+		v := after
+		if v == nil {
+			v = before
+		}
+		mutex.Lock()
+		last[v.GetKey()] = op
+		mutex.Unlock()
+	})
+
+	for i := 0; i < keys; i++ {
+		c.Put(fmt.Sprintf("k%02d", i), "v", 0)
+	}
+	Conf.MaxMemory = memory.Used()
+
+	parallel(2, 0, func(g int) {
+		for i := 0; i < testRounds; i++ {
+			if g == 1 {
+				other.Put(fmt.Sprintf("o%03d", i), "v", 0)
+				continue
+			}
+			var cmds []kv.TxCommand
+			for j := 0; j < 5; j++ {
+				cmds = append(cmds, kv.TxCommand{Cmd: TxPut, Key: fmt.Sprintf("k%02d", (i+j)%keys), Args: []string{"v"}})
+			}
+			//奇数次回滚
+			if i%2 == 1 {
+				cmds = append(cmds, kv.TxCommand{Cmd: TxLGetRange, Key: "l", Args: []string{"a", "b"}})
+			}
+			_, err := c.Exec(cmds, nil)
+			if (err != nil) != (i%2 == 1) && IsOOM(err) == false {
+				t.Error(err)
+			}
+		}
+	}, nil)
+
+	syncTx(c)
+	for i := 0; i < keys; i++ {
+		key := fmt.Sprintf("k%02d", i)
+		_, err := c.Get(key)
+		_, statErr := os.Stat(filepath.Join(c.paths.ValueDBPath, key))
+		mutex.Lock()
+		op, ok := last[key]
+		mutex.Unlock()
+		if (err == nil) != (statErr == nil) || (err == nil) != (ok && op != kv.Del) {
+			t.Fatalf("Key:%s in memory %v, on disk %v, last notification %v", key, err == nil, statErr == nil, op)
+		}
+	}
+	if c.stringLRU.evictions == 0 {
+		t.Fatal("no key evicted")
+	}
+}
+
+func TestLDelRange(t *testing.T) {
+	c, clean := newTestCache(t)
+	defer clean()
+
+	c.LPut("l", []string{"a", "b", "c", "d"}, 0)
+	c.LPutEx("old", []string{"a"}, kv.ExpireMillis(1))
+	time.Sleep(5 * time.Millisecond)
+
+	tests := []struct {
+		key      string
+		beg, end int32
+		err      bool
+		left     string
+	}{
+		{"l", -1, 0, true, "[a b c d]"},
+		{"l", 0, -1, true, "[a b c d]"},
+		{"l", 2, 1, true, "[a b c d]"},
+		{"l", 4, 5, true, "[a b c d]"},
+		{"l", 1, 2, false, "[a c d]"},
+		{"l", 1, 10, false, "[a]"},
+		{"old", 0, 1, true, "[]"},
+		{"none", 0, 1, true, "[]"},
+	}
+	for _, tt := range tests {
+		err := c.LDelRange(tt.key, tt.beg, tt.end)
+		if (err != nil) != tt.err {
+			t.Fatalf("LDelRange %s %d %d: error %v, want error %v", tt.key, tt.beg, tt.end, err, tt.err)
+		}
+		if v, _ := c.LGet(tt.key); fmt.Sprint(v) != tt.left {
+			t.Fatalf("LDelRange %s %d %d: list is %v, want %s", tt.key, tt.beg, tt.end, v, tt.left)
+		}
+	}
+
+	_, err := c.Exec([]kv.TxCommand{{Cmd: TxLDelRange, Key: "l", Args: []string{"-1", "0"}}}, nil)
+	if e, ok := err.(TxError); ok == false || e.Index != 0 {
+		t.Fatalf("ldelrange l -1 0: error %v, want command 0 failed", err)
+	}
+}
@@ -0,0 +1,139 @@
+package cache
+
+import (
+	"errors"
+	"fmt"
+	"github.com/llr104/lightkv/cache/kv"
+)
+
+/*
+CAS 写入时key当前的修改版本和期望的版本不一致，写入没有执行
+*/
+type VersionError struct {
+	Key      string
+	Expected uint64
+	Actual   uint64
+}
+
+func (e VersionError) Error() string {
+	return fmt.Sprintf("CONFLICT Key:%s version is %d, expected %d", e.Key, e.Actual, e.Expected)
+}
+
+func IsVersionError(err error) bool {
+	var e VersionError
+	return errors.As(err, &e)
+}
+
+/*
+key当前的修改版本等于 version 时才执行写入，返回写入后的版本，删除成功时返回0
+version 为 GetVersion 等读取返回的版本，为0表示key必须不存在
+和 PutEx 一样持有 txMutex 的读锁和key的锁，检查版本和写入之间同一个key的其他写入只能等待
+*/
+func (s *Cache) cas(h *lru, key string, version uint64, write func() error) (uint64, error) {
+	s.txMutex.RLock()
+	defer s.txMutex.RUnlock()
+	defer s.keyLocks.lock(key)()
+
+	if r := h.Revision(key); r != version {
+		return 0, VersionError{Key: key, Expected: version, Actual: r}
+	}
+
+	if err := write(); err != nil {
+		return 0, err
+	}
+	return h.Revision(key), nil
+}
+
+/*
+string
+*/
+func (s *Cache) PutCAS(key string, v string, e kv.Expiration, version uint64) (uint64, error) {
+	return s.cas(s.stringLRU, key, version, func() error {
+		return s.putEx(key, v, e)
+	})
+}
+
+func (s *Cache) DeleteCAS(key string, version uint64) error {
+	_, err := s.cas(s.stringLRU, key, version, func() error {
+		if err := s.checkType(kv.ValueData, key); err != nil {
+			return err
+		}
+		return s.del(key)
+	})
+	return err
+}
+
+/*
+map
+*/
+func (s *Cache) HMPutCAS(hmKey string, keys []string, fields []string, e kv.Expiration, version uint64) (uint64, error) {
+	return s.cas(s.mapLRU, hmKey, version, func() error {
+		return s.hmPutEx(hmKey, keys, fields, e)
+	})
+}
+
+func (s *Cache) HMDelMemberCAS(hmKey string, fieldKey string, version uint64) (uint64, error) {
+	return s.cas(s.mapLRU, hmKey, version, func() error {
+		return s.hmDelMember(hmKey, fieldKey)
+	})
+}
+
+func (s *Cache) HMDelCAS(hmKey string, version uint64) error {
+	_, err := s.cas(s.mapLRU, hmKey, version, func() error {
+		if err := s.checkType(kv.MapData, hmKey); err != nil {
+			return err
+		}
+		return s.hDel(hmKey)
+	})
+	return err
+}
+
+/*
+list
+*/
+func (s *Cache) LPutCAS(key string, value []string, e kv.Expiration, version uint64) (uint64, error) {
+	return s.cas(s.listLRU, key, version, func() error {
+		return s.lPutEx(key, value, e)
+	})
+}
+
+func (s *Cache) LDelRangeCAS(key string, beg int32, end int32, version uint64) (uint64, error) {
+	return s.cas(s.listLRU, key, version, func() error {
+		return s.lDelRange(key, beg, end)
+	})
+}
+
+func (s *Cache) LDelCAS(key string, version uint64) error {
+	_, err := s.cas(s.listLRU, key, version, func() error {
+		if err := s.checkType(kv.ListData, key); err != nil {
+			return err
+		}
+		return s.lDel(key)
+	})
+	return err
+}
+
+/*
+set
+*/
+func (s *Cache) SPutCAS(key string, value []string, e kv.Expiration, version uint64) (uint64, error) {
+	return s.cas(s.setLRU, key, version, func() error {
+		return s.sPutEx(key, value, e)
+	})
+}
+
+func (s *Cache) SDelMemberCAS(key string, value string, version uint64) (uint64, error) {
+	return s.cas(s.setLRU, key, version, func() error {
+		return s.sDelMember(key, value)
+	})
+}
+
+func (s *Cache) SDelCAS(key string, version uint64) error {
+	_, err := s.cas(s.setLRU, key, version, func() error {
+		if err := s.checkType(kv.SetData, key); err != nil {
+			return err
+		}
+		return s.sDel(key)
+	})
+	return err
+}
@@ -0,0 +1,121 @@
+package cache
+
+import (
+	"github.com/llr104/lightkv/cache/kv"
+	"strconv"
+	"testing"
+)
+
+/*
+每一步使用上一次写入返回的版本或者过期的版本写入
+*/
+func TestCAS(t *testing.T) {
+	c, clean := newTestCache(t)
+	defer clean()
+
+	e := kv.ExpireSeconds(0)
+	type write func(version uint64) (uint64, error)
+	types := []struct {
+		name string
+		put  write
+		del  func(version uint64) error
+		read func() (uint64, error)
+	}{
+		{"string",
+			func(v uint64) (uint64, error) { return c.PutCAS("s", "v", e, v) },
+			func(v uint64) error { return c.DeleteCAS("s", v) },
+			func() (uint64, error) { _, v, err := c.GetVersion("s"); return v, err }},
+		{"map",
+			func(v uint64) (uint64, error) { return c.HMPutCAS("m", []string{"f"}, []string{"v"}, e, v) },
+			func(v uint64) error { return c.HMDelCAS("m", v) },
+			func() (uint64, error) { _, v, err := c.HMGetVersion("m"); return v, err }},
+		{"list",
+			func(v uint64) (uint64, error) { return c.LPutCAS("l", []string{"v"}, e, v) },
+			func(v uint64) error { return c.LDelCAS("l", v) },
+			func() (uint64, error) { _, v, err := c.LGetVersion("l"); return v, err }},
+		{"set",
+			func(v uint64) (uint64, error) { return c.SPutCAS("st", []string{"v"}, e, v) },
+			func(v uint64) error { return c.SDelCAS("st", v) },
+			func() (uint64, error) { _, v, err := c.SGetVersion("st"); return v, err }},
+	}
+
+	for _, ty := range types {
+		var first, second uint64
+		steps := []struct {
+			name     string
+			op       func() (uint64, error)
+			conflict bool
+		}{
+			{"create", func() (uint64, error) { v, err := ty.put(0); first = v; return v, err }, false},
+			{"create existing", func() (uint64, error) { return ty.put(0) }, true},
+			{"update", func() (uint64, error) { v, err := ty.put(first); second = v; return v, err }, false},
+			{"update stale", func() (uint64, error) { return ty.put(first) }, true},
+			{"delete stale", func() (uint64, error) { return 0, ty.del(first) }, true},
+			{"delete", func() (uint64, error) { return 0, ty.del(second) }, false},
+			{"create again", func() (uint64, error) { return ty.put(0) }, false},
+		}
+
+		for _, st := range steps {
+			before, _ := ty.read()
+			v, err := st.op()
+			if IsVersionError(err) != st.conflict {
+				t.Fatalf("%s %s: error %v, want conflict %v", ty.name, st.name, err, st.conflict)
+			}
+			if err != nil && st.conflict == false {
+				t.Fatalf("%s %s: %s", ty.name, st.name, err)
+			}
+			after, readErr := ty.read()
+			if st.conflict {
+				if after != before {
+					t.Fatalf("%s %s: version changed from %d to %d by failed write", ty.name, st.name, before, after)
+				}
+				if e, ok := err.(VersionError); ok == false || e.Actual != before {
+					t.Fatalf("%s %s: error %v, want actual version %d", ty.name, st.name, err, before)
+				}
+				continue
+			}
+			if st.name == "delete" {
+				if readErr == nil {
+					t.Fatalf("%s %s: key still exists", ty.name, st.name)
+				}
+				continue
+			}
+			if v != after || v <= before {
+				t.Fatalf("%s %s: returned version %d, read %d, previous %d", ty.name, st.name, v, after, before)
+			}
+		}
+	}
+}
+
+/*
+多个协程用 CAS 对同一个key加1，冲突时重新读取，结果等于加1的总次数
+*/
+func TestConcurrentCAS(t *testing.T) {
+	c, clean := newTestCache(t)
+	defer clean()
+
+	c.Put("n", "0", 0)
+	parallel(testWriters, 2, func(g int) {
+		for i := 0; i < testRounds; {
+			v, version, err := c.GetVersion("n")
+			if err != nil {
+				t.Error(err)
+				return
+			}
+			n, _ := strconv.Atoi(v)
+			_, err = c.PutCAS("n", strconv.Itoa(n+1), kv.KeepTTL(), version)
+			if err == nil {
+				i++
+			}else if IsVersionError(err) == false {
+				t.Error(err)
+				return
+			}
+		}
+	}, func() {
+		c.GetVersion("n")
+	})
+
+	if v, _ := c.Get("n"); v != strconv.Itoa(testWriters*testRounds) {
+		t.Fatalf("Key:n is %s, want %d", v, testWriters*testRounds)
+	}
+}
@@ -4,9 +4,11 @@ package main
 import (
 	"fmt"
 	_ "fmt"
+	"github.com/llr104/lightkv/cache"
 	"github.com/llr104/lightkv/cache/kv"
 	"github.com/llr104/lightkv/server"
 	"log"
+	"math"
 	"time"
 )
 
@@ -16,6 +18,16 @@ func main() {
 	testMap()
 	testList()
 	testSet()
+	testHLL()
+	testGeo()
+	testStream()
+	testJSON()
+	testFilter()
+	testTimeSeries()
+	testScan()
+	testTTL()
+	testKeyspace()
+	testDatabases()
 
 	time.Sleep(time.Second*60)
 }
@@ -101,17 +113,18 @@ func testMap()  {
 	//新增map
 	c.HMPut("hmtest1", keys, vals, 0)
 
-	str := c.HMGet("hmtest1")
-	log.Printf("获取hmtest1 map:\n%s", str)
+	m, _ := c.HMGet("hmtest1")
+	log.Printf("获取hmtest1 map:%v", m)
 
 	//删除hmtest1 map 中的k1
 	c.HMDelMember("hmtest1", "k1")
-	str = c.HMGet("hmtest1")
+	m, _ = c.HMGet("hmtest1")
 
-	log.Printf("hmtest1 map 删除了k1后:\n%s", str)
+	log.Printf("hmtest1 map 删除了k1后:%v", m)
 
 	c.HMDel("hmtest1")
-	log.Printf("删除hmtest1 map后，hmtest1的值:\n%s", c.HMGet("hmtest1"))
+	m, _ = c.HMGet("hmtest1")
+	log.Printf("删除hmtest1 map后，hmtest1的值:%v", m)
 
 
 	c.HMWatch("hmtest2", "", func(hk string, k string, beforeV string, afterV string, t kv.OpType) {
@@ -168,7 +181,8 @@ func testMap()  {
 	//新增hmtest2 map
 	c.HMPut("hmtest2", keys, vals, 3)
 
-	log.Printf("获取 hmtest2 map的值:\n%s", c.HMGet("hmtest2"))
+	m, _ = c.HMGet("hmtest2")
+	log.Printf("获取 hmtest2 map的值:%v", m)
 
 	log.Printf("获取hmtest2 map 中k2元素:%s", c.HMGetMember("hmtest2", "k2"))
 
@@ -291,4 +305,369 @@ func testSet()  {
 	c.SDel("setwatch")
 
 	time.Sleep(2*time.Second)
-}
\ No newline at end of file
+}
+func testHLL()  {
+	c := server.NewClient("127.0.0.1:9980")
+	c.Start()
+	defer c.Close()
+
+	c.ClearHLL()
+
+	c.PFAdd("uv1", []string{"u1", "u2", "u3", "u1"}, 0)
+	c.PFAdd("uv2", []string{"u3", "u4", "u5"}, 0)
+
+	n, _ := c.PFCount("uv1")
+	log.Printf("uv1 基数:%d", n)
+
+	n, _ = c.PFCount("uv1", "uv2")
+	log.Printf("uv1、uv2 并集基数:%d", n)
+
+	c.PFMerge("uvall", "uv1", "uv2")
+	n, _ = c.PFCount("uvall")
+	log.Printf("合并后 uvall 基数:%d", n)
+
+	for i:=0; i<10000; i++{
+		c.PFAdd("uvbig", []string{fmt.Sprintf("user%d", i)}, 0)
+	}
+	n, _ = c.PFCount("uvbig")
+	log.Printf("uvbig 添加10000个元素后基数:%d", n)
+
+	c.PFDel("uv1")
+	n, _ = c.PFCount("uv1")
+	log.Printf("删除 uv1 后基数:%d", n)
+
+	time.Sleep(2*time.Second)
+}
+
+func testGeo()  {
+	c := server.NewClient("127.0.0.1:9980")
+	c.Start()
+	defer c.Close()
+
+	c.ClearGeo()
+
+	c.GeoAdd("drivers", []kv.GeoMember{
+		{Name: "palermo", Longitude: 13.361389, Latitude: 38.115556},
+		{Name: "catania", Longitude: 15.087269, Latitude: 37.502669},
+		{Name: "agrigento", Longitude: 13.583333, Latitude: 37.316667},
+	}, 0)
+
+	arr, _ := c.GeoPos("drivers", "palermo", "catania")
+	log.Printf("palermo、catania 的坐标:%v", arr)
+
+	d, _ := c.GeoDist("drivers", "palermo", "catania", "km")
+	log.Printf("palermo 到 catania 的距离:%.2fkm", d)
+
+	arr, _ = c.GeoSearch("drivers", kv.GeoSearchOption{Longitude: 15, Latitude: 37,
+		Radius: 200, Unit: "km", Sort: kv.GeoSortAsc})
+	log.Printf("半径200km内的成员:%v", arr)
+
+	arr, _ = c.GeoSearch("drivers", kv.GeoSearchOption{Member: "palermo",
+		Width: 400, Height: 400, Unit: "km", Count: 2})
+	log.Printf("palermo 周围400km*400km内最近的2个成员:%v", arr)
+
+	c.GeoDelMember("drivers", "agrigento")
+	c.GeoDel("drivers")
+
+	time.Sleep(2*time.Second)
+}
+
+func testStream()  {
+	c := server.NewClient("127.0.0.1:9980")
+	c.Start()
+	defer c.Close()
+
+	c.ClearStream()
+
+	c.XGroupCreate("orders", "g1", "$", true)
+
+	go func() {
+		time.Sleep(500*time.Millisecond)
+		c.XAdd("orders", "*", map[string]string{"item": "apple", "num": "3"}, kv.StreamTrim{})
+		c.XAdd("orders", "*", map[string]string{"item": "pear", "num": "1"}, kv.StreamTrim{MaxLen: 1000})
+	}()
+
+	arr, _ := c.XRead([]string{"orders"}, []string{"$"}, 10, 2000)
+	log.Printf("阻塞读取到的消息:%v", arr)
+
+	l, _ := c.XLen("orders")
+	log.Printf("orders 消息数:%d", l)
+
+	arr, _ = c.XReadGroup("g1", "c1", []string{"orders"}, []string{">"}, 1, 0, false)
+	for _, s := range arr {
+		for _, e := range s.Entries {
+			log.Printf("c1 收到消息 %s:%v", e.ID, e.Fields)
+			c.XAck(s.Key, "g1", e.ID.String())
+		}
+	}
+
+	arr, _ = c.XReadGroup("g1", "c2", []string{"orders"}, []string{">"}, 1, 0, false)
+	p, _ := c.XPending("orders", "g1", "", 10)
+	log.Printf("未确认的消息:%v", p)
+
+	for _, s := range arr {
+		for _, e := range s.Entries {
+			es, _ := c.XClaim("orders", "g1", "c1", 0, e.ID.String())
+			log.Printf("c1 接管的消息:%v", es)
+			c.XAck("orders", "g1", e.ID.String())
+		}
+	}
+
+	es, _ := c.XRevRange("orders", "+", "-", 10)
+	log.Printf("倒序查询:%v", es)
+
+	c.XDel("orders")
+
+	time.Sleep(2*time.Second)
+}
+
+func testJSON()  {
+	c := server.NewClient("127.0.0.1:9980")
+	c.Start()
+	defer c.Close()
+
+	c.ClearJSON()
+
+	c.JSet("user", "$", `{"name":"tom","age":1,"tags":[],"address":{"city":"sz"}}`, 0)
+
+	c.JWatchKey("user", func(key string, path string, before string, after string, opType kv.OpType) {
+		log.Printf("user 变化 path:%s, before:%s, after:%s, opType:%d", path, before, after, opType)
+	})
+
+	c.JSet("user", "$.address.city", `"gz"`, 0)
+
+	age, _ := c.JNumIncrBy("user", "age", "1")
+	log.Printf("age 加1后:%s", age)
+
+	n, _ := c.JArrAppend("user", "tags", `"a"`, `"b"`)
+	log.Printf("tags 长度:%d", n)
+
+	c.JDelPath("user", "tags[0]")
+
+	v, _ := c.JGet("user", "name", "tags")
+	log.Printf("name、tags:%s", v)
+
+	v, _ = c.JGet("user")
+	log.Printf("user:%s", v)
+
+	c.JDel("user")
+
+	time.Sleep(2*time.Second)
+}
+
+func testFilter()  {
+	c := server.NewClient("127.0.0.1:9980")
+	c.Start()
+	defer c.Close()
+
+	c.ClearBloom()
+	c.ClearCuckoo()
+
+	c.BFReserve("urls", 0.001, 10000, 0)
+	added, _ := c.BFAdd("urls", []string{"https://a.com", "https://b.com", "https://a.com"}, 0)
+	log.Printf("bloom 添加结果:%v", added)
+
+	exists, _ := c.BFExists("urls", "https://a.com", "https://c.com")
+	log.Printf("bloom 是否存在:%v", exists)
+
+	info, _ := c.BFInfo("urls")
+	log.Printf("bloom 信息:%v", info)
+
+	added, _ = c.CFAdd("users", []string{"u1", "u2", "u1"}, true, 0)
+	log.Printf("cuckoo 添加结果:%v", added)
+
+	ok, _ := c.CFDelMember("users", "u1")
+	log.Printf("cuckoo 删除u1:%v", ok)
+
+	exists, _ = c.CFExists("users", "u1", "u2")
+	log.Printf("cuckoo 是否存在:%v", exists)
+
+	cinfo, _ := c.CFInfo("users")
+	log.Printf("cuckoo 信息:%v", cinfo)
+
+	c.BFDel("urls")
+	c.CFDel("users")
+
+	time.Sleep(2*time.Second)
+}
+
+func testTimeSeries()  {
+	c := server.NewClient("127.0.0.1:9980")
+	c.Start()
+	defer c.Close()
+
+	c.ClearTS()
+
+	c.TSCreate("cpu", 3600*1000, 0)
+	c.TSCreateRule("cpu", "cpu_10s", "avg", 10*1000)
+
+	var t int64 = 1600000000000
+	samples := make([]kv.TSSample, 0)
+	for i := 0; i < 30; i++ {
+		samples = append(samples, kv.TSSample{Time: t + int64(i)*1000, Value: float64(i%10)})
+	}
+	c.TSMAdd("cpu", samples, 0)
+
+	r, _ := c.TSRange("cpu", t, t+5000, "", 0, 0)
+	log.Printf("timeseries 前5秒的样本:%v", r)
+
+	r, _ = c.TSRange("cpu", 0, math.MaxInt64, "max", 10*1000, 0)
+	log.Printf("timeseries 每10秒的最大值:%v", r)
+
+	r, _ = c.TSRange("cpu_10s", 0, math.MaxInt64, "", 0, 0)
+	log.Printf("timeseries 降采样结果:%v", r)
+
+	info, _ := c.TSInfo("cpu")
+	log.Printf("timeseries 信息:%v", info)
+
+	c.TSDel("cpu")
+	c.TSDel("cpu_10s")
+
+	time.Sleep(2*time.Second)
+}
+
+func testScan()  {
+	c := server.NewClient("127.0.0.1:9980")
+	c.Start()
+	defer c.Close()
+
+	for i := 0; i < 50; i++ {
+		c.Put(fmt.Sprintf("scan:%d", i), "v", 0)
+	}
+	c.HMPut("scan:map", []string{"f1", "f2", "g1"}, []string{"1", "2", "3"}, 0)
+	c.SPut("scan:set", []string{"a1", "a2", "b1"}, 0)
+
+	var cursor uint64 = 0
+	n := 0
+	for {
+		keys, next, err := c.Scan(cursor, "scan:*", 20, "string")
+		if err != nil {
+			break
+		}
+		n += len(keys)
+		if next == 0 {
+			break
+		}
+		cursor = next
+	}
+	log.Printf("scan 找到的字符串key个数:%d", n)
+
+	fields, _, _ := c.HScan("scan:map", 0, "f*", 10)
+	log.Printf("hscan 结果:%v", fields)
+
+	members, _, _ := c.SScan("scan:set", 0, "a?", 10)
+	log.Printf("sscan 结果:%v", members)
+
+	for i := 0; i < 50; i++ {
+		c.Del(fmt.Sprintf("scan:%d", i))
+	}
+	c.HMDel("scan:map")
+	c.SDel("scan:set")
+
+	time.Sleep(2*time.Second)
+}
+
+func testTTL()  {
+	c := server.NewClient("127.0.0.1:9980")
+	c.Start()
+	defer c.Close()
+
+	c.Put("ttl", "v", 0)
+	c.SPut("ttl", []string{"a"}, 0)
+
+	_, err := c.TTL("ttl", "")
+	log.Printf("ttl 同名key存在于多种类型:%v", err)
+
+	c.Expire("ttl", "string", 60)
+	ttl, _ := c.TTL("ttl", "string")
+	pttl, _ := c.PTTL("ttl", "string")
+	log.Printf("ttl 剩余时间:%d秒 %d毫秒", ttl, pttl)
+
+	c.ExpireAt("ttl", "set", time.Now().Unix()+3600)
+	ttl, _ = c.TTL("ttl", "set")
+	log.Printf("ttl set剩余时间:%d秒", ttl)
+
+	ok, _ := c.Persist("ttl", "string")
+	ttl, _ = c.TTL("ttl", "string")
+	log.Printf("ttl persist:%v, 剩余时间:%d", ok, ttl)
+
+	c.PutEx("ttl", "v2", kv.ExpireMillis(1500))
+	c.PutEx("ttl", "v3", kv.KeepTTL())
+	pttl, _ = c.PTTL("ttl", "string")
+	log.Printf("ttl 保留过期时间:%d毫秒", pttl)
+
+	c.LPutEx("ttllist", []string{"a"}, kv.ExpireAtMillis(time.Now().UnixNano()/int64(time.Millisecond)+60000))
+	c.PExpire("ttllist", "list", 500)
+	pttl, _ = c.PTTL("ttllist", "list")
+	log.Printf("ttl list剩余时间:%d毫秒", pttl)
+
+	c.Del("ttl")
+	c.SDel("ttl")
+	c.LDel("ttllist")
+
+	time.Sleep(2*time.Second)
+}
+
+func testKeyspace()  {
+	c := server.NewClient("127.0.0.1:9980")
+	c.Start()
+	defer c.Close()
+
+	c.Put("keyspace", "v", 0)
+	t, _ := c.Type("keyspace")
+	n, _ := c.Exists("keyspace", "keyspace1")
+	log.Printf("keyspace 类型:%s, 存在的个数:%d", t, n)
+
+	c.Rename("keyspace", "keyspace1")
+	log.Printf("keyspace 重命名后的值:%s", c.Get("keyspace1"))
+
+	ok, _ := c.Copy("keyspace1", "keyspace2", true)
+	log.Printf("keyspace 复制:%v, 复制后的值:%s", ok, c.Get("keyspace2"))
+	ok, _ = c.Move("keyspace2", "1")
+	log.Printf("keyspace 移动到数据库1:%v", ok)
+
+	err := c.LPut("keyspace1", []string{"a"}, 0)
+	log.Printf("keyspace 统一键空间模式下的类型错误:%v", server.IsWrongType(err))
+
+	info, _ := c.KeyInfo("keyspace1", "")
+	log.Printf("keyspace 元数据:%+v", info)
+	stats, _ := c.KeyStats()
+	log.Printf("keyspace 字符串类型汇总:%+v", stats[kv.ValueData])
+	log.Printf("keyspace 字符串类型淘汰策略:%s, 命中率:%.2f", stats[kv.ValueData].Policy, stats[kv.ValueData].HitRatio)
+
+	usage, _ := c.MemoryUsage("keyspace1", "")
+	mem, _ := c.MemoryStats(false)
+	log.Printf("keyspace 占用内存:%d, 服务端计入:%d, heapAlloc:%d", usage, mem.Accounted, mem.HeapAlloc)
+
+	err = c.Put("keyspace2", "v", 0)
+	log.Printf("keyspace 写入时超过 maxmemory:%v", server.IsOOM(err))
+
+	n, _ = c.DelKeys("keyspace1", "keyspace2")
+	log.Printf("keyspace 删除的个数:%d", n)
+
+	time.Sleep(2*time.Second)
+}
+
+func testDatabases()  {
+	c := server.NewClient("127.0.0.1:9980")
+	c.Start()
+	defer c.Close()
+
+	c.CreateDB("1")
+	c.Select("1")
+	c.WatchKey("dbkey", func(key string, beforeValue string, afterValue string, opType kv.OpType) {
+		log.Printf("databases db1 watch key:%s, beforeValue:%s, afterValue:%s, opType:%d", key, beforeValue, afterValue, opType)
+	})
+	c.Put("dbkey", "v1", 0)
+	log.Printf("databases db1 dbkey:%s", c.Get("dbkey"))
+
+	c.Select(cache.DefaultDB)
+	c.Put("dbkey", "v0", 0)
+	log.Printf("databases db0 dbkey:%s", c.Get("dbkey"))
+
+	c.Select("1")
+	c.Del("dbkey")
+	log.Printf("databases db1 删除后 dbkey:%s, db0 中的 dbkey 不受影响", c.Get("dbkey"))
+
+	time.Sleep(2*time.Second)
+}
@@ -6,9 +6,9 @@ import (
 )
 
 func main() {
-	c := cache.NewCache()
-	api := server.NewApi(c)
+	dbs := cache.NewDatabases()
+	api := server.NewApi(dbs)
 	go api.Start()
 
-	server.NewRpcServer(c)
+	server.NewRpcServer(dbs)
 }
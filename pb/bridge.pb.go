@@ -175,8 +175,9 @@ type GetRsp struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Key   string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
-	Value string `protobuf:"bytes,2,opt,name=kv,proto3" json:"kv,omitempty"`
+	Key     string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Value   string `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+	Version uint64 `protobuf:"varint,3,opt,name=version,proto3" json:"version,omitempty"`
 }
 
 func (x *GetRsp) Reset() {
@@ -225,20 +226,134 @@ func (x *GetRsp) GetValue() string {
 	return ""
 }
 
+func (x *GetRsp) GetVersion() uint64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+// mode 0:value为相对的毫秒数 1:value为unix毫秒时间戳 2:保留原有的过期时间，设置后忽略 expire
+type Expiration struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Mode  int32 `protobuf:"varint,1,opt,name=mode,proto3" json:"mode,omitempty"`
+	Value int64 `protobuf:"varint,2,opt,name=value,proto3" json:"value,omitempty"`
+}
+
+func (x *Expiration) Reset() {
+	*x = Expiration{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_bridge_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Expiration) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Expiration) ProtoMessage() {}
+
+func (x *Expiration) ProtoReflect() protoreflect.Message {
+	mi := &file_bridge_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Expiration.ProtoReflect.Descriptor instead.
+func (*Expiration) Descriptor() ([]byte, []int) {
+	return file_bridge_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *Expiration) GetMode() int32 {
+	if x != nil {
+		return x.Mode
+	}
+	return 0
+}
+
+func (x *Expiration) GetValue() int64 {
+	if x != nil {
+		return x.Value
+	}
+	return 0
+}
+
+// 写请求带上时为 CAS 写入，key当前的修改版本等于 version 时才执行，0表示key必须不存在
+// 读取返回的 version 为值的修改版本，CAS 写入成功后返回写入后的版本
+type Precondition struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Version uint64 `protobuf:"varint,1,opt,name=version,proto3" json:"version,omitempty"`
+}
+
+func (x *Precondition) Reset() {
+	*x = Precondition{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_bridge_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Precondition) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Precondition) ProtoMessage() {}
+
+func (x *Precondition) ProtoReflect() protoreflect.Message {
+	mi := &file_bridge_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Precondition.ProtoReflect.Descriptor instead.
+func (*Precondition) Descriptor() ([]byte, []int) {
+	return file_bridge_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *Precondition) GetVersion() uint64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
 type PutReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Key    string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
-	Value  string `protobuf:"bytes,2,opt,name=kv,proto3" json:"kv,omitempty"`
-	Expire int64  `protobuf:"varint,3,opt,name=expire,proto3" json:"expire,omitempty"`
+	Key          string        `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Value        string        `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+	Expire       int64         `protobuf:"varint,3,opt,name=expire,proto3" json:"expire,omitempty"`
+	Expiration   *Expiration   `protobuf:"bytes,4,opt,name=expiration,proto3" json:"expiration,omitempty"`
+	Precondition *Precondition `protobuf:"bytes,5,opt,name=precondition,proto3" json:"precondition,omitempty"`
 }
 
 func (x *PutReq) Reset() {
 	*x = PutReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_bridge_proto_msgTypes[4]
+		mi := &file_bridge_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -251,7 +366,7 @@ func (x *PutReq) String() string {
 func (*PutReq) ProtoMessage() {}
 
 func (x *PutReq) ProtoReflect() protoreflect.Message {
-	mi := &file_bridge_proto_msgTypes[4]
+	mi := &file_bridge_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -264,7 +379,7 @@ func (x *PutReq) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PutReq.ProtoReflect.Descriptor instead.
 func (*PutReq) Descriptor() ([]byte, []int) {
-	return file_bridge_proto_rawDescGZIP(), []int{4}
+	return file_bridge_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *PutReq) GetKey() string {
@@ -288,20 +403,35 @@ func (x *PutReq) GetExpire() int64 {
 	return 0
 }
 
+func (x *PutReq) GetExpiration() *Expiration {
+	if x != nil {
+		return x.Expiration
+	}
+	return nil
+}
+
+func (x *PutReq) GetPrecondition() *Precondition {
+	if x != nil {
+		return x.Precondition
+	}
+	return nil
+}
+
 type PutRsp struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Key    string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
-	Value  string `protobuf:"bytes,2,opt,name=kv,proto3" json:"kv,omitempty"`
-	Expire int64  `protobuf:"varint,3,opt,name=expire,proto3" json:"expire,omitempty"`
+	Key     string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Value   string `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+	Expire  int64  `protobuf:"varint,3,opt,name=expire,proto3" json:"expire,omitempty"`
+	Version uint64 `protobuf:"varint,4,opt,name=version,proto3" json:"version,omitempty"`
 }
 
 func (x *PutRsp) Reset() {
 	*x = PutRsp{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_bridge_proto_msgTypes[5]
+		mi := &file_bridge_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -314,7 +444,7 @@ func (x *PutRsp) String() string {
 func (*PutRsp) ProtoMessage() {}
 
 func (x *PutRsp) ProtoReflect() protoreflect.Message {
-	mi := &file_bridge_proto_msgTypes[5]
+	mi := &file_bridge_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -327,7 +457,7 @@ func (x *PutRsp) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PutRsp.ProtoReflect.Descriptor instead.
 func (*PutRsp) Descriptor() ([]byte, []int) {
-	return file_bridge_proto_rawDescGZIP(), []int{5}
+	return file_bridge_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *PutRsp) GetKey() string {
@@ -351,18 +481,26 @@ func (x *PutRsp) GetExpire() int64 {
 	return 0
 }
 
+func (x *PutRsp) GetVersion() uint64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
 type DelReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Key string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Key          string        `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Precondition *Precondition `protobuf:"bytes,2,opt,name=precondition,proto3" json:"precondition,omitempty"`
 }
 
 func (x *DelReq) Reset() {
 	*x = DelReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_bridge_proto_msgTypes[6]
+		mi := &file_bridge_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -375,7 +513,7 @@ func (x *DelReq) String() string {
 func (*DelReq) ProtoMessage() {}
 
 func (x *DelReq) ProtoReflect() protoreflect.Message {
-	mi := &file_bridge_proto_msgTypes[6]
+	mi := &file_bridge_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -388,7 +526,7 @@ func (x *DelReq) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DelReq.ProtoReflect.Descriptor instead.
 func (*DelReq) Descriptor() ([]byte, []int) {
-	return file_bridge_proto_rawDescGZIP(), []int{6}
+	return file_bridge_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *DelReq) GetKey() string {
@@ -398,6 +536,13 @@ func (x *DelReq) GetKey() string {
 	return ""
 }
 
+func (x *DelReq) GetPrecondition() *Precondition {
+	if x != nil {
+		return x.Precondition
+	}
+	return nil
+}
+
 type DelRsp struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -409,7 +554,7 @@ type DelRsp struct {
 func (x *DelRsp) Reset() {
 	*x = DelRsp{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_bridge_proto_msgTypes[7]
+		mi := &file_bridge_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -422,7 +567,7 @@ func (x *DelRsp) String() string {
 func (*DelRsp) ProtoMessage() {}
 
 func (x *DelRsp) ProtoReflect() protoreflect.Message {
-	mi := &file_bridge_proto_msgTypes[7]
+	mi := &file_bridge_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -435,7 +580,7 @@ func (x *DelRsp) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DelRsp.ProtoReflect.Descriptor instead.
 func (*DelRsp) Descriptor() ([]byte, []int) {
-	return file_bridge_proto_rawDescGZIP(), []int{7}
+	return file_bridge_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *DelRsp) GetKey() string {
@@ -456,7 +601,7 @@ type PublishReq struct {
 func (x *PublishReq) Reset() {
 	*x = PublishReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_bridge_proto_msgTypes[8]
+		mi := &file_bridge_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -469,7 +614,7 @@ func (x *PublishReq) String() string {
 func (*PublishReq) ProtoMessage() {}
 
 func (x *PublishReq) ProtoReflect() protoreflect.Message {
-	mi := &file_bridge_proto_msgTypes[8]
+	mi := &file_bridge_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -482,7 +627,7 @@ func (x *PublishReq) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PublishReq.ProtoReflect.Descriptor instead.
 func (*PublishReq) Descriptor() ([]byte, []int) {
-	return file_bridge_proto_rawDescGZIP(), []int{8}
+	return file_bridge_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *PublishReq) GetTimestamp() int64 {
@@ -503,12 +648,14 @@ type PublishRsp struct {
 	AfterValue  string `protobuf:"bytes,4,opt,name=afterValue,proto3" json:"afterValue,omitempty"`
 	Type        int32  `protobuf:"varint,5,opt,name=type,proto3" json:"type,omitempty"`
 	DataType    int32  `protobuf:"varint,6,opt,name=dataType,proto3" json:"dataType,omitempty"`
+	Path        string `protobuf:"bytes,7,opt,name=path,proto3" json:"path,omitempty"`
+	Db          string `protobuf:"bytes,8,opt,name=db,proto3" json:"db,omitempty"`
 }
 
 func (x *PublishRsp) Reset() {
 	*x = PublishRsp{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_bridge_proto_msgTypes[9]
+		mi := &file_bridge_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -521,7 +668,7 @@ func (x *PublishRsp) String() string {
 func (*PublishRsp) ProtoMessage() {}
 
 func (x *PublishRsp) ProtoReflect() protoreflect.Message {
-	mi := &file_bridge_proto_msgTypes[9]
+	mi := &file_bridge_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -534,7 +681,7 @@ func (x *PublishRsp) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PublishRsp.ProtoReflect.Descriptor instead.
 func (*PublishRsp) Descriptor() ([]byte, []int) {
-	return file_bridge_proto_rawDescGZIP(), []int{9}
+	return file_bridge_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *PublishRsp) GetHmKey() string {
@@ -579,6 +726,20 @@ func (x *PublishRsp) GetDataType() int32 {
 	return 0
 }
 
+func (x *PublishRsp) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+func (x *PublishRsp) GetDb() string {
+	if x != nil {
+		return x.Db
+	}
+	return ""
+}
+
 type WatchReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -590,7 +751,7 @@ type WatchReq struct {
 func (x *WatchReq) Reset() {
 	*x = WatchReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_bridge_proto_msgTypes[10]
+		mi := &file_bridge_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -603,7 +764,7 @@ func (x *WatchReq) String() string {
 func (*WatchReq) ProtoMessage() {}
 
 func (x *WatchReq) ProtoReflect() protoreflect.Message {
-	mi := &file_bridge_proto_msgTypes[10]
+	mi := &file_bridge_proto_msgTypes[12]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -616,7 +777,7 @@ func (x *WatchReq) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use WatchReq.ProtoReflect.Descriptor instead.
 func (*WatchReq) Descriptor() ([]byte, []int) {
-	return file_bridge_proto_rawDescGZIP(), []int{10}
+	return file_bridge_proto_rawDescGZIP(), []int{12}
 }
 
 func (x *WatchReq) GetKey() string {
@@ -637,7 +798,7 @@ type WatchRsp struct {
 func (x *WatchRsp) Reset() {
 	*x = WatchRsp{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_bridge_proto_msgTypes[11]
+		mi := &file_bridge_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -650,7 +811,7 @@ func (x *WatchRsp) String() string {
 func (*WatchRsp) ProtoMessage() {}
 
 func (x *WatchRsp) ProtoReflect() protoreflect.Message {
-	mi := &file_bridge_proto_msgTypes[11]
+	mi := &file_bridge_proto_msgTypes[13]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -663,7 +824,7 @@ func (x *WatchRsp) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use WatchRsp.ProtoReflect.Descriptor instead.
 func (*WatchRsp) Descriptor() ([]byte, []int) {
-	return file_bridge_proto_rawDescGZIP(), []int{11}
+	return file_bridge_proto_rawDescGZIP(), []int{13}
 }
 
 func (x *WatchRsp) GetKey() string {
@@ -684,7 +845,7 @@ type HMGetReq struct {
 func (x *HMGetReq) Reset() {
 	*x = HMGetReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_bridge_proto_msgTypes[12]
+		mi := &file_bridge_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -697,7 +858,7 @@ func (x *HMGetReq) String() string {
 func (*HMGetReq) ProtoMessage() {}
 
 func (x *HMGetReq) ProtoReflect() protoreflect.Message {
-	mi := &file_bridge_proto_msgTypes[12]
+	mi := &file_bridge_proto_msgTypes[14]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -710,7 +871,7 @@ func (x *HMGetReq) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use HMGetReq.ProtoReflect.Descriptor instead.
 func (*HMGetReq) Descriptor() ([]byte, []int) {
-	return file_bridge_proto_rawDescGZIP(), []int{12}
+	return file_bridge_proto_rawDescGZIP(), []int{14}
 }
 
 func (x *HMGetReq) GetHmKey() string {
@@ -720,19 +881,22 @@ func (x *HMGetReq) GetHmKey() string {
 	return ""
 }
 
+// value 已废弃，不再返回，使用 fields
 type HMGetRsp struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	HmKey string `protobuf:"bytes,1,opt,name=hmKey,proto3" json:"hmKey,omitempty"`
-	Value string `protobuf:"bytes,2,opt,name=kv,proto3" json:"kv,omitempty"`
+	HmKey   string            `protobuf:"bytes,1,opt,name=hmKey,proto3" json:"hmKey,omitempty"`
+	Value   string            `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+	Fields  map[string]string `protobuf:"bytes,3,rep,name=fields,proto3" json:"fields,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Version uint64            `protobuf:"varint,4,opt,name=version,proto3" json:"version,omitempty"`
 }
 
 func (x *HMGetRsp) Reset() {
 	*x = HMGetRsp{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_bridge_proto_msgTypes[13]
+		mi := &file_bridge_proto_msgTypes[15]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -745,7 +909,7 @@ func (x *HMGetRsp) String() string {
 func (*HMGetRsp) ProtoMessage() {}
 
 func (x *HMGetRsp) ProtoReflect() protoreflect.Message {
-	mi := &file_bridge_proto_msgTypes[13]
+	mi := &file_bridge_proto_msgTypes[15]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -758,7 +922,7 @@ func (x *HMGetRsp) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use HMGetRsp.ProtoReflect.Descriptor instead.
 func (*HMGetRsp) Descriptor() ([]byte, []int) {
-	return file_bridge_proto_rawDescGZIP(), []int{13}
+	return file_bridge_proto_rawDescGZIP(), []int{15}
 }
 
 func (x *HMGetRsp) GetHmKey() string {
@@ -775,6 +939,20 @@ func (x *HMGetRsp) GetValue() string {
 	return ""
 }
 
+func (x *HMGetRsp) GetFields() map[string]string {
+	if x != nil {
+		return x.Fields
+	}
+	return nil
+}
+
+func (x *HMGetRsp) GetVersion() uint64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
 type HMGetMemberReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -787,7 +965,7 @@ type HMGetMemberReq struct {
 func (x *HMGetMemberReq) Reset() {
 	*x = HMGetMemberReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_bridge_proto_msgTypes[14]
+		mi := &file_bridge_proto_msgTypes[16]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -800,7 +978,7 @@ func (x *HMGetMemberReq) String() string {
 func (*HMGetMemberReq) ProtoMessage() {}
 
 func (x *HMGetMemberReq) ProtoReflect() protoreflect.Message {
-	mi := &file_bridge_proto_msgTypes[14]
+	mi := &file_bridge_proto_msgTypes[16]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -813,7 +991,7 @@ func (x *HMGetMemberReq) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use HMGetMemberReq.ProtoReflect.Descriptor instead.
 func (*HMGetMemberReq) Descriptor() ([]byte, []int) {
-	return file_bridge_proto_rawDescGZIP(), []int{14}
+	return file_bridge_proto_rawDescGZIP(), []int{16}
 }
 
 func (x *HMGetMemberReq) GetHmKey() string {
@@ -837,13 +1015,13 @@ type HMGetMemberRsp struct {
 
 	HmKey string `protobuf:"bytes,1,opt,name=hmKey,proto3" json:"hmKey,omitempty"`
 	Key   string `protobuf:"bytes,2,opt,name=key,proto3" json:"key,omitempty"`
-	Value string `protobuf:"bytes,3,opt,name=kv,proto3" json:"kv,omitempty"`
+	Value string `protobuf:"bytes,3,opt,name=value,proto3" json:"value,omitempty"`
 }
 
 func (x *HMGetMemberRsp) Reset() {
 	*x = HMGetMemberRsp{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_bridge_proto_msgTypes[15]
+		mi := &file_bridge_proto_msgTypes[17]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -856,7 +1034,7 @@ func (x *HMGetMemberRsp) String() string {
 func (*HMGetMemberRsp) ProtoMessage() {}
 
 func (x *HMGetMemberRsp) ProtoReflect() protoreflect.Message {
-	mi := &file_bridge_proto_msgTypes[15]
+	mi := &file_bridge_proto_msgTypes[17]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -869,7 +1047,7 @@ func (x *HMGetMemberRsp) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use HMGetMemberRsp.ProtoReflect.Descriptor instead.
 func (*HMGetMemberRsp) Descriptor() ([]byte, []int) {
-	return file_bridge_proto_rawDescGZIP(), []int{15}
+	return file_bridge_proto_rawDescGZIP(), []int{17}
 }
 
 func (x *HMGetMemberRsp) GetHmKey() string {
@@ -898,16 +1076,18 @@ type HMPutReq struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	HmKey  string   `protobuf:"bytes,1,opt,name=hmKey,proto3" json:"hmKey,omitempty"`
-	Key    []string `protobuf:"bytes,2,rep,name=key,proto3" json:"key,omitempty"`
-	Value  []string `protobuf:"bytes,3,rep,name=kv,proto3" json:"kv,omitempty"`
-	Expire int64    `protobuf:"varint,4,opt,name=expire,proto3" json:"expire,omitempty"`
+	HmKey        string        `protobuf:"bytes,1,opt,name=hmKey,proto3" json:"hmKey,omitempty"`
+	Key          []string      `protobuf:"bytes,2,rep,name=key,proto3" json:"key,omitempty"`
+	Value        []string      `protobuf:"bytes,3,rep,name=value,proto3" json:"value,omitempty"`
+	Expire       int64         `protobuf:"varint,4,opt,name=expire,proto3" json:"expire,omitempty"`
+	Expiration   *Expiration   `protobuf:"bytes,5,opt,name=expiration,proto3" json:"expiration,omitempty"`
+	Precondition *Precondition `protobuf:"bytes,6,opt,name=precondition,proto3" json:"precondition,omitempty"`
 }
 
 func (x *HMPutReq) Reset() {
 	*x = HMPutReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_bridge_proto_msgTypes[16]
+		mi := &file_bridge_proto_msgTypes[18]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -920,7 +1100,7 @@ func (x *HMPutReq) String() string {
 func (*HMPutReq) ProtoMessage() {}
 
 func (x *HMPutReq) ProtoReflect() protoreflect.Message {
-	mi := &file_bridge_proto_msgTypes[16]
+	mi := &file_bridge_proto_msgTypes[18]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -933,7 +1113,7 @@ func (x *HMPutReq) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use HMPutReq.ProtoReflect.Descriptor instead.
 func (*HMPutReq) Descriptor() ([]byte, []int) {
-	return file_bridge_proto_rawDescGZIP(), []int{16}
+	return file_bridge_proto_rawDescGZIP(), []int{18}
 }
 
 func (x *HMPutReq) GetHmKey() string {
@@ -964,21 +1144,36 @@ func (x *HMPutReq) GetExpire() int64 {
 	return 0
 }
 
+func (x *HMPutReq) GetExpiration() *Expiration {
+	if x != nil {
+		return x.Expiration
+	}
+	return nil
+}
+
+func (x *HMPutReq) GetPrecondition() *Precondition {
+	if x != nil {
+		return x.Precondition
+	}
+	return nil
+}
+
 type HMPutRsp struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	HmKey  string   `protobuf:"bytes,1,opt,name=hmKey,proto3" json:"hmKey,omitempty"`
-	Key    []string `protobuf:"bytes,2,rep,name=key,proto3" json:"key,omitempty"`
-	Value  []string `protobuf:"bytes,3,rep,name=kv,proto3" json:"kv,omitempty"`
-	Expire int64    `protobuf:"varint,4,opt,name=expire,proto3" json:"expire,omitempty"`
+	HmKey   string   `protobuf:"bytes,1,opt,name=hmKey,proto3" json:"hmKey,omitempty"`
+	Key     []string `protobuf:"bytes,2,rep,name=key,proto3" json:"key,omitempty"`
+	Value   []string `protobuf:"bytes,3,rep,name=value,proto3" json:"value,omitempty"`
+	Expire  int64    `protobuf:"varint,4,opt,name=expire,proto3" json:"expire,omitempty"`
+	Version uint64   `protobuf:"varint,5,opt,name=version,proto3" json:"version,omitempty"`
 }
 
 func (x *HMPutRsp) Reset() {
 	*x = HMPutRsp{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_bridge_proto_msgTypes[17]
+		mi := &file_bridge_proto_msgTypes[19]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -991,7 +1186,7 @@ func (x *HMPutRsp) String() string {
 func (*HMPutRsp) ProtoMessage() {}
 
 func (x *HMPutRsp) ProtoReflect() protoreflect.Message {
-	mi := &file_bridge_proto_msgTypes[17]
+	mi := &file_bridge_proto_msgTypes[19]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1004,7 +1199,7 @@ func (x *HMPutRsp) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use HMPutRsp.ProtoReflect.Descriptor instead.
 func (*HMPutRsp) Descriptor() ([]byte, []int) {
-	return file_bridge_proto_rawDescGZIP(), []int{17}
+	return file_bridge_proto_rawDescGZIP(), []int{19}
 }
 
 func (x *HMPutRsp) GetHmKey() string {
@@ -1035,18 +1230,26 @@ func (x *HMPutRsp) GetExpire() int64 {
 	return 0
 }
 
+func (x *HMPutRsp) GetVersion() uint64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
 type HMDelReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	HmKey string `protobuf:"bytes,1,opt,name=hmKey,proto3" json:"hmKey,omitempty"`
+	HmKey        string        `protobuf:"bytes,1,opt,name=hmKey,proto3" json:"hmKey,omitempty"`
+	Precondition *Precondition `protobuf:"bytes,2,opt,name=precondition,proto3" json:"precondition,omitempty"`
 }
 
 func (x *HMDelReq) Reset() {
 	*x = HMDelReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_bridge_proto_msgTypes[18]
+		mi := &file_bridge_proto_msgTypes[20]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1059,7 +1262,7 @@ func (x *HMDelReq) String() string {
 func (*HMDelReq) ProtoMessage() {}
 
 func (x *HMDelReq) ProtoReflect() protoreflect.Message {
-	mi := &file_bridge_proto_msgTypes[18]
+	mi := &file_bridge_proto_msgTypes[20]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1072,7 +1275,7 @@ func (x *HMDelReq) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use HMDelReq.ProtoReflect.Descriptor instead.
 func (*HMDelReq) Descriptor() ([]byte, []int) {
-	return file_bridge_proto_rawDescGZIP(), []int{18}
+	return file_bridge_proto_rawDescGZIP(), []int{20}
 }
 
 func (x *HMDelReq) GetHmKey() string {
@@ -1082,6 +1285,13 @@ func (x *HMDelReq) GetHmKey() string {
 	return ""
 }
 
+func (x *HMDelReq) GetPrecondition() *Precondition {
+	if x != nil {
+		return x.Precondition
+	}
+	return nil
+}
+
 type HMDelRsp struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -1093,7 +1303,7 @@ type HMDelRsp struct {
 func (x *HMDelRsp) Reset() {
 	*x = HMDelRsp{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_bridge_proto_msgTypes[19]
+		mi := &file_bridge_proto_msgTypes[21]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1106,7 +1316,7 @@ func (x *HMDelRsp) String() string {
 func (*HMDelRsp) ProtoMessage() {}
 
 func (x *HMDelRsp) ProtoReflect() protoreflect.Message {
-	mi := &file_bridge_proto_msgTypes[19]
+	mi := &file_bridge_proto_msgTypes[21]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1119,7 +1329,7 @@ func (x *HMDelRsp) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use HMDelRsp.ProtoReflect.Descriptor instead.
 func (*HMDelRsp) Descriptor() ([]byte, []int) {
-	return file_bridge_proto_rawDescGZIP(), []int{19}
+	return file_bridge_proto_rawDescGZIP(), []int{21}
 }
 
 func (x *HMDelRsp) GetHmKey() string {
@@ -1134,14 +1344,15 @@ type HMDelMemberReq struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	HmKey string `protobuf:"bytes,1,opt,name=hmKey,proto3" json:"hmKey,omitempty"`
-	Key   string `protobuf:"bytes,2,opt,name=key,proto3" json:"key,omitempty"`
+	HmKey        string        `protobuf:"bytes,1,opt,name=hmKey,proto3" json:"hmKey,omitempty"`
+	Key          string        `protobuf:"bytes,2,opt,name=key,proto3" json:"key,omitempty"`
+	Precondition *Precondition `protobuf:"bytes,3,opt,name=precondition,proto3" json:"precondition,omitempty"`
 }
 
 func (x *HMDelMemberReq) Reset() {
 	*x = HMDelMemberReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_bridge_proto_msgTypes[20]
+		mi := &file_bridge_proto_msgTypes[22]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1154,7 +1365,7 @@ func (x *HMDelMemberReq) String() string {
 func (*HMDelMemberReq) ProtoMessage() {}
 
 func (x *HMDelMemberReq) ProtoReflect() protoreflect.Message {
-	mi := &file_bridge_proto_msgTypes[20]
+	mi := &file_bridge_proto_msgTypes[22]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1167,7 +1378,7 @@ func (x *HMDelMemberReq) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use HMDelMemberReq.ProtoReflect.Descriptor instead.
 func (*HMDelMemberReq) Descriptor() ([]byte, []int) {
-	return file_bridge_proto_rawDescGZIP(), []int{20}
+	return file_bridge_proto_rawDescGZIP(), []int{22}
 }
 
 func (x *HMDelMemberReq) GetHmKey() string {
@@ -1184,19 +1395,27 @@ func (x *HMDelMemberReq) GetKey() string {
 	return ""
 }
 
-type HMDelMemberRsp struct {
+func (x *HMDelMemberReq) GetPrecondition() *Precondition {
+	if x != nil {
+		return x.Precondition
+	}
+	return nil
+}
+
+type HMDelMemberRsp struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	HmKey string `protobuf:"bytes,1,opt,name=hmKey,proto3" json:"hmKey,omitempty"`
-	Key   string `protobuf:"bytes,2,opt,name=key,proto3" json:"key,omitempty"`
+	HmKey   string `protobuf:"bytes,1,opt,name=hmKey,proto3" json:"hmKey,omitempty"`
+	Key     string `protobuf:"bytes,2,opt,name=key,proto3" json:"key,omitempty"`
+	Version uint64 `protobuf:"varint,3,opt,name=version,proto3" json:"version,omitempty"`
 }
 
 func (x *HMDelMemberRsp) Reset() {
 	*x = HMDelMemberRsp{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_bridge_proto_msgTypes[21]
+		mi := &file_bridge_proto_msgTypes[23]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1209,7 +1428,7 @@ func (x *HMDelMemberRsp) String() string {
 func (*HMDelMemberRsp) ProtoMessage() {}
 
 func (x *HMDelMemberRsp) ProtoReflect() protoreflect.Message {
-	mi := &file_bridge_proto_msgTypes[21]
+	mi := &file_bridge_proto_msgTypes[23]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1222,7 +1441,7 @@ func (x *HMDelMemberRsp) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use HMDelMemberRsp.ProtoReflect.Descriptor instead.
 func (*HMDelMemberRsp) Descriptor() ([]byte, []int) {
-	return file_bridge_proto_rawDescGZIP(), []int{21}
+	return file_bridge_proto_rawDescGZIP(), []int{23}
 }
 
 func (x *HMDelMemberRsp) GetHmKey() string {
@@ -1239,6 +1458,13 @@ func (x *HMDelMemberRsp) GetKey() string {
 	return ""
 }
 
+func (x *HMDelMemberRsp) GetVersion() uint64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
 type HMWatchReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -1251,7 +1477,7 @@ type HMWatchReq struct {
 func (x *HMWatchReq) Reset() {
 	*x = HMWatchReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_bridge_proto_msgTypes[22]
+		mi := &file_bridge_proto_msgTypes[24]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1264,7 +1490,7 @@ func (x *HMWatchReq) String() string {
 func (*HMWatchReq) ProtoMessage() {}
 
 func (x *HMWatchReq) ProtoReflect() protoreflect.Message {
-	mi := &file_bridge_proto_msgTypes[22]
+	mi := &file_bridge_proto_msgTypes[24]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1277,7 +1503,7 @@ func (x *HMWatchReq) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use HMWatchReq.ProtoReflect.Descriptor instead.
 func (*HMWatchReq) Descriptor() ([]byte, []int) {
-	return file_bridge_proto_rawDescGZIP(), []int{22}
+	return file_bridge_proto_rawDescGZIP(), []int{24}
 }
 
 func (x *HMWatchReq) GetHmKey() string {
@@ -1306,7 +1532,7 @@ type HMWatchRsp struct {
 func (x *HMWatchRsp) Reset() {
 	*x = HMWatchRsp{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_bridge_proto_msgTypes[23]
+		mi := &file_bridge_proto_msgTypes[25]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1319,7 +1545,7 @@ func (x *HMWatchRsp) String() string {
 func (*HMWatchRsp) ProtoMessage() {}
 
 func (x *HMWatchRsp) ProtoReflect() protoreflect.Message {
-	mi := &file_bridge_proto_msgTypes[23]
+	mi := &file_bridge_proto_msgTypes[25]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1332,7 +1558,7 @@ func (x *HMWatchRsp) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use HMWatchRsp.ProtoReflect.Descriptor instead.
 func (*HMWatchRsp) Descriptor() ([]byte, []int) {
-	return file_bridge_proto_rawDescGZIP(), []int{23}
+	return file_bridge_proto_rawDescGZIP(), []int{25}
 }
 
 func (x *HMWatchRsp) GetHmKey() string {
@@ -1360,7 +1586,7 @@ type LGetReq struct {
 func (x *LGetReq) Reset() {
 	*x = LGetReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_bridge_proto_msgTypes[24]
+		mi := &file_bridge_proto_msgTypes[26]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1373,7 +1599,7 @@ func (x *LGetReq) String() string {
 func (*LGetReq) ProtoMessage() {}
 
 func (x *LGetReq) ProtoReflect() protoreflect.Message {
-	mi := &file_bridge_proto_msgTypes[24]
+	mi := &file_bridge_proto_msgTypes[26]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1386,7 +1612,7 @@ func (x *LGetReq) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use LGetReq.ProtoReflect.Descriptor instead.
 func (*LGetReq) Descriptor() ([]byte, []int) {
-	return file_bridge_proto_rawDescGZIP(), []int{24}
+	return file_bridge_proto_rawDescGZIP(), []int{26}
 }
 
 func (x *LGetReq) GetKey() string {
@@ -1401,14 +1627,15 @@ type LGetRsp struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Key   string   `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
-	Value []string `protobuf:"bytes,2,rep,name=kv,proto3" json:"kv,omitempty"`
+	Key     string   `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Value   []string `protobuf:"bytes,2,rep,name=value,proto3" json:"value,omitempty"`
+	Version uint64   `protobuf:"varint,3,opt,name=version,proto3" json:"version,omitempty"`
 }
 
 func (x *LGetRsp) Reset() {
 	*x = LGetRsp{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_bridge_proto_msgTypes[25]
+		mi := &file_bridge_proto_msgTypes[27]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1421,7 +1648,7 @@ func (x *LGetRsp) String() string {
 func (*LGetRsp) ProtoMessage() {}
 
 func (x *LGetRsp) ProtoReflect() protoreflect.Message {
-	mi := &file_bridge_proto_msgTypes[25]
+	mi := &file_bridge_proto_msgTypes[27]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1434,7 +1661,7 @@ func (x *LGetRsp) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use LGetRsp.ProtoReflect.Descriptor instead.
 func (*LGetRsp) Descriptor() ([]byte, []int) {
-	return file_bridge_proto_rawDescGZIP(), []int{25}
+	return file_bridge_proto_rawDescGZIP(), []int{27}
 }
 
 func (x *LGetRsp) GetKey() string {
@@ -1451,6 +1678,13 @@ func (x *LGetRsp) GetValue() []string {
 	return nil
 }
 
+func (x *LGetRsp) GetVersion() uint64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
 type LGetRangeReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -1464,7 +1698,7 @@ type LGetRangeReq struct {
 func (x *LGetRangeReq) Reset() {
 	*x = LGetRangeReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_bridge_proto_msgTypes[26]
+		mi := &file_bridge_proto_msgTypes[28]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1477,7 +1711,7 @@ func (x *LGetRangeReq) String() string {
 func (*LGetRangeReq) ProtoMessage() {}
 
 func (x *LGetRangeReq) ProtoReflect() protoreflect.Message {
-	mi := &file_bridge_proto_msgTypes[26]
+	mi := &file_bridge_proto_msgTypes[28]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1490,7 +1724,7 @@ func (x *LGetRangeReq) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use LGetRangeReq.ProtoReflect.Descriptor instead.
 func (*LGetRangeReq) Descriptor() ([]byte, []int) {
-	return file_bridge_proto_rawDescGZIP(), []int{26}
+	return file_bridge_proto_rawDescGZIP(), []int{28}
 }
 
 func (x *LGetRangeReq) GetKey() string {
@@ -1520,13 +1754,13 @@ type LGetRangeRsp struct {
 	unknownFields protoimpl.UnknownFields
 
 	Key   string   `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
-	Value []string `protobuf:"bytes,2,rep,name=kv,proto3" json:"kv,omitempty"`
+	Value []string `protobuf:"bytes,2,rep,name=value,proto3" json:"value,omitempty"`
 }
 
 func (x *LGetRangeRsp) Reset() {
 	*x = LGetRangeRsp{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_bridge_proto_msgTypes[27]
+		mi := &file_bridge_proto_msgTypes[29]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1539,7 +1773,7 @@ func (x *LGetRangeRsp) String() string {
 func (*LGetRangeRsp) ProtoMessage() {}
 
 func (x *LGetRangeRsp) ProtoReflect() protoreflect.Message {
-	mi := &file_bridge_proto_msgTypes[27]
+	mi := &file_bridge_proto_msgTypes[29]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1552,7 +1786,7 @@ func (x *LGetRangeRsp) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use LGetRangeRsp.ProtoReflect.Descriptor instead.
 func (*LGetRangeRsp) Descriptor() ([]byte, []int) {
-	return file_bridge_proto_rawDescGZIP(), []int{27}
+	return file_bridge_proto_rawDescGZIP(), []int{29}
 }
 
 func (x *LGetRangeRsp) GetKey() string {
@@ -1574,15 +1808,17 @@ type LPutReq struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Key    string   `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
-	Value  []string `protobuf:"bytes,2,rep,name=kv,proto3" json:"kv,omitempty"`
-	Expire int64    `protobuf:"varint,3,opt,name=expire,proto3" json:"expire,omitempty"`
+	Key          string        `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Value        []string      `protobuf:"bytes,2,rep,name=value,proto3" json:"value,omitempty"`
+	Expire       int64         `protobuf:"varint,3,opt,name=expire,proto3" json:"expire,omitempty"`
+	Expiration   *Expiration   `protobuf:"bytes,4,opt,name=expiration,proto3" json:"expiration,omitempty"`
+	Precondition *Precondition `protobuf:"bytes,5,opt,name=precondition,proto3" json:"precondition,omitempty"`
 }
 
 func (x *LPutReq) Reset() {
 	*x = LPutReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_bridge_proto_msgTypes[28]
+		mi := &file_bridge_proto_msgTypes[30]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1595,7 +1831,7 @@ func (x *LPutReq) String() string {
 func (*LPutReq) ProtoMessage() {}
 
 func (x *LPutReq) ProtoReflect() protoreflect.Message {
-	mi := &file_bridge_proto_msgTypes[28]
+	mi := &file_bridge_proto_msgTypes[30]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1608,7 +1844,7 @@ func (x *LPutReq) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use LPutReq.ProtoReflect.Descriptor instead.
 func (*LPutReq) Descriptor() ([]byte, []int) {
-	return file_bridge_proto_rawDescGZIP(), []int{28}
+	return file_bridge_proto_rawDescGZIP(), []int{30}
 }
 
 func (x *LPutReq) GetKey() string {
@@ -1632,20 +1868,35 @@ func (x *LPutReq) GetExpire() int64 {
 	return 0
 }
 
+func (x *LPutReq) GetExpiration() *Expiration {
+	if x != nil {
+		return x.Expiration
+	}
+	return nil
+}
+
+func (x *LPutReq) GetPrecondition() *Precondition {
+	if x != nil {
+		return x.Precondition
+	}
+	return nil
+}
+
 type LPutRsp struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Key    string   `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
-	Value  []string `protobuf:"bytes,2,rep,name=kv,proto3" json:"kv,omitempty"`
-	Expire int64    `protobuf:"varint,3,opt,name=expire,proto3" json:"expire,omitempty"`
+	Key     string   `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Value   []string `protobuf:"bytes,2,rep,name=value,proto3" json:"value,omitempty"`
+	Expire  int64    `protobuf:"varint,3,opt,name=expire,proto3" json:"expire,omitempty"`
+	Version uint64   `protobuf:"varint,4,opt,name=version,proto3" json:"version,omitempty"`
 }
 
 func (x *LPutRsp) Reset() {
 	*x = LPutRsp{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_bridge_proto_msgTypes[29]
+		mi := &file_bridge_proto_msgTypes[31]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1658,7 +1909,7 @@ func (x *LPutRsp) String() string {
 func (*LPutRsp) ProtoMessage() {}
 
 func (x *LPutRsp) ProtoReflect() protoreflect.Message {
-	mi := &file_bridge_proto_msgTypes[29]
+	mi := &file_bridge_proto_msgTypes[31]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1671,7 +1922,7 @@ func (x *LPutRsp) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use LPutRsp.ProtoReflect.Descriptor instead.
 func (*LPutRsp) Descriptor() ([]byte, []int) {
-	return file_bridge_proto_rawDescGZIP(), []int{29}
+	return file_bridge_proto_rawDescGZIP(), []int{31}
 }
 
 func (x *LPutRsp) GetKey() string {
@@ -1695,18 +1946,26 @@ func (x *LPutRsp) GetExpire() int64 {
 	return 0
 }
 
+func (x *LPutRsp) GetVersion() uint64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
 type LDelReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Key string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Key          string        `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Precondition *Precondition `protobuf:"bytes,2,opt,name=precondition,proto3" json:"precondition,omitempty"`
 }
 
 func (x *LDelReq) Reset() {
 	*x = LDelReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_bridge_proto_msgTypes[30]
+		mi := &file_bridge_proto_msgTypes[32]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1719,7 +1978,7 @@ func (x *LDelReq) String() string {
 func (*LDelReq) ProtoMessage() {}
 
 func (x *LDelReq) ProtoReflect() protoreflect.Message {
-	mi := &file_bridge_proto_msgTypes[30]
+	mi := &file_bridge_proto_msgTypes[32]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1732,7 +1991,7 @@ func (x *LDelReq) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use LDelReq.ProtoReflect.Descriptor instead.
 func (*LDelReq) Descriptor() ([]byte, []int) {
-	return file_bridge_proto_rawDescGZIP(), []int{30}
+	return file_bridge_proto_rawDescGZIP(), []int{32}
 }
 
 func (x *LDelReq) GetKey() string {
@@ -1742,6 +2001,13 @@ func (x *LDelReq) GetKey() string {
 	return ""
 }
 
+func (x *LDelReq) GetPrecondition() *Precondition {
+	if x != nil {
+		return x.Precondition
+	}
+	return nil
+}
+
 type LDelRsp struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -1753,7 +2019,7 @@ type LDelRsp struct {
 func (x *LDelRsp) Reset() {
 	*x = LDelRsp{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_bridge_proto_msgTypes[31]
+		mi := &file_bridge_proto_msgTypes[33]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1766,7 +2032,7 @@ func (x *LDelRsp) String() string {
 func (*LDelRsp) ProtoMessage() {}
 
 func (x *LDelRsp) ProtoReflect() protoreflect.Message {
-	mi := &file_bridge_proto_msgTypes[31]
+	mi := &file_bridge_proto_msgTypes[33]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1779,7 +2045,7 @@ func (x *LDelRsp) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use LDelRsp.ProtoReflect.Descriptor instead.
 func (*LDelRsp) Descriptor() ([]byte, []int) {
-	return file_bridge_proto_rawDescGZIP(), []int{31}
+	return file_bridge_proto_rawDescGZIP(), []int{33}
 }
 
 func (x *LDelRsp) GetKey() string {
@@ -1794,15 +2060,16 @@ type LDelRangeReq struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Key      string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
-	BegIndex int32  `protobuf:"varint,2,opt,name=begIndex,proto3" json:"begIndex,omitempty"`
-	EndIndex int32  `protobuf:"varint,3,opt,name=endIndex,proto3" json:"endIndex,omitempty"`
+	Key          string        `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	BegIndex     int32         `protobuf:"varint,2,opt,name=begIndex,proto3" json:"begIndex,omitempty"`
+	EndIndex     int32         `protobuf:"varint,3,opt,name=endIndex,proto3" json:"endIndex,omitempty"`
+	Precondition *Precondition `protobuf:"bytes,4,opt,name=precondition,proto3" json:"precondition,omitempty"`
 }
 
 func (x *LDelRangeReq) Reset() {
 	*x = LDelRangeReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_bridge_proto_msgTypes[32]
+		mi := &file_bridge_proto_msgTypes[34]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1815,7 +2082,7 @@ func (x *LDelRangeReq) String() string {
 func (*LDelRangeReq) ProtoMessage() {}
 
 func (x *LDelRangeReq) ProtoReflect() protoreflect.Message {
-	mi := &file_bridge_proto_msgTypes[32]
+	mi := &file_bridge_proto_msgTypes[34]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1828,7 +2095,7 @@ func (x *LDelRangeReq) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use LDelRangeReq.ProtoReflect.Descriptor instead.
 func (*LDelRangeReq) Descriptor() ([]byte, []int) {
-	return file_bridge_proto_rawDescGZIP(), []int{32}
+	return file_bridge_proto_rawDescGZIP(), []int{34}
 }
 
 func (x *LDelRangeReq) GetKey() string {
@@ -1852,18 +2119,26 @@ func (x *LDelRangeReq) GetEndIndex() int32 {
 	return 0
 }
 
+func (x *LDelRangeReq) GetPrecondition() *Precondition {
+	if x != nil {
+		return x.Precondition
+	}
+	return nil
+}
+
 type LDelRangeRsp struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Key string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Key     string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Version uint64 `protobuf:"varint,2,opt,name=version,proto3" json:"version,omitempty"`
 }
 
 func (x *LDelRangeRsp) Reset() {
 	*x = LDelRangeRsp{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_bridge_proto_msgTypes[33]
+		mi := &file_bridge_proto_msgTypes[35]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1876,7 +2151,7 @@ func (x *LDelRangeRsp) String() string {
 func (*LDelRangeRsp) ProtoMessage() {}
 
 func (x *LDelRangeRsp) ProtoReflect() protoreflect.Message {
-	mi := &file_bridge_proto_msgTypes[33]
+	mi := &file_bridge_proto_msgTypes[35]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1889,7 +2164,7 @@ func (x *LDelRangeRsp) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use LDelRangeRsp.ProtoReflect.Descriptor instead.
 func (*LDelRangeRsp) Descriptor() ([]byte, []int) {
-	return file_bridge_proto_rawDescGZIP(), []int{33}
+	return file_bridge_proto_rawDescGZIP(), []int{35}
 }
 
 func (x *LDelRangeRsp) GetKey() string {
@@ -1899,6 +2174,13 @@ func (x *LDelRangeRsp) GetKey() string {
 	return ""
 }
 
+func (x *LDelRangeRsp) GetVersion() uint64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
 type LWatchReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -1910,7 +2192,7 @@ type LWatchReq struct {
 func (x *LWatchReq) Reset() {
 	*x = LWatchReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_bridge_proto_msgTypes[34]
+		mi := &file_bridge_proto_msgTypes[36]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1923,7 +2205,7 @@ func (x *LWatchReq) String() string {
 func (*LWatchReq) ProtoMessage() {}
 
 func (x *LWatchReq) ProtoReflect() protoreflect.Message {
-	mi := &file_bridge_proto_msgTypes[34]
+	mi := &file_bridge_proto_msgTypes[36]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1936,7 +2218,7 @@ func (x *LWatchReq) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use LWatchReq.ProtoReflect.Descriptor instead.
 func (*LWatchReq) Descriptor() ([]byte, []int) {
-	return file_bridge_proto_rawDescGZIP(), []int{34}
+	return file_bridge_proto_rawDescGZIP(), []int{36}
 }
 
 func (x *LWatchReq) GetKey() string {
@@ -1957,7 +2239,7 @@ type LWatchRsp struct {
 func (x *LWatchRsp) Reset() {
 	*x = LWatchRsp{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_bridge_proto_msgTypes[35]
+		mi := &file_bridge_proto_msgTypes[37]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1970,7 +2252,7 @@ func (x *LWatchRsp) String() string {
 func (*LWatchRsp) ProtoMessage() {}
 
 func (x *LWatchRsp) ProtoReflect() protoreflect.Message {
-	mi := &file_bridge_proto_msgTypes[35]
+	mi := &file_bridge_proto_msgTypes[37]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1983,7 +2265,7 @@ func (x *LWatchRsp) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use LWatchRsp.ProtoReflect.Descriptor instead.
 func (*LWatchRsp) Descriptor() ([]byte, []int) {
-	return file_bridge_proto_rawDescGZIP(), []int{35}
+	return file_bridge_proto_rawDescGZIP(), []int{37}
 }
 
 func (x *LWatchRsp) GetKey() string {
@@ -2004,7 +2286,7 @@ type SGetReq struct {
 func (x *SGetReq) Reset() {
 	*x = SGetReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_bridge_proto_msgTypes[36]
+		mi := &file_bridge_proto_msgTypes[38]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2017,7 +2299,7 @@ func (x *SGetReq) String() string {
 func (*SGetReq) ProtoMessage() {}
 
 func (x *SGetReq) ProtoReflect() protoreflect.Message {
-	mi := &file_bridge_proto_msgTypes[36]
+	mi := &file_bridge_proto_msgTypes[38]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2030,7 +2312,7 @@ func (x *SGetReq) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SGetReq.ProtoReflect.Descriptor instead.
 func (*SGetReq) Descriptor() ([]byte, []int) {
-	return file_bridge_proto_rawDescGZIP(), []int{36}
+	return file_bridge_proto_rawDescGZIP(), []int{38}
 }
 
 func (x *SGetReq) GetKey() string {
@@ -2045,14 +2327,15 @@ type SGetRsp struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Key   string   `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
-	Value []string `protobuf:"bytes,2,rep,name=kv,proto3" json:"kv,omitempty"`
+	Key     string   `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Value   []string `protobuf:"bytes,2,rep,name=value,proto3" json:"value,omitempty"`
+	Version uint64   `protobuf:"varint,3,opt,name=version,proto3" json:"version,omitempty"`
 }
 
 func (x *SGetRsp) Reset() {
 	*x = SGetRsp{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_bridge_proto_msgTypes[37]
+		mi := &file_bridge_proto_msgTypes[39]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2065,7 +2348,7 @@ func (x *SGetRsp) String() string {
 func (*SGetRsp) ProtoMessage() {}
 
 func (x *SGetRsp) ProtoReflect() protoreflect.Message {
-	mi := &file_bridge_proto_msgTypes[37]
+	mi := &file_bridge_proto_msgTypes[39]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2078,7 +2361,7 @@ func (x *SGetRsp) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SGetRsp.ProtoReflect.Descriptor instead.
 func (*SGetRsp) Descriptor() ([]byte, []int) {
-	return file_bridge_proto_rawDescGZIP(), []int{37}
+	return file_bridge_proto_rawDescGZIP(), []int{39}
 }
 
 func (x *SGetRsp) GetKey() string {
@@ -2095,20 +2378,29 @@ func (x *SGetRsp) GetValue() []string {
 	return nil
 }
 
+func (x *SGetRsp) GetVersion() uint64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
 type SPutReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Key    string   `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
-	Value  []string `protobuf:"bytes,2,rep,name=kv,proto3" json:"kv,omitempty"`
-	Expire int64    `protobuf:"varint,3,opt,name=expire,proto3" json:"expire,omitempty"`
+	Key          string        `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Value        []string      `protobuf:"bytes,2,rep,name=value,proto3" json:"value,omitempty"`
+	Expire       int64         `protobuf:"varint,3,opt,name=expire,proto3" json:"expire,omitempty"`
+	Expiration   *Expiration   `protobuf:"bytes,4,opt,name=expiration,proto3" json:"expiration,omitempty"`
+	Precondition *Precondition `protobuf:"bytes,5,opt,name=precondition,proto3" json:"precondition,omitempty"`
 }
 
 func (x *SPutReq) Reset() {
 	*x = SPutReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_bridge_proto_msgTypes[38]
+		mi := &file_bridge_proto_msgTypes[40]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2121,7 +2413,7 @@ func (x *SPutReq) String() string {
 func (*SPutReq) ProtoMessage() {}
 
 func (x *SPutReq) ProtoReflect() protoreflect.Message {
-	mi := &file_bridge_proto_msgTypes[38]
+	mi := &file_bridge_proto_msgTypes[40]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2134,7 +2426,7 @@ func (x *SPutReq) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SPutReq.ProtoReflect.Descriptor instead.
 func (*SPutReq) Descriptor() ([]byte, []int) {
-	return file_bridge_proto_rawDescGZIP(), []int{38}
+	return file_bridge_proto_rawDescGZIP(), []int{40}
 }
 
 func (x *SPutReq) GetKey() string {
@@ -2158,20 +2450,35 @@ func (x *SPutReq) GetExpire() int64 {
 	return 0
 }
 
+func (x *SPutReq) GetExpiration() *Expiration {
+	if x != nil {
+		return x.Expiration
+	}
+	return nil
+}
+
+func (x *SPutReq) GetPrecondition() *Precondition {
+	if x != nil {
+		return x.Precondition
+	}
+	return nil
+}
+
 type SPutRsp struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Key    string   `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
-	Value  []string `protobuf:"bytes,2,rep,name=kv,proto3" json:"kv,omitempty"`
-	Expire int64    `protobuf:"varint,3,opt,name=expire,proto3" json:"expire,omitempty"`
+	Key     string   `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Value   []string `protobuf:"bytes,2,rep,name=value,proto3" json:"value,omitempty"`
+	Expire  int64    `protobuf:"varint,3,opt,name=expire,proto3" json:"expire,omitempty"`
+	Version uint64   `protobuf:"varint,4,opt,name=version,proto3" json:"version,omitempty"`
 }
 
 func (x *SPutRsp) Reset() {
 	*x = SPutRsp{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_bridge_proto_msgTypes[39]
+		mi := &file_bridge_proto_msgTypes[41]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2184,7 +2491,7 @@ func (x *SPutRsp) String() string {
 func (*SPutRsp) ProtoMessage() {}
 
 func (x *SPutRsp) ProtoReflect() protoreflect.Message {
-	mi := &file_bridge_proto_msgTypes[39]
+	mi := &file_bridge_proto_msgTypes[41]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2197,7 +2504,7 @@ func (x *SPutRsp) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SPutRsp.ProtoReflect.Descriptor instead.
 func (*SPutRsp) Descriptor() ([]byte, []int) {
-	return file_bridge_proto_rawDescGZIP(), []int{39}
+	return file_bridge_proto_rawDescGZIP(), []int{41}
 }
 
 func (x *SPutRsp) GetKey() string {
@@ -2221,18 +2528,26 @@ func (x *SPutRsp) GetExpire() int64 {
 	return 0
 }
 
+func (x *SPutRsp) GetVersion() uint64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
 type SDelReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Key string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Key          string        `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Precondition *Precondition `protobuf:"bytes,2,opt,name=precondition,proto3" json:"precondition,omitempty"`
 }
 
 func (x *SDelReq) Reset() {
 	*x = SDelReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_bridge_proto_msgTypes[40]
+		mi := &file_bridge_proto_msgTypes[42]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2245,7 +2560,7 @@ func (x *SDelReq) String() string {
 func (*SDelReq) ProtoMessage() {}
 
 func (x *SDelReq) ProtoReflect() protoreflect.Message {
-	mi := &file_bridge_proto_msgTypes[40]
+	mi := &file_bridge_proto_msgTypes[42]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2258,7 +2573,7 @@ func (x *SDelReq) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SDelReq.ProtoReflect.Descriptor instead.
 func (*SDelReq) Descriptor() ([]byte, []int) {
-	return file_bridge_proto_rawDescGZIP(), []int{40}
+	return file_bridge_proto_rawDescGZIP(), []int{42}
 }
 
 func (x *SDelReq) GetKey() string {
@@ -2268,6 +2583,13 @@ func (x *SDelReq) GetKey() string {
 	return ""
 }
 
+func (x *SDelReq) GetPrecondition() *Precondition {
+	if x != nil {
+		return x.Precondition
+	}
+	return nil
+}
+
 type SDelRsp struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -2279,7 +2601,7 @@ type SDelRsp struct {
 func (x *SDelRsp) Reset() {
 	*x = SDelRsp{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_bridge_proto_msgTypes[41]
+		mi := &file_bridge_proto_msgTypes[43]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2292,7 +2614,7 @@ func (x *SDelRsp) String() string {
 func (*SDelRsp) ProtoMessage() {}
 
 func (x *SDelRsp) ProtoReflect() protoreflect.Message {
-	mi := &file_bridge_proto_msgTypes[41]
+	mi := &file_bridge_proto_msgTypes[43]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2305,7 +2627,7 @@ func (x *SDelRsp) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SDelRsp.ProtoReflect.Descriptor instead.
 func (*SDelRsp) Descriptor() ([]byte, []int) {
-	return file_bridge_proto_rawDescGZIP(), []int{41}
+	return file_bridge_proto_rawDescGZIP(), []int{43}
 }
 
 func (x *SDelRsp) GetKey() string {
@@ -2320,14 +2642,15 @@ type SDelMemberReq struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Key   string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
-	Value string `protobuf:"bytes,2,opt,name=kv,proto3" json:"kv,omitempty"`
+	Key          string        `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Value        string        `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+	Precondition *Precondition `protobuf:"bytes,3,opt,name=precondition,proto3" json:"precondition,omitempty"`
 }
 
 func (x *SDelMemberReq) Reset() {
 	*x = SDelMemberReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_bridge_proto_msgTypes[42]
+		mi := &file_bridge_proto_msgTypes[44]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2340,7 +2663,7 @@ func (x *SDelMemberReq) String() string {
 func (*SDelMemberReq) ProtoMessage() {}
 
 func (x *SDelMemberReq) ProtoReflect() protoreflect.Message {
-	mi := &file_bridge_proto_msgTypes[42]
+	mi := &file_bridge_proto_msgTypes[44]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2353,7 +2676,7 @@ func (x *SDelMemberReq) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SDelMemberReq.ProtoReflect.Descriptor instead.
 func (*SDelMemberReq) Descriptor() ([]byte, []int) {
-	return file_bridge_proto_rawDescGZIP(), []int{42}
+	return file_bridge_proto_rawDescGZIP(), []int{44}
 }
 
 func (x *SDelMemberReq) GetKey() string {
@@ -2370,19 +2693,27 @@ func (x *SDelMemberReq) GetValue() string {
 	return ""
 }
 
+func (x *SDelMemberReq) GetPrecondition() *Precondition {
+	if x != nil {
+		return x.Precondition
+	}
+	return nil
+}
+
 type SDelMemberRsp struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Key   string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
-	Value string `protobuf:"bytes,2,opt,name=kv,proto3" json:"kv,omitempty"`
+	Key     string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Value   string `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+	Version uint64 `protobuf:"varint,3,opt,name=version,proto3" json:"version,omitempty"`
 }
 
 func (x *SDelMemberRsp) Reset() {
 	*x = SDelMemberRsp{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_bridge_proto_msgTypes[43]
+		mi := &file_bridge_proto_msgTypes[45]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2395,7 +2726,7 @@ func (x *SDelMemberRsp) String() string {
 func (*SDelMemberRsp) ProtoMessage() {}
 
 func (x *SDelMemberRsp) ProtoReflect() protoreflect.Message {
-	mi := &file_bridge_proto_msgTypes[43]
+	mi := &file_bridge_proto_msgTypes[45]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2408,7 +2739,7 @@ func (x *SDelMemberRsp) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SDelMemberRsp.ProtoReflect.Descriptor instead.
 func (*SDelMemberRsp) Descriptor() ([]byte, []int) {
-	return file_bridge_proto_rawDescGZIP(), []int{43}
+	return file_bridge_proto_rawDescGZIP(), []int{45}
 }
 
 func (x *SDelMemberRsp) GetKey() string {
@@ -2425,6 +2756,13 @@ func (x *SDelMemberRsp) GetValue() string {
 	return ""
 }
 
+func (x *SDelMemberRsp) GetVersion() uint64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
 type SWatchReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -2436,7 +2774,7 @@ type SWatchReq struct {
 func (x *SWatchReq) Reset() {
 	*x = SWatchReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_bridge_proto_msgTypes[44]
+		mi := &file_bridge_proto_msgTypes[46]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2449,7 +2787,7 @@ func (x *SWatchReq) String() string {
 func (*SWatchReq) ProtoMessage() {}
 
 func (x *SWatchReq) ProtoReflect() protoreflect.Message {
-	mi := &file_bridge_proto_msgTypes[44]
+	mi := &file_bridge_proto_msgTypes[46]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2462,7 +2800,7 @@ func (x *SWatchReq) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SWatchReq.ProtoReflect.Descriptor instead.
 func (*SWatchReq) Descriptor() ([]byte, []int) {
-	return file_bridge_proto_rawDescGZIP(), []int{44}
+	return file_bridge_proto_rawDescGZIP(), []int{46}
 }
 
 func (x *SWatchReq) GetKey() string {
@@ -2483,7 +2821,7 @@ type SWatchRsp struct {
 func (x *SWatchRsp) Reset() {
 	*x = SWatchRsp{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_bridge_proto_msgTypes[45]
+		mi := &file_bridge_proto_msgTypes[47]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2496,7 +2834,7 @@ func (x *SWatchRsp) String() string {
 func (*SWatchRsp) ProtoMessage() {}
 
 func (x *SWatchRsp) ProtoReflect() protoreflect.Message {
-	mi := &file_bridge_proto_msgTypes[45]
+	mi := &file_bridge_proto_msgTypes[47]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2509,7 +2847,7 @@ func (x *SWatchRsp) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SWatchRsp.ProtoReflect.Descriptor instead.
 func (*SWatchRsp) Descriptor() ([]byte, []int) {
-	return file_bridge_proto_rawDescGZIP(), []int{45}
+	return file_bridge_proto_rawDescGZIP(), []int{47}
 }
 
 func (x *SWatchRsp) GetKey() string {
@@ -2519,29 +2857,35 @@ func (x *SWatchRsp) GetKey() string {
 	return ""
 }
 
-type ClearReq struct {
+// 没有设置 expire 和 expiration 时保留key原有的过期时间
+type PFAddReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	Key        string      `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Value      []string    `protobuf:"bytes,2,rep,name=value,proto3" json:"value,omitempty"`
+	Expire     int64       `protobuf:"varint,3,opt,name=expire,proto3" json:"expire,omitempty"`
+	Expiration *Expiration `protobuf:"bytes,4,opt,name=expiration,proto3" json:"expiration,omitempty"`
 }
 
-func (x *ClearReq) Reset() {
-	*x = ClearReq{}
+func (x *PFAddReq) Reset() {
+	*x = PFAddReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_bridge_proto_msgTypes[46]
+		mi := &file_bridge_proto_msgTypes[48]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ClearReq) String() string {
+func (x *PFAddReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ClearReq) ProtoMessage() {}
+func (*PFAddReq) ProtoMessage() {}
 
-func (x *ClearReq) ProtoReflect() protoreflect.Message {
-	mi := &file_bridge_proto_msgTypes[46]
+func (x *PFAddReq) ProtoReflect() protoreflect.Message {
+	mi := &file_bridge_proto_msgTypes[48]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2552,34 +2896,65 @@ func (x *ClearReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ClearReq.ProtoReflect.Descriptor instead.
-func (*ClearReq) Descriptor() ([]byte, []int) {
-	return file_bridge_proto_rawDescGZIP(), []int{46}
+// Deprecated: Use PFAddReq.ProtoReflect.Descriptor instead.
+func (*PFAddReq) Descriptor() ([]byte, []int) {
+	return file_bridge_proto_rawDescGZIP(), []int{48}
 }
 
-type ClearRsp struct {
+func (x *PFAddReq) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *PFAddReq) GetValue() []string {
+	if x != nil {
+		return x.Value
+	}
+	return nil
+}
+
+func (x *PFAddReq) GetExpire() int64 {
+	if x != nil {
+		return x.Expire
+	}
+	return 0
+}
+
+func (x *PFAddReq) GetExpiration() *Expiration {
+	if x != nil {
+		return x.Expiration
+	}
+	return nil
+}
+
+type PFAddRsp struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	Key     string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Changed bool   `protobuf:"varint,2,opt,name=changed,proto3" json:"changed,omitempty"`
 }
 
-func (x *ClearRsp) Reset() {
-	*x = ClearRsp{}
+func (x *PFAddRsp) Reset() {
+	*x = PFAddRsp{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_bridge_proto_msgTypes[47]
+		mi := &file_bridge_proto_msgTypes[49]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ClearRsp) String() string {
+func (x *PFAddRsp) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ClearRsp) ProtoMessage() {}
+func (*PFAddRsp) ProtoMessage() {}
 
-func (x *ClearRsp) ProtoReflect() protoreflect.Message {
-	mi := &file_bridge_proto_msgTypes[47]
+func (x *PFAddRsp) ProtoReflect() protoreflect.Message {
+	mi := &file_bridge_proto_msgTypes[49]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2590,2059 +2965,17235 @@ func (x *ClearRsp) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ClearRsp.ProtoReflect.Descriptor instead.
-func (*ClearRsp) Descriptor() ([]byte, []int) {
-	return file_bridge_proto_rawDescGZIP(), []int{47}
+// Deprecated: Use PFAddRsp.ProtoReflect.Descriptor instead.
+func (*PFAddRsp) Descriptor() ([]byte, []int) {
+	return file_bridge_proto_rawDescGZIP(), []int{49}
 }
 
-var File_bridge_proto protoreflect.FileDescriptor
+func (x *PFAddRsp) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
 
-var file_bridge_proto_rawDesc = []byte{
-	0x0a, 0x0c, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x06,
-	0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x22, 0x27, 0x0a, 0x07, 0x50, 0x69, 0x6e, 0x67, 0x52, 0x65,
-	0x71, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x22,
-	0x27, 0x0a, 0x07, 0x50, 0x69, 0x6e, 0x67, 0x52, 0x73, 0x70, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x69,
-	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x74,
-	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x22, 0x1a, 0x0a, 0x06, 0x47, 0x65, 0x74, 0x52,
-	0x65, 0x71, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x03, 0x6b, 0x65, 0x79, 0x22, 0x30, 0x0a, 0x06, 0x47, 0x65, 0x74, 0x52, 0x73, 0x70, 0x12, 0x10,
-	0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79,
-	0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x48, 0x0a, 0x06, 0x50, 0x75, 0x74, 0x52, 0x65, 0x71,
-	0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b,
-	0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x65, 0x78, 0x70, 0x69,
-	0x72, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65,
-	0x22, 0x48, 0x0a, 0x06, 0x50, 0x75, 0x74, 0x52, 0x73, 0x70, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65,
-	0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05,
-	0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c,
-	0x75, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x03, 0x52, 0x06, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x22, 0x1a, 0x0a, 0x06, 0x44, 0x65,
-	0x6c, 0x52, 0x65, 0x71, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x22, 0x1a, 0x0a, 0x06, 0x44, 0x65, 0x6c, 0x52, 0x73, 0x70,
-	0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b,
-	0x65, 0x79, 0x22, 0x2a, 0x0a, 0x0a, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x52, 0x65, 0x71,
-	0x12, 0x1c, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x03, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x22, 0xa6,
-	0x01, 0x0a, 0x0a, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x52, 0x73, 0x70, 0x12, 0x14, 0x0a,
-	0x05, 0x68, 0x6d, 0x4b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x68, 0x6d,
-	0x4b, 0x65, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x20, 0x0a, 0x0b, 0x62, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x56,
-	0x61, 0x6c, 0x75, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x62, 0x65, 0x66, 0x6f,
-	0x72, 0x65, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x61, 0x66, 0x74, 0x65, 0x72,
-	0x56, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x61, 0x66, 0x74,
-	0x65, 0x72, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18,
-	0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x64,
-	0x61, 0x74, 0x61, 0x54, 0x79, 0x70, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x64,
-	0x61, 0x74, 0x61, 0x54, 0x79, 0x70, 0x65, 0x22, 0x1c, 0x0a, 0x08, 0x57, 0x61, 0x74, 0x63, 0x68,
-	0x52, 0x65, 0x71, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x03, 0x6b, 0x65, 0x79, 0x22, 0x1c, 0x0a, 0x08, 0x57, 0x61, 0x74, 0x63, 0x68, 0x52, 0x73,
-	0x70, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03,
-	0x6b, 0x65, 0x79, 0x22, 0x20, 0x0a, 0x08, 0x48, 0x4d, 0x47, 0x65, 0x74, 0x52, 0x65, 0x71, 0x12,
-	0x14, 0x0a, 0x05, 0x68, 0x6d, 0x4b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
-	0x68, 0x6d, 0x4b, 0x65, 0x79, 0x22, 0x36, 0x0a, 0x08, 0x48, 0x4d, 0x47, 0x65, 0x74, 0x52, 0x73,
-	0x70, 0x12, 0x14, 0x0a, 0x05, 0x68, 0x6d, 0x4b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x05, 0x68, 0x6d, 0x4b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x38, 0x0a,
-	0x0e, 0x48, 0x4d, 0x47, 0x65, 0x74, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x52, 0x65, 0x71, 0x12,
-	0x14, 0x0a, 0x05, 0x68, 0x6d, 0x4b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
-	0x68, 0x6d, 0x4b, 0x65, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x22, 0x4e, 0x0a, 0x0e, 0x48, 0x4d, 0x47, 0x65, 0x74,
-	0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x52, 0x73, 0x70, 0x12, 0x14, 0x0a, 0x05, 0x68, 0x6d, 0x4b,
-	0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x68, 0x6d, 0x4b, 0x65, 0x79, 0x12,
-	0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65,
-	0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x60, 0x0a, 0x08, 0x48, 0x4d, 0x50, 0x75, 0x74,
-	0x52, 0x65, 0x71, 0x12, 0x14, 0x0a, 0x05, 0x68, 0x6d, 0x4b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x05, 0x68, 0x6d, 0x4b, 0x65, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79,
-	0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76,
-	0x61, 0x6c, 0x75, 0x65, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75,
-	0x65, 0x12, 0x16, 0x0a, 0x06, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28,
-	0x03, 0x52, 0x06, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x22, 0x60, 0x0a, 0x08, 0x48, 0x4d, 0x50,
-	0x75, 0x74, 0x52, 0x73, 0x70, 0x12, 0x14, 0x0a, 0x05, 0x68, 0x6d, 0x4b, 0x65, 0x79, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x68, 0x6d, 0x4b, 0x65, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b,
-	0x65, 0x79, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a,
-	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61,
-	0x6c, 0x75, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x18, 0x04, 0x20,
-	0x01, 0x28, 0x03, 0x52, 0x06, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x22, 0x20, 0x0a, 0x08, 0x48,
-	0x4d, 0x44, 0x65, 0x6c, 0x52, 0x65, 0x71, 0x12, 0x14, 0x0a, 0x05, 0x68, 0x6d, 0x4b, 0x65, 0x79,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x68, 0x6d, 0x4b, 0x65, 0x79, 0x22, 0x20, 0x0a,
-	0x08, 0x48, 0x4d, 0x44, 0x65, 0x6c, 0x52, 0x73, 0x70, 0x12, 0x14, 0x0a, 0x05, 0x68, 0x6d, 0x4b,
-	0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x68, 0x6d, 0x4b, 0x65, 0x79, 0x22,
-	0x38, 0x0a, 0x0e, 0x48, 0x4d, 0x44, 0x65, 0x6c, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x52, 0x65,
-	0x71, 0x12, 0x14, 0x0a, 0x05, 0x68, 0x6d, 0x4b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x05, 0x68, 0x6d, 0x4b, 0x65, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x22, 0x38, 0x0a, 0x0e, 0x48, 0x4d, 0x44,
-	0x65, 0x6c, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x52, 0x73, 0x70, 0x12, 0x14, 0x0a, 0x05, 0x68,
-	0x6d, 0x4b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x68, 0x6d, 0x4b, 0x65,
-	0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03,
-	0x6b, 0x65, 0x79, 0x22, 0x34, 0x0a, 0x0a, 0x48, 0x4d, 0x57, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65,
-	0x71, 0x12, 0x14, 0x0a, 0x05, 0x68, 0x6d, 0x4b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x05, 0x68, 0x6d, 0x4b, 0x65, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x22, 0x34, 0x0a, 0x0a, 0x48, 0x4d, 0x57,
-	0x61, 0x74, 0x63, 0x68, 0x52, 0x73, 0x70, 0x12, 0x14, 0x0a, 0x05, 0x68, 0x6d, 0x4b, 0x65, 0x79,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x68, 0x6d, 0x4b, 0x65, 0x79, 0x12, 0x10, 0x0a,
-	0x03, 0x6b, 0x65, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x22,
-	0x1b, 0x0a, 0x07, 0x4c, 0x47, 0x65, 0x74, 0x52, 0x65, 0x71, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65,
-	0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x22, 0x31, 0x0a, 0x07,
-	0x4c, 0x47, 0x65, 0x74, 0x52, 0x73, 0x70, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c,
-	0x75, 0x65, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22,
-	0x58, 0x0a, 0x0c, 0x4c, 0x47, 0x65, 0x74, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x65, 0x71, 0x12,
-	0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65,
-	0x79, 0x12, 0x1a, 0x0a, 0x08, 0x62, 0x65, 0x67, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x05, 0x52, 0x08, 0x62, 0x65, 0x67, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x1a, 0x0a,
-	0x08, 0x65, 0x6e, 0x64, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52,
-	0x08, 0x65, 0x6e, 0x64, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x22, 0x36, 0x0a, 0x0c, 0x4c, 0x47, 0x65,
-	0x74, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x73, 0x70, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76,
-	0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75,
-	0x65, 0x22, 0x49, 0x0a, 0x07, 0x4c, 0x50, 0x75, 0x74, 0x52, 0x65, 0x71, 0x12, 0x10, 0x0a, 0x03,
-	0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14,
-	0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x76,
-	0x61, 0x6c, 0x75, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x22, 0x49, 0x0a, 0x07,
-	0x4c, 0x50, 0x75, 0x74, 0x52, 0x73, 0x70, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c,
-	0x75, 0x65, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x12,
-	0x16, 0x0a, 0x06, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52,
-	0x06, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x22, 0x1b, 0x0a, 0x07, 0x4c, 0x44, 0x65, 0x6c, 0x52,
-	0x65, 0x71, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x03, 0x6b, 0x65, 0x79, 0x22, 0x1b, 0x0a, 0x07, 0x4c, 0x44, 0x65, 0x6c, 0x52, 0x73, 0x70, 0x12,
-	0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65,
-	0x79, 0x22, 0x58, 0x0a, 0x0c, 0x4c, 0x44, 0x65, 0x6c, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x65,
-	0x71, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03,
-	0x6b, 0x65, 0x79, 0x12, 0x1a, 0x0a, 0x08, 0x62, 0x65, 0x67, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x62, 0x65, 0x67, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x12,
-	0x1a, 0x0a, 0x08, 0x65, 0x6e, 0x64, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x05, 0x52, 0x08, 0x65, 0x6e, 0x64, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x22, 0x20, 0x0a, 0x0c, 0x4c,
-	0x44, 0x65, 0x6c, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x73, 0x70, 0x12, 0x10, 0x0a, 0x03, 0x6b,
-	0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x22, 0x1d, 0x0a,
-	0x09, 0x4c, 0x57, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x71, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65,
-	0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x22, 0x1d, 0x0a, 0x09,
-	0x4c, 0x57, 0x61, 0x74, 0x63, 0x68, 0x52, 0x73, 0x70, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x22, 0x1b, 0x0a, 0x07, 0x53,
-	0x47, 0x65, 0x74, 0x52, 0x65, 0x71, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x22, 0x31, 0x0a, 0x07, 0x53, 0x47, 0x65, 0x74,
-	0x52, 0x73, 0x70, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02,
-	0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x49, 0x0a, 0x07, 0x53,
-	0x50, 0x75, 0x74, 0x52, 0x65, 0x71, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75,
-	0x65, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x16,
-	0x0a, 0x06, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06,
-	0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x22, 0x49, 0x0a, 0x07, 0x53, 0x50, 0x75, 0x74, 0x52, 0x73,
-	0x70, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03,
-	0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x03,
-	0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x65, 0x78, 0x70,
-	0x69, 0x72, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x65, 0x78, 0x70, 0x69, 0x72,
-	0x65, 0x22, 0x1b, 0x0a, 0x07, 0x53, 0x44, 0x65, 0x6c, 0x52, 0x65, 0x71, 0x12, 0x10, 0x0a, 0x03,
-	0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x22, 0x1b,
-	0x0a, 0x07, 0x53, 0x44, 0x65, 0x6c, 0x52, 0x73, 0x70, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x22, 0x37, 0x0a, 0x0d, 0x53,
-	0x44, 0x65, 0x6c, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x52, 0x65, 0x71, 0x12, 0x10, 0x0a, 0x03,
-	0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14,
-	0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76,
-	0x61, 0x6c, 0x75, 0x65, 0x22, 0x37, 0x0a, 0x0d, 0x53, 0x44, 0x65, 0x6c, 0x4d, 0x65, 0x6d, 0x62,
-	0x65, 0x72, 0x52, 0x73, 0x70, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x1d, 0x0a,
-	0x09, 0x53, 0x57, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x71, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65,
-	0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x22, 0x1d, 0x0a, 0x09,
-	0x53, 0x57, 0x61, 0x74, 0x63, 0x68, 0x52, 0x73, 0x70, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x22, 0x0a, 0x0a, 0x08, 0x43,
-	0x6c, 0x65, 0x61, 0x72, 0x52, 0x65, 0x71, 0x22, 0x0a, 0x0a, 0x08, 0x43, 0x6c, 0x65, 0x61, 0x72,
-	0x52, 0x73, 0x70, 0x32, 0x9f, 0x0c, 0x0a, 0x09, 0x52, 0x70, 0x63, 0x42, 0x72, 0x69, 0x64, 0x67,
-	0x65, 0x12, 0x2a, 0x0a, 0x04, 0x50, 0x69, 0x6e, 0x67, 0x12, 0x0f, 0x2e, 0x62, 0x72, 0x69, 0x64,
-	0x67, 0x65, 0x2e, 0x50, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x1a, 0x0f, 0x2e, 0x62, 0x72, 0x69,
-	0x64, 0x67, 0x65, 0x2e, 0x50, 0x69, 0x6e, 0x67, 0x52, 0x73, 0x70, 0x22, 0x00, 0x12, 0x37, 0x0a,
-	0x07, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x12, 0x12, 0x2e, 0x62, 0x72, 0x69, 0x64, 0x67,
-	0x65, 0x2e, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x52, 0x65, 0x71, 0x1a, 0x12, 0x2e, 0x62,
-	0x72, 0x69, 0x64, 0x67, 0x65, 0x2e, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x52, 0x73, 0x70,
-	0x22, 0x00, 0x28, 0x01, 0x30, 0x01, 0x12, 0x27, 0x0a, 0x03, 0x47, 0x65, 0x74, 0x12, 0x0e, 0x2e,
-	0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x2e, 0x47, 0x65, 0x74, 0x52, 0x65, 0x71, 0x1a, 0x0e, 0x2e,
-	0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x2e, 0x47, 0x65, 0x74, 0x52, 0x73, 0x70, 0x22, 0x00, 0x12,
-	0x27, 0x0a, 0x03, 0x50, 0x75, 0x74, 0x12, 0x0e, 0x2e, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x2e,
-	0x50, 0x75, 0x74, 0x52, 0x65, 0x71, 0x1a, 0x0e, 0x2e, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x2e,
-	0x50, 0x75, 0x74, 0x52, 0x73, 0x70, 0x22, 0x00, 0x12, 0x27, 0x0a, 0x03, 0x44, 0x65, 0x6c, 0x12,
-	0x0e, 0x2e, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x2e, 0x44, 0x65, 0x6c, 0x52, 0x65, 0x71, 0x1a,
-	0x0e, 0x2e, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x2e, 0x44, 0x65, 0x6c, 0x52, 0x73, 0x70, 0x22,
-	0x00, 0x12, 0x30, 0x0a, 0x08, 0x57, 0x61, 0x74, 0x63, 0x68, 0x4b, 0x65, 0x79, 0x12, 0x10, 0x2e,
-	0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x2e, 0x57, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x71, 0x1a,
-	0x10, 0x2e, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x2e, 0x57, 0x61, 0x74, 0x63, 0x68, 0x52, 0x73,
-	0x70, 0x22, 0x00, 0x12, 0x32, 0x0a, 0x0a, 0x55, 0x6e, 0x57, 0x61, 0x74, 0x63, 0x68, 0x4b, 0x65,
-	0x79, 0x12, 0x10, 0x2e, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x2e, 0x57, 0x61, 0x74, 0x63, 0x68,
-	0x52, 0x65, 0x71, 0x1a, 0x10, 0x2e, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x2e, 0x57, 0x61, 0x74,
-	0x63, 0x68, 0x52, 0x73, 0x70, 0x22, 0x00, 0x12, 0x32, 0x0a, 0x0a, 0x43, 0x6c, 0x65, 0x61, 0x72,
-	0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x10, 0x2e, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x2e, 0x43,
-	0x6c, 0x65, 0x61, 0x72, 0x52, 0x65, 0x71, 0x1a, 0x10, 0x2e, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65,
-	0x2e, 0x43, 0x6c, 0x65, 0x61, 0x72, 0x52, 0x73, 0x70, 0x22, 0x00, 0x12, 0x2d, 0x0a, 0x05, 0x48,
-	0x4d, 0x47, 0x65, 0x74, 0x12, 0x10, 0x2e, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x2e, 0x48, 0x4d,
-	0x47, 0x65, 0x74, 0x52, 0x65, 0x71, 0x1a, 0x10, 0x2e, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x2e,
-	0x48, 0x4d, 0x47, 0x65, 0x74, 0x52, 0x73, 0x70, 0x22, 0x00, 0x12, 0x3f, 0x0a, 0x0b, 0x48, 0x4d,
-	0x47, 0x65, 0x74, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x16, 0x2e, 0x62, 0x72, 0x69, 0x64,
-	0x67, 0x65, 0x2e, 0x48, 0x4d, 0x47, 0x65, 0x74, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x52, 0x65,
-	0x71, 0x1a, 0x16, 0x2e, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x2e, 0x48, 0x4d, 0x47, 0x65, 0x74,
-	0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x52, 0x73, 0x70, 0x22, 0x00, 0x12, 0x2d, 0x0a, 0x05, 0x48,
-	0x4d, 0x50, 0x75, 0x74, 0x12, 0x10, 0x2e, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x2e, 0x48, 0x4d,
-	0x50, 0x75, 0x74, 0x52, 0x65, 0x71, 0x1a, 0x10, 0x2e, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x2e,
-	0x48, 0x4d, 0x50, 0x75, 0x74, 0x52, 0x73, 0x70, 0x22, 0x00, 0x12, 0x2d, 0x0a, 0x05, 0x48, 0x4d,
-	0x44, 0x65, 0x6c, 0x12, 0x10, 0x2e, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x2e, 0x48, 0x4d, 0x44,
-	0x65, 0x6c, 0x52, 0x65, 0x71, 0x1a, 0x10, 0x2e, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x2e, 0x48,
-	0x4d, 0x44, 0x65, 0x6c, 0x52, 0x73, 0x70, 0x22, 0x00, 0x12, 0x3f, 0x0a, 0x0b, 0x48, 0x4d, 0x44,
-	0x65, 0x6c, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x16, 0x2e, 0x62, 0x72, 0x69, 0x64, 0x67,
-	0x65, 0x2e, 0x48, 0x4d, 0x44, 0x65, 0x6c, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x52, 0x65, 0x71,
-	0x1a, 0x16, 0x2e, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x2e, 0x48, 0x4d, 0x44, 0x65, 0x6c, 0x4d,
-	0x65, 0x6d, 0x62, 0x65, 0x72, 0x52, 0x73, 0x70, 0x22, 0x00, 0x12, 0x33, 0x0a, 0x07, 0x48, 0x4d,
-	0x57, 0x61, 0x74, 0x63, 0x68, 0x12, 0x12, 0x2e, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x2e, 0x48,
-	0x4d, 0x57, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x71, 0x1a, 0x12, 0x2e, 0x62, 0x72, 0x69, 0x64,
-	0x67, 0x65, 0x2e, 0x48, 0x4d, 0x57, 0x61, 0x74, 0x63, 0x68, 0x52, 0x73, 0x70, 0x22, 0x00, 0x12,
-	0x35, 0x0a, 0x09, 0x48, 0x4d, 0x55, 0x6e, 0x57, 0x61, 0x74, 0x63, 0x68, 0x12, 0x12, 0x2e, 0x62,
-	0x72, 0x69, 0x64, 0x67, 0x65, 0x2e, 0x48, 0x4d, 0x57, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x71,
-	0x1a, 0x12, 0x2e, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x2e, 0x48, 0x4d, 0x57, 0x61, 0x74, 0x63,
-	0x68, 0x52, 0x73, 0x70, 0x22, 0x00, 0x12, 0x30, 0x0a, 0x08, 0x43, 0x6c, 0x65, 0x61, 0x72, 0x4d,
-	0x61, 0x70, 0x12, 0x10, 0x2e, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x2e, 0x43, 0x6c, 0x65, 0x61,
-	0x72, 0x52, 0x65, 0x71, 0x1a, 0x10, 0x2e, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x2e, 0x43, 0x6c,
-	0x65, 0x61, 0x72, 0x52, 0x73, 0x70, 0x22, 0x00, 0x12, 0x2a, 0x0a, 0x04, 0x4c, 0x47, 0x65, 0x74,
-	0x12, 0x0f, 0x2e, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x2e, 0x4c, 0x47, 0x65, 0x74, 0x52, 0x65,
-	0x71, 0x1a, 0x0f, 0x2e, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x2e, 0x4c, 0x47, 0x65, 0x74, 0x52,
-	0x73, 0x70, 0x22, 0x00, 0x12, 0x39, 0x0a, 0x09, 0x4c, 0x47, 0x65, 0x74, 0x52, 0x61, 0x6e, 0x67,
-	0x65, 0x12, 0x14, 0x2e, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x2e, 0x4c, 0x47, 0x65, 0x74, 0x52,
-	0x61, 0x6e, 0x67, 0x65, 0x52, 0x65, 0x71, 0x1a, 0x14, 0x2e, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65,
-	0x2e, 0x4c, 0x47, 0x65, 0x74, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x73, 0x70, 0x22, 0x00, 0x12,
-	0x2a, 0x0a, 0x04, 0x4c, 0x50, 0x75, 0x74, 0x12, 0x0f, 0x2e, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65,
-	0x2e, 0x4c, 0x50, 0x75, 0x74, 0x52, 0x65, 0x71, 0x1a, 0x0f, 0x2e, 0x62, 0x72, 0x69, 0x64, 0x67,
-	0x65, 0x2e, 0x4c, 0x50, 0x75, 0x74, 0x52, 0x73, 0x70, 0x22, 0x00, 0x12, 0x2a, 0x0a, 0x04, 0x4c,
-	0x44, 0x65, 0x6c, 0x12, 0x0f, 0x2e, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x2e, 0x4c, 0x44, 0x65,
-	0x6c, 0x52, 0x65, 0x71, 0x1a, 0x0f, 0x2e, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x2e, 0x4c, 0x44,
-	0x65, 0x6c, 0x52, 0x73, 0x70, 0x22, 0x00, 0x12, 0x39, 0x0a, 0x09, 0x4c, 0x44, 0x65, 0x6c, 0x52,
-	0x61, 0x6e, 0x67, 0x65, 0x12, 0x14, 0x2e, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x2e, 0x4c, 0x44,
-	0x65, 0x6c, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x65, 0x71, 0x1a, 0x14, 0x2e, 0x62, 0x72, 0x69,
-	0x64, 0x67, 0x65, 0x2e, 0x4c, 0x44, 0x65, 0x6c, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x73, 0x70,
-	0x22, 0x00, 0x12, 0x30, 0x0a, 0x06, 0x4c, 0x57, 0x61, 0x74, 0x63, 0x68, 0x12, 0x11, 0x2e, 0x62,
-	0x72, 0x69, 0x64, 0x67, 0x65, 0x2e, 0x4c, 0x57, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x71, 0x1a,
-	0x11, 0x2e, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x2e, 0x4c, 0x57, 0x61, 0x74, 0x63, 0x68, 0x52,
-	0x73, 0x70, 0x22, 0x00, 0x12, 0x32, 0x0a, 0x08, 0x4c, 0x55, 0x6e, 0x57, 0x61, 0x74, 0x63, 0x68,
-	0x12, 0x11, 0x2e, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x2e, 0x4c, 0x57, 0x61, 0x74, 0x63, 0x68,
-	0x52, 0x65, 0x71, 0x1a, 0x11, 0x2e, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x2e, 0x4c, 0x57, 0x61,
-	0x74, 0x63, 0x68, 0x52, 0x73, 0x70, 0x22, 0x00, 0x12, 0x31, 0x0a, 0x09, 0x43, 0x6c, 0x65, 0x61,
-	0x72, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x10, 0x2e, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x2e, 0x43,
-	0x6c, 0x65, 0x61, 0x72, 0x52, 0x65, 0x71, 0x1a, 0x10, 0x2e, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65,
-	0x2e, 0x43, 0x6c, 0x65, 0x61, 0x72, 0x52, 0x73, 0x70, 0x22, 0x00, 0x12, 0x2a, 0x0a, 0x04, 0x53,
-	0x47, 0x65, 0x74, 0x12, 0x0f, 0x2e, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x2e, 0x53, 0x47, 0x65,
-	0x74, 0x52, 0x65, 0x71, 0x1a, 0x0f, 0x2e, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x2e, 0x53, 0x47,
-	0x65, 0x74, 0x52, 0x73, 0x70, 0x22, 0x00, 0x12, 0x2a, 0x0a, 0x04, 0x53, 0x50, 0x75, 0x74, 0x12,
-	0x0f, 0x2e, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x2e, 0x53, 0x50, 0x75, 0x74, 0x52, 0x65, 0x71,
-	0x1a, 0x0f, 0x2e, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x2e, 0x53, 0x50, 0x75, 0x74, 0x52, 0x73,
-	0x70, 0x22, 0x00, 0x12, 0x2a, 0x0a, 0x04, 0x53, 0x44, 0x65, 0x6c, 0x12, 0x0f, 0x2e, 0x62, 0x72,
-	0x69, 0x64, 0x67, 0x65, 0x2e, 0x53, 0x44, 0x65, 0x6c, 0x52, 0x65, 0x71, 0x1a, 0x0f, 0x2e, 0x62,
-	0x72, 0x69, 0x64, 0x67, 0x65, 0x2e, 0x53, 0x44, 0x65, 0x6c, 0x52, 0x73, 0x70, 0x22, 0x00, 0x12,
-	0x3c, 0x0a, 0x0a, 0x53, 0x44, 0x65, 0x6c, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x15, 0x2e,
-	0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x2e, 0x53, 0x44, 0x65, 0x6c, 0x4d, 0x65, 0x6d, 0x62, 0x65,
-	0x72, 0x52, 0x65, 0x71, 0x1a, 0x15, 0x2e, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x2e, 0x53, 0x44,
-	0x65, 0x6c, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x52, 0x73, 0x70, 0x22, 0x00, 0x12, 0x30, 0x0a,
-	0x06, 0x53, 0x57, 0x61, 0x74, 0x63, 0x68, 0x12, 0x11, 0x2e, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65,
-	0x2e, 0x53, 0x57, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x71, 0x1a, 0x11, 0x2e, 0x62, 0x72, 0x69,
-	0x64, 0x67, 0x65, 0x2e, 0x53, 0x57, 0x61, 0x74, 0x63, 0x68, 0x52, 0x73, 0x70, 0x22, 0x00, 0x12,
-	0x32, 0x0a, 0x08, 0x53, 0x55, 0x6e, 0x57, 0x61, 0x74, 0x63, 0x68, 0x12, 0x11, 0x2e, 0x62, 0x72,
-	0x69, 0x64, 0x67, 0x65, 0x2e, 0x53, 0x57, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x71, 0x1a, 0x11,
-	0x2e, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x2e, 0x53, 0x57, 0x61, 0x74, 0x63, 0x68, 0x52, 0x73,
-	0x70, 0x22, 0x00, 0x12, 0x30, 0x0a, 0x08, 0x43, 0x6c, 0x65, 0x61, 0x72, 0x53, 0x65, 0x74, 0x12,
-	0x10, 0x2e, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x2e, 0x43, 0x6c, 0x65, 0x61, 0x72, 0x52, 0x65,
-	0x71, 0x1a, 0x10, 0x2e, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x2e, 0x43, 0x6c, 0x65, 0x61, 0x72,
-	0x52, 0x73, 0x70, 0x22, 0x00, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+func (x *PFAddRsp) GetChanged() bool {
+	if x != nil {
+		return x.Changed
+	}
+	return false
 }
 
-var (
-	file_bridge_proto_rawDescOnce sync.Once
-	file_bridge_proto_rawDescData = file_bridge_proto_rawDesc
-)
+type PFCountReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
 
-func file_bridge_proto_rawDescGZIP() []byte {
-	file_bridge_proto_rawDescOnce.Do(func() {
-		file_bridge_proto_rawDescData = protoimpl.X.CompressGZIP(file_bridge_proto_rawDescData)
-	})
-	return file_bridge_proto_rawDescData
+	Key []string `protobuf:"bytes,1,rep,name=key,proto3" json:"key,omitempty"`
 }
 
-var file_bridge_proto_msgTypes = make([]protoimpl.MessageInfo, 48)
-var file_bridge_proto_goTypes = []interface{}{
-	(*PingReq)(nil),        // 0: bridge.PingReq
-	(*PingRsp)(nil),        // 1: bridge.PingRsp
-	(*GetReq)(nil),         // 2: bridge.GetReq
-	(*GetRsp)(nil),         // 3: bridge.GetRsp
-	(*PutReq)(nil),         // 4: bridge.PutReq
-	(*PutRsp)(nil),         // 5: bridge.PutRsp
-	(*DelReq)(nil),         // 6: bridge.DelReq
-	(*DelRsp)(nil),         // 7: bridge.DelRsp
-	(*PublishReq)(nil),     // 8: bridge.PublishReq
-	(*PublishRsp)(nil),     // 9: bridge.PublishRsp
-	(*WatchReq)(nil),       // 10: bridge.WatchReq
-	(*WatchRsp)(nil),       // 11: bridge.WatchRsp
-	(*HMGetReq)(nil),       // 12: bridge.HMGetReq
-	(*HMGetRsp)(nil),       // 13: bridge.HMGetRsp
-	(*HMGetMemberReq)(nil), // 14: bridge.HMGetMemberReq
-	(*HMGetMemberRsp)(nil), // 15: bridge.HMGetMemberRsp
-	(*HMPutReq)(nil),       // 16: bridge.HMPutReq
-	(*HMPutRsp)(nil),       // 17: bridge.HMPutRsp
-	(*HMDelReq)(nil),       // 18: bridge.HMDelReq
-	(*HMDelRsp)(nil),       // 19: bridge.HMDelRsp
-	(*HMDelMemberReq)(nil), // 20: bridge.HMDelMemberReq
-	(*HMDelMemberRsp)(nil), // 21: bridge.HMDelMemberRsp
-	(*HMWatchReq)(nil),     // 22: bridge.HMWatchReq
-	(*HMWatchRsp)(nil),     // 23: bridge.HMWatchRsp
-	(*LGetReq)(nil),        // 24: bridge.LGetReq
-	(*LGetRsp)(nil),        // 25: bridge.LGetRsp
-	(*LGetRangeReq)(nil),   // 26: bridge.LGetRangeReq
-	(*LGetRangeRsp)(nil),   // 27: bridge.LGetRangeRsp
-	(*LPutReq)(nil),        // 28: bridge.LPutReq
-	(*LPutRsp)(nil),        // 29: bridge.LPutRsp
-	(*LDelReq)(nil),        // 30: bridge.LDelReq
-	(*LDelRsp)(nil),        // 31: bridge.LDelRsp
-	(*LDelRangeReq)(nil),   // 32: bridge.LDelRangeReq
-	(*LDelRangeRsp)(nil),   // 33: bridge.LDelRangeRsp
-	(*LWatchReq)(nil),      // 34: bridge.LWatchReq
-	(*LWatchRsp)(nil),      // 35: bridge.LWatchRsp
-	(*SGetReq)(nil),        // 36: bridge.SGetReq
-	(*SGetRsp)(nil),        // 37: bridge.SGetRsp
-	(*SPutReq)(nil),        // 38: bridge.SPutReq
-	(*SPutRsp)(nil),        // 39: bridge.SPutRsp
-	(*SDelReq)(nil),        // 40: bridge.SDelReq
-	(*SDelRsp)(nil),        // 41: bridge.SDelRsp
-	(*SDelMemberReq)(nil),  // 42: bridge.SDelMemberReq
-	(*SDelMemberRsp)(nil),  // 43: bridge.SDelMemberRsp
-	(*SWatchReq)(nil),      // 44: bridge.SWatchReq
-	(*SWatchRsp)(nil),      // 45: bridge.SWatchRsp
-	(*ClearReq)(nil),       // 46: bridge.ClearReq
-	(*ClearRsp)(nil),       // 47: bridge.ClearRsp
+func (x *PFCountReq) Reset() {
+	*x = PFCountReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_bridge_proto_msgTypes[50]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
 }
-var file_bridge_proto_depIdxs = []int32{
-	0,  // 0: bridge.RpcBridge.Ping:input_type -> bridge.PingReq
-	8,  // 1: bridge.RpcBridge.Publish:input_type -> bridge.PublishReq
-	2,  // 2: bridge.RpcBridge.Get:input_type -> bridge.GetReq
-	4,  // 3: bridge.RpcBridge.Put:input_type -> bridge.PutReq
-	6,  // 4: bridge.RpcBridge.Del:input_type -> bridge.DelReq
-	10, // 5: bridge.RpcBridge.WatchKey:input_type -> bridge.WatchReq
-	10, // 6: bridge.RpcBridge.UnWatchKey:input_type -> bridge.WatchReq
-	46, // 7: bridge.RpcBridge.ClearString:input_type -> bridge.ClearReq
-	12, // 8: bridge.RpcBridge.HMGet:input_type -> bridge.HMGetReq
-	14, // 9: bridge.RpcBridge.HMGetMember:input_type -> bridge.HMGetMemberReq
-	16, // 10: bridge.RpcBridge.HMPut:input_type -> bridge.HMPutReq
-	18, // 11: bridge.RpcBridge.HMDel:input_type -> bridge.HMDelReq
-	20, // 12: bridge.RpcBridge.HMDelMember:input_type -> bridge.HMDelMemberReq
-	22, // 13: bridge.RpcBridge.HMWatch:input_type -> bridge.HMWatchReq
-	22, // 14: bridge.RpcBridge.HMUnWatch:input_type -> bridge.HMWatchReq
-	46, // 15: bridge.RpcBridge.ClearMap:input_type -> bridge.ClearReq
-	24, // 16: bridge.RpcBridge.LGet:input_type -> bridge.LGetReq
-	26, // 17: bridge.RpcBridge.LGetRange:input_type -> bridge.LGetRangeReq
-	28, // 18: bridge.RpcBridge.LPut:input_type -> bridge.LPutReq
-	30, // 19: bridge.RpcBridge.LDel:input_type -> bridge.LDelReq
-	32, // 20: bridge.RpcBridge.LDelRange:input_type -> bridge.LDelRangeReq
-	34, // 21: bridge.RpcBridge.LWatch:input_type -> bridge.LWatchReq
-	34, // 22: bridge.RpcBridge.LUnWatch:input_type -> bridge.LWatchReq
-	46, // 23: bridge.RpcBridge.ClearList:input_type -> bridge.ClearReq
-	36, // 24: bridge.RpcBridge.SGet:input_type -> bridge.SGetReq
-	38, // 25: bridge.RpcBridge.SPut:input_type -> bridge.SPutReq
-	40, // 26: bridge.RpcBridge.SDel:input_type -> bridge.SDelReq
-	42, // 27: bridge.RpcBridge.SDelMember:input_type -> bridge.SDelMemberReq
-	44, // 28: bridge.RpcBridge.SWatch:input_type -> bridge.SWatchReq
-	44, // 29: bridge.RpcBridge.SUnWatch:input_type -> bridge.SWatchReq
-	46, // 30: bridge.RpcBridge.ClearSet:input_type -> bridge.ClearReq
-	1,  // 31: bridge.RpcBridge.Ping:output_type -> bridge.PingRsp
-	9,  // 32: bridge.RpcBridge.Publish:output_type -> bridge.PublishRsp
-	3,  // 33: bridge.RpcBridge.Get:output_type -> bridge.GetRsp
-	5,  // 34: bridge.RpcBridge.Put:output_type -> bridge.PutRsp
-	7,  // 35: bridge.RpcBridge.Del:output_type -> bridge.DelRsp
-	11, // 36: bridge.RpcBridge.WatchKey:output_type -> bridge.WatchRsp
-	11, // 37: bridge.RpcBridge.UnWatchKey:output_type -> bridge.WatchRsp
-	47, // 38: bridge.RpcBridge.ClearString:output_type -> bridge.ClearRsp
-	13, // 39: bridge.RpcBridge.HMGet:output_type -> bridge.HMGetRsp
-	15, // 40: bridge.RpcBridge.HMGetMember:output_type -> bridge.HMGetMemberRsp
-	17, // 41: bridge.RpcBridge.HMPut:output_type -> bridge.HMPutRsp
-	19, // 42: bridge.RpcBridge.HMDel:output_type -> bridge.HMDelRsp
-	21, // 43: bridge.RpcBridge.HMDelMember:output_type -> bridge.HMDelMemberRsp
-	23, // 44: bridge.RpcBridge.HMWatch:output_type -> bridge.HMWatchRsp
-	23, // 45: bridge.RpcBridge.HMUnWatch:output_type -> bridge.HMWatchRsp
-	47, // 46: bridge.RpcBridge.ClearMap:output_type -> bridge.ClearRsp
-	25, // 47: bridge.RpcBridge.LGet:output_type -> bridge.LGetRsp
-	27, // 48: bridge.RpcBridge.LGetRange:output_type -> bridge.LGetRangeRsp
-	29, // 49: bridge.RpcBridge.LPut:output_type -> bridge.LPutRsp
-	31, // 50: bridge.RpcBridge.LDel:output_type -> bridge.LDelRsp
-	33, // 51: bridge.RpcBridge.LDelRange:output_type -> bridge.LDelRangeRsp
-	35, // 52: bridge.RpcBridge.LWatch:output_type -> bridge.LWatchRsp
-	35, // 53: bridge.RpcBridge.LUnWatch:output_type -> bridge.LWatchRsp
-	47, // 54: bridge.RpcBridge.ClearList:output_type -> bridge.ClearRsp
-	37, // 55: bridge.RpcBridge.SGet:output_type -> bridge.SGetRsp
-	39, // 56: bridge.RpcBridge.SPut:output_type -> bridge.SPutRsp
-	41, // 57: bridge.RpcBridge.SDel:output_type -> bridge.SDelRsp
-	43, // 58: bridge.RpcBridge.SDelMember:output_type -> bridge.SDelMemberRsp
-	45, // 59: bridge.RpcBridge.SWatch:output_type -> bridge.SWatchRsp
-	45, // 60: bridge.RpcBridge.SUnWatch:output_type -> bridge.SWatchRsp
-	47, // 61: bridge.RpcBridge.ClearSet:output_type -> bridge.ClearRsp
-	31, // [31:62] is the sub-list for method output_type
-	0,  // [0:31] is the sub-list for method input_type
-	0,  // [0:0] is the sub-list for extension type_name
-	0,  // [0:0] is the sub-list for extension extendee
-	0,  // [0:0] is the sub-list for field type_name
+
+func (x *PFCountReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func init() { file_bridge_proto_init() }
-func file_bridge_proto_init() {
-	if File_bridge_proto != nil {
-		return
-	}
-	if !protoimpl.UnsafeEnabled {
-		file_bridge_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PingReq); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_bridge_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PingRsp); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
+func (*PFCountReq) ProtoMessage() {}
+
+func (x *PFCountReq) ProtoReflect() protoreflect.Message {
+	mi := &file_bridge_proto_msgTypes[50]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
-		file_bridge_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetReq); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PFCountReq.ProtoReflect.Descriptor instead.
+func (*PFCountReq) Descriptor() ([]byte, []int) {
+	return file_bridge_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *PFCountReq) GetKey() []string {
+	if x != nil {
+		return x.Key
+	}
+	return nil
+}
+
+type PFCountRsp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Key   []string `protobuf:"bytes,1,rep,name=key,proto3" json:"key,omitempty"`
+	Count uint64   `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+}
+
+func (x *PFCountRsp) Reset() {
+	*x = PFCountRsp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_bridge_proto_msgTypes[51]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PFCountRsp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PFCountRsp) ProtoMessage() {}
+
+func (x *PFCountRsp) ProtoReflect() protoreflect.Message {
+	mi := &file_bridge_proto_msgTypes[51]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
-		file_bridge_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetRsp); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PFCountRsp.ProtoReflect.Descriptor instead.
+func (*PFCountRsp) Descriptor() ([]byte, []int) {
+	return file_bridge_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *PFCountRsp) GetKey() []string {
+	if x != nil {
+		return x.Key
+	}
+	return nil
+}
+
+func (x *PFCountRsp) GetCount() uint64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+type PFMergeReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	DestKey string   `protobuf:"bytes,1,opt,name=destKey,proto3" json:"destKey,omitempty"`
+	SrcKey  []string `protobuf:"bytes,2,rep,name=srcKey,proto3" json:"srcKey,omitempty"`
+}
+
+func (x *PFMergeReq) Reset() {
+	*x = PFMergeReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_bridge_proto_msgTypes[52]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PFMergeReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PFMergeReq) ProtoMessage() {}
+
+func (x *PFMergeReq) ProtoReflect() protoreflect.Message {
+	mi := &file_bridge_proto_msgTypes[52]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
-		file_bridge_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PutReq); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_bridge_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PutRsp); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_bridge_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DelReq); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PFMergeReq.ProtoReflect.Descriptor instead.
+func (*PFMergeReq) Descriptor() ([]byte, []int) {
+	return file_bridge_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *PFMergeReq) GetDestKey() string {
+	if x != nil {
+		return x.DestKey
+	}
+	return ""
+}
+
+func (x *PFMergeReq) GetSrcKey() []string {
+	if x != nil {
+		return x.SrcKey
+	}
+	return nil
+}
+
+type PFMergeRsp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	DestKey string `protobuf:"bytes,1,opt,name=destKey,proto3" json:"destKey,omitempty"`
+}
+
+func (x *PFMergeRsp) Reset() {
+	*x = PFMergeRsp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_bridge_proto_msgTypes[53]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PFMergeRsp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PFMergeRsp) ProtoMessage() {}
+
+func (x *PFMergeRsp) ProtoReflect() protoreflect.Message {
+	mi := &file_bridge_proto_msgTypes[53]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
-		file_bridge_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DelRsp); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PFMergeRsp.ProtoReflect.Descriptor instead.
+func (*PFMergeRsp) Descriptor() ([]byte, []int) {
+	return file_bridge_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *PFMergeRsp) GetDestKey() string {
+	if x != nil {
+		return x.DestKey
+	}
+	return ""
+}
+
+type PFDelReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Key string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+}
+
+func (x *PFDelReq) Reset() {
+	*x = PFDelReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_bridge_proto_msgTypes[54]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PFDelReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PFDelReq) ProtoMessage() {}
+
+func (x *PFDelReq) ProtoReflect() protoreflect.Message {
+	mi := &file_bridge_proto_msgTypes[54]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
-		file_bridge_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PublishReq); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PFDelReq.ProtoReflect.Descriptor instead.
+func (*PFDelReq) Descriptor() ([]byte, []int) {
+	return file_bridge_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *PFDelReq) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+type PFDelRsp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Key string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+}
+
+func (x *PFDelRsp) Reset() {
+	*x = PFDelRsp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_bridge_proto_msgTypes[55]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PFDelRsp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PFDelRsp) ProtoMessage() {}
+
+func (x *PFDelRsp) ProtoReflect() protoreflect.Message {
+	mi := &file_bridge_proto_msgTypes[55]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
-		file_bridge_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PublishRsp); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PFDelRsp.ProtoReflect.Descriptor instead.
+func (*PFDelRsp) Descriptor() ([]byte, []int) {
+	return file_bridge_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *PFDelRsp) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+type GeoMember struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name      string  `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Longitude float64 `protobuf:"fixed64,2,opt,name=longitude,proto3" json:"longitude,omitempty"`
+	Latitude  float64 `protobuf:"fixed64,3,opt,name=latitude,proto3" json:"latitude,omitempty"`
+	Dist      float64 `protobuf:"fixed64,4,opt,name=dist,proto3" json:"dist,omitempty"`
+}
+
+func (x *GeoMember) Reset() {
+	*x = GeoMember{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_bridge_proto_msgTypes[56]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GeoMember) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GeoMember) ProtoMessage() {}
+
+func (x *GeoMember) ProtoReflect() protoreflect.Message {
+	mi := &file_bridge_proto_msgTypes[56]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
-		file_bridge_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*WatchReq); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GeoMember.ProtoReflect.Descriptor instead.
+func (*GeoMember) Descriptor() ([]byte, []int) {
+	return file_bridge_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *GeoMember) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *GeoMember) GetLongitude() float64 {
+	if x != nil {
+		return x.Longitude
+	}
+	return 0
+}
+
+func (x *GeoMember) GetLatitude() float64 {
+	if x != nil {
+		return x.Latitude
+	}
+	return 0
+}
+
+func (x *GeoMember) GetDist() float64 {
+	if x != nil {
+		return x.Dist
+	}
+	return 0
+}
+
+// 没有设置 expire 和 expiration 时保留key原有的过期时间
+type GeoAddReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Key        string       `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Member     []*GeoMember `protobuf:"bytes,2,rep,name=member,proto3" json:"member,omitempty"`
+	Expire     int64        `protobuf:"varint,3,opt,name=expire,proto3" json:"expire,omitempty"`
+	Expiration *Expiration  `protobuf:"bytes,4,opt,name=expiration,proto3" json:"expiration,omitempty"`
+}
+
+func (x *GeoAddReq) Reset() {
+	*x = GeoAddReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_bridge_proto_msgTypes[57]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GeoAddReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GeoAddReq) ProtoMessage() {}
+
+func (x *GeoAddReq) ProtoReflect() protoreflect.Message {
+	mi := &file_bridge_proto_msgTypes[57]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
-		file_bridge_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*WatchRsp); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_bridge_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*HMGetReq); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_bridge_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*HMGetRsp); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_bridge_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*HMGetMemberReq); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GeoAddReq.ProtoReflect.Descriptor instead.
+func (*GeoAddReq) Descriptor() ([]byte, []int) {
+	return file_bridge_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *GeoAddReq) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *GeoAddReq) GetMember() []*GeoMember {
+	if x != nil {
+		return x.Member
+	}
+	return nil
+}
+
+func (x *GeoAddReq) GetExpire() int64 {
+	if x != nil {
+		return x.Expire
+	}
+	return 0
+}
+
+func (x *GeoAddReq) GetExpiration() *Expiration {
+	if x != nil {
+		return x.Expiration
+	}
+	return nil
+}
+
+type GeoAddRsp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Key   string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Added int32  `protobuf:"varint,2,opt,name=added,proto3" json:"added,omitempty"`
+}
+
+func (x *GeoAddRsp) Reset() {
+	*x = GeoAddRsp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_bridge_proto_msgTypes[58]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GeoAddRsp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GeoAddRsp) ProtoMessage() {}
+
+func (x *GeoAddRsp) ProtoReflect() protoreflect.Message {
+	mi := &file_bridge_proto_msgTypes[58]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
-		file_bridge_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*HMGetMemberRsp); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GeoAddRsp.ProtoReflect.Descriptor instead.
+func (*GeoAddRsp) Descriptor() ([]byte, []int) {
+	return file_bridge_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *GeoAddRsp) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *GeoAddRsp) GetAdded() int32 {
+	if x != nil {
+		return x.Added
+	}
+	return 0
+}
+
+type GeoPosReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Key    string   `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Member []string `protobuf:"bytes,2,rep,name=member,proto3" json:"member,omitempty"`
+}
+
+func (x *GeoPosReq) Reset() {
+	*x = GeoPosReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_bridge_proto_msgTypes[59]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GeoPosReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GeoPosReq) ProtoMessage() {}
+
+func (x *GeoPosReq) ProtoReflect() protoreflect.Message {
+	mi := &file_bridge_proto_msgTypes[59]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
-		file_bridge_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*HMPutReq); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GeoPosReq.ProtoReflect.Descriptor instead.
+func (*GeoPosReq) Descriptor() ([]byte, []int) {
+	return file_bridge_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *GeoPosReq) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *GeoPosReq) GetMember() []string {
+	if x != nil {
+		return x.Member
+	}
+	return nil
+}
+
+type GeoPosRsp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Key    string       `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Member []*GeoMember `protobuf:"bytes,2,rep,name=member,proto3" json:"member,omitempty"`
+}
+
+func (x *GeoPosRsp) Reset() {
+	*x = GeoPosRsp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_bridge_proto_msgTypes[60]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GeoPosRsp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GeoPosRsp) ProtoMessage() {}
+
+func (x *GeoPosRsp) ProtoReflect() protoreflect.Message {
+	mi := &file_bridge_proto_msgTypes[60]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
-		file_bridge_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*HMPutRsp); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GeoPosRsp.ProtoReflect.Descriptor instead.
+func (*GeoPosRsp) Descriptor() ([]byte, []int) {
+	return file_bridge_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *GeoPosRsp) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *GeoPosRsp) GetMember() []*GeoMember {
+	if x != nil {
+		return x.Member
+	}
+	return nil
+}
+
+type GeoDistReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Key     string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Member1 string `protobuf:"bytes,2,opt,name=member1,proto3" json:"member1,omitempty"`
+	Member2 string `protobuf:"bytes,3,opt,name=member2,proto3" json:"member2,omitempty"`
+	Unit    string `protobuf:"bytes,4,opt,name=unit,proto3" json:"unit,omitempty"`
+}
+
+func (x *GeoDistReq) Reset() {
+	*x = GeoDistReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_bridge_proto_msgTypes[61]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GeoDistReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GeoDistReq) ProtoMessage() {}
+
+func (x *GeoDistReq) ProtoReflect() protoreflect.Message {
+	mi := &file_bridge_proto_msgTypes[61]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
-		file_bridge_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*HMDelReq); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_bridge_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*HMDelRsp); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GeoDistReq.ProtoReflect.Descriptor instead.
+func (*GeoDistReq) Descriptor() ([]byte, []int) {
+	return file_bridge_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *GeoDistReq) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *GeoDistReq) GetMember1() string {
+	if x != nil {
+		return x.Member1
+	}
+	return ""
+}
+
+func (x *GeoDistReq) GetMember2() string {
+	if x != nil {
+		return x.Member2
+	}
+	return ""
+}
+
+func (x *GeoDistReq) GetUnit() string {
+	if x != nil {
+		return x.Unit
+	}
+	return ""
+}
+
+type GeoDistRsp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Key  string  `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Dist float64 `protobuf:"fixed64,2,opt,name=dist,proto3" json:"dist,omitempty"`
+	Unit string  `protobuf:"bytes,3,opt,name=unit,proto3" json:"unit,omitempty"`
+}
+
+func (x *GeoDistRsp) Reset() {
+	*x = GeoDistRsp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_bridge_proto_msgTypes[62]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GeoDistRsp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GeoDistRsp) ProtoMessage() {}
+
+func (x *GeoDistRsp) ProtoReflect() protoreflect.Message {
+	mi := &file_bridge_proto_msgTypes[62]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
-		file_bridge_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*HMDelMemberReq); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GeoDistRsp.ProtoReflect.Descriptor instead.
+func (*GeoDistRsp) Descriptor() ([]byte, []int) {
+	return file_bridge_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *GeoDistRsp) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *GeoDistRsp) GetDist() float64 {
+	if x != nil {
+		return x.Dist
+	}
+	return 0
+}
+
+func (x *GeoDistRsp) GetUnit() string {
+	if x != nil {
+		return x.Unit
+	}
+	return ""
+}
+
+type GeoSearchReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Key       string  `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Member    string  `protobuf:"bytes,2,opt,name=member,proto3" json:"member,omitempty"`
+	Longitude float64 `protobuf:"fixed64,3,opt,name=longitude,proto3" json:"longitude,omitempty"`
+	Latitude  float64 `protobuf:"fixed64,4,opt,name=latitude,proto3" json:"latitude,omitempty"`
+	Radius    float64 `protobuf:"fixed64,5,opt,name=radius,proto3" json:"radius,omitempty"`
+	Width     float64 `protobuf:"fixed64,6,opt,name=width,proto3" json:"width,omitempty"`
+	Height    float64 `protobuf:"fixed64,7,opt,name=height,proto3" json:"height,omitempty"`
+	Unit      string  `protobuf:"bytes,8,opt,name=unit,proto3" json:"unit,omitempty"`
+	Sort      string  `protobuf:"bytes,9,opt,name=sort,proto3" json:"sort,omitempty"`
+	Count     int32   `protobuf:"varint,10,opt,name=count,proto3" json:"count,omitempty"`
+}
+
+func (x *GeoSearchReq) Reset() {
+	*x = GeoSearchReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_bridge_proto_msgTypes[63]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GeoSearchReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GeoSearchReq) ProtoMessage() {}
+
+func (x *GeoSearchReq) ProtoReflect() protoreflect.Message {
+	mi := &file_bridge_proto_msgTypes[63]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
-		file_bridge_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*HMDelMemberRsp); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GeoSearchReq.ProtoReflect.Descriptor instead.
+func (*GeoSearchReq) Descriptor() ([]byte, []int) {
+	return file_bridge_proto_rawDescGZIP(), []int{63}
+}
+
+func (x *GeoSearchReq) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *GeoSearchReq) GetMember() string {
+	if x != nil {
+		return x.Member
+	}
+	return ""
+}
+
+func (x *GeoSearchReq) GetLongitude() float64 {
+	if x != nil {
+		return x.Longitude
+	}
+	return 0
+}
+
+func (x *GeoSearchReq) GetLatitude() float64 {
+	if x != nil {
+		return x.Latitude
+	}
+	return 0
+}
+
+func (x *GeoSearchReq) GetRadius() float64 {
+	if x != nil {
+		return x.Radius
+	}
+	return 0
+}
+
+func (x *GeoSearchReq) GetWidth() float64 {
+	if x != nil {
+		return x.Width
+	}
+	return 0
+}
+
+func (x *GeoSearchReq) GetHeight() float64 {
+	if x != nil {
+		return x.Height
+	}
+	return 0
+}
+
+func (x *GeoSearchReq) GetUnit() string {
+	if x != nil {
+		return x.Unit
+	}
+	return ""
+}
+
+func (x *GeoSearchReq) GetSort() string {
+	if x != nil {
+		return x.Sort
+	}
+	return ""
+}
+
+func (x *GeoSearchReq) GetCount() int32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+type GeoSearchRsp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Key    string       `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Member []*GeoMember `protobuf:"bytes,2,rep,name=member,proto3" json:"member,omitempty"`
+}
+
+func (x *GeoSearchRsp) Reset() {
+	*x = GeoSearchRsp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_bridge_proto_msgTypes[64]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GeoSearchRsp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GeoSearchRsp) ProtoMessage() {}
+
+func (x *GeoSearchRsp) ProtoReflect() protoreflect.Message {
+	mi := &file_bridge_proto_msgTypes[64]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
-		file_bridge_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*HMWatchReq); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GeoSearchRsp.ProtoReflect.Descriptor instead.
+func (*GeoSearchRsp) Descriptor() ([]byte, []int) {
+	return file_bridge_proto_rawDescGZIP(), []int{64}
+}
+
+func (x *GeoSearchRsp) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *GeoSearchRsp) GetMember() []*GeoMember {
+	if x != nil {
+		return x.Member
+	}
+	return nil
+}
+
+type GeoDelReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Key string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+}
+
+func (x *GeoDelReq) Reset() {
+	*x = GeoDelReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_bridge_proto_msgTypes[65]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GeoDelReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GeoDelReq) ProtoMessage() {}
+
+func (x *GeoDelReq) ProtoReflect() protoreflect.Message {
+	mi := &file_bridge_proto_msgTypes[65]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
-		file_bridge_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*HMWatchRsp); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_bridge_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*LGetReq); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GeoDelReq.ProtoReflect.Descriptor instead.
+func (*GeoDelReq) Descriptor() ([]byte, []int) {
+	return file_bridge_proto_rawDescGZIP(), []int{65}
+}
+
+func (x *GeoDelReq) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+type GeoDelRsp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Key string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+}
+
+func (x *GeoDelRsp) Reset() {
+	*x = GeoDelRsp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_bridge_proto_msgTypes[66]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GeoDelRsp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GeoDelRsp) ProtoMessage() {}
+
+func (x *GeoDelRsp) ProtoReflect() protoreflect.Message {
+	mi := &file_bridge_proto_msgTypes[66]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
-		file_bridge_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*LGetRsp); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GeoDelRsp.ProtoReflect.Descriptor instead.
+func (*GeoDelRsp) Descriptor() ([]byte, []int) {
+	return file_bridge_proto_rawDescGZIP(), []int{66}
+}
+
+func (x *GeoDelRsp) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+type GeoDelMemberReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Key    string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Member string `protobuf:"bytes,2,opt,name=member,proto3" json:"member,omitempty"`
+}
+
+func (x *GeoDelMemberReq) Reset() {
+	*x = GeoDelMemberReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_bridge_proto_msgTypes[67]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GeoDelMemberReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GeoDelMemberReq) ProtoMessage() {}
+
+func (x *GeoDelMemberReq) ProtoReflect() protoreflect.Message {
+	mi := &file_bridge_proto_msgTypes[67]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
-		file_bridge_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*LGetRangeReq); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GeoDelMemberReq.ProtoReflect.Descriptor instead.
+func (*GeoDelMemberReq) Descriptor() ([]byte, []int) {
+	return file_bridge_proto_rawDescGZIP(), []int{67}
+}
+
+func (x *GeoDelMemberReq) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *GeoDelMemberReq) GetMember() string {
+	if x != nil {
+		return x.Member
+	}
+	return ""
+}
+
+type GeoDelMemberRsp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Key    string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Member string `protobuf:"bytes,2,opt,name=member,proto3" json:"member,omitempty"`
+}
+
+func (x *GeoDelMemberRsp) Reset() {
+	*x = GeoDelMemberRsp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_bridge_proto_msgTypes[68]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GeoDelMemberRsp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GeoDelMemberRsp) ProtoMessage() {}
+
+func (x *GeoDelMemberRsp) ProtoReflect() protoreflect.Message {
+	mi := &file_bridge_proto_msgTypes[68]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
-		file_bridge_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*LGetRangeRsp); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GeoDelMemberRsp.ProtoReflect.Descriptor instead.
+func (*GeoDelMemberRsp) Descriptor() ([]byte, []int) {
+	return file_bridge_proto_rawDescGZIP(), []int{68}
+}
+
+func (x *GeoDelMemberRsp) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *GeoDelMemberRsp) GetMember() string {
+	if x != nil {
+		return x.Member
+	}
+	return ""
+}
+
+type StreamEntry struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id     string            `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Fields map[string]string `protobuf:"bytes,2,rep,name=fields,proto3" json:"fields,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+}
+
+func (x *StreamEntry) Reset() {
+	*x = StreamEntry{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_bridge_proto_msgTypes[69]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StreamEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StreamEntry) ProtoMessage() {}
+
+func (x *StreamEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_bridge_proto_msgTypes[69]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
-		file_bridge_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*LPutReq); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StreamEntry.ProtoReflect.Descriptor instead.
+func (*StreamEntry) Descriptor() ([]byte, []int) {
+	return file_bridge_proto_rawDescGZIP(), []int{69}
+}
+
+func (x *StreamEntry) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *StreamEntry) GetFields() map[string]string {
+	if x != nil {
+		return x.Fields
+	}
+	return nil
+}
+
+type StreamEntries struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Key   string         `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Entry []*StreamEntry `protobuf:"bytes,2,rep,name=entry,proto3" json:"entry,omitempty"`
+}
+
+func (x *StreamEntries) Reset() {
+	*x = StreamEntries{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_bridge_proto_msgTypes[70]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StreamEntries) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StreamEntries) ProtoMessage() {}
+
+func (x *StreamEntries) ProtoReflect() protoreflect.Message {
+	mi := &file_bridge_proto_msgTypes[70]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
-		file_bridge_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*LPutRsp); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_bridge_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*LDelReq); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_bridge_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*LDelRsp); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_bridge_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*LDelRangeReq); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StreamEntries.ProtoReflect.Descriptor instead.
+func (*StreamEntries) Descriptor() ([]byte, []int) {
+	return file_bridge_proto_rawDescGZIP(), []int{70}
+}
+
+func (x *StreamEntries) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *StreamEntries) GetEntry() []*StreamEntry {
+	if x != nil {
+		return x.Entry
+	}
+	return nil
+}
+
+type StreamPending struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id            string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Consumer      string `protobuf:"bytes,2,opt,name=consumer,proto3" json:"consumer,omitempty"`
+	Idle          int64  `protobuf:"varint,3,opt,name=idle,proto3" json:"idle,omitempty"`
+	DeliveryCount int64  `protobuf:"varint,4,opt,name=deliveryCount,proto3" json:"deliveryCount,omitempty"`
+}
+
+func (x *StreamPending) Reset() {
+	*x = StreamPending{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_bridge_proto_msgTypes[71]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StreamPending) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StreamPending) ProtoMessage() {}
+
+func (x *StreamPending) ProtoReflect() protoreflect.Message {
+	mi := &file_bridge_proto_msgTypes[71]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
-		file_bridge_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*LDelRangeRsp); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StreamPending.ProtoReflect.Descriptor instead.
+func (*StreamPending) Descriptor() ([]byte, []int) {
+	return file_bridge_proto_rawDescGZIP(), []int{71}
+}
+
+func (x *StreamPending) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *StreamPending) GetConsumer() string {
+	if x != nil {
+		return x.Consumer
+	}
+	return ""
+}
+
+func (x *StreamPending) GetIdle() int64 {
+	if x != nil {
+		return x.Idle
+	}
+	return 0
+}
+
+func (x *StreamPending) GetDeliveryCount() int64 {
+	if x != nil {
+		return x.DeliveryCount
+	}
+	return 0
+}
+
+type XAddReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Key    string   `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Id     string   `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	Field  []string `protobuf:"bytes,3,rep,name=field,proto3" json:"field,omitempty"`
+	Value  []string `protobuf:"bytes,4,rep,name=value,proto3" json:"value,omitempty"`
+	MaxLen int64    `protobuf:"varint,5,opt,name=maxLen,proto3" json:"maxLen,omitempty"`
+	MaxAge int64    `protobuf:"varint,6,opt,name=maxAge,proto3" json:"maxAge,omitempty"`
+}
+
+func (x *XAddReq) Reset() {
+	*x = XAddReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_bridge_proto_msgTypes[72]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *XAddReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*XAddReq) ProtoMessage() {}
+
+func (x *XAddReq) ProtoReflect() protoreflect.Message {
+	mi := &file_bridge_proto_msgTypes[72]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
-		file_bridge_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*LWatchReq); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use XAddReq.ProtoReflect.Descriptor instead.
+func (*XAddReq) Descriptor() ([]byte, []int) {
+	return file_bridge_proto_rawDescGZIP(), []int{72}
+}
+
+func (x *XAddReq) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *XAddReq) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *XAddReq) GetField() []string {
+	if x != nil {
+		return x.Field
+	}
+	return nil
+}
+
+func (x *XAddReq) GetValue() []string {
+	if x != nil {
+		return x.Value
+	}
+	return nil
+}
+
+func (x *XAddReq) GetMaxLen() int64 {
+	if x != nil {
+		return x.MaxLen
+	}
+	return 0
+}
+
+func (x *XAddReq) GetMaxAge() int64 {
+	if x != nil {
+		return x.MaxAge
+	}
+	return 0
+}
+
+type XAddRsp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Key string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Id  string `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *XAddRsp) Reset() {
+	*x = XAddRsp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_bridge_proto_msgTypes[73]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *XAddRsp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*XAddRsp) ProtoMessage() {}
+
+func (x *XAddRsp) ProtoReflect() protoreflect.Message {
+	mi := &file_bridge_proto_msgTypes[73]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
-		file_bridge_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*LWatchRsp); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use XAddRsp.ProtoReflect.Descriptor instead.
+func (*XAddRsp) Descriptor() ([]byte, []int) {
+	return file_bridge_proto_rawDescGZIP(), []int{73}
+}
+
+func (x *XAddRsp) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *XAddRsp) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type XLenReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Key string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+}
+
+func (x *XLenReq) Reset() {
+	*x = XLenReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_bridge_proto_msgTypes[74]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *XLenReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*XLenReq) ProtoMessage() {}
+
+func (x *XLenReq) ProtoReflect() protoreflect.Message {
+	mi := &file_bridge_proto_msgTypes[74]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
-		file_bridge_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SGetReq); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_bridge_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SGetRsp); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_bridge_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SPutReq); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_bridge_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SPutRsp); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_bridge_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SDelReq); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use XLenReq.ProtoReflect.Descriptor instead.
+func (*XLenReq) Descriptor() ([]byte, []int) {
+	return file_bridge_proto_rawDescGZIP(), []int{74}
+}
+
+func (x *XLenReq) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+type XLenRsp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Key string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Len int64  `protobuf:"varint,2,opt,name=len,proto3" json:"len,omitempty"`
+}
+
+func (x *XLenRsp) Reset() {
+	*x = XLenRsp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_bridge_proto_msgTypes[75]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *XLenRsp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*XLenRsp) ProtoMessage() {}
+
+func (x *XLenRsp) ProtoReflect() protoreflect.Message {
+	mi := &file_bridge_proto_msgTypes[75]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
-		file_bridge_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SDelRsp); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use XLenRsp.ProtoReflect.Descriptor instead.
+func (*XLenRsp) Descriptor() ([]byte, []int) {
+	return file_bridge_proto_rawDescGZIP(), []int{75}
+}
+
+func (x *XLenRsp) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *XLenRsp) GetLen() int64 {
+	if x != nil {
+		return x.Len
+	}
+	return 0
+}
+
+type XRangeReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Key   string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Start string `protobuf:"bytes,2,opt,name=start,proto3" json:"start,omitempty"`
+	End   string `protobuf:"bytes,3,opt,name=end,proto3" json:"end,omitempty"`
+	Count int32  `protobuf:"varint,4,opt,name=count,proto3" json:"count,omitempty"`
+	Rev   bool   `protobuf:"varint,5,opt,name=rev,proto3" json:"rev,omitempty"`
+}
+
+func (x *XRangeReq) Reset() {
+	*x = XRangeReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_bridge_proto_msgTypes[76]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *XRangeReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*XRangeReq) ProtoMessage() {}
+
+func (x *XRangeReq) ProtoReflect() protoreflect.Message {
+	mi := &file_bridge_proto_msgTypes[76]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
-		file_bridge_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SDelMemberReq); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use XRangeReq.ProtoReflect.Descriptor instead.
+func (*XRangeReq) Descriptor() ([]byte, []int) {
+	return file_bridge_proto_rawDescGZIP(), []int{76}
+}
+
+func (x *XRangeReq) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *XRangeReq) GetStart() string {
+	if x != nil {
+		return x.Start
+	}
+	return ""
+}
+
+func (x *XRangeReq) GetEnd() string {
+	if x != nil {
+		return x.End
+	}
+	return ""
+}
+
+func (x *XRangeReq) GetCount() int32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+func (x *XRangeReq) GetRev() bool {
+	if x != nil {
+		return x.Rev
+	}
+	return false
+}
+
+type XRangeRsp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Key   string         `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Entry []*StreamEntry `protobuf:"bytes,2,rep,name=entry,proto3" json:"entry,omitempty"`
+}
+
+func (x *XRangeRsp) Reset() {
+	*x = XRangeRsp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_bridge_proto_msgTypes[77]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *XRangeRsp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*XRangeRsp) ProtoMessage() {}
+
+func (x *XRangeRsp) ProtoReflect() protoreflect.Message {
+	mi := &file_bridge_proto_msgTypes[77]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
-		file_bridge_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SDelMemberRsp); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use XRangeRsp.ProtoReflect.Descriptor instead.
+func (*XRangeRsp) Descriptor() ([]byte, []int) {
+	return file_bridge_proto_rawDescGZIP(), []int{77}
+}
+
+func (x *XRangeRsp) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *XRangeRsp) GetEntry() []*StreamEntry {
+	if x != nil {
+		return x.Entry
+	}
+	return nil
+}
+
+type XReadReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Key   []string `protobuf:"bytes,1,rep,name=key,proto3" json:"key,omitempty"`
+	Id    []string `protobuf:"bytes,2,rep,name=id,proto3" json:"id,omitempty"`
+	Count int32    `protobuf:"varint,3,opt,name=count,proto3" json:"count,omitempty"`
+	Block int64    `protobuf:"varint,4,opt,name=block,proto3" json:"block,omitempty"`
+}
+
+func (x *XReadReq) Reset() {
+	*x = XReadReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_bridge_proto_msgTypes[78]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *XReadReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*XReadReq) ProtoMessage() {}
+
+func (x *XReadReq) ProtoReflect() protoreflect.Message {
+	mi := &file_bridge_proto_msgTypes[78]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
-		file_bridge_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SWatchReq); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use XReadReq.ProtoReflect.Descriptor instead.
+func (*XReadReq) Descriptor() ([]byte, []int) {
+	return file_bridge_proto_rawDescGZIP(), []int{78}
+}
+
+func (x *XReadReq) GetKey() []string {
+	if x != nil {
+		return x.Key
+	}
+	return nil
+}
+
+func (x *XReadReq) GetId() []string {
+	if x != nil {
+		return x.Id
+	}
+	return nil
+}
+
+func (x *XReadReq) GetCount() int32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+func (x *XReadReq) GetBlock() int64 {
+	if x != nil {
+		return x.Block
+	}
+	return 0
+}
+
+type XReadRsp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Stream []*StreamEntries `protobuf:"bytes,1,rep,name=stream,proto3" json:"stream,omitempty"`
+}
+
+func (x *XReadRsp) Reset() {
+	*x = XReadRsp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_bridge_proto_msgTypes[79]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *XReadRsp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*XReadRsp) ProtoMessage() {}
+
+func (x *XReadRsp) ProtoReflect() protoreflect.Message {
+	mi := &file_bridge_proto_msgTypes[79]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
-		file_bridge_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SWatchRsp); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use XReadRsp.ProtoReflect.Descriptor instead.
+func (*XReadRsp) Descriptor() ([]byte, []int) {
+	return file_bridge_proto_rawDescGZIP(), []int{79}
+}
+
+func (x *XReadRsp) GetStream() []*StreamEntries {
+	if x != nil {
+		return x.Stream
+	}
+	return nil
+}
+
+type XGroupReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Key      string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Group    string `protobuf:"bytes,2,opt,name=group,proto3" json:"group,omitempty"`
+	Id       string `protobuf:"bytes,3,opt,name=id,proto3" json:"id,omitempty"`
+	MkStream bool   `protobuf:"varint,4,opt,name=mkStream,proto3" json:"mkStream,omitempty"`
+}
+
+func (x *XGroupReq) Reset() {
+	*x = XGroupReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_bridge_proto_msgTypes[80]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *XGroupReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*XGroupReq) ProtoMessage() {}
+
+func (x *XGroupReq) ProtoReflect() protoreflect.Message {
+	mi := &file_bridge_proto_msgTypes[80]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
-		file_bridge_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ClearReq); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use XGroupReq.ProtoReflect.Descriptor instead.
+func (*XGroupReq) Descriptor() ([]byte, []int) {
+	return file_bridge_proto_rawDescGZIP(), []int{80}
+}
+
+func (x *XGroupReq) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *XGroupReq) GetGroup() string {
+	if x != nil {
+		return x.Group
+	}
+	return ""
+}
+
+func (x *XGroupReq) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *XGroupReq) GetMkStream() bool {
+	if x != nil {
+		return x.MkStream
+	}
+	return false
+}
+
+type XGroupRsp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Key   string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Group string `protobuf:"bytes,2,opt,name=group,proto3" json:"group,omitempty"`
+}
+
+func (x *XGroupRsp) Reset() {
+	*x = XGroupRsp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_bridge_proto_msgTypes[81]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *XGroupRsp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*XGroupRsp) ProtoMessage() {}
+
+func (x *XGroupRsp) ProtoReflect() protoreflect.Message {
+	mi := &file_bridge_proto_msgTypes[81]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
-		file_bridge_proto_msgTypes[47].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ClearRsp); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use XGroupRsp.ProtoReflect.Descriptor instead.
+func (*XGroupRsp) Descriptor() ([]byte, []int) {
+	return file_bridge_proto_rawDescGZIP(), []int{81}
+}
+
+func (x *XGroupRsp) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *XGroupRsp) GetGroup() string {
+	if x != nil {
+		return x.Group
+	}
+	return ""
+}
+
+type XReadGroupReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Group    string   `protobuf:"bytes,1,opt,name=group,proto3" json:"group,omitempty"`
+	Consumer string   `protobuf:"bytes,2,opt,name=consumer,proto3" json:"consumer,omitempty"`
+	Key      []string `protobuf:"bytes,3,rep,name=key,proto3" json:"key,omitempty"`
+	Id       []string `protobuf:"bytes,4,rep,name=id,proto3" json:"id,omitempty"`
+	Count    int32    `protobuf:"varint,5,opt,name=count,proto3" json:"count,omitempty"`
+	Block    int64    `protobuf:"varint,6,opt,name=block,proto3" json:"block,omitempty"`
+	NoAck    bool     `protobuf:"varint,7,opt,name=noAck,proto3" json:"noAck,omitempty"`
+}
+
+func (x *XReadGroupReq) Reset() {
+	*x = XReadGroupReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_bridge_proto_msgTypes[82]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *XReadGroupReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*XReadGroupReq) ProtoMessage() {}
+
+func (x *XReadGroupReq) ProtoReflect() protoreflect.Message {
+	mi := &file_bridge_proto_msgTypes[82]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use XReadGroupReq.ProtoReflect.Descriptor instead.
+func (*XReadGroupReq) Descriptor() ([]byte, []int) {
+	return file_bridge_proto_rawDescGZIP(), []int{82}
+}
+
+func (x *XReadGroupReq) GetGroup() string {
+	if x != nil {
+		return x.Group
+	}
+	return ""
+}
+
+func (x *XReadGroupReq) GetConsumer() string {
+	if x != nil {
+		return x.Consumer
+	}
+	return ""
+}
+
+func (x *XReadGroupReq) GetKey() []string {
+	if x != nil {
+		return x.Key
+	}
+	return nil
+}
+
+func (x *XReadGroupReq) GetId() []string {
+	if x != nil {
+		return x.Id
+	}
+	return nil
+}
+
+func (x *XReadGroupReq) GetCount() int32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+func (x *XReadGroupReq) GetBlock() int64 {
+	if x != nil {
+		return x.Block
+	}
+	return 0
+}
+
+func (x *XReadGroupReq) GetNoAck() bool {
+	if x != nil {
+		return x.NoAck
+	}
+	return false
+}
+
+type XAckReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Key   string   `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Group string   `protobuf:"bytes,2,opt,name=group,proto3" json:"group,omitempty"`
+	Id    []string `protobuf:"bytes,3,rep,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *XAckReq) Reset() {
+	*x = XAckReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_bridge_proto_msgTypes[83]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *XAckReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*XAckReq) ProtoMessage() {}
+
+func (x *XAckReq) ProtoReflect() protoreflect.Message {
+	mi := &file_bridge_proto_msgTypes[83]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use XAckReq.ProtoReflect.Descriptor instead.
+func (*XAckReq) Descriptor() ([]byte, []int) {
+	return file_bridge_proto_rawDescGZIP(), []int{83}
+}
+
+func (x *XAckReq) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *XAckReq) GetGroup() string {
+	if x != nil {
+		return x.Group
+	}
+	return ""
+}
+
+func (x *XAckReq) GetId() []string {
+	if x != nil {
+		return x.Id
+	}
+	return nil
+}
+
+type XAckRsp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Key   string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Count int64  `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+}
+
+func (x *XAckRsp) Reset() {
+	*x = XAckRsp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_bridge_proto_msgTypes[84]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *XAckRsp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*XAckRsp) ProtoMessage() {}
+
+func (x *XAckRsp) ProtoReflect() protoreflect.Message {
+	mi := &file_bridge_proto_msgTypes[84]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use XAckRsp.ProtoReflect.Descriptor instead.
+func (*XAckRsp) Descriptor() ([]byte, []int) {
+	return file_bridge_proto_rawDescGZIP(), []int{84}
+}
+
+func (x *XAckRsp) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *XAckRsp) GetCount() int64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+type XPendingReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Key      string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Group    string `protobuf:"bytes,2,opt,name=group,proto3" json:"group,omitempty"`
+	Consumer string `protobuf:"bytes,3,opt,name=consumer,proto3" json:"consumer,omitempty"`
+	Count    int32  `protobuf:"varint,4,opt,name=count,proto3" json:"count,omitempty"`
+}
+
+func (x *XPendingReq) Reset() {
+	*x = XPendingReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_bridge_proto_msgTypes[85]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *XPendingReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*XPendingReq) ProtoMessage() {}
+
+func (x *XPendingReq) ProtoReflect() protoreflect.Message {
+	mi := &file_bridge_proto_msgTypes[85]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use XPendingReq.ProtoReflect.Descriptor instead.
+func (*XPendingReq) Descriptor() ([]byte, []int) {
+	return file_bridge_proto_rawDescGZIP(), []int{85}
+}
+
+func (x *XPendingReq) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *XPendingReq) GetGroup() string {
+	if x != nil {
+		return x.Group
+	}
+	return ""
+}
+
+func (x *XPendingReq) GetConsumer() string {
+	if x != nil {
+		return x.Consumer
+	}
+	return ""
+}
+
+func (x *XPendingReq) GetCount() int32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+type XPendingRsp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Key     string           `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Pending []*StreamPending `protobuf:"bytes,2,rep,name=pending,proto3" json:"pending,omitempty"`
+}
+
+func (x *XPendingRsp) Reset() {
+	*x = XPendingRsp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_bridge_proto_msgTypes[86]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *XPendingRsp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*XPendingRsp) ProtoMessage() {}
+
+func (x *XPendingRsp) ProtoReflect() protoreflect.Message {
+	mi := &file_bridge_proto_msgTypes[86]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use XPendingRsp.ProtoReflect.Descriptor instead.
+func (*XPendingRsp) Descriptor() ([]byte, []int) {
+	return file_bridge_proto_rawDescGZIP(), []int{86}
+}
+
+func (x *XPendingRsp) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *XPendingRsp) GetPending() []*StreamPending {
+	if x != nil {
+		return x.Pending
+	}
+	return nil
+}
+
+type XClaimReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Key      string   `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Group    string   `protobuf:"bytes,2,opt,name=group,proto3" json:"group,omitempty"`
+	Consumer string   `protobuf:"bytes,3,opt,name=consumer,proto3" json:"consumer,omitempty"`
+	MinIdle  int64    `protobuf:"varint,4,opt,name=minIdle,proto3" json:"minIdle,omitempty"`
+	Id       []string `protobuf:"bytes,5,rep,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *XClaimReq) Reset() {
+	*x = XClaimReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_bridge_proto_msgTypes[87]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *XClaimReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*XClaimReq) ProtoMessage() {}
+
+func (x *XClaimReq) ProtoReflect() protoreflect.Message {
+	mi := &file_bridge_proto_msgTypes[87]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use XClaimReq.ProtoReflect.Descriptor instead.
+func (*XClaimReq) Descriptor() ([]byte, []int) {
+	return file_bridge_proto_rawDescGZIP(), []int{87}
+}
+
+func (x *XClaimReq) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *XClaimReq) GetGroup() string {
+	if x != nil {
+		return x.Group
+	}
+	return ""
+}
+
+func (x *XClaimReq) GetConsumer() string {
+	if x != nil {
+		return x.Consumer
+	}
+	return ""
+}
+
+func (x *XClaimReq) GetMinIdle() int64 {
+	if x != nil {
+		return x.MinIdle
+	}
+	return 0
+}
+
+func (x *XClaimReq) GetId() []string {
+	if x != nil {
+		return x.Id
+	}
+	return nil
+}
+
+type XClaimRsp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Key   string         `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Entry []*StreamEntry `protobuf:"bytes,2,rep,name=entry,proto3" json:"entry,omitempty"`
+}
+
+func (x *XClaimRsp) Reset() {
+	*x = XClaimRsp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_bridge_proto_msgTypes[88]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *XClaimRsp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*XClaimRsp) ProtoMessage() {}
+
+func (x *XClaimRsp) ProtoReflect() protoreflect.Message {
+	mi := &file_bridge_proto_msgTypes[88]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use XClaimRsp.ProtoReflect.Descriptor instead.
+func (*XClaimRsp) Descriptor() ([]byte, []int) {
+	return file_bridge_proto_rawDescGZIP(), []int{88}
+}
+
+func (x *XClaimRsp) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *XClaimRsp) GetEntry() []*StreamEntry {
+	if x != nil {
+		return x.Entry
+	}
+	return nil
+}
+
+type XTrimReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Key    string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	MaxLen int64  `protobuf:"varint,2,opt,name=maxLen,proto3" json:"maxLen,omitempty"`
+	MaxAge int64  `protobuf:"varint,3,opt,name=maxAge,proto3" json:"maxAge,omitempty"`
+}
+
+func (x *XTrimReq) Reset() {
+	*x = XTrimReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_bridge_proto_msgTypes[89]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *XTrimReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*XTrimReq) ProtoMessage() {}
+
+func (x *XTrimReq) ProtoReflect() protoreflect.Message {
+	mi := &file_bridge_proto_msgTypes[89]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use XTrimReq.ProtoReflect.Descriptor instead.
+func (*XTrimReq) Descriptor() ([]byte, []int) {
+	return file_bridge_proto_rawDescGZIP(), []int{89}
+}
+
+func (x *XTrimReq) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *XTrimReq) GetMaxLen() int64 {
+	if x != nil {
+		return x.MaxLen
+	}
+	return 0
+}
+
+func (x *XTrimReq) GetMaxAge() int64 {
+	if x != nil {
+		return x.MaxAge
+	}
+	return 0
+}
+
+type XTrimRsp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Key   string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Count int64  `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+}
+
+func (x *XTrimRsp) Reset() {
+	*x = XTrimRsp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_bridge_proto_msgTypes[90]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *XTrimRsp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*XTrimRsp) ProtoMessage() {}
+
+func (x *XTrimRsp) ProtoReflect() protoreflect.Message {
+	mi := &file_bridge_proto_msgTypes[90]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use XTrimRsp.ProtoReflect.Descriptor instead.
+func (*XTrimRsp) Descriptor() ([]byte, []int) {
+	return file_bridge_proto_rawDescGZIP(), []int{90}
+}
+
+func (x *XTrimRsp) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *XTrimRsp) GetCount() int64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+type XDelMemberReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Key string   `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Id  []string `protobuf:"bytes,2,rep,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *XDelMemberReq) Reset() {
+	*x = XDelMemberReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_bridge_proto_msgTypes[91]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *XDelMemberReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*XDelMemberReq) ProtoMessage() {}
+
+func (x *XDelMemberReq) ProtoReflect() protoreflect.Message {
+	mi := &file_bridge_proto_msgTypes[91]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use XDelMemberReq.ProtoReflect.Descriptor instead.
+func (*XDelMemberReq) Descriptor() ([]byte, []int) {
+	return file_bridge_proto_rawDescGZIP(), []int{91}
+}
+
+func (x *XDelMemberReq) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *XDelMemberReq) GetId() []string {
+	if x != nil {
+		return x.Id
+	}
+	return nil
+}
+
+type XDelMemberRsp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Key   string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Count int64  `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+}
+
+func (x *XDelMemberRsp) Reset() {
+	*x = XDelMemberRsp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_bridge_proto_msgTypes[92]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *XDelMemberRsp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*XDelMemberRsp) ProtoMessage() {}
+
+func (x *XDelMemberRsp) ProtoReflect() protoreflect.Message {
+	mi := &file_bridge_proto_msgTypes[92]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use XDelMemberRsp.ProtoReflect.Descriptor instead.
+func (*XDelMemberRsp) Descriptor() ([]byte, []int) {
+	return file_bridge_proto_rawDescGZIP(), []int{92}
+}
+
+func (x *XDelMemberRsp) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *XDelMemberRsp) GetCount() int64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+type XDelReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Key string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+}
+
+func (x *XDelReq) Reset() {
+	*x = XDelReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_bridge_proto_msgTypes[93]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *XDelReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*XDelReq) ProtoMessage() {}
+
+func (x *XDelReq) ProtoReflect() protoreflect.Message {
+	mi := &file_bridge_proto_msgTypes[93]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use XDelReq.ProtoReflect.Descriptor instead.
+func (*XDelReq) Descriptor() ([]byte, []int) {
+	return file_bridge_proto_rawDescGZIP(), []int{93}
+}
+
+func (x *XDelReq) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+type XDelRsp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Key string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+}
+
+func (x *XDelRsp) Reset() {
+	*x = XDelRsp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_bridge_proto_msgTypes[94]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *XDelRsp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*XDelRsp) ProtoMessage() {}
+
+func (x *XDelRsp) ProtoReflect() protoreflect.Message {
+	mi := &file_bridge_proto_msgTypes[94]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use XDelRsp.ProtoReflect.Descriptor instead.
+func (*XDelRsp) Descriptor() ([]byte, []int) {
+	return file_bridge_proto_rawDescGZIP(), []int{94}
+}
+
+func (x *XDelRsp) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+type JSetReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Key    string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Path   string `protobuf:"bytes,2,opt,name=path,proto3" json:"path,omitempty"`
+	Value  string `protobuf:"bytes,3,opt,name=value,proto3" json:"value,omitempty"`
+	Expire int64  `protobuf:"varint,4,opt,name=expire,proto3" json:"expire,omitempty"`
+}
+
+func (x *JSetReq) Reset() {
+	*x = JSetReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_bridge_proto_msgTypes[95]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *JSetReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*JSetReq) ProtoMessage() {}
+
+func (x *JSetReq) ProtoReflect() protoreflect.Message {
+	mi := &file_bridge_proto_msgTypes[95]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use JSetReq.ProtoReflect.Descriptor instead.
+func (*JSetReq) Descriptor() ([]byte, []int) {
+	return file_bridge_proto_rawDescGZIP(), []int{95}
+}
+
+func (x *JSetReq) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *JSetReq) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+func (x *JSetReq) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+func (x *JSetReq) GetExpire() int64 {
+	if x != nil {
+		return x.Expire
+	}
+	return 0
+}
+
+type JSetRsp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Key  string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Path string `protobuf:"bytes,2,opt,name=path,proto3" json:"path,omitempty"`
+}
+
+func (x *JSetRsp) Reset() {
+	*x = JSetRsp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_bridge_proto_msgTypes[96]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *JSetRsp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*JSetRsp) ProtoMessage() {}
+
+func (x *JSetRsp) ProtoReflect() protoreflect.Message {
+	mi := &file_bridge_proto_msgTypes[96]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use JSetRsp.ProtoReflect.Descriptor instead.
+func (*JSetRsp) Descriptor() ([]byte, []int) {
+	return file_bridge_proto_rawDescGZIP(), []int{96}
+}
+
+func (x *JSetRsp) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *JSetRsp) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+type JGetReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Key  string   `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Path []string `protobuf:"bytes,2,rep,name=path,proto3" json:"path,omitempty"`
+}
+
+func (x *JGetReq) Reset() {
+	*x = JGetReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_bridge_proto_msgTypes[97]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *JGetReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*JGetReq) ProtoMessage() {}
+
+func (x *JGetReq) ProtoReflect() protoreflect.Message {
+	mi := &file_bridge_proto_msgTypes[97]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use JGetReq.ProtoReflect.Descriptor instead.
+func (*JGetReq) Descriptor() ([]byte, []int) {
+	return file_bridge_proto_rawDescGZIP(), []int{97}
+}
+
+func (x *JGetReq) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *JGetReq) GetPath() []string {
+	if x != nil {
+		return x.Path
+	}
+	return nil
+}
+
+type JGetRsp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Key   string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Value string `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+}
+
+func (x *JGetRsp) Reset() {
+	*x = JGetRsp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_bridge_proto_msgTypes[98]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *JGetRsp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*JGetRsp) ProtoMessage() {}
+
+func (x *JGetRsp) ProtoReflect() protoreflect.Message {
+	mi := &file_bridge_proto_msgTypes[98]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use JGetRsp.ProtoReflect.Descriptor instead.
+func (*JGetRsp) Descriptor() ([]byte, []int) {
+	return file_bridge_proto_rawDescGZIP(), []int{98}
+}
+
+func (x *JGetRsp) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *JGetRsp) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+type JDelPathReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Key  string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Path string `protobuf:"bytes,2,opt,name=path,proto3" json:"path,omitempty"`
+}
+
+func (x *JDelPathReq) Reset() {
+	*x = JDelPathReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_bridge_proto_msgTypes[99]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *JDelPathReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*JDelPathReq) ProtoMessage() {}
+
+func (x *JDelPathReq) ProtoReflect() protoreflect.Message {
+	mi := &file_bridge_proto_msgTypes[99]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use JDelPathReq.ProtoReflect.Descriptor instead.
+func (*JDelPathReq) Descriptor() ([]byte, []int) {
+	return file_bridge_proto_rawDescGZIP(), []int{99}
+}
+
+func (x *JDelPathReq) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *JDelPathReq) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+type JDelPathRsp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Key   string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Count int64  `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+}
+
+func (x *JDelPathRsp) Reset() {
+	*x = JDelPathRsp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_bridge_proto_msgTypes[100]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *JDelPathRsp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*JDelPathRsp) ProtoMessage() {}
+
+func (x *JDelPathRsp) ProtoReflect() protoreflect.Message {
+	mi := &file_bridge_proto_msgTypes[100]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use JDelPathRsp.ProtoReflect.Descriptor instead.
+func (*JDelPathRsp) Descriptor() ([]byte, []int) {
+	return file_bridge_proto_rawDescGZIP(), []int{100}
+}
+
+func (x *JDelPathRsp) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *JDelPathRsp) GetCount() int64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+type JArrAppendReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Key   string   `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Path  string   `protobuf:"bytes,2,opt,name=path,proto3" json:"path,omitempty"`
+	Value []string `protobuf:"bytes,3,rep,name=value,proto3" json:"value,omitempty"`
+}
+
+func (x *JArrAppendReq) Reset() {
+	*x = JArrAppendReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_bridge_proto_msgTypes[101]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *JArrAppendReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*JArrAppendReq) ProtoMessage() {}
+
+func (x *JArrAppendReq) ProtoReflect() protoreflect.Message {
+	mi := &file_bridge_proto_msgTypes[101]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use JArrAppendReq.ProtoReflect.Descriptor instead.
+func (*JArrAppendReq) Descriptor() ([]byte, []int) {
+	return file_bridge_proto_rawDescGZIP(), []int{101}
+}
+
+func (x *JArrAppendReq) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *JArrAppendReq) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+func (x *JArrAppendReq) GetValue() []string {
+	if x != nil {
+		return x.Value
+	}
+	return nil
+}
+
+type JArrAppendRsp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Key string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Len int64  `protobuf:"varint,2,opt,name=len,proto3" json:"len,omitempty"`
+}
+
+func (x *JArrAppendRsp) Reset() {
+	*x = JArrAppendRsp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_bridge_proto_msgTypes[102]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *JArrAppendRsp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*JArrAppendRsp) ProtoMessage() {}
+
+func (x *JArrAppendRsp) ProtoReflect() protoreflect.Message {
+	mi := &file_bridge_proto_msgTypes[102]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use JArrAppendRsp.ProtoReflect.Descriptor instead.
+func (*JArrAppendRsp) Descriptor() ([]byte, []int) {
+	return file_bridge_proto_rawDescGZIP(), []int{102}
+}
+
+func (x *JArrAppendRsp) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *JArrAppendRsp) GetLen() int64 {
+	if x != nil {
+		return x.Len
+	}
+	return 0
+}
+
+type JNumIncrByReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Key  string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Path string `protobuf:"bytes,2,opt,name=path,proto3" json:"path,omitempty"`
+	By   string `protobuf:"bytes,3,opt,name=by,proto3" json:"by,omitempty"`
+}
+
+func (x *JNumIncrByReq) Reset() {
+	*x = JNumIncrByReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_bridge_proto_msgTypes[103]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *JNumIncrByReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*JNumIncrByReq) ProtoMessage() {}
+
+func (x *JNumIncrByReq) ProtoReflect() protoreflect.Message {
+	mi := &file_bridge_proto_msgTypes[103]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use JNumIncrByReq.ProtoReflect.Descriptor instead.
+func (*JNumIncrByReq) Descriptor() ([]byte, []int) {
+	return file_bridge_proto_rawDescGZIP(), []int{103}
+}
+
+func (x *JNumIncrByReq) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *JNumIncrByReq) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+func (x *JNumIncrByReq) GetBy() string {
+	if x != nil {
+		return x.By
+	}
+	return ""
+}
+
+type JNumIncrByRsp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Key   string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Value string `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+}
+
+func (x *JNumIncrByRsp) Reset() {
+	*x = JNumIncrByRsp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_bridge_proto_msgTypes[104]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *JNumIncrByRsp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*JNumIncrByRsp) ProtoMessage() {}
+
+func (x *JNumIncrByRsp) ProtoReflect() protoreflect.Message {
+	mi := &file_bridge_proto_msgTypes[104]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use JNumIncrByRsp.ProtoReflect.Descriptor instead.
+func (*JNumIncrByRsp) Descriptor() ([]byte, []int) {
+	return file_bridge_proto_rawDescGZIP(), []int{104}
+}
+
+func (x *JNumIncrByRsp) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *JNumIncrByRsp) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+type JDelReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Key string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+}
+
+func (x *JDelReq) Reset() {
+	*x = JDelReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_bridge_proto_msgTypes[105]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *JDelReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*JDelReq) ProtoMessage() {}
+
+func (x *JDelReq) ProtoReflect() protoreflect.Message {
+	mi := &file_bridge_proto_msgTypes[105]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use JDelReq.ProtoReflect.Descriptor instead.
+func (*JDelReq) Descriptor() ([]byte, []int) {
+	return file_bridge_proto_rawDescGZIP(), []int{105}
+}
+
+func (x *JDelReq) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+type JDelRsp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Key string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+}
+
+func (x *JDelRsp) Reset() {
+	*x = JDelRsp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_bridge_proto_msgTypes[106]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *JDelRsp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*JDelRsp) ProtoMessage() {}
+
+func (x *JDelRsp) ProtoReflect() protoreflect.Message {
+	mi := &file_bridge_proto_msgTypes[106]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use JDelRsp.ProtoReflect.Descriptor instead.
+func (*JDelRsp) Descriptor() ([]byte, []int) {
+	return file_bridge_proto_rawDescGZIP(), []int{106}
+}
+
+func (x *JDelRsp) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+type JWatchReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Key string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+}
+
+func (x *JWatchReq) Reset() {
+	*x = JWatchReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_bridge_proto_msgTypes[107]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *JWatchReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*JWatchReq) ProtoMessage() {}
+
+func (x *JWatchReq) ProtoReflect() protoreflect.Message {
+	mi := &file_bridge_proto_msgTypes[107]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use JWatchReq.ProtoReflect.Descriptor instead.
+func (*JWatchReq) Descriptor() ([]byte, []int) {
+	return file_bridge_proto_rawDescGZIP(), []int{107}
+}
+
+func (x *JWatchReq) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+type JWatchRsp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Key string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+}
+
+func (x *JWatchRsp) Reset() {
+	*x = JWatchRsp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_bridge_proto_msgTypes[108]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *JWatchRsp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*JWatchRsp) ProtoMessage() {}
+
+func (x *JWatchRsp) ProtoReflect() protoreflect.Message {
+	mi := &file_bridge_proto_msgTypes[108]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use JWatchRsp.ProtoReflect.Descriptor instead.
+func (*JWatchRsp) Descriptor() ([]byte, []int) {
+	return file_bridge_proto_rawDescGZIP(), []int{108}
+}
+
+func (x *JWatchRsp) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+type BFReserveReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Key       string  `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	ErrorRate float64 `protobuf:"fixed64,2,opt,name=errorRate,proto3" json:"errorRate,omitempty"`
+	Capacity  uint64  `protobuf:"varint,3,opt,name=capacity,proto3" json:"capacity,omitempty"`
+	Expire    int64   `protobuf:"varint,4,opt,name=expire,proto3" json:"expire,omitempty"`
+}
+
+func (x *BFReserveReq) Reset() {
+	*x = BFReserveReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_bridge_proto_msgTypes[109]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BFReserveReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BFReserveReq) ProtoMessage() {}
+
+func (x *BFReserveReq) ProtoReflect() protoreflect.Message {
+	mi := &file_bridge_proto_msgTypes[109]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BFReserveReq.ProtoReflect.Descriptor instead.
+func (*BFReserveReq) Descriptor() ([]byte, []int) {
+	return file_bridge_proto_rawDescGZIP(), []int{109}
+}
+
+func (x *BFReserveReq) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *BFReserveReq) GetErrorRate() float64 {
+	if x != nil {
+		return x.ErrorRate
+	}
+	return 0
+}
+
+func (x *BFReserveReq) GetCapacity() uint64 {
+	if x != nil {
+		return x.Capacity
+	}
+	return 0
+}
+
+func (x *BFReserveReq) GetExpire() int64 {
+	if x != nil {
+		return x.Expire
+	}
+	return 0
+}
+
+type BFReserveRsp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Key string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+}
+
+func (x *BFReserveRsp) Reset() {
+	*x = BFReserveRsp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_bridge_proto_msgTypes[110]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BFReserveRsp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BFReserveRsp) ProtoMessage() {}
+
+func (x *BFReserveRsp) ProtoReflect() protoreflect.Message {
+	mi := &file_bridge_proto_msgTypes[110]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BFReserveRsp.ProtoReflect.Descriptor instead.
+func (*BFReserveRsp) Descriptor() ([]byte, []int) {
+	return file_bridge_proto_rawDescGZIP(), []int{110}
+}
+
+func (x *BFReserveRsp) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+type BFAddReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Key    string   `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Item   []string `protobuf:"bytes,2,rep,name=item,proto3" json:"item,omitempty"`
+	Expire int64    `protobuf:"varint,3,opt,name=expire,proto3" json:"expire,omitempty"`
+}
+
+func (x *BFAddReq) Reset() {
+	*x = BFAddReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_bridge_proto_msgTypes[111]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BFAddReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BFAddReq) ProtoMessage() {}
+
+func (x *BFAddReq) ProtoReflect() protoreflect.Message {
+	mi := &file_bridge_proto_msgTypes[111]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BFAddReq.ProtoReflect.Descriptor instead.
+func (*BFAddReq) Descriptor() ([]byte, []int) {
+	return file_bridge_proto_rawDescGZIP(), []int{111}
+}
+
+func (x *BFAddReq) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *BFAddReq) GetItem() []string {
+	if x != nil {
+		return x.Item
+	}
+	return nil
+}
+
+func (x *BFAddReq) GetExpire() int64 {
+	if x != nil {
+		return x.Expire
+	}
+	return 0
+}
+
+type BFAddRsp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Key   string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Added []bool `protobuf:"varint,2,rep,packed,name=added,proto3" json:"added,omitempty"`
+}
+
+func (x *BFAddRsp) Reset() {
+	*x = BFAddRsp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_bridge_proto_msgTypes[112]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BFAddRsp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BFAddRsp) ProtoMessage() {}
+
+func (x *BFAddRsp) ProtoReflect() protoreflect.Message {
+	mi := &file_bridge_proto_msgTypes[112]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BFAddRsp.ProtoReflect.Descriptor instead.
+func (*BFAddRsp) Descriptor() ([]byte, []int) {
+	return file_bridge_proto_rawDescGZIP(), []int{112}
+}
+
+func (x *BFAddRsp) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *BFAddRsp) GetAdded() []bool {
+	if x != nil {
+		return x.Added
+	}
+	return nil
+}
+
+type BFExistsReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Key  string   `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Item []string `protobuf:"bytes,2,rep,name=item,proto3" json:"item,omitempty"`
+}
+
+func (x *BFExistsReq) Reset() {
+	*x = BFExistsReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_bridge_proto_msgTypes[113]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BFExistsReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BFExistsReq) ProtoMessage() {}
+
+func (x *BFExistsReq) ProtoReflect() protoreflect.Message {
+	mi := &file_bridge_proto_msgTypes[113]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BFExistsReq.ProtoReflect.Descriptor instead.
+func (*BFExistsReq) Descriptor() ([]byte, []int) {
+	return file_bridge_proto_rawDescGZIP(), []int{113}
+}
+
+func (x *BFExistsReq) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *BFExistsReq) GetItem() []string {
+	if x != nil {
+		return x.Item
+	}
+	return nil
+}
+
+type BFExistsRsp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Key    string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Exists []bool `protobuf:"varint,2,rep,packed,name=exists,proto3" json:"exists,omitempty"`
+}
+
+func (x *BFExistsRsp) Reset() {
+	*x = BFExistsRsp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_bridge_proto_msgTypes[114]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BFExistsRsp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BFExistsRsp) ProtoMessage() {}
+
+func (x *BFExistsRsp) ProtoReflect() protoreflect.Message {
+	mi := &file_bridge_proto_msgTypes[114]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BFExistsRsp.ProtoReflect.Descriptor instead.
+func (*BFExistsRsp) Descriptor() ([]byte, []int) {
+	return file_bridge_proto_rawDescGZIP(), []int{114}
+}
+
+func (x *BFExistsRsp) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *BFExistsRsp) GetExists() []bool {
+	if x != nil {
+		return x.Exists
+	}
+	return nil
+}
+
+type BFInfoReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Key string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+}
+
+func (x *BFInfoReq) Reset() {
+	*x = BFInfoReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_bridge_proto_msgTypes[115]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BFInfoReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BFInfoReq) ProtoMessage() {}
+
+func (x *BFInfoReq) ProtoReflect() protoreflect.Message {
+	mi := &file_bridge_proto_msgTypes[115]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BFInfoReq.ProtoReflect.Descriptor instead.
+func (*BFInfoReq) Descriptor() ([]byte, []int) {
+	return file_bridge_proto_rawDescGZIP(), []int{115}
+}
+
+func (x *BFInfoReq) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+type BFInfoRsp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Key       string  `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Capacity  uint64  `protobuf:"varint,2,opt,name=capacity,proto3" json:"capacity,omitempty"`
+	Size      int64   `protobuf:"varint,3,opt,name=size,proto3" json:"size,omitempty"`
+	Filters   int32   `protobuf:"varint,4,opt,name=filters,proto3" json:"filters,omitempty"`
+	Items     uint64  `protobuf:"varint,5,opt,name=items,proto3" json:"items,omitempty"`
+	ErrorRate float64 `protobuf:"fixed64,6,opt,name=errorRate,proto3" json:"errorRate,omitempty"`
+	Expansion int32   `protobuf:"varint,7,opt,name=expansion,proto3" json:"expansion,omitempty"`
+}
+
+func (x *BFInfoRsp) Reset() {
+	*x = BFInfoRsp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_bridge_proto_msgTypes[116]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BFInfoRsp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BFInfoRsp) ProtoMessage() {}
+
+func (x *BFInfoRsp) ProtoReflect() protoreflect.Message {
+	mi := &file_bridge_proto_msgTypes[116]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BFInfoRsp.ProtoReflect.Descriptor instead.
+func (*BFInfoRsp) Descriptor() ([]byte, []int) {
+	return file_bridge_proto_rawDescGZIP(), []int{116}
+}
+
+func (x *BFInfoRsp) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *BFInfoRsp) GetCapacity() uint64 {
+	if x != nil {
+		return x.Capacity
+	}
+	return 0
+}
+
+func (x *BFInfoRsp) GetSize() int64 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+func (x *BFInfoRsp) GetFilters() int32 {
+	if x != nil {
+		return x.Filters
+	}
+	return 0
+}
+
+func (x *BFInfoRsp) GetItems() uint64 {
+	if x != nil {
+		return x.Items
+	}
+	return 0
+}
+
+func (x *BFInfoRsp) GetErrorRate() float64 {
+	if x != nil {
+		return x.ErrorRate
+	}
+	return 0
+}
+
+func (x *BFInfoRsp) GetExpansion() int32 {
+	if x != nil {
+		return x.Expansion
+	}
+	return 0
+}
+
+type BFDelReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Key string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+}
+
+func (x *BFDelReq) Reset() {
+	*x = BFDelReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_bridge_proto_msgTypes[117]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BFDelReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BFDelReq) ProtoMessage() {}
+
+func (x *BFDelReq) ProtoReflect() protoreflect.Message {
+	mi := &file_bridge_proto_msgTypes[117]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BFDelReq.ProtoReflect.Descriptor instead.
+func (*BFDelReq) Descriptor() ([]byte, []int) {
+	return file_bridge_proto_rawDescGZIP(), []int{117}
+}
+
+func (x *BFDelReq) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+type BFDelRsp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Key string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+}
+
+func (x *BFDelRsp) Reset() {
+	*x = BFDelRsp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_bridge_proto_msgTypes[118]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BFDelRsp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BFDelRsp) ProtoMessage() {}
+
+func (x *BFDelRsp) ProtoReflect() protoreflect.Message {
+	mi := &file_bridge_proto_msgTypes[118]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BFDelRsp.ProtoReflect.Descriptor instead.
+func (*BFDelRsp) Descriptor() ([]byte, []int) {
+	return file_bridge_proto_rawDescGZIP(), []int{118}
+}
+
+func (x *BFDelRsp) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+type CFReserveReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Key      string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Capacity uint64 `protobuf:"varint,2,opt,name=capacity,proto3" json:"capacity,omitempty"`
+	Expire   int64  `protobuf:"varint,3,opt,name=expire,proto3" json:"expire,omitempty"`
+}
+
+func (x *CFReserveReq) Reset() {
+	*x = CFReserveReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_bridge_proto_msgTypes[119]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CFReserveReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CFReserveReq) ProtoMessage() {}
+
+func (x *CFReserveReq) ProtoReflect() protoreflect.Message {
+	mi := &file_bridge_proto_msgTypes[119]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CFReserveReq.ProtoReflect.Descriptor instead.
+func (*CFReserveReq) Descriptor() ([]byte, []int) {
+	return file_bridge_proto_rawDescGZIP(), []int{119}
+}
+
+func (x *CFReserveReq) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *CFReserveReq) GetCapacity() uint64 {
+	if x != nil {
+		return x.Capacity
+	}
+	return 0
+}
+
+func (x *CFReserveReq) GetExpire() int64 {
+	if x != nil {
+		return x.Expire
+	}
+	return 0
+}
+
+type CFReserveRsp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Key string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+}
+
+func (x *CFReserveRsp) Reset() {
+	*x = CFReserveRsp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_bridge_proto_msgTypes[120]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CFReserveRsp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CFReserveRsp) ProtoMessage() {}
+
+func (x *CFReserveRsp) ProtoReflect() protoreflect.Message {
+	mi := &file_bridge_proto_msgTypes[120]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CFReserveRsp.ProtoReflect.Descriptor instead.
+func (*CFReserveRsp) Descriptor() ([]byte, []int) {
+	return file_bridge_proto_rawDescGZIP(), []int{120}
+}
+
+func (x *CFReserveRsp) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+type CFAddReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Key    string   `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Item   []string `protobuf:"bytes,2,rep,name=item,proto3" json:"item,omitempty"`
+	Nx     bool     `protobuf:"varint,3,opt,name=nx,proto3" json:"nx,omitempty"`
+	Expire int64    `protobuf:"varint,4,opt,name=expire,proto3" json:"expire,omitempty"`
+}
+
+func (x *CFAddReq) Reset() {
+	*x = CFAddReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_bridge_proto_msgTypes[121]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CFAddReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CFAddReq) ProtoMessage() {}
+
+func (x *CFAddReq) ProtoReflect() protoreflect.Message {
+	mi := &file_bridge_proto_msgTypes[121]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CFAddReq.ProtoReflect.Descriptor instead.
+func (*CFAddReq) Descriptor() ([]byte, []int) {
+	return file_bridge_proto_rawDescGZIP(), []int{121}
+}
+
+func (x *CFAddReq) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *CFAddReq) GetItem() []string {
+	if x != nil {
+		return x.Item
+	}
+	return nil
+}
+
+func (x *CFAddReq) GetNx() bool {
+	if x != nil {
+		return x.Nx
+	}
+	return false
+}
+
+func (x *CFAddReq) GetExpire() int64 {
+	if x != nil {
+		return x.Expire
+	}
+	return 0
+}
+
+type CFAddRsp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Key   string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Added []bool `protobuf:"varint,2,rep,packed,name=added,proto3" json:"added,omitempty"`
+}
+
+func (x *CFAddRsp) Reset() {
+	*x = CFAddRsp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_bridge_proto_msgTypes[122]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CFAddRsp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CFAddRsp) ProtoMessage() {}
+
+func (x *CFAddRsp) ProtoReflect() protoreflect.Message {
+	mi := &file_bridge_proto_msgTypes[122]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CFAddRsp.ProtoReflect.Descriptor instead.
+func (*CFAddRsp) Descriptor() ([]byte, []int) {
+	return file_bridge_proto_rawDescGZIP(), []int{122}
+}
+
+func (x *CFAddRsp) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *CFAddRsp) GetAdded() []bool {
+	if x != nil {
+		return x.Added
+	}
+	return nil
+}
+
+type CFExistsReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Key  string   `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Item []string `protobuf:"bytes,2,rep,name=item,proto3" json:"item,omitempty"`
+}
+
+func (x *CFExistsReq) Reset() {
+	*x = CFExistsReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_bridge_proto_msgTypes[123]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CFExistsReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CFExistsReq) ProtoMessage() {}
+
+func (x *CFExistsReq) ProtoReflect() protoreflect.Message {
+	mi := &file_bridge_proto_msgTypes[123]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CFExistsReq.ProtoReflect.Descriptor instead.
+func (*CFExistsReq) Descriptor() ([]byte, []int) {
+	return file_bridge_proto_rawDescGZIP(), []int{123}
+}
+
+func (x *CFExistsReq) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *CFExistsReq) GetItem() []string {
+	if x != nil {
+		return x.Item
+	}
+	return nil
+}
+
+type CFExistsRsp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Key    string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Exists []bool `protobuf:"varint,2,rep,packed,name=exists,proto3" json:"exists,omitempty"`
+}
+
+func (x *CFExistsRsp) Reset() {
+	*x = CFExistsRsp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_bridge_proto_msgTypes[124]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CFExistsRsp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CFExistsRsp) ProtoMessage() {}
+
+func (x *CFExistsRsp) ProtoReflect() protoreflect.Message {
+	mi := &file_bridge_proto_msgTypes[124]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CFExistsRsp.ProtoReflect.Descriptor instead.
+func (*CFExistsRsp) Descriptor() ([]byte, []int) {
+	return file_bridge_proto_rawDescGZIP(), []int{124}
+}
+
+func (x *CFExistsRsp) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *CFExistsRsp) GetExists() []bool {
+	if x != nil {
+		return x.Exists
+	}
+	return nil
+}
+
+type CFDelMemberReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Key  string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Item string `protobuf:"bytes,2,opt,name=item,proto3" json:"item,omitempty"`
+}
+
+func (x *CFDelMemberReq) Reset() {
+	*x = CFDelMemberReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_bridge_proto_msgTypes[125]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CFDelMemberReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CFDelMemberReq) ProtoMessage() {}
+
+func (x *CFDelMemberReq) ProtoReflect() protoreflect.Message {
+	mi := &file_bridge_proto_msgTypes[125]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CFDelMemberReq.ProtoReflect.Descriptor instead.
+func (*CFDelMemberReq) Descriptor() ([]byte, []int) {
+	return file_bridge_proto_rawDescGZIP(), []int{125}
+}
+
+func (x *CFDelMemberReq) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *CFDelMemberReq) GetItem() string {
+	if x != nil {
+		return x.Item
+	}
+	return ""
+}
+
+type CFDelMemberRsp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Key     string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Deleted bool   `protobuf:"varint,2,opt,name=deleted,proto3" json:"deleted,omitempty"`
+}
+
+func (x *CFDelMemberRsp) Reset() {
+	*x = CFDelMemberRsp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_bridge_proto_msgTypes[126]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CFDelMemberRsp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CFDelMemberRsp) ProtoMessage() {}
+
+func (x *CFDelMemberRsp) ProtoReflect() protoreflect.Message {
+	mi := &file_bridge_proto_msgTypes[126]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CFDelMemberRsp.ProtoReflect.Descriptor instead.
+func (*CFDelMemberRsp) Descriptor() ([]byte, []int) {
+	return file_bridge_proto_rawDescGZIP(), []int{126}
+}
+
+func (x *CFDelMemberRsp) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *CFDelMemberRsp) GetDeleted() bool {
+	if x != nil {
+		return x.Deleted
+	}
+	return false
+}
+
+type CFInfoReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Key string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+}
+
+func (x *CFInfoReq) Reset() {
+	*x = CFInfoReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_bridge_proto_msgTypes[127]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CFInfoReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CFInfoReq) ProtoMessage() {}
+
+func (x *CFInfoReq) ProtoReflect() protoreflect.Message {
+	mi := &file_bridge_proto_msgTypes[127]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CFInfoReq.ProtoReflect.Descriptor instead.
+func (*CFInfoReq) Descriptor() ([]byte, []int) {
+	return file_bridge_proto_rawDescGZIP(), []int{127}
+}
+
+func (x *CFInfoReq) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+type CFInfoRsp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Key           string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Capacity      uint64 `protobuf:"varint,2,opt,name=capacity,proto3" json:"capacity,omitempty"`
+	Size          int64  `protobuf:"varint,3,opt,name=size,proto3" json:"size,omitempty"`
+	Buckets       uint64 `protobuf:"varint,4,opt,name=buckets,proto3" json:"buckets,omitempty"`
+	Filters       int32  `protobuf:"varint,5,opt,name=filters,proto3" json:"filters,omitempty"`
+	Items         uint64 `protobuf:"varint,6,opt,name=items,proto3" json:"items,omitempty"`
+	Deleted       uint64 `protobuf:"varint,7,opt,name=deleted,proto3" json:"deleted,omitempty"`
+	BucketSize    int32  `protobuf:"varint,8,opt,name=bucketSize,proto3" json:"bucketSize,omitempty"`
+	Expansion     int32  `protobuf:"varint,9,opt,name=expansion,proto3" json:"expansion,omitempty"`
+	MaxIterations int32  `protobuf:"varint,10,opt,name=maxIterations,proto3" json:"maxIterations,omitempty"`
+}
+
+func (x *CFInfoRsp) Reset() {
+	*x = CFInfoRsp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_bridge_proto_msgTypes[128]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CFInfoRsp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CFInfoRsp) ProtoMessage() {}
+
+func (x *CFInfoRsp) ProtoReflect() protoreflect.Message {
+	mi := &file_bridge_proto_msgTypes[128]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CFInfoRsp.ProtoReflect.Descriptor instead.
+func (*CFInfoRsp) Descriptor() ([]byte, []int) {
+	return file_bridge_proto_rawDescGZIP(), []int{128}
+}
+
+func (x *CFInfoRsp) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *CFInfoRsp) GetCapacity() uint64 {
+	if x != nil {
+		return x.Capacity
+	}
+	return 0
+}
+
+func (x *CFInfoRsp) GetSize() int64 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+func (x *CFInfoRsp) GetBuckets() uint64 {
+	if x != nil {
+		return x.Buckets
+	}
+	return 0
+}
+
+func (x *CFInfoRsp) GetFilters() int32 {
+	if x != nil {
+		return x.Filters
+	}
+	return 0
+}
+
+func (x *CFInfoRsp) GetItems() uint64 {
+	if x != nil {
+		return x.Items
+	}
+	return 0
+}
+
+func (x *CFInfoRsp) GetDeleted() uint64 {
+	if x != nil {
+		return x.Deleted
+	}
+	return 0
+}
+
+func (x *CFInfoRsp) GetBucketSize() int32 {
+	if x != nil {
+		return x.BucketSize
+	}
+	return 0
+}
+
+func (x *CFInfoRsp) GetExpansion() int32 {
+	if x != nil {
+		return x.Expansion
+	}
+	return 0
+}
+
+func (x *CFInfoRsp) GetMaxIterations() int32 {
+	if x != nil {
+		return x.MaxIterations
+	}
+	return 0
+}
+
+type CFDelReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Key string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+}
+
+func (x *CFDelReq) Reset() {
+	*x = CFDelReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_bridge_proto_msgTypes[129]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CFDelReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CFDelReq) ProtoMessage() {}
+
+func (x *CFDelReq) ProtoReflect() protoreflect.Message {
+	mi := &file_bridge_proto_msgTypes[129]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CFDelReq.ProtoReflect.Descriptor instead.
+func (*CFDelReq) Descriptor() ([]byte, []int) {
+	return file_bridge_proto_rawDescGZIP(), []int{129}
+}
+
+func (x *CFDelReq) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+type CFDelRsp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Key string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+}
+
+func (x *CFDelRsp) Reset() {
+	*x = CFDelRsp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_bridge_proto_msgTypes[130]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CFDelRsp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CFDelRsp) ProtoMessage() {}
+
+func (x *CFDelRsp) ProtoReflect() protoreflect.Message {
+	mi := &file_bridge_proto_msgTypes[130]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CFDelRsp.ProtoReflect.Descriptor instead.
+func (*CFDelRsp) Descriptor() ([]byte, []int) {
+	return file_bridge_proto_rawDescGZIP(), []int{130}
+}
+
+func (x *CFDelRsp) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+type TSSample struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Timestamp int64   `protobuf:"varint,1,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	Value     float64 `protobuf:"fixed64,2,opt,name=value,proto3" json:"value,omitempty"`
+}
+
+func (x *TSSample) Reset() {
+	*x = TSSample{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_bridge_proto_msgTypes[131]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TSSample) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TSSample) ProtoMessage() {}
+
+func (x *TSSample) ProtoReflect() protoreflect.Message {
+	mi := &file_bridge_proto_msgTypes[131]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TSSample.ProtoReflect.Descriptor instead.
+func (*TSSample) Descriptor() ([]byte, []int) {
+	return file_bridge_proto_rawDescGZIP(), []int{131}
+}
+
+func (x *TSSample) GetTimestamp() int64 {
+	if x != nil {
+		return x.Timestamp
+	}
+	return 0
+}
+
+func (x *TSSample) GetValue() float64 {
+	if x != nil {
+		return x.Value
+	}
+	return 0
+}
+
+type TSCreateReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Key       string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Retention int64  `protobuf:"varint,2,opt,name=retention,proto3" json:"retention,omitempty"`
+	Expire    int64  `protobuf:"varint,3,opt,name=expire,proto3" json:"expire,omitempty"`
+}
+
+func (x *TSCreateReq) Reset() {
+	*x = TSCreateReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_bridge_proto_msgTypes[132]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TSCreateReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TSCreateReq) ProtoMessage() {}
+
+func (x *TSCreateReq) ProtoReflect() protoreflect.Message {
+	mi := &file_bridge_proto_msgTypes[132]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TSCreateReq.ProtoReflect.Descriptor instead.
+func (*TSCreateReq) Descriptor() ([]byte, []int) {
+	return file_bridge_proto_rawDescGZIP(), []int{132}
+}
+
+func (x *TSCreateReq) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *TSCreateReq) GetRetention() int64 {
+	if x != nil {
+		return x.Retention
+	}
+	return 0
+}
+
+func (x *TSCreateReq) GetExpire() int64 {
+	if x != nil {
+		return x.Expire
+	}
+	return 0
+}
+
+type TSCreateRsp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Key string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+}
+
+func (x *TSCreateRsp) Reset() {
+	*x = TSCreateRsp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_bridge_proto_msgTypes[133]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TSCreateRsp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TSCreateRsp) ProtoMessage() {}
+
+func (x *TSCreateRsp) ProtoReflect() protoreflect.Message {
+	mi := &file_bridge_proto_msgTypes[133]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TSCreateRsp.ProtoReflect.Descriptor instead.
+func (*TSCreateRsp) Descriptor() ([]byte, []int) {
+	return file_bridge_proto_rawDescGZIP(), []int{133}
+}
+
+func (x *TSCreateRsp) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+type TSAddReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Key     string      `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Samples []*TSSample `protobuf:"bytes,2,rep,name=samples,proto3" json:"samples,omitempty"`
+	Expire  int64       `protobuf:"varint,3,opt,name=expire,proto3" json:"expire,omitempty"`
+}
+
+func (x *TSAddReq) Reset() {
+	*x = TSAddReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_bridge_proto_msgTypes[134]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TSAddReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TSAddReq) ProtoMessage() {}
+
+func (x *TSAddReq) ProtoReflect() protoreflect.Message {
+	mi := &file_bridge_proto_msgTypes[134]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TSAddReq.ProtoReflect.Descriptor instead.
+func (*TSAddReq) Descriptor() ([]byte, []int) {
+	return file_bridge_proto_rawDescGZIP(), []int{134}
+}
+
+func (x *TSAddReq) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *TSAddReq) GetSamples() []*TSSample {
+	if x != nil {
+		return x.Samples
+	}
+	return nil
+}
+
+func (x *TSAddReq) GetExpire() int64 {
+	if x != nil {
+		return x.Expire
+	}
+	return 0
+}
+
+type TSAddRsp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Key        string  `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Timestamps []int64 `protobuf:"varint,2,rep,packed,name=timestamps,proto3" json:"timestamps,omitempty"`
+}
+
+func (x *TSAddRsp) Reset() {
+	*x = TSAddRsp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_bridge_proto_msgTypes[135]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TSAddRsp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TSAddRsp) ProtoMessage() {}
+
+func (x *TSAddRsp) ProtoReflect() protoreflect.Message {
+	mi := &file_bridge_proto_msgTypes[135]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TSAddRsp.ProtoReflect.Descriptor instead.
+func (*TSAddRsp) Descriptor() ([]byte, []int) {
+	return file_bridge_proto_rawDescGZIP(), []int{135}
+}
+
+func (x *TSAddRsp) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *TSAddRsp) GetTimestamps() []int64 {
+	if x != nil {
+		return x.Timestamps
+	}
+	return nil
+}
+
+type TSRangeReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Key         string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	From        int64  `protobuf:"varint,2,opt,name=from,proto3" json:"from,omitempty"`
+	To          int64  `protobuf:"varint,3,opt,name=to,proto3" json:"to,omitempty"`
+	Aggregation string `protobuf:"bytes,4,opt,name=aggregation,proto3" json:"aggregation,omitempty"`
+	Bucket      int64  `protobuf:"varint,5,opt,name=bucket,proto3" json:"bucket,omitempty"`
+	Count       int32  `protobuf:"varint,6,opt,name=count,proto3" json:"count,omitempty"`
+}
+
+func (x *TSRangeReq) Reset() {
+	*x = TSRangeReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_bridge_proto_msgTypes[136]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TSRangeReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TSRangeReq) ProtoMessage() {}
+
+func (x *TSRangeReq) ProtoReflect() protoreflect.Message {
+	mi := &file_bridge_proto_msgTypes[136]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TSRangeReq.ProtoReflect.Descriptor instead.
+func (*TSRangeReq) Descriptor() ([]byte, []int) {
+	return file_bridge_proto_rawDescGZIP(), []int{136}
+}
+
+func (x *TSRangeReq) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *TSRangeReq) GetFrom() int64 {
+	if x != nil {
+		return x.From
+	}
+	return 0
+}
+
+func (x *TSRangeReq) GetTo() int64 {
+	if x != nil {
+		return x.To
+	}
+	return 0
+}
+
+func (x *TSRangeReq) GetAggregation() string {
+	if x != nil {
+		return x.Aggregation
+	}
+	return ""
+}
+
+func (x *TSRangeReq) GetBucket() int64 {
+	if x != nil {
+		return x.Bucket
+	}
+	return 0
+}
+
+func (x *TSRangeReq) GetCount() int32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+type TSRangeRsp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Key     string      `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Samples []*TSSample `protobuf:"bytes,2,rep,name=samples,proto3" json:"samples,omitempty"`
+}
+
+func (x *TSRangeRsp) Reset() {
+	*x = TSRangeRsp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_bridge_proto_msgTypes[137]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TSRangeRsp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TSRangeRsp) ProtoMessage() {}
+
+func (x *TSRangeRsp) ProtoReflect() protoreflect.Message {
+	mi := &file_bridge_proto_msgTypes[137]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TSRangeRsp.ProtoReflect.Descriptor instead.
+func (*TSRangeRsp) Descriptor() ([]byte, []int) {
+	return file_bridge_proto_rawDescGZIP(), []int{137}
+}
+
+func (x *TSRangeRsp) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *TSRangeRsp) GetSamples() []*TSSample {
+	if x != nil {
+		return x.Samples
+	}
+	return nil
+}
+
+type TSGetReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Key string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+}
+
+func (x *TSGetReq) Reset() {
+	*x = TSGetReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_bridge_proto_msgTypes[138]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TSGetReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TSGetReq) ProtoMessage() {}
+
+func (x *TSGetReq) ProtoReflect() protoreflect.Message {
+	mi := &file_bridge_proto_msgTypes[138]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TSGetReq.ProtoReflect.Descriptor instead.
+func (*TSGetReq) Descriptor() ([]byte, []int) {
+	return file_bridge_proto_rawDescGZIP(), []int{138}
+}
+
+func (x *TSGetReq) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+type TSGetRsp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Key    string    `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Sample *TSSample `protobuf:"bytes,2,opt,name=sample,proto3" json:"sample,omitempty"`
+}
+
+func (x *TSGetRsp) Reset() {
+	*x = TSGetRsp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_bridge_proto_msgTypes[139]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TSGetRsp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TSGetRsp) ProtoMessage() {}
+
+func (x *TSGetRsp) ProtoReflect() protoreflect.Message {
+	mi := &file_bridge_proto_msgTypes[139]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TSGetRsp.ProtoReflect.Descriptor instead.
+func (*TSGetRsp) Descriptor() ([]byte, []int) {
+	return file_bridge_proto_rawDescGZIP(), []int{139}
+}
+
+func (x *TSGetRsp) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *TSGetRsp) GetSample() *TSSample {
+	if x != nil {
+		return x.Sample
+	}
+	return nil
+}
+
+type TSDelRangeReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Key  string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	From int64  `protobuf:"varint,2,opt,name=from,proto3" json:"from,omitempty"`
+	To   int64  `protobuf:"varint,3,opt,name=to,proto3" json:"to,omitempty"`
+}
+
+func (x *TSDelRangeReq) Reset() {
+	*x = TSDelRangeReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_bridge_proto_msgTypes[140]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TSDelRangeReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TSDelRangeReq) ProtoMessage() {}
+
+func (x *TSDelRangeReq) ProtoReflect() protoreflect.Message {
+	mi := &file_bridge_proto_msgTypes[140]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TSDelRangeReq.ProtoReflect.Descriptor instead.
+func (*TSDelRangeReq) Descriptor() ([]byte, []int) {
+	return file_bridge_proto_rawDescGZIP(), []int{140}
+}
+
+func (x *TSDelRangeReq) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *TSDelRangeReq) GetFrom() int64 {
+	if x != nil {
+		return x.From
+	}
+	return 0
+}
+
+func (x *TSDelRangeReq) GetTo() int64 {
+	if x != nil {
+		return x.To
+	}
+	return 0
+}
+
+type TSDelRangeRsp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Key   string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Count int32  `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+}
+
+func (x *TSDelRangeRsp) Reset() {
+	*x = TSDelRangeRsp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_bridge_proto_msgTypes[141]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TSDelRangeRsp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TSDelRangeRsp) ProtoMessage() {}
+
+func (x *TSDelRangeRsp) ProtoReflect() protoreflect.Message {
+	mi := &file_bridge_proto_msgTypes[141]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TSDelRangeRsp.ProtoReflect.Descriptor instead.
+func (*TSDelRangeRsp) Descriptor() ([]byte, []int) {
+	return file_bridge_proto_rawDescGZIP(), []int{141}
+}
+
+func (x *TSDelRangeRsp) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *TSDelRangeRsp) GetCount() int32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+type TSRuleReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SourceKey   string `protobuf:"bytes,1,opt,name=sourceKey,proto3" json:"sourceKey,omitempty"`
+	DestKey     string `protobuf:"bytes,2,opt,name=destKey,proto3" json:"destKey,omitempty"`
+	Aggregation string `protobuf:"bytes,3,opt,name=aggregation,proto3" json:"aggregation,omitempty"`
+	Bucket      int64  `protobuf:"varint,4,opt,name=bucket,proto3" json:"bucket,omitempty"`
+}
+
+func (x *TSRuleReq) Reset() {
+	*x = TSRuleReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_bridge_proto_msgTypes[142]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TSRuleReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TSRuleReq) ProtoMessage() {}
+
+func (x *TSRuleReq) ProtoReflect() protoreflect.Message {
+	mi := &file_bridge_proto_msgTypes[142]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TSRuleReq.ProtoReflect.Descriptor instead.
+func (*TSRuleReq) Descriptor() ([]byte, []int) {
+	return file_bridge_proto_rawDescGZIP(), []int{142}
+}
+
+func (x *TSRuleReq) GetSourceKey() string {
+	if x != nil {
+		return x.SourceKey
+	}
+	return ""
+}
+
+func (x *TSRuleReq) GetDestKey() string {
+	if x != nil {
+		return x.DestKey
+	}
+	return ""
+}
+
+func (x *TSRuleReq) GetAggregation() string {
+	if x != nil {
+		return x.Aggregation
+	}
+	return ""
+}
+
+func (x *TSRuleReq) GetBucket() int64 {
+	if x != nil {
+		return x.Bucket
+	}
+	return 0
+}
+
+type TSRuleRsp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SourceKey string `protobuf:"bytes,1,opt,name=sourceKey,proto3" json:"sourceKey,omitempty"`
+	DestKey   string `protobuf:"bytes,2,opt,name=destKey,proto3" json:"destKey,omitempty"`
+}
+
+func (x *TSRuleRsp) Reset() {
+	*x = TSRuleRsp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_bridge_proto_msgTypes[143]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TSRuleRsp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TSRuleRsp) ProtoMessage() {}
+
+func (x *TSRuleRsp) ProtoReflect() protoreflect.Message {
+	mi := &file_bridge_proto_msgTypes[143]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TSRuleRsp.ProtoReflect.Descriptor instead.
+func (*TSRuleRsp) Descriptor() ([]byte, []int) {
+	return file_bridge_proto_rawDescGZIP(), []int{143}
+}
+
+func (x *TSRuleRsp) GetSourceKey() string {
+	if x != nil {
+		return x.SourceKey
+	}
+	return ""
+}
+
+func (x *TSRuleRsp) GetDestKey() string {
+	if x != nil {
+		return x.DestKey
+	}
+	return ""
+}
+
+type TSRule struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	DestKey     string `protobuf:"bytes,1,opt,name=destKey,proto3" json:"destKey,omitempty"`
+	Aggregation string `protobuf:"bytes,2,opt,name=aggregation,proto3" json:"aggregation,omitempty"`
+	Bucket      int64  `protobuf:"varint,3,opt,name=bucket,proto3" json:"bucket,omitempty"`
+}
+
+func (x *TSRule) Reset() {
+	*x = TSRule{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_bridge_proto_msgTypes[144]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TSRule) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TSRule) ProtoMessage() {}
+
+func (x *TSRule) ProtoReflect() protoreflect.Message {
+	mi := &file_bridge_proto_msgTypes[144]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TSRule.ProtoReflect.Descriptor instead.
+func (*TSRule) Descriptor() ([]byte, []int) {
+	return file_bridge_proto_rawDescGZIP(), []int{144}
+}
+
+func (x *TSRule) GetDestKey() string {
+	if x != nil {
+		return x.DestKey
+	}
+	return ""
+}
+
+func (x *TSRule) GetAggregation() string {
+	if x != nil {
+		return x.Aggregation
+	}
+	return ""
+}
+
+func (x *TSRule) GetBucket() int64 {
+	if x != nil {
+		return x.Bucket
+	}
+	return 0
+}
+
+type TSInfoReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Key string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+}
+
+func (x *TSInfoReq) Reset() {
+	*x = TSInfoReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_bridge_proto_msgTypes[145]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TSInfoReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TSInfoReq) ProtoMessage() {}
+
+func (x *TSInfoReq) ProtoReflect() protoreflect.Message {
+	mi := &file_bridge_proto_msgTypes[145]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TSInfoReq.ProtoReflect.Descriptor instead.
+func (*TSInfoReq) Descriptor() ([]byte, []int) {
+	return file_bridge_proto_rawDescGZIP(), []int{145}
+}
+
+func (x *TSInfoReq) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+type TSInfoRsp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Key            string    `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	TotalSamples   int64     `protobuf:"varint,2,opt,name=totalSamples,proto3" json:"totalSamples,omitempty"`
+	FirstTimestamp int64     `protobuf:"varint,3,opt,name=firstTimestamp,proto3" json:"firstTimestamp,omitempty"`
+	LastTimestamp  int64     `protobuf:"varint,4,opt,name=lastTimestamp,proto3" json:"lastTimestamp,omitempty"`
+	Retention      int64     `protobuf:"varint,5,opt,name=retention,proto3" json:"retention,omitempty"`
+	SourceKey      string    `protobuf:"bytes,6,opt,name=sourceKey,proto3" json:"sourceKey,omitempty"`
+	Rules          []*TSRule `protobuf:"bytes,7,rep,name=rules,proto3" json:"rules,omitempty"`
+}
+
+func (x *TSInfoRsp) Reset() {
+	*x = TSInfoRsp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_bridge_proto_msgTypes[146]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TSInfoRsp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TSInfoRsp) ProtoMessage() {}
+
+func (x *TSInfoRsp) ProtoReflect() protoreflect.Message {
+	mi := &file_bridge_proto_msgTypes[146]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TSInfoRsp.ProtoReflect.Descriptor instead.
+func (*TSInfoRsp) Descriptor() ([]byte, []int) {
+	return file_bridge_proto_rawDescGZIP(), []int{146}
+}
+
+func (x *TSInfoRsp) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *TSInfoRsp) GetTotalSamples() int64 {
+	if x != nil {
+		return x.TotalSamples
+	}
+	return 0
+}
+
+func (x *TSInfoRsp) GetFirstTimestamp() int64 {
+	if x != nil {
+		return x.FirstTimestamp
+	}
+	return 0
+}
+
+func (x *TSInfoRsp) GetLastTimestamp() int64 {
+	if x != nil {
+		return x.LastTimestamp
+	}
+	return 0
+}
+
+func (x *TSInfoRsp) GetRetention() int64 {
+	if x != nil {
+		return x.Retention
+	}
+	return 0
+}
+
+func (x *TSInfoRsp) GetSourceKey() string {
+	if x != nil {
+		return x.SourceKey
+	}
+	return ""
+}
+
+func (x *TSInfoRsp) GetRules() []*TSRule {
+	if x != nil {
+		return x.Rules
+	}
+	return nil
+}
+
+type TSDelReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Key string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+}
+
+func (x *TSDelReq) Reset() {
+	*x = TSDelReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_bridge_proto_msgTypes[147]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TSDelReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TSDelReq) ProtoMessage() {}
+
+func (x *TSDelReq) ProtoReflect() protoreflect.Message {
+	mi := &file_bridge_proto_msgTypes[147]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TSDelReq.ProtoReflect.Descriptor instead.
+func (*TSDelReq) Descriptor() ([]byte, []int) {
+	return file_bridge_proto_rawDescGZIP(), []int{147}
+}
+
+func (x *TSDelReq) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+type TSDelRsp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Key string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+}
+
+func (x *TSDelRsp) Reset() {
+	*x = TSDelRsp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_bridge_proto_msgTypes[148]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TSDelRsp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TSDelRsp) ProtoMessage() {}
+
+func (x *TSDelRsp) ProtoReflect() protoreflect.Message {
+	mi := &file_bridge_proto_msgTypes[148]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TSDelRsp.ProtoReflect.Descriptor instead.
+func (*TSDelRsp) Descriptor() ([]byte, []int) {
+	return file_bridge_proto_rawDescGZIP(), []int{148}
+}
+
+func (x *TSDelRsp) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+type ScanKey struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Key  string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Type string `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
+}
+
+func (x *ScanKey) Reset() {
+	*x = ScanKey{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_bridge_proto_msgTypes[149]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ScanKey) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScanKey) ProtoMessage() {}
+
+func (x *ScanKey) ProtoReflect() protoreflect.Message {
+	mi := &file_bridge_proto_msgTypes[149]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ScanKey.ProtoReflect.Descriptor instead.
+func (*ScanKey) Descriptor() ([]byte, []int) {
+	return file_bridge_proto_rawDescGZIP(), []int{149}
+}
+
+func (x *ScanKey) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *ScanKey) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+type ScanReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Cursor uint64 `protobuf:"varint,1,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	Match  string `protobuf:"bytes,2,opt,name=match,proto3" json:"match,omitempty"`
+	Count  int32  `protobuf:"varint,3,opt,name=count,proto3" json:"count,omitempty"`
+	Type   string `protobuf:"bytes,4,opt,name=type,proto3" json:"type,omitempty"`
+}
+
+func (x *ScanReq) Reset() {
+	*x = ScanReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_bridge_proto_msgTypes[150]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ScanReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScanReq) ProtoMessage() {}
+
+func (x *ScanReq) ProtoReflect() protoreflect.Message {
+	mi := &file_bridge_proto_msgTypes[150]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ScanReq.ProtoReflect.Descriptor instead.
+func (*ScanReq) Descriptor() ([]byte, []int) {
+	return file_bridge_proto_rawDescGZIP(), []int{150}
+}
+
+func (x *ScanReq) GetCursor() uint64 {
+	if x != nil {
+		return x.Cursor
+	}
+	return 0
+}
+
+func (x *ScanReq) GetMatch() string {
+	if x != nil {
+		return x.Match
+	}
+	return ""
+}
+
+func (x *ScanReq) GetCount() int32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+func (x *ScanReq) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+type ScanRsp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Cursor uint64     `protobuf:"varint,1,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	Keys   []*ScanKey `protobuf:"bytes,2,rep,name=keys,proto3" json:"keys,omitempty"`
+}
+
+func (x *ScanRsp) Reset() {
+	*x = ScanRsp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_bridge_proto_msgTypes[151]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ScanRsp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScanRsp) ProtoMessage() {}
+
+func (x *ScanRsp) ProtoReflect() protoreflect.Message {
+	mi := &file_bridge_proto_msgTypes[151]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ScanRsp.ProtoReflect.Descriptor instead.
+func (*ScanRsp) Descriptor() ([]byte, []int) {
+	return file_bridge_proto_rawDescGZIP(), []int{151}
+}
+
+func (x *ScanRsp) GetCursor() uint64 {
+	if x != nil {
+		return x.Cursor
+	}
+	return 0
+}
+
+func (x *ScanRsp) GetKeys() []*ScanKey {
+	if x != nil {
+		return x.Keys
+	}
+	return nil
+}
+
+type HScanReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	HmKey  string `protobuf:"bytes,1,opt,name=hmKey,proto3" json:"hmKey,omitempty"`
+	Cursor uint64 `protobuf:"varint,2,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	Match  string `protobuf:"bytes,3,opt,name=match,proto3" json:"match,omitempty"`
+	Count  int32  `protobuf:"varint,4,opt,name=count,proto3" json:"count,omitempty"`
+}
+
+func (x *HScanReq) Reset() {
+	*x = HScanReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_bridge_proto_msgTypes[152]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *HScanReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HScanReq) ProtoMessage() {}
+
+func (x *HScanReq) ProtoReflect() protoreflect.Message {
+	mi := &file_bridge_proto_msgTypes[152]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HScanReq.ProtoReflect.Descriptor instead.
+func (*HScanReq) Descriptor() ([]byte, []int) {
+	return file_bridge_proto_rawDescGZIP(), []int{152}
+}
+
+func (x *HScanReq) GetHmKey() string {
+	if x != nil {
+		return x.HmKey
+	}
+	return ""
+}
+
+func (x *HScanReq) GetCursor() uint64 {
+	if x != nil {
+		return x.Cursor
+	}
+	return 0
+}
+
+func (x *HScanReq) GetMatch() string {
+	if x != nil {
+		return x.Match
+	}
+	return ""
+}
+
+func (x *HScanReq) GetCount() int32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+type HScanRsp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	HmKey  string            `protobuf:"bytes,1,opt,name=hmKey,proto3" json:"hmKey,omitempty"`
+	Cursor uint64            `protobuf:"varint,2,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	Fields map[string]string `protobuf:"bytes,3,rep,name=fields,proto3" json:"fields,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+}
+
+func (x *HScanRsp) Reset() {
+	*x = HScanRsp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_bridge_proto_msgTypes[153]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *HScanRsp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HScanRsp) ProtoMessage() {}
+
+func (x *HScanRsp) ProtoReflect() protoreflect.Message {
+	mi := &file_bridge_proto_msgTypes[153]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HScanRsp.ProtoReflect.Descriptor instead.
+func (*HScanRsp) Descriptor() ([]byte, []int) {
+	return file_bridge_proto_rawDescGZIP(), []int{153}
+}
+
+func (x *HScanRsp) GetHmKey() string {
+	if x != nil {
+		return x.HmKey
+	}
+	return ""
+}
+
+func (x *HScanRsp) GetCursor() uint64 {
+	if x != nil {
+		return x.Cursor
+	}
+	return 0
+}
+
+func (x *HScanRsp) GetFields() map[string]string {
+	if x != nil {
+		return x.Fields
+	}
+	return nil
+}
+
+type SScanReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Key    string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Cursor uint64 `protobuf:"varint,2,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	Match  string `protobuf:"bytes,3,opt,name=match,proto3" json:"match,omitempty"`
+	Count  int32  `protobuf:"varint,4,opt,name=count,proto3" json:"count,omitempty"`
+}
+
+func (x *SScanReq) Reset() {
+	*x = SScanReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_bridge_proto_msgTypes[154]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SScanReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SScanReq) ProtoMessage() {}
+
+func (x *SScanReq) ProtoReflect() protoreflect.Message {
+	mi := &file_bridge_proto_msgTypes[154]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SScanReq.ProtoReflect.Descriptor instead.
+func (*SScanReq) Descriptor() ([]byte, []int) {
+	return file_bridge_proto_rawDescGZIP(), []int{154}
+}
+
+func (x *SScanReq) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *SScanReq) GetCursor() uint64 {
+	if x != nil {
+		return x.Cursor
+	}
+	return 0
+}
+
+func (x *SScanReq) GetMatch() string {
+	if x != nil {
+		return x.Match
+	}
+	return ""
+}
+
+func (x *SScanReq) GetCount() int32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+type SScanRsp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Key     string   `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Cursor  uint64   `protobuf:"varint,2,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	Members []string `protobuf:"bytes,3,rep,name=members,proto3" json:"members,omitempty"`
+}
+
+func (x *SScanRsp) Reset() {
+	*x = SScanRsp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_bridge_proto_msgTypes[155]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SScanRsp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SScanRsp) ProtoMessage() {}
+
+func (x *SScanRsp) ProtoReflect() protoreflect.Message {
+	mi := &file_bridge_proto_msgTypes[155]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SScanRsp.ProtoReflect.Descriptor instead.
+func (*SScanRsp) Descriptor() ([]byte, []int) {
+	return file_bridge_proto_rawDescGZIP(), []int{155}
+}
+
+func (x *SScanRsp) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *SScanRsp) GetCursor() uint64 {
+	if x != nil {
+		return x.Cursor
+	}
+	return 0
+}
+
+func (x *SScanRsp) GetMembers() []string {
+	if x != nil {
+		return x.Members
+	}
+	return nil
+}
+
+type TTLReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Key  string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Type string `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
+}
+
+func (x *TTLReq) Reset() {
+	*x = TTLReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_bridge_proto_msgTypes[156]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TTLReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TTLReq) ProtoMessage() {}
+
+func (x *TTLReq) ProtoReflect() protoreflect.Message {
+	mi := &file_bridge_proto_msgTypes[156]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TTLReq.ProtoReflect.Descriptor instead.
+func (*TTLReq) Descriptor() ([]byte, []int) {
+	return file_bridge_proto_rawDescGZIP(), []int{156}
+}
+
+func (x *TTLReq) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *TTLReq) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+type TTLRsp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Key string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Ttl int64  `protobuf:"varint,2,opt,name=ttl,proto3" json:"ttl,omitempty"`
+}
+
+func (x *TTLRsp) Reset() {
+	*x = TTLRsp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_bridge_proto_msgTypes[157]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TTLRsp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TTLRsp) ProtoMessage() {}
+
+func (x *TTLRsp) ProtoReflect() protoreflect.Message {
+	mi := &file_bridge_proto_msgTypes[157]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TTLRsp.ProtoReflect.Descriptor instead.
+func (*TTLRsp) Descriptor() ([]byte, []int) {
+	return file_bridge_proto_rawDescGZIP(), []int{157}
+}
+
+func (x *TTLRsp) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *TTLRsp) GetTtl() int64 {
+	if x != nil {
+		return x.Ttl
+	}
+	return 0
+}
+
+type ExpireReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Key     string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Type    string `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
+	Seconds int64  `protobuf:"varint,3,opt,name=seconds,proto3" json:"seconds,omitempty"`
+}
+
+func (x *ExpireReq) Reset() {
+	*x = ExpireReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_bridge_proto_msgTypes[158]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExpireReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExpireReq) ProtoMessage() {}
+
+func (x *ExpireReq) ProtoReflect() protoreflect.Message {
+	mi := &file_bridge_proto_msgTypes[158]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExpireReq.ProtoReflect.Descriptor instead.
+func (*ExpireReq) Descriptor() ([]byte, []int) {
+	return file_bridge_proto_rawDescGZIP(), []int{158}
+}
+
+func (x *ExpireReq) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *ExpireReq) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *ExpireReq) GetSeconds() int64 {
+	if x != nil {
+		return x.Seconds
+	}
+	return 0
+}
+
+type ExpireAtReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Key       string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Type      string `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
+	Timestamp int64  `protobuf:"varint,3,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+}
+
+func (x *ExpireAtReq) Reset() {
+	*x = ExpireAtReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_bridge_proto_msgTypes[159]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExpireAtReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExpireAtReq) ProtoMessage() {}
+
+func (x *ExpireAtReq) ProtoReflect() protoreflect.Message {
+	mi := &file_bridge_proto_msgTypes[159]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExpireAtReq.ProtoReflect.Descriptor instead.
+func (*ExpireAtReq) Descriptor() ([]byte, []int) {
+	return file_bridge_proto_rawDescGZIP(), []int{159}
+}
+
+func (x *ExpireAtReq) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *ExpireAtReq) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *ExpireAtReq) GetTimestamp() int64 {
+	if x != nil {
+		return x.Timestamp
+	}
+	return 0
+}
+
+type PersistReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Key  string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Type string `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
+}
+
+func (x *PersistReq) Reset() {
+	*x = PersistReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_bridge_proto_msgTypes[160]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PersistReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PersistReq) ProtoMessage() {}
+
+func (x *PersistReq) ProtoReflect() protoreflect.Message {
+	mi := &file_bridge_proto_msgTypes[160]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PersistReq.ProtoReflect.Descriptor instead.
+func (*PersistReq) Descriptor() ([]byte, []int) {
+	return file_bridge_proto_rawDescGZIP(), []int{160}
+}
+
+func (x *PersistReq) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *PersistReq) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+type ExpireRsp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Key string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Ok  bool   `protobuf:"varint,2,opt,name=ok,proto3" json:"ok,omitempty"`
+}
+
+func (x *ExpireRsp) Reset() {
+	*x = ExpireRsp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_bridge_proto_msgTypes[161]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExpireRsp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExpireRsp) ProtoMessage() {}
+
+func (x *ExpireRsp) ProtoReflect() protoreflect.Message {
+	mi := &file_bridge_proto_msgTypes[161]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExpireRsp.ProtoReflect.Descriptor instead.
+func (*ExpireRsp) Descriptor() ([]byte, []int) {
+	return file_bridge_proto_rawDescGZIP(), []int{161}
+}
+
+func (x *ExpireRsp) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *ExpireRsp) GetOk() bool {
+	if x != nil {
+		return x.Ok
+	}
+	return false
+}
+
+type PExpireReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Key          string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Type         string `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
+	Milliseconds int64  `protobuf:"varint,3,opt,name=milliseconds,proto3" json:"milliseconds,omitempty"`
+}
+
+func (x *PExpireReq) Reset() {
+	*x = PExpireReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_bridge_proto_msgTypes[162]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PExpireReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PExpireReq) ProtoMessage() {}
+
+func (x *PExpireReq) ProtoReflect() protoreflect.Message {
+	mi := &file_bridge_proto_msgTypes[162]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PExpireReq.ProtoReflect.Descriptor instead.
+func (*PExpireReq) Descriptor() ([]byte, []int) {
+	return file_bridge_proto_rawDescGZIP(), []int{162}
+}
+
+func (x *PExpireReq) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *PExpireReq) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *PExpireReq) GetMilliseconds() int64 {
+	if x != nil {
+		return x.Milliseconds
+	}
+	return 0
+}
+
+type PExpireAtReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Key       string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Type      string `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
+	Timestamp int64  `protobuf:"varint,3,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+}
+
+func (x *PExpireAtReq) Reset() {
+	*x = PExpireAtReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_bridge_proto_msgTypes[163]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PExpireAtReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PExpireAtReq) ProtoMessage() {}
+
+func (x *PExpireAtReq) ProtoReflect() protoreflect.Message {
+	mi := &file_bridge_proto_msgTypes[163]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PExpireAtReq.ProtoReflect.Descriptor instead.
+func (*PExpireAtReq) Descriptor() ([]byte, []int) {
+	return file_bridge_proto_rawDescGZIP(), []int{163}
+}
+
+func (x *PExpireAtReq) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *PExpireAtReq) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *PExpireAtReq) GetTimestamp() int64 {
+	if x != nil {
+		return x.Timestamp
+	}
+	return 0
+}
+
+type TypeReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Key string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+}
+
+func (x *TypeReq) Reset() {
+	*x = TypeReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_bridge_proto_msgTypes[164]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TypeReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TypeReq) ProtoMessage() {}
+
+func (x *TypeReq) ProtoReflect() protoreflect.Message {
+	mi := &file_bridge_proto_msgTypes[164]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TypeReq.ProtoReflect.Descriptor instead.
+func (*TypeReq) Descriptor() ([]byte, []int) {
+	return file_bridge_proto_rawDescGZIP(), []int{164}
+}
+
+func (x *TypeReq) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+type TypeRsp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Key  string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Type string `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
+}
+
+func (x *TypeRsp) Reset() {
+	*x = TypeRsp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_bridge_proto_msgTypes[165]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TypeRsp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TypeRsp) ProtoMessage() {}
+
+func (x *TypeRsp) ProtoReflect() protoreflect.Message {
+	mi := &file_bridge_proto_msgTypes[165]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TypeRsp.ProtoReflect.Descriptor instead.
+func (*TypeRsp) Descriptor() ([]byte, []int) {
+	return file_bridge_proto_rawDescGZIP(), []int{165}
+}
+
+func (x *TypeRsp) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *TypeRsp) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+type ExistsReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Keys []string `protobuf:"bytes,1,rep,name=keys,proto3" json:"keys,omitempty"`
+}
+
+func (x *ExistsReq) Reset() {
+	*x = ExistsReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_bridge_proto_msgTypes[166]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExistsReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExistsReq) ProtoMessage() {}
+
+func (x *ExistsReq) ProtoReflect() protoreflect.Message {
+	mi := &file_bridge_proto_msgTypes[166]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExistsReq.ProtoReflect.Descriptor instead.
+func (*ExistsReq) Descriptor() ([]byte, []int) {
+	return file_bridge_proto_rawDescGZIP(), []int{166}
+}
+
+func (x *ExistsReq) GetKeys() []string {
+	if x != nil {
+		return x.Keys
+	}
+	return nil
+}
+
+type ExistsRsp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Count int32 `protobuf:"varint,1,opt,name=count,proto3" json:"count,omitempty"`
+}
+
+func (x *ExistsRsp) Reset() {
+	*x = ExistsRsp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_bridge_proto_msgTypes[167]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExistsRsp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExistsRsp) ProtoMessage() {}
+
+func (x *ExistsRsp) ProtoReflect() protoreflect.Message {
+	mi := &file_bridge_proto_msgTypes[167]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExistsRsp.ProtoReflect.Descriptor instead.
+func (*ExistsRsp) Descriptor() ([]byte, []int) {
+	return file_bridge_proto_rawDescGZIP(), []int{167}
+}
+
+func (x *ExistsRsp) GetCount() int32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+type DelKeysReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Keys []string `protobuf:"bytes,1,rep,name=keys,proto3" json:"keys,omitempty"`
+}
+
+func (x *DelKeysReq) Reset() {
+	*x = DelKeysReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_bridge_proto_msgTypes[168]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DelKeysReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DelKeysReq) ProtoMessage() {}
+
+func (x *DelKeysReq) ProtoReflect() protoreflect.Message {
+	mi := &file_bridge_proto_msgTypes[168]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DelKeysReq.ProtoReflect.Descriptor instead.
+func (*DelKeysReq) Descriptor() ([]byte, []int) {
+	return file_bridge_proto_rawDescGZIP(), []int{168}
+}
+
+func (x *DelKeysReq) GetKeys() []string {
+	if x != nil {
+		return x.Keys
+	}
+	return nil
+}
+
+type DelKeysRsp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Count int32 `protobuf:"varint,1,opt,name=count,proto3" json:"count,omitempty"`
+}
+
+func (x *DelKeysRsp) Reset() {
+	*x = DelKeysRsp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_bridge_proto_msgTypes[169]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DelKeysRsp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DelKeysRsp) ProtoMessage() {}
+
+func (x *DelKeysRsp) ProtoReflect() protoreflect.Message {
+	mi := &file_bridge_proto_msgTypes[169]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DelKeysRsp.ProtoReflect.Descriptor instead.
+func (*DelKeysRsp) Descriptor() ([]byte, []int) {
+	return file_bridge_proto_rawDescGZIP(), []int{169}
+}
+
+func (x *DelKeysRsp) GetCount() int32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+type RenameReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Key    string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	NewKey string `protobuf:"bytes,2,opt,name=newKey,proto3" json:"newKey,omitempty"`
+}
+
+func (x *RenameReq) Reset() {
+	*x = RenameReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_bridge_proto_msgTypes[170]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RenameReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RenameReq) ProtoMessage() {}
+
+func (x *RenameReq) ProtoReflect() protoreflect.Message {
+	mi := &file_bridge_proto_msgTypes[170]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RenameReq.ProtoReflect.Descriptor instead.
+func (*RenameReq) Descriptor() ([]byte, []int) {
+	return file_bridge_proto_rawDescGZIP(), []int{170}
+}
+
+func (x *RenameReq) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *RenameReq) GetNewKey() string {
+	if x != nil {
+		return x.NewKey
+	}
+	return ""
+}
+
+type RenameRsp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Key    string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	NewKey string `protobuf:"bytes,2,opt,name=newKey,proto3" json:"newKey,omitempty"`
+}
+
+func (x *RenameRsp) Reset() {
+	*x = RenameRsp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_bridge_proto_msgTypes[171]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RenameRsp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RenameRsp) ProtoMessage() {}
+
+func (x *RenameRsp) ProtoReflect() protoreflect.Message {
+	mi := &file_bridge_proto_msgTypes[171]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RenameRsp.ProtoReflect.Descriptor instead.
+func (*RenameRsp) Descriptor() ([]byte, []int) {
+	return file_bridge_proto_rawDescGZIP(), []int{171}
+}
+
+func (x *RenameRsp) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *RenameRsp) GetNewKey() string {
+	if x != nil {
+		return x.NewKey
+	}
+	return ""
+}
+
+// 选择当前连接使用的数据库，请求的metadata中带有db时以metadata为准
+type SelectReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Db string `protobuf:"bytes,1,opt,name=db,proto3" json:"db,omitempty"`
+}
+
+func (x *SelectReq) Reset() {
+	*x = SelectReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_bridge_proto_msgTypes[172]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SelectReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SelectReq) ProtoMessage() {}
+
+func (x *SelectReq) ProtoReflect() protoreflect.Message {
+	mi := &file_bridge_proto_msgTypes[172]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SelectReq.ProtoReflect.Descriptor instead.
+func (*SelectReq) Descriptor() ([]byte, []int) {
+	return file_bridge_proto_rawDescGZIP(), []int{172}
+}
+
+func (x *SelectReq) GetDb() string {
+	if x != nil {
+		return x.Db
+	}
+	return ""
+}
+
+type SelectRsp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Db string `protobuf:"bytes,1,opt,name=db,proto3" json:"db,omitempty"`
+}
+
+func (x *SelectRsp) Reset() {
+	*x = SelectRsp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_bridge_proto_msgTypes[173]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SelectRsp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SelectRsp) ProtoMessage() {}
+
+func (x *SelectRsp) ProtoReflect() protoreflect.Message {
+	mi := &file_bridge_proto_msgTypes[173]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SelectRsp.ProtoReflect.Descriptor instead.
+func (*SelectRsp) Descriptor() ([]byte, []int) {
+	return file_bridge_proto_rawDescGZIP(), []int{173}
+}
+
+func (x *SelectRsp) GetDb() string {
+	if x != nil {
+		return x.Db
+	}
+	return ""
+}
+
+type CreateDBReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Db string `protobuf:"bytes,1,opt,name=db,proto3" json:"db,omitempty"`
+}
+
+func (x *CreateDBReq) Reset() {
+	*x = CreateDBReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_bridge_proto_msgTypes[174]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateDBReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateDBReq) ProtoMessage() {}
+
+func (x *CreateDBReq) ProtoReflect() protoreflect.Message {
+	mi := &file_bridge_proto_msgTypes[174]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateDBReq.ProtoReflect.Descriptor instead.
+func (*CreateDBReq) Descriptor() ([]byte, []int) {
+	return file_bridge_proto_rawDescGZIP(), []int{174}
+}
+
+func (x *CreateDBReq) GetDb() string {
+	if x != nil {
+		return x.Db
+	}
+	return ""
+}
+
+// created 为false表示数据库已经存在
+type CreateDBRsp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Db      string `protobuf:"bytes,1,opt,name=db,proto3" json:"db,omitempty"`
+	Created bool   `protobuf:"varint,2,opt,name=created,proto3" json:"created,omitempty"`
+}
+
+func (x *CreateDBRsp) Reset() {
+	*x = CreateDBRsp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_bridge_proto_msgTypes[175]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateDBRsp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateDBRsp) ProtoMessage() {}
+
+func (x *CreateDBRsp) ProtoReflect() protoreflect.Message {
+	mi := &file_bridge_proto_msgTypes[175]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateDBRsp.ProtoReflect.Descriptor instead.
+func (*CreateDBRsp) Descriptor() ([]byte, []int) {
+	return file_bridge_proto_rawDescGZIP(), []int{175}
+}
+
+func (x *CreateDBRsp) GetDb() string {
+	if x != nil {
+		return x.Db
+	}
+	return ""
+}
+
+func (x *CreateDBRsp) GetCreated() bool {
+	if x != nil {
+		return x.Created
+	}
+	return false
+}
+
+// db 为空时复制到当前数据库
+type CopyReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Key     string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	NewKey  string `protobuf:"bytes,2,opt,name=newKey,proto3" json:"newKey,omitempty"`
+	Db      string `protobuf:"bytes,3,opt,name=db,proto3" json:"db,omitempty"`
+	Replace bool   `protobuf:"varint,4,opt,name=replace,proto3" json:"replace,omitempty"`
+}
+
+func (x *CopyReq) Reset() {
+	*x = CopyReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_bridge_proto_msgTypes[176]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CopyReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CopyReq) ProtoMessage() {}
+
+func (x *CopyReq) ProtoReflect() protoreflect.Message {
+	mi := &file_bridge_proto_msgTypes[176]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CopyReq.ProtoReflect.Descriptor instead.
+func (*CopyReq) Descriptor() ([]byte, []int) {
+	return file_bridge_proto_rawDescGZIP(), []int{176}
+}
+
+func (x *CopyReq) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *CopyReq) GetNewKey() string {
+	if x != nil {
+		return x.NewKey
+	}
+	return ""
+}
+
+func (x *CopyReq) GetDb() string {
+	if x != nil {
+		return x.Db
+	}
+	return ""
+}
+
+func (x *CopyReq) GetReplace() bool {
+	if x != nil {
+		return x.Replace
+	}
+	return false
+}
+
+type CopyRsp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Key    string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	NewKey string `protobuf:"bytes,2,opt,name=newKey,proto3" json:"newKey,omitempty"`
+	Ok     bool   `protobuf:"varint,3,opt,name=ok,proto3" json:"ok,omitempty"`
+}
+
+func (x *CopyRsp) Reset() {
+	*x = CopyRsp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_bridge_proto_msgTypes[177]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CopyRsp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CopyRsp) ProtoMessage() {}
+
+func (x *CopyRsp) ProtoReflect() protoreflect.Message {
+	mi := &file_bridge_proto_msgTypes[177]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CopyRsp.ProtoReflect.Descriptor instead.
+func (*CopyRsp) Descriptor() ([]byte, []int) {
+	return file_bridge_proto_rawDescGZIP(), []int{177}
+}
+
+func (x *CopyRsp) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *CopyRsp) GetNewKey() string {
+	if x != nil {
+		return x.NewKey
+	}
+	return ""
+}
+
+func (x *CopyRsp) GetOk() bool {
+	if x != nil {
+		return x.Ok
+	}
+	return false
+}
+
+type MoveReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Key string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Db  string `protobuf:"bytes,2,opt,name=db,proto3" json:"db,omitempty"`
+}
+
+func (x *MoveReq) Reset() {
+	*x = MoveReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_bridge_proto_msgTypes[178]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MoveReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MoveReq) ProtoMessage() {}
+
+func (x *MoveReq) ProtoReflect() protoreflect.Message {
+	mi := &file_bridge_proto_msgTypes[178]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MoveReq.ProtoReflect.Descriptor instead.
+func (*MoveReq) Descriptor() ([]byte, []int) {
+	return file_bridge_proto_rawDescGZIP(), []int{178}
+}
+
+func (x *MoveReq) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *MoveReq) GetDb() string {
+	if x != nil {
+		return x.Db
+	}
+	return ""
+}
+
+type MoveRsp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Key string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Db  string `protobuf:"bytes,2,opt,name=db,proto3" json:"db,omitempty"`
+	Ok  bool   `protobuf:"varint,3,opt,name=ok,proto3" json:"ok,omitempty"`
+}
+
+func (x *MoveRsp) Reset() {
+	*x = MoveRsp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_bridge_proto_msgTypes[179]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MoveRsp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MoveRsp) ProtoMessage() {}
+
+func (x *MoveRsp) ProtoReflect() protoreflect.Message {
+	mi := &file_bridge_proto_msgTypes[179]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MoveRsp.ProtoReflect.Descriptor instead.
+func (*MoveRsp) Descriptor() ([]byte, []int) {
+	return file_bridge_proto_rawDescGZIP(), []int{179}
+}
+
+func (x *MoveRsp) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *MoveRsp) GetDb() string {
+	if x != nil {
+		return x.Db
+	}
+	return ""
+}
+
+func (x *MoveRsp) GetOk() bool {
+	if x != nil {
+		return x.Ok
+	}
+	return false
+}
+
+type KeyInfoReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Key  string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Type string `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
+}
+
+func (x *KeyInfoReq) Reset() {
+	*x = KeyInfoReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_bridge_proto_msgTypes[180]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *KeyInfoReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*KeyInfoReq) ProtoMessage() {}
+
+func (x *KeyInfoReq) ProtoReflect() protoreflect.Message {
+	mi := &file_bridge_proto_msgTypes[180]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use KeyInfoReq.ProtoReflect.Descriptor instead.
+func (*KeyInfoReq) Descriptor() ([]byte, []int) {
+	return file_bridge_proto_rawDescGZIP(), []int{180}
+}
+
+func (x *KeyInfoReq) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *KeyInfoReq) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+// 时间为unix毫秒时间戳，ttl 为剩余的毫秒数，没有过期时间时为-1
+type KeyInfoRsp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Key      string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Type     string `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
+	Encoding string `protobuf:"bytes,3,opt,name=encoding,proto3" json:"encoding,omitempty"`
+	Size     int64  `protobuf:"varint,4,opt,name=size,proto3" json:"size,omitempty"`
+	Created  int64  `protobuf:"varint,5,opt,name=created,proto3" json:"created,omitempty"`
+	Modified int64  `protobuf:"varint,6,opt,name=modified,proto3" json:"modified,omitempty"`
+	Accessed int64  `protobuf:"varint,7,opt,name=accessed,proto3" json:"accessed,omitempty"`
+	Idle     int64  `protobuf:"varint,8,opt,name=idle,proto3" json:"idle,omitempty"`
+	Hits     uint64 `protobuf:"varint,9,opt,name=hits,proto3" json:"hits,omitempty"`
+	Ttl      int64  `protobuf:"varint,10,opt,name=ttl,proto3" json:"ttl,omitempty"`
+	Freq     int64  `protobuf:"varint,11,opt,name=freq,proto3" json:"freq,omitempty"`
+}
+
+func (x *KeyInfoRsp) Reset() {
+	*x = KeyInfoRsp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_bridge_proto_msgTypes[181]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *KeyInfoRsp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*KeyInfoRsp) ProtoMessage() {}
+
+func (x *KeyInfoRsp) ProtoReflect() protoreflect.Message {
+	mi := &file_bridge_proto_msgTypes[181]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use KeyInfoRsp.ProtoReflect.Descriptor instead.
+func (*KeyInfoRsp) Descriptor() ([]byte, []int) {
+	return file_bridge_proto_rawDescGZIP(), []int{181}
+}
+
+func (x *KeyInfoRsp) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *KeyInfoRsp) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *KeyInfoRsp) GetEncoding() string {
+	if x != nil {
+		return x.Encoding
+	}
+	return ""
+}
+
+func (x *KeyInfoRsp) GetSize() int64 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+func (x *KeyInfoRsp) GetCreated() int64 {
+	if x != nil {
+		return x.Created
+	}
+	return 0
+}
+
+func (x *KeyInfoRsp) GetModified() int64 {
+	if x != nil {
+		return x.Modified
+	}
+	return 0
+}
+
+func (x *KeyInfoRsp) GetAccessed() int64 {
+	if x != nil {
+		return x.Accessed
+	}
+	return 0
+}
+
+func (x *KeyInfoRsp) GetIdle() int64 {
+	if x != nil {
+		return x.Idle
+	}
+	return 0
+}
+
+func (x *KeyInfoRsp) GetHits() uint64 {
+	if x != nil {
+		return x.Hits
+	}
+	return 0
+}
+
+func (x *KeyInfoRsp) GetTtl() int64 {
+	if x != nil {
+		return x.Ttl
+	}
+	return 0
+}
+
+func (x *KeyInfoRsp) GetFreq() int64 {
+	if x != nil {
+		return x.Freq
+	}
+	return 0
+}
+
+type KeyStatsReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *KeyStatsReq) Reset() {
+	*x = KeyStatsReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_bridge_proto_msgTypes[182]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *KeyStatsReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*KeyStatsReq) ProtoMessage() {}
+
+func (x *KeyStatsReq) ProtoReflect() protoreflect.Message {
+	mi := &file_bridge_proto_msgTypes[182]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use KeyStatsReq.ProtoReflect.Descriptor instead.
+func (*KeyStatsReq) Descriptor() ([]byte, []int) {
+	return file_bridge_proto_rawDescGZIP(), []int{182}
+}
+
+type TypeStats struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Type      string  `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
+	Keys      int64   `protobuf:"varint,2,opt,name=keys,proto3" json:"keys,omitempty"`
+	Size      int64   `protobuf:"varint,3,opt,name=size,proto3" json:"size,omitempty"`
+	MaxSize   int64   `protobuf:"varint,4,opt,name=maxSize,proto3" json:"maxSize,omitempty"`
+	Volatile  int64   `protobuf:"varint,5,opt,name=volatile,proto3" json:"volatile,omitempty"`
+	Hits      uint64  `protobuf:"varint,6,opt,name=hits,proto3" json:"hits,omitempty"`
+	Evictions uint64  `protobuf:"varint,7,opt,name=evictions,proto3" json:"evictions,omitempty"`
+	AvgIdle   int64   `protobuf:"varint,8,opt,name=avgIdle,proto3" json:"avgIdle,omitempty"`
+	MaxIdle   int64   `protobuf:"varint,9,opt,name=maxIdle,proto3" json:"maxIdle,omitempty"`
+	Policy    string  `protobuf:"bytes,10,opt,name=policy,proto3" json:"policy,omitempty"`
+	Misses    uint64  `protobuf:"varint,11,opt,name=misses,proto3" json:"misses,omitempty"`
+	HitRatio  float64 `protobuf:"fixed64,12,opt,name=hitRatio,proto3" json:"hitRatio,omitempty"`
+	Rejected  uint64  `protobuf:"varint,13,opt,name=rejected,proto3" json:"rejected,omitempty"`
+}
+
+func (x *TypeStats) Reset() {
+	*x = TypeStats{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_bridge_proto_msgTypes[183]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TypeStats) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TypeStats) ProtoMessage() {}
+
+func (x *TypeStats) ProtoReflect() protoreflect.Message {
+	mi := &file_bridge_proto_msgTypes[183]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TypeStats.ProtoReflect.Descriptor instead.
+func (*TypeStats) Descriptor() ([]byte, []int) {
+	return file_bridge_proto_rawDescGZIP(), []int{183}
+}
+
+func (x *TypeStats) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *TypeStats) GetKeys() int64 {
+	if x != nil {
+		return x.Keys
+	}
+	return 0
+}
+
+func (x *TypeStats) GetSize() int64 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+func (x *TypeStats) GetMaxSize() int64 {
+	if x != nil {
+		return x.MaxSize
+	}
+	return 0
+}
+
+func (x *TypeStats) GetVolatile() int64 {
+	if x != nil {
+		return x.Volatile
+	}
+	return 0
+}
+
+func (x *TypeStats) GetHits() uint64 {
+	if x != nil {
+		return x.Hits
+	}
+	return 0
+}
+
+func (x *TypeStats) GetEvictions() uint64 {
+	if x != nil {
+		return x.Evictions
+	}
+	return 0
+}
+
+func (x *TypeStats) GetAvgIdle() int64 {
+	if x != nil {
+		return x.AvgIdle
+	}
+	return 0
+}
+
+func (x *TypeStats) GetMaxIdle() int64 {
+	if x != nil {
+		return x.MaxIdle
+	}
+	return 0
+}
+
+func (x *TypeStats) GetPolicy() string {
+	if x != nil {
+		return x.Policy
+	}
+	return ""
+}
+
+func (x *TypeStats) GetMisses() uint64 {
+	if x != nil {
+		return x.Misses
+	}
+	return 0
+}
+
+func (x *TypeStats) GetHitRatio() float64 {
+	if x != nil {
+		return x.HitRatio
+	}
+	return 0
+}
+
+func (x *TypeStats) GetRejected() uint64 {
+	if x != nil {
+		return x.Rejected
+	}
+	return 0
+}
+
+type KeyStatsRsp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Stats []*TypeStats `protobuf:"bytes,1,rep,name=stats,proto3" json:"stats,omitempty"`
+}
+
+func (x *KeyStatsRsp) Reset() {
+	*x = KeyStatsRsp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_bridge_proto_msgTypes[184]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *KeyStatsRsp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*KeyStatsRsp) ProtoMessage() {}
+
+func (x *KeyStatsRsp) ProtoReflect() protoreflect.Message {
+	mi := &file_bridge_proto_msgTypes[184]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use KeyStatsRsp.ProtoReflect.Descriptor instead.
+func (*KeyStatsRsp) Descriptor() ([]byte, []int) {
+	return file_bridge_proto_rawDescGZIP(), []int{184}
+}
+
+func (x *KeyStatsRsp) GetStats() []*TypeStats {
+	if x != nil {
+		return x.Stats
+	}
+	return nil
+}
+
+type MemoryUsageReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Key  string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Type string `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
+}
+
+func (x *MemoryUsageReq) Reset() {
+	*x = MemoryUsageReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_bridge_proto_msgTypes[185]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MemoryUsageReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MemoryUsageReq) ProtoMessage() {}
+
+func (x *MemoryUsageReq) ProtoReflect() protoreflect.Message {
+	mi := &file_bridge_proto_msgTypes[185]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MemoryUsageReq.ProtoReflect.Descriptor instead.
+func (*MemoryUsageReq) Descriptor() ([]byte, []int) {
+	return file_bridge_proto_rawDescGZIP(), []int{185}
+}
+
+func (x *MemoryUsageReq) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *MemoryUsageReq) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+type MemoryUsageRsp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Key   string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Bytes int64  `protobuf:"varint,2,opt,name=bytes,proto3" json:"bytes,omitempty"`
+}
+
+func (x *MemoryUsageRsp) Reset() {
+	*x = MemoryUsageRsp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_bridge_proto_msgTypes[186]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MemoryUsageRsp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MemoryUsageRsp) ProtoMessage() {}
+
+func (x *MemoryUsageRsp) ProtoReflect() protoreflect.Message {
+	mi := &file_bridge_proto_msgTypes[186]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MemoryUsageRsp.ProtoReflect.Descriptor instead.
+func (*MemoryUsageRsp) Descriptor() ([]byte, []int) {
+	return file_bridge_proto_rawDescGZIP(), []int{186}
+}
+
+func (x *MemoryUsageRsp) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *MemoryUsageRsp) GetBytes() int64 {
+	if x != nil {
+		return x.Bytes
+	}
+	return 0
+}
+
+type MemoryStatsReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Gc bool `protobuf:"varint,1,opt,name=gc,proto3" json:"gc,omitempty"`
+}
+
+func (x *MemoryStatsReq) Reset() {
+	*x = MemoryStatsReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_bridge_proto_msgTypes[187]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MemoryStatsReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MemoryStatsReq) ProtoMessage() {}
+
+func (x *MemoryStatsReq) ProtoReflect() protoreflect.Message {
+	mi := &file_bridge_proto_msgTypes[187]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MemoryStatsReq.ProtoReflect.Descriptor instead.
+func (*MemoryStatsReq) Descriptor() ([]byte, []int) {
+	return file_bridge_proto_rawDescGZIP(), []int{187}
+}
+
+func (x *MemoryStatsReq) GetGc() bool {
+	if x != nil {
+		return x.Gc
+	}
+	return false
+}
+
+type MemoryStatsRsp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Accounted   int64   `protobuf:"varint,1,opt,name=accounted,proto3" json:"accounted,omitempty"`
+	Keys        int64   `protobuf:"varint,2,opt,name=keys,proto3" json:"keys,omitempty"`
+	MaxMemory   int64   `protobuf:"varint,3,opt,name=maxMemory,proto3" json:"maxMemory,omitempty"`
+	Policy      string  `protobuf:"bytes,4,opt,name=policy,proto3" json:"policy,omitempty"`
+	HeapAlloc   uint64  `protobuf:"varint,5,opt,name=heapAlloc,proto3" json:"heapAlloc,omitempty"`
+	HeapInuse   uint64  `protobuf:"varint,6,opt,name=heapInuse,proto3" json:"heapInuse,omitempty"`
+	HeapSys     uint64  `protobuf:"varint,7,opt,name=heapSys,proto3" json:"heapSys,omitempty"`
+	HeapObjects uint64  `protobuf:"varint,8,opt,name=heapObjects,proto3" json:"heapObjects,omitempty"`
+	Sys         uint64  `protobuf:"varint,9,opt,name=sys,proto3" json:"sys,omitempty"`
+	NumGC       uint32  `protobuf:"varint,10,opt,name=numGC,proto3" json:"numGC,omitempty"`
+	HeapRatio   float64 `protobuf:"fixed64,11,opt,name=heapRatio,proto3" json:"heapRatio,omitempty"`
+}
+
+func (x *MemoryStatsRsp) Reset() {
+	*x = MemoryStatsRsp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_bridge_proto_msgTypes[188]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MemoryStatsRsp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MemoryStatsRsp) ProtoMessage() {}
+
+func (x *MemoryStatsRsp) ProtoReflect() protoreflect.Message {
+	mi := &file_bridge_proto_msgTypes[188]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MemoryStatsRsp.ProtoReflect.Descriptor instead.
+func (*MemoryStatsRsp) Descriptor() ([]byte, []int) {
+	return file_bridge_proto_rawDescGZIP(), []int{188}
+}
+
+func (x *MemoryStatsRsp) GetAccounted() int64 {
+	if x != nil {
+		return x.Accounted
+	}
+	return 0
+}
+
+func (x *MemoryStatsRsp) GetKeys() int64 {
+	if x != nil {
+		return x.Keys
+	}
+	return 0
+}
+
+func (x *MemoryStatsRsp) GetMaxMemory() int64 {
+	if x != nil {
+		return x.MaxMemory
+	}
+	return 0
+}
+
+func (x *MemoryStatsRsp) GetPolicy() string {
+	if x != nil {
+		return x.Policy
+	}
+	return ""
+}
+
+func (x *MemoryStatsRsp) GetHeapAlloc() uint64 {
+	if x != nil {
+		return x.HeapAlloc
+	}
+	return 0
+}
+
+func (x *MemoryStatsRsp) GetHeapInuse() uint64 {
+	if x != nil {
+		return x.HeapInuse
+	}
+	return 0
+}
+
+func (x *MemoryStatsRsp) GetHeapSys() uint64 {
+	if x != nil {
+		return x.HeapSys
+	}
+	return 0
+}
+
+func (x *MemoryStatsRsp) GetHeapObjects() uint64 {
+	if x != nil {
+		return x.HeapObjects
+	}
+	return 0
+}
+
+func (x *MemoryStatsRsp) GetSys() uint64 {
+	if x != nil {
+		return x.Sys
+	}
+	return 0
+}
+
+func (x *MemoryStatsRsp) GetNumGC() uint32 {
+	if x != nil {
+		return x.NumGC
+	}
+	return 0
+}
+
+func (x *MemoryStatsRsp) GetHeapRatio() float64 {
+	if x != nil {
+		return x.HeapRatio
+	}
+	return 0
+}
+
+type TxWatchReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Keys []string `protobuf:"bytes,1,rep,name=keys,proto3" json:"keys,omitempty"`
+}
+
+func (x *TxWatchReq) Reset() {
+	*x = TxWatchReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_bridge_proto_msgTypes[189]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TxWatchReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TxWatchReq) ProtoMessage() {}
+
+func (x *TxWatchReq) ProtoReflect() protoreflect.Message {
+	mi := &file_bridge_proto_msgTypes[189]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TxWatchReq.ProtoReflect.Descriptor instead.
+func (*TxWatchReq) Descriptor() ([]byte, []int) {
+	return file_bridge_proto_rawDescGZIP(), []int{189}
+}
+
+func (x *TxWatchReq) GetKeys() []string {
+	if x != nil {
+		return x.Keys
+	}
+	return nil
+}
+
+type TxWatchRsp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Revisions map[string]uint64 `protobuf:"bytes,1,rep,name=revisions,proto3" json:"revisions,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+}
+
+func (x *TxWatchRsp) Reset() {
+	*x = TxWatchRsp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_bridge_proto_msgTypes[190]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TxWatchRsp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TxWatchRsp) ProtoMessage() {}
+
+func (x *TxWatchRsp) ProtoReflect() protoreflect.Message {
+	mi := &file_bridge_proto_msgTypes[190]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TxWatchRsp.ProtoReflect.Descriptor instead.
+func (*TxWatchRsp) Descriptor() ([]byte, []int) {
+	return file_bridge_proto_rawDescGZIP(), []int{190}
+}
+
+func (x *TxWatchRsp) GetRevisions() map[string]uint64 {
+	if x != nil {
+		return x.Revisions
+	}
+	return nil
+}
+
+// 写入的命令 expire 为过期的秒数，0为不过期
+type TxCommand struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Cmd    string   `protobuf:"bytes,1,opt,name=cmd,proto3" json:"cmd,omitempty"`
+	Key    string   `protobuf:"bytes,2,opt,name=key,proto3" json:"key,omitempty"`
+	Args   []string `protobuf:"bytes,3,rep,name=args,proto3" json:"args,omitempty"`
+	Expire int64    `protobuf:"varint,4,opt,name=expire,proto3" json:"expire,omitempty"`
+}
+
+func (x *TxCommand) Reset() {
+	*x = TxCommand{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_bridge_proto_msgTypes[191]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TxCommand) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TxCommand) ProtoMessage() {}
+
+func (x *TxCommand) ProtoReflect() protoreflect.Message {
+	mi := &file_bridge_proto_msgTypes[191]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TxCommand.ProtoReflect.Descriptor instead.
+func (*TxCommand) Descriptor() ([]byte, []int) {
+	return file_bridge_proto_rawDescGZIP(), []int{191}
+}
+
+func (x *TxCommand) GetCmd() string {
+	if x != nil {
+		return x.Cmd
+	}
+	return ""
+}
+
+func (x *TxCommand) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *TxCommand) GetArgs() []string {
+	if x != nil {
+		return x.Args
+	}
+	return nil
+}
+
+func (x *TxCommand) GetExpire() int64 {
+	if x != nil {
+		return x.Expire
+	}
+	return 0
+}
+
+type TxResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Value  string            `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty"`
+	Values []string          `protobuf:"bytes,2,rep,name=values,proto3" json:"values,omitempty"`
+	Fields map[string]string `protobuf:"bytes,3,rep,name=fields,proto3" json:"fields,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+}
+
+func (x *TxResult) Reset() {
+	*x = TxResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_bridge_proto_msgTypes[192]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TxResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TxResult) ProtoMessage() {}
+
+func (x *TxResult) ProtoReflect() protoreflect.Message {
+	mi := &file_bridge_proto_msgTypes[192]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TxResult.ProtoReflect.Descriptor instead.
+func (*TxResult) Descriptor() ([]byte, []int) {
+	return file_bridge_proto_rawDescGZIP(), []int{192}
+}
+
+func (x *TxResult) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+func (x *TxResult) GetValues() []string {
+	if x != nil {
+		return x.Values
+	}
+	return nil
+}
+
+func (x *TxResult) GetFields() map[string]string {
+	if x != nil {
+		return x.Fields
+	}
+	return nil
+}
+
+// watch 为 TxWatch 返回的修改版本，任意一个key的版本改变时不执行
+type ExecReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Commands []*TxCommand      `protobuf:"bytes,1,rep,name=commands,proto3" json:"commands,omitempty"`
+	Watch    map[string]uint64 `protobuf:"bytes,2,rep,name=watch,proto3" json:"watch,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+}
+
+func (x *ExecReq) Reset() {
+	*x = ExecReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_bridge_proto_msgTypes[193]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExecReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExecReq) ProtoMessage() {}
+
+func (x *ExecReq) ProtoReflect() protoreflect.Message {
+	mi := &file_bridge_proto_msgTypes[193]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExecReq.ProtoReflect.Descriptor instead.
+func (*ExecReq) Descriptor() ([]byte, []int) {
+	return file_bridge_proto_rawDescGZIP(), []int{193}
+}
+
+func (x *ExecReq) GetCommands() []*TxCommand {
+	if x != nil {
+		return x.Commands
+	}
+	return nil
+}
+
+func (x *ExecReq) GetWatch() map[string]uint64 {
+	if x != nil {
+		return x.Watch
+	}
+	return nil
+}
+
+type ExecRsp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Results []*TxResult `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+}
+
+func (x *ExecRsp) Reset() {
+	*x = ExecRsp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_bridge_proto_msgTypes[194]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExecRsp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExecRsp) ProtoMessage() {}
+
+func (x *ExecRsp) ProtoReflect() protoreflect.Message {
+	mi := &file_bridge_proto_msgTypes[194]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExecRsp.ProtoReflect.Descriptor instead.
+func (*ExecRsp) Descriptor() ([]byte, []int) {
+	return file_bridge_proto_rawDescGZIP(), []int{194}
+}
+
+func (x *ExecRsp) GetResults() []*TxResult {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+type MGetReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Keys []string `protobuf:"bytes,1,rep,name=keys,proto3" json:"keys,omitempty"`
+}
+
+func (x *MGetReq) Reset() {
+	*x = MGetReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_bridge_proto_msgTypes[195]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MGetReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MGetReq) ProtoMessage() {}
+
+func (x *MGetReq) ProtoReflect() protoreflect.Message {
+	mi := &file_bridge_proto_msgTypes[195]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MGetReq.ProtoReflect.Descriptor instead.
+func (*MGetReq) Descriptor() ([]byte, []int) {
+	return file_bridge_proto_rawDescGZIP(), []int{195}
+}
+
+func (x *MGetReq) GetKeys() []string {
+	if x != nil {
+		return x.Keys
+	}
+	return nil
+}
+
+// found 为false表示对应的key不存在、已过期或者不是string
+type MGetRsp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Values []string `protobuf:"bytes,1,rep,name=values,proto3" json:"values,omitempty"`
+	Found  []bool   `protobuf:"varint,2,rep,packed,name=found,proto3" json:"found,omitempty"`
+}
+
+func (x *MGetRsp) Reset() {
+	*x = MGetRsp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_bridge_proto_msgTypes[196]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MGetRsp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MGetRsp) ProtoMessage() {}
+
+func (x *MGetRsp) ProtoReflect() protoreflect.Message {
+	mi := &file_bridge_proto_msgTypes[196]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MGetRsp.ProtoReflect.Descriptor instead.
+func (*MGetRsp) Descriptor() ([]byte, []int) {
+	return file_bridge_proto_rawDescGZIP(), []int{196}
+}
+
+func (x *MGetRsp) GetValues() []string {
+	if x != nil {
+		return x.Values
+	}
+	return nil
+}
+
+func (x *MGetRsp) GetFound() []bool {
+	if x != nil {
+		return x.Found
+	}
+	return nil
+}
+
+type MSetReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Keys       []string    `protobuf:"bytes,1,rep,name=keys,proto3" json:"keys,omitempty"`
+	Values     []string    `protobuf:"bytes,2,rep,name=values,proto3" json:"values,omitempty"`
+	Expire     int64       `protobuf:"varint,3,opt,name=expire,proto3" json:"expire,omitempty"`
+	Expiration *Expiration `protobuf:"bytes,4,opt,name=expiration,proto3" json:"expiration,omitempty"`
+}
+
+func (x *MSetReq) Reset() {
+	*x = MSetReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_bridge_proto_msgTypes[197]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MSetReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MSetReq) ProtoMessage() {}
+
+func (x *MSetReq) ProtoReflect() protoreflect.Message {
+	mi := &file_bridge_proto_msgTypes[197]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MSetReq.ProtoReflect.Descriptor instead.
+func (*MSetReq) Descriptor() ([]byte, []int) {
+	return file_bridge_proto_rawDescGZIP(), []int{197}
+}
+
+func (x *MSetReq) GetKeys() []string {
+	if x != nil {
+		return x.Keys
+	}
+	return nil
+}
+
+func (x *MSetReq) GetValues() []string {
+	if x != nil {
+		return x.Values
+	}
+	return nil
+}
+
+func (x *MSetReq) GetExpire() int64 {
+	if x != nil {
+		return x.Expire
+	}
+	return 0
+}
+
+func (x *MSetReq) GetExpiration() *Expiration {
+	if x != nil {
+		return x.Expiration
+	}
+	return nil
+}
+
+type MSetRsp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *MSetRsp) Reset() {
+	*x = MSetRsp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_bridge_proto_msgTypes[198]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MSetRsp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MSetRsp) ProtoMessage() {}
+
+func (x *MSetRsp) ProtoReflect() protoreflect.Message {
+	mi := &file_bridge_proto_msgTypes[198]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MSetRsp.ProtoReflect.Descriptor instead.
+func (*MSetRsp) Descriptor() ([]byte, []int) {
+	return file_bridge_proto_rawDescGZIP(), []int{198}
+}
+
+// 每条命令单独执行，互相之间不是原子的
+type BatchReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Commands []*TxCommand `protobuf:"bytes,1,rep,name=commands,proto3" json:"commands,omitempty"`
+}
+
+func (x *BatchReq) Reset() {
+	*x = BatchReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_bridge_proto_msgTypes[199]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BatchReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchReq) ProtoMessage() {}
+
+func (x *BatchReq) ProtoReflect() protoreflect.Message {
+	mi := &file_bridge_proto_msgTypes[199]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchReq.ProtoReflect.Descriptor instead.
+func (*BatchReq) Descriptor() ([]byte, []int) {
+	return file_bridge_proto_rawDescGZIP(), []int{199}
+}
+
+func (x *BatchReq) GetCommands() []*TxCommand {
+	if x != nil {
+		return x.Commands
+	}
+	return nil
+}
+
+// error 不为空表示这条命令失败
+type BatchResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Value  string            `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty"`
+	Values []string          `protobuf:"bytes,2,rep,name=values,proto3" json:"values,omitempty"`
+	Fields map[string]string `protobuf:"bytes,3,rep,name=fields,proto3" json:"fields,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Error  string            `protobuf:"bytes,4,opt,name=error,proto3" json:"error,omitempty"`
+}
+
+func (x *BatchResult) Reset() {
+	*x = BatchResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_bridge_proto_msgTypes[200]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BatchResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchResult) ProtoMessage() {}
+
+func (x *BatchResult) ProtoReflect() protoreflect.Message {
+	mi := &file_bridge_proto_msgTypes[200]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchResult.ProtoReflect.Descriptor instead.
+func (*BatchResult) Descriptor() ([]byte, []int) {
+	return file_bridge_proto_rawDescGZIP(), []int{200}
+}
+
+func (x *BatchResult) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+func (x *BatchResult) GetValues() []string {
+	if x != nil {
+		return x.Values
+	}
+	return nil
+}
+
+func (x *BatchResult) GetFields() map[string]string {
+	if x != nil {
+		return x.Fields
+	}
+	return nil
+}
+
+func (x *BatchResult) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+type BatchRsp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Results []*BatchResult `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+}
+
+func (x *BatchRsp) Reset() {
+	*x = BatchRsp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_bridge_proto_msgTypes[201]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BatchRsp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchRsp) ProtoMessage() {}
+
+func (x *BatchRsp) ProtoReflect() protoreflect.Message {
+	mi := &file_bridge_proto_msgTypes[201]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchRsp.ProtoReflect.Descriptor instead.
+func (*BatchRsp) Descriptor() ([]byte, []int) {
+	return file_bridge_proto_rawDescGZIP(), []int{201}
+}
+
+func (x *BatchRsp) GetResults() []*BatchResult {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+type ClearReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *ClearReq) Reset() {
+	*x = ClearReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_bridge_proto_msgTypes[202]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ClearReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClearReq) ProtoMessage() {}
+
+func (x *ClearReq) ProtoReflect() protoreflect.Message {
+	mi := &file_bridge_proto_msgTypes[202]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClearReq.ProtoReflect.Descriptor instead.
+func (*ClearReq) Descriptor() ([]byte, []int) {
+	return file_bridge_proto_rawDescGZIP(), []int{202}
+}
+
+type ClearRsp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *ClearRsp) Reset() {
+	*x = ClearRsp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_bridge_proto_msgTypes[203]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ClearRsp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClearRsp) ProtoMessage() {}
+
+func (x *ClearRsp) ProtoReflect() protoreflect.Message {
+	mi := &file_bridge_proto_msgTypes[203]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClearRsp.ProtoReflect.Descriptor instead.
+func (*ClearRsp) Descriptor() ([]byte, []int) {
+	return file_bridge_proto_rawDescGZIP(), []int{203}
+}
+
+var File_bridge_proto protoreflect.FileDescriptor
+
+var file_bridge_proto_rawDesc = []byte{
+	0x0a, 0x0c, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x06,
+	0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x22, 0x27, 0x0a, 0x07, 0x50, 0x69, 0x6e, 0x67, 0x52, 0x65,
+	0x71, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x22,
+	0x27, 0x0a, 0x07, 0x50, 0x69, 0x6e, 0x67, 0x52, 0x73, 0x70, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x74,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x22, 0x1a, 0x0a, 0x06, 0x47, 0x65, 0x74, 0x52,
+	0x65, 0x71, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x03, 0x6b, 0x65, 0x79, 0x22, 0x4a, 0x0a, 0x06, 0x47, 0x65, 0x74, 0x52, 0x73, 0x70, 0x12, 0x10,
+	0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79,
+	0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f,
+	0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
+	0x22, 0x36, 0x0a, 0x0a, 0x45, 0x78, 0x70, 0x69, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x12,
+	0x0a, 0x04, 0x6d, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x6d, 0x6f,
+	0x64, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x28, 0x0a, 0x0c, 0x50, 0x72, 0x65, 0x63,
+	0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73,
+	0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69,
+	0x6f, 0x6e, 0x22, 0xb6, 0x01, 0x0a, 0x06, 0x50, 0x75, 0x74, 0x52, 0x65, 0x71, 0x12, 0x10, 0x0a,
+	0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12,
+	0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x12, 0x32, 0x0a,
+	0x0a, 0x65, 0x78, 0x70, 0x69, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x12, 0x2e, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x2e, 0x45, 0x78, 0x70, 0x69, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0a, 0x65, 0x78, 0x70, 0x69, 0x72, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x12, 0x38, 0x0a, 0x0c, 0x70, 0x72, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f,
+	0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65,
+	0x2e, 0x50, 0x72, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0c, 0x70,
+	0x72, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x62, 0x0a, 0x06, 0x50,
+	0x75, 0x74, 0x52, 0x73, 0x70, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x16, 0x0a,
+	0x06, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x65,
+	0x78, 0x70, 0x69, 0x72, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x22,
+	0x54, 0x0a, 0x06, 0x44, 0x65, 0x6c, 0x52, 0x65, 0x71, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x38, 0x0a, 0x0c, 0x70,
+	0x72, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x14, 0x2e, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x2e, 0x50, 0x72, 0x65, 0x63, 0x6f,
+	0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0c, 0x70, 0x72, 0x65, 0x63, 0x6f, 0x6e, 0x64,
+	0x69, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x1a, 0x0a, 0x06, 0x44, 0x65, 0x6c, 0x52, 0x73, 0x70, 0x12,
+	0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65,
+	0x79, 0x22, 0x2a, 0x0a, 0x0a, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x52, 0x65, 0x71, 0x12,
+	0x1c, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x22, 0xca, 0x01,
+	0x0a, 0x0a, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x52, 0x73, 0x70, 0x12, 0x14, 0x0a, 0x05,
+	0x68, 0x6d, 0x4b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x68, 0x6d, 0x4b,
+	0x65, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x03, 0x6b, 0x65, 0x79, 0x12, 0x20, 0x0a, 0x0b, 0x62, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x56, 0x61,
+	0x6c, 0x75, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x62, 0x65, 0x66, 0x6f, 0x72,
+	0x65, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x61, 0x66, 0x74, 0x65, 0x72, 0x56,
+	0x61, 0x6c, 0x75, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x61, 0x66, 0x74, 0x65,
+	0x72, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x61,
+	0x74, 0x61, 0x54, 0x79, 0x70, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x64, 0x61,
+	0x74, 0x61, 0x54, 0x79, 0x70, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x12, 0x0e, 0x0a, 0x02, 0x64, 0x62,
+	0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x64, 0x62, 0x22, 0x1c, 0x0a, 0x08, 0x57, 0x61,
+	0x74, 0x63, 0x68, 0x52, 0x65, 0x71, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x22, 0x1c, 0x0a, 0x08, 0x57, 0x61, 0x74, 0x63,
+	0x68, 0x52, 0x73, 0x70, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x22, 0x20, 0x0a, 0x08, 0x48, 0x4d, 0x47, 0x65, 0x74, 0x52,
+	0x65, 0x71, 0x12, 0x14, 0x0a, 0x05, 0x68, 0x6d, 0x4b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x68, 0x6d, 0x4b, 0x65, 0x79, 0x22, 0xc1, 0x01, 0x0a, 0x08, 0x48, 0x4d, 0x47,
+	0x65, 0x74, 0x52, 0x73, 0x70, 0x12, 0x14, 0x0a, 0x05, 0x68, 0x6d, 0x4b, 0x65, 0x79, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x68, 0x6d, 0x4b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x12, 0x34, 0x0a, 0x06, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x1c, 0x2e, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x2e, 0x48, 0x4d, 0x47, 0x65, 0x74,
+	0x52, 0x73, 0x70, 0x2e, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52,
+	0x06, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69,
+	0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f,
+	0x6e, 0x1a, 0x39, 0x0a, 0x0b, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79,
+	0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b,
+	0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x38, 0x0a, 0x0e,
+	0x48, 0x4d, 0x47, 0x65, 0x74, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x52, 0x65, 0x71, 0x12, 0x14,
+	0x0a, 0x05, 0x68, 0x6d, 0x4b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x68,
+	0x6d, 0x4b, 0x65, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x22, 0x4e, 0x0a, 0x0e, 0x48, 0x4d, 0x47, 0x65, 0x74, 0x4d,
+	0x65, 0x6d, 0x62, 0x65, 0x72, 0x52, 0x73, 0x70, 0x12, 0x14, 0x0a, 0x05, 0x68, 0x6d, 0x4b, 0x65,
+	0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x68, 0x6d, 0x4b, 0x65, 0x79, 0x12, 0x10,
+	0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79,
+	0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0xce, 0x01, 0x0a, 0x08, 0x48, 0x4d, 0x50, 0x75, 0x74,
+	0x52, 0x65, 0x71, 0x12, 0x14, 0x0a, 0x05, 0x68, 0x6d, 0x4b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x05, 0x68, 0x6d, 0x4b, 0x65, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79,
+	0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x12, 0x16, 0x0a, 0x06, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x06, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x12, 0x32, 0x0a, 0x0a, 0x65, 0x78, 0x70,
+	0x69, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e,
+	0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x2e, 0x45, 0x78, 0x70, 0x69, 0x72, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x52, 0x0a, 0x65, 0x78, 0x70, 0x69, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x38, 0x0a,
+	0x0c, 0x70, 0x72, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x2e, 0x50, 0x72, 0x65,
+	0x63, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0c, 0x70, 0x72, 0x65, 0x63, 0x6f,
+	0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x7a, 0x0a, 0x08, 0x48, 0x4d, 0x50, 0x75, 0x74,
+	0x52, 0x73, 0x70, 0x12, 0x14, 0x0a, 0x05, 0x68, 0x6d, 0x4b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x05, 0x68, 0x6d, 0x4b, 0x65, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79,
+	0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x12, 0x16, 0x0a, 0x06, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x06, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72,
+	0x73, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73,
+	0x69, 0x6f, 0x6e, 0x22, 0x5a, 0x0a, 0x08, 0x48, 0x4d, 0x44, 0x65, 0x6c, 0x52, 0x65, 0x71, 0x12,
+	0x14, 0x0a, 0x05, 0x68, 0x6d, 0x4b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
+	0x68, 0x6d, 0x4b, 0x65, 0x79, 0x12, 0x38, 0x0a, 0x0c, 0x70, 0x72, 0x65, 0x63, 0x6f, 0x6e, 0x64,
+	0x69, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x62, 0x72,
+	0x69, 0x64, 0x67, 0x65, 0x2e, 0x50, 0x72, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f,
+	0x6e, 0x52, 0x0c, 0x70, 0x72, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x22,
+	0x20, 0x0a, 0x08, 0x48, 0x4d, 0x44, 0x65, 0x6c, 0x52, 0x73, 0x70, 0x12, 0x14, 0x0a, 0x05, 0x68,
+	0x6d, 0x4b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x68, 0x6d, 0x4b, 0x65,
+	0x79, 0x22, 0x72, 0x0a, 0x0e, 0x48, 0x4d, 0x44, 0x65, 0x6c, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72,
+	0x52, 0x65, 0x71, 0x12, 0x14, 0x0a, 0x05, 0x68, 0x6d, 0x4b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x05, 0x68, 0x6d, 0x4b, 0x65, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x38, 0x0a, 0x0c, 0x70,
+	0x72, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x14, 0x2e, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x2e, 0x50, 0x72, 0x65, 0x63, 0x6f,
+	0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0c, 0x70, 0x72, 0x65, 0x63, 0x6f, 0x6e, 0x64,
+	0x69, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x52, 0x0a, 0x0e, 0x48, 0x4d, 0x44, 0x65, 0x6c, 0x4d, 0x65,
+	0x6d, 0x62, 0x65, 0x72, 0x52, 0x73, 0x70, 0x12, 0x14, 0x0a, 0x05, 0x68, 0x6d, 0x4b, 0x65, 0x79,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x68, 0x6d, 0x4b, 0x65, 0x79, 0x12, 0x10, 0x0a,
+	0x03, 0x6b, 0x65, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12,
+	0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x34, 0x0a, 0x0a, 0x48, 0x4d, 0x57,
+	0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x71, 0x12, 0x14, 0x0a, 0x05, 0x68, 0x6d, 0x4b, 0x65, 0x79,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x68, 0x6d, 0x4b, 0x65, 0x79, 0x12, 0x10, 0x0a,
+	0x03, 0x6b, 0x65, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x22,
+	0x34, 0x0a, 0x0a, 0x48, 0x4d, 0x57, 0x61, 0x74, 0x63, 0x68, 0x52, 0x73, 0x70, 0x12, 0x14, 0x0a,
+	0x05, 0x68, 0x6d, 0x4b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x68, 0x6d,
+	0x4b, 0x65, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x03, 0x6b, 0x65, 0x79, 0x22, 0x1b, 0x0a, 0x07, 0x4c, 0x47, 0x65, 0x74, 0x52, 0x65, 0x71,
+	0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b,
+	0x65, 0x79, 0x22, 0x4b, 0x0a, 0x07, 0x4c, 0x47, 0x65, 0x74, 0x52, 0x73, 0x70, 0x12, 0x10, 0x0a,
+	0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12,
+	0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x22,
+	0x58, 0x0a, 0x0c, 0x4c, 0x47, 0x65, 0x74, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x65, 0x71, 0x12,
+	0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65,
+	0x79, 0x12, 0x1a, 0x0a, 0x08, 0x62, 0x65, 0x67, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x08, 0x62, 0x65, 0x67, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x1a, 0x0a,
+	0x08, 0x65, 0x6e, 0x64, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x08, 0x65, 0x6e, 0x64, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x22, 0x36, 0x0a, 0x0c, 0x4c, 0x47, 0x65,
+	0x74, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x73, 0x70, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x22, 0xb7, 0x01, 0x0a, 0x07, 0x4c, 0x50, 0x75, 0x74, 0x52, 0x65, 0x71, 0x12, 0x10, 0x0a,
+	0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12,
+	0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x12, 0x32, 0x0a,
+	0x0a, 0x65, 0x78, 0x70, 0x69, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x12, 0x2e, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x2e, 0x45, 0x78, 0x70, 0x69, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0a, 0x65, 0x78, 0x70, 0x69, 0x72, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x12, 0x38, 0x0a, 0x0c, 0x70, 0x72, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f,
+	0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65,
+	0x2e, 0x50, 0x72, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0c, 0x70,
+	0x72, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x63, 0x0a, 0x07, 0x4c,
+	0x50, 0x75, 0x74, 0x52, 0x73, 0x70, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x16,
+	0x0a, 0x06, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06,
+	0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f,
+	0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
+	0x22, 0x55, 0x0a, 0x07, 0x4c, 0x44, 0x65, 0x6c, 0x52, 0x65, 0x71, 0x12, 0x10, 0x0a, 0x03, 0x6b,
+	0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x38, 0x0a,
+	0x0c, 0x70, 0x72, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x2e, 0x50, 0x72, 0x65,
+	0x63, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0c, 0x70, 0x72, 0x65, 0x63, 0x6f,
+	0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x1b, 0x0a, 0x07, 0x4c, 0x44, 0x65, 0x6c, 0x52,
+	0x73, 0x70, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x03, 0x6b, 0x65, 0x79, 0x22, 0x92, 0x01, 0x0a, 0x0c, 0x4c, 0x44, 0x65, 0x6c, 0x52, 0x61, 0x6e,
+	0x67, 0x65, 0x52, 0x65, 0x71, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x1a, 0x0a, 0x08, 0x62, 0x65, 0x67, 0x49, 0x6e,
+	0x64, 0x65, 0x78, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x62, 0x65, 0x67, 0x49, 0x6e,
+	0x64, 0x65, 0x78, 0x12, 0x1a, 0x0a, 0x08, 0x65, 0x6e, 0x64, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x65, 0x6e, 0x64, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x12,
+	0x38, 0x0a, 0x0c, 0x70, 0x72, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x2e, 0x50,
+	0x72, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0c, 0x70, 0x72, 0x65,
+	0x63, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x3a, 0x0a, 0x0c, 0x4c, 0x44, 0x65,
+	0x6c, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x73, 0x70, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x18, 0x0a, 0x07, 0x76,
+	0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x76, 0x65,
+	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x1d, 0x0a, 0x09, 0x4c, 0x57, 0x61, 0x74, 0x63, 0x68, 0x52,
+	0x65, 0x71, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x03, 0x6b, 0x65, 0x79, 0x22, 0x1d, 0x0a, 0x09, 0x4c, 0x57, 0x61, 0x74, 0x63, 0x68, 0x52, 0x73,
+	0x70, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03,
+	0x6b, 0x65, 0x79, 0x22, 0x1b, 0x0a, 0x07, 0x53, 0x47, 0x65, 0x74, 0x52, 0x65, 0x71, 0x12, 0x10,
+	0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79,
+	0x22, 0x4b, 0x0a, 0x07, 0x53, 0x47, 0x65, 0x74, 0x52, 0x73, 0x70, 0x12, 0x10, 0x0a, 0x03, 0x6b,
+	0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a,
+	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0xb7, 0x01,
+	0x0a, 0x07, 0x53, 0x50, 0x75, 0x74, 0x52, 0x65, 0x71, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x12, 0x16, 0x0a, 0x06, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x06, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x12, 0x32, 0x0a, 0x0a, 0x65, 0x78, 0x70,
+	0x69, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e,
+	0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x2e, 0x45, 0x78, 0x70, 0x69, 0x72, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x52, 0x0a, 0x65, 0x78, 0x70, 0x69, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x38, 0x0a,
+	0x0c, 0x70, 0x72, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x2e, 0x50, 0x72, 0x65,
+	0x63, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0c, 0x70, 0x72, 0x65, 0x63, 0x6f,
+	0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x63, 0x0a, 0x07, 0x53, 0x50, 0x75, 0x74, 0x52,
+	0x73, 0x70, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20,
+	0x03, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x65, 0x78,
+	0x70, 0x69, 0x72, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x65, 0x78, 0x70, 0x69,
+	0x72, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x55, 0x0a, 0x07,
+	0x53, 0x44, 0x65, 0x6c, 0x52, 0x65, 0x71, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x38, 0x0a, 0x0c, 0x70, 0x72, 0x65,
+	0x63, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x14, 0x2e, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x2e, 0x50, 0x72, 0x65, 0x63, 0x6f, 0x6e, 0x64,
+	0x69, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0c, 0x70, 0x72, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x69, 0x74,
+	0x69, 0x6f, 0x6e, 0x22, 0x1b, 0x0a, 0x07, 0x53, 0x44, 0x65, 0x6c, 0x52, 0x73, 0x70, 0x12, 0x10,
+	0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79,
+	0x22, 0x71, 0x0a, 0x0d, 0x53, 0x44, 0x65, 0x6c, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x52, 0x65,
+	0x71, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03,
+	0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x38, 0x0a, 0x0c, 0x70, 0x72, 0x65,
+	0x63, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x14, 0x2e, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x2e, 0x50, 0x72, 0x65, 0x63, 0x6f, 0x6e, 0x64,
+	0x69, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0c, 0x70, 0x72, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x69, 0x74,
+	0x69, 0x6f, 0x6e, 0x22, 0x51, 0x0a, 0x0d, 0x53, 0x44, 0x65, 0x6c, 0x4d, 0x65, 0x6d, 0x62, 0x65,
+	0x72, 0x52, 0x73, 0x70, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x18, 0x0a, 0x07,
+	0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x76,
+	0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x1d, 0x0a, 0x09, 0x53, 0x57, 0x61, 0x74, 0x63, 0x68,
+	0x52, 0x65, 0x71, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x03, 0x6b, 0x65, 0x79, 0x22, 0x1d, 0x0a, 0x09, 0x53, 0x57, 0x61, 0x74, 0x63, 0x68, 0x52,
+	0x73, 0x70, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x03, 0x6b, 0x65, 0x79, 0x22, 0x7e, 0x0a, 0x08, 0x50, 0x46, 0x41, 0x64, 0x64, 0x52, 0x65, 0x71,
+	0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b,
+	0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x03, 0x28,
+	0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x65, 0x78, 0x70, 0x69,
+	0x72, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65,
+	0x12, 0x32, 0x0a, 0x0a, 0x65, 0x78, 0x70, 0x69, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x2e, 0x45, 0x78,
+	0x70, 0x69, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0a, 0x65, 0x78, 0x70, 0x69, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x22, 0x36, 0x0a, 0x08, 0x50, 0x46, 0x41, 0x64, 0x64, 0x52, 0x73, 0x70,
+	0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b,
+	0x65, 0x79, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x64, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x07, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x64, 0x22, 0x1e, 0x0a, 0x0a,
+	0x50, 0x46, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65,
+	0x79, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x22, 0x34, 0x0a, 0x0a,
+	0x50, 0x46, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x73, 0x70, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65,
+	0x79, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x22, 0x3e, 0x0a, 0x0a, 0x50, 0x46, 0x4d, 0x65, 0x72, 0x67, 0x65, 0x52, 0x65, 0x71,
+	0x12, 0x18, 0x0a, 0x07, 0x64, 0x65, 0x73, 0x74, 0x4b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x07, 0x64, 0x65, 0x73, 0x74, 0x4b, 0x65, 0x79, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x72,
+	0x63, 0x4b, 0x65, 0x79, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x73, 0x72, 0x63, 0x4b,
+	0x65, 0x79, 0x22, 0x26, 0x0a, 0x0a, 0x50, 0x46, 0x4d, 0x65, 0x72, 0x67, 0x65, 0x52, 0x73, 0x70,
+	0x12, 0x18, 0x0a, 0x07, 0x64, 0x65, 0x73, 0x74, 0x4b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x07, 0x64, 0x65, 0x73, 0x74, 0x4b, 0x65, 0x79, 0x22, 0x1c, 0x0a, 0x08, 0x50, 0x46,
+	0x44, 0x65, 0x6c, 0x52, 0x65, 0x71, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x22, 0x1c, 0x0a, 0x08, 0x50, 0x46, 0x44, 0x65,
+	0x6c, 0x52, 0x73, 0x70, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x22, 0x6d, 0x0a, 0x09, 0x47, 0x65, 0x6f, 0x4d, 0x65, 0x6d,
+	0x62, 0x65, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x6c, 0x6f, 0x6e, 0x67, 0x69,
+	0x74, 0x75, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x09, 0x6c, 0x6f, 0x6e, 0x67,
+	0x69, 0x74, 0x75, 0x64, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x6c, 0x61, 0x74, 0x69, 0x74, 0x75, 0x64,
+	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x08, 0x6c, 0x61, 0x74, 0x69, 0x74, 0x75, 0x64,
+	0x65, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x69, 0x73, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x01, 0x52,
+	0x04, 0x64, 0x69, 0x73, 0x74, 0x22, 0x94, 0x01, 0x0a, 0x09, 0x47, 0x65, 0x6f, 0x41, 0x64, 0x64,
+	0x52, 0x65, 0x71, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x29, 0x0a, 0x06, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x18,
+	0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x2e, 0x47,
+	0x65, 0x6f, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x52, 0x06, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72,
+	0x12, 0x16, 0x0a, 0x06, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x06, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x12, 0x32, 0x0a, 0x0a, 0x65, 0x78, 0x70, 0x69,
+	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x62,
+	0x72, 0x69, 0x64, 0x67, 0x65, 0x2e, 0x45, 0x78, 0x70, 0x69, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x52, 0x0a, 0x65, 0x78, 0x70, 0x69, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x33, 0x0a, 0x09,
+	0x47, 0x65, 0x6f, 0x41, 0x64, 0x64, 0x52, 0x73, 0x70, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x61,
+	0x64, 0x64, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x61, 0x64, 0x64, 0x65,
+	0x64, 0x22, 0x35, 0x0a, 0x09, 0x47, 0x65, 0x6f, 0x50, 0x6f, 0x73, 0x52, 0x65, 0x71, 0x12, 0x10,
+	0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79,
+	0x12, 0x16, 0x0a, 0x06, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09,
+	0x52, 0x06, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x22, 0x48, 0x0a, 0x09, 0x47, 0x65, 0x6f, 0x50,
+	0x6f, 0x73, 0x52, 0x73, 0x70, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x29, 0x0a, 0x06, 0x6d, 0x65, 0x6d, 0x62, 0x65,
+	0x72, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65,
+	0x2e, 0x47, 0x65, 0x6f, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x52, 0x06, 0x6d, 0x65, 0x6d, 0x62,
+	0x65, 0x72, 0x22, 0x66, 0x0a, 0x0a, 0x47, 0x65, 0x6f, 0x44, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71,
+	0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b,
+	0x65, 0x79, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x31, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x31, 0x12, 0x18, 0x0a, 0x07,
+	0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x32, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d,
+	0x65, 0x6d, 0x62, 0x65, 0x72, 0x32, 0x12, 0x12, 0x0a, 0x04, 0x75, 0x6e, 0x69, 0x74, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x75, 0x6e, 0x69, 0x74, 0x22, 0x46, 0x0a, 0x0a, 0x47, 0x65,
+	0x6f, 0x44, 0x69, 0x73, 0x74, 0x52, 0x73, 0x70, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x69,
+	0x73, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x04, 0x64, 0x69, 0x73, 0x74, 0x12, 0x12,
+	0x0a, 0x04, 0x75, 0x6e, 0x69, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x75, 0x6e,
+	0x69, 0x74, 0x22, 0xf6, 0x01, 0x0a, 0x0c, 0x47, 0x65, 0x6f, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68,
+	0x52, 0x65, 0x71, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x16, 0x0a, 0x06, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x1c, 0x0a,
+	0x09, 0x6c, 0x6f, 0x6e, 0x67, 0x69, 0x74, 0x75, 0x64, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01,
+	0x52, 0x09, 0x6c, 0x6f, 0x6e, 0x67, 0x69, 0x74, 0x75, 0x64, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x6c,
+	0x61, 0x74, 0x69, 0x74, 0x75, 0x64, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x01, 0x52, 0x08, 0x6c,
+	0x61, 0x74, 0x69, 0x74, 0x75, 0x64, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x61, 0x64, 0x69, 0x75,
+	0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x01, 0x52, 0x06, 0x72, 0x61, 0x64, 0x69, 0x75, 0x73, 0x12,
+	0x14, 0x0a, 0x05, 0x77, 0x69, 0x64, 0x74, 0x68, 0x18, 0x06, 0x20, 0x01, 0x28, 0x01, 0x52, 0x05,
+	0x77, 0x69, 0x64, 0x74, 0x68, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18,
+	0x07, 0x20, 0x01, 0x28, 0x01, 0x52, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x12, 0x0a,
+	0x04, 0x75, 0x6e, 0x69, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x75, 0x6e, 0x69,
+	0x74, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x6f, 0x72, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x73, 0x6f, 0x72, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x0a,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x4b, 0x0a, 0x0c, 0x47,
+	0x65, 0x6f, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x52, 0x73, 0x70, 0x12, 0x10, 0x0a, 0x03, 0x6b,
+	0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x29, 0x0a,
+	0x06, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e,
+	0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x2e, 0x47, 0x65, 0x6f, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72,
+	0x52, 0x06, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x22, 0x1d, 0x0a, 0x09, 0x47, 0x65, 0x6f, 0x44,
+	0x65, 0x6c, 0x52, 0x65, 0x71, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x22, 0x1d, 0x0a, 0x09, 0x47, 0x65, 0x6f, 0x44, 0x65,
+	0x6c, 0x52, 0x73, 0x70, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x22, 0x3b, 0x0a, 0x0f, 0x47, 0x65, 0x6f, 0x44, 0x65, 0x6c,
+	0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x52, 0x65, 0x71, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x16, 0x0a, 0x06, 0x6d,
+	0x65, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6d, 0x65, 0x6d,
+	0x62, 0x65, 0x72, 0x22, 0x3b, 0x0a, 0x0f, 0x47, 0x65, 0x6f, 0x44, 0x65, 0x6c, 0x4d, 0x65, 0x6d,
+	0x62, 0x65, 0x72, 0x52, 0x73, 0x70, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x16, 0x0a, 0x06, 0x6d, 0x65, 0x6d, 0x62,
+	0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72,
+	0x22, 0x91, 0x01, 0x0a, 0x0b, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x45, 0x6e, 0x74, 0x72, 0x79,
+	0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64,
+	0x12, 0x37, 0x0a, 0x06, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x1f, 0x2e, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x2e, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d,
+	0x45, 0x6e, 0x74, 0x72, 0x79, 0x2e, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x45, 0x6e, 0x74, 0x72,
+	0x79, 0x52, 0x06, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x1a, 0x39, 0x0a, 0x0b, 0x46, 0x69, 0x65,
+	0x6c, 0x64, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x3a, 0x02, 0x38, 0x01, 0x22, 0x4c, 0x0a, 0x0d, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x45, 0x6e,
+	0x74, 0x72, 0x69, 0x65, 0x73, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x29, 0x0a, 0x05, 0x65, 0x6e, 0x74, 0x72, 0x79,
+	0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x2e,
+	0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x05, 0x65, 0x6e, 0x74,
+	0x72, 0x79, 0x22, 0x75, 0x0a, 0x0d, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x50, 0x65, 0x6e, 0x64,
+	0x69, 0x6e, 0x67, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x02, 0x69, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x6f, 0x6e, 0x73, 0x75, 0x6d, 0x65, 0x72, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x6f, 0x6e, 0x73, 0x75, 0x6d, 0x65, 0x72, 0x12,
+	0x12, 0x0a, 0x04, 0x69, 0x64, 0x6c, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x69,
+	0x64, 0x6c, 0x65, 0x12, 0x24, 0x0a, 0x0d, 0x64, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x43,
+	0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x64, 0x65, 0x6c, 0x69,
+	0x76, 0x65, 0x72, 0x79, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x87, 0x01, 0x0a, 0x07, 0x58, 0x41,
+	0x64, 0x64, 0x52, 0x65, 0x71, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x66, 0x69, 0x65, 0x6c, 0x64,
+	0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x12, 0x14, 0x0a,
+	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x6d, 0x61, 0x78, 0x4c, 0x65, 0x6e, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x06, 0x6d, 0x61, 0x78, 0x4c, 0x65, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x6d,
+	0x61, 0x78, 0x41, 0x67, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x6d, 0x61, 0x78,
+	0x41, 0x67, 0x65, 0x22, 0x2b, 0x0a, 0x07, 0x58, 0x41, 0x64, 0x64, 0x52, 0x73, 0x70, 0x12, 0x10,
+	0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79,
+	0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64,
+	0x22, 0x1b, 0x0a, 0x07, 0x58, 0x4c, 0x65, 0x6e, 0x52, 0x65, 0x71, 0x12, 0x10, 0x0a, 0x03, 0x6b,
+	0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x22, 0x2d, 0x0a,
+	0x07, 0x58, 0x4c, 0x65, 0x6e, 0x52, 0x73, 0x70, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6c, 0x65,
+	0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x03, 0x6c, 0x65, 0x6e, 0x22, 0x6d, 0x0a, 0x09,
+	0x58, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x65, 0x71, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x73,
+	0x74, 0x61, 0x72, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x73, 0x74, 0x61, 0x72,
+	0x74, 0x12, 0x10, 0x0a, 0x03, 0x65, 0x6e, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03,
+	0x65, 0x6e, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x72, 0x65, 0x76,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x03, 0x72, 0x65, 0x76, 0x22, 0x48, 0x0a, 0x09, 0x58,
+	0x52, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x73, 0x70, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x29, 0x0a, 0x05, 0x65, 0x6e,
+	0x74, 0x72, 0x79, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x62, 0x72, 0x69, 0x64,
+	0x67, 0x65, 0x2e, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x05,
+	0x65, 0x6e, 0x74, 0x72, 0x79, 0x22, 0x58, 0x0a, 0x08, 0x58, 0x52, 0x65, 0x61, 0x64, 0x52, 0x65,
+	0x71, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x03,
+	0x6b, 0x65, 0x79, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52,
+	0x02, 0x69, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x62, 0x6c, 0x6f,
+	0x63, 0x6b, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x22,
+	0x39, 0x0a, 0x08, 0x58, 0x52, 0x65, 0x61, 0x64, 0x52, 0x73, 0x70, 0x12, 0x2d, 0x0a, 0x06, 0x73,
+	0x74, 0x72, 0x65, 0x61, 0x6d, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x62, 0x72,
+	0x69, 0x64, 0x67, 0x65, 0x2e, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x45, 0x6e, 0x74, 0x72, 0x69,
+	0x65, 0x73, 0x52, 0x06, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x22, 0x5f, 0x0a, 0x09, 0x58, 0x47,
+	0x72, 0x6f, 0x75, 0x70, 0x52, 0x65, 0x71, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x67, 0x72, 0x6f,
+	0x75, 0x70, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x12,
+	0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12,
+	0x1a, 0x0a, 0x08, 0x6d, 0x6b, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x08, 0x6d, 0x6b, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x22, 0x33, 0x0a, 0x09, 0x58,
+	0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x73, 0x70, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x67, 0x72,
+	0x6f, 0x75, 0x70, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x67, 0x72, 0x6f, 0x75, 0x70,
+	0x22, 0xa5, 0x01, 0x0a, 0x0d, 0x58, 0x52, 0x65, 0x61, 0x64, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52,
+	0x65, 0x71, 0x12, 0x14, 0x0a, 0x05, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x6f, 0x6e, 0x73,
+	0x75, 0x6d, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x6f, 0x6e, 0x73,
+	0x75, 0x6d, 0x65, 0x72, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x03, 0x20, 0x03, 0x28,
+	0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x04, 0x20, 0x03,
+	0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x14, 0x0a, 0x05,
+	0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x62, 0x6c, 0x6f,
+	0x63, 0x6b, 0x12, 0x14, 0x0a, 0x05, 0x6e, 0x6f, 0x41, 0x63, 0x6b, 0x18, 0x07, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x05, 0x6e, 0x6f, 0x41, 0x63, 0x6b, 0x22, 0x41, 0x0a, 0x07, 0x58, 0x41, 0x63, 0x6b,
+	0x52, 0x65, 0x71, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x12, 0x0e, 0x0a, 0x02, 0x69,
+	0x64, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0x31, 0x0a, 0x07, 0x58,
+	0x41, 0x63, 0x6b, 0x52, 0x73, 0x70, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x67,
+	0x0a, 0x0b, 0x58, 0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x12, 0x10, 0x0a,
+	0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12,
+	0x14, 0x0a, 0x05, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
+	0x67, 0x72, 0x6f, 0x75, 0x70, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x6f, 0x6e, 0x73, 0x75, 0x6d, 0x65,
+	0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x6f, 0x6e, 0x73, 0x75, 0x6d, 0x65,
+	0x72, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x50, 0x0a, 0x0b, 0x58, 0x50, 0x65, 0x6e, 0x64,
+	0x69, 0x6e, 0x67, 0x52, 0x73, 0x70, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x2f, 0x0a, 0x07, 0x70, 0x65, 0x6e, 0x64,
+	0x69, 0x6e, 0x67, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x62, 0x72, 0x69, 0x64,
+	0x67, 0x65, 0x2e, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67,
+	0x52, 0x07, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x22, 0x79, 0x0a, 0x09, 0x58, 0x43, 0x6c,
+	0x61, 0x69, 0x6d, 0x52, 0x65, 0x71, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x67, 0x72, 0x6f, 0x75,
+	0x70, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x12, 0x1a,
+	0x0a, 0x08, 0x63, 0x6f, 0x6e, 0x73, 0x75, 0x6d, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x08, 0x63, 0x6f, 0x6e, 0x73, 0x75, 0x6d, 0x65, 0x72, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x69,
+	0x6e, 0x49, 0x64, 0x6c, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x6d, 0x69, 0x6e,
+	0x49, 0x64, 0x6c, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x05, 0x20, 0x03, 0x28, 0x09,
+	0x52, 0x02, 0x69, 0x64, 0x22, 0x48, 0x0a, 0x09, 0x58, 0x43, 0x6c, 0x61, 0x69, 0x6d, 0x52, 0x73,
+	0x70, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03,
+	0x6b, 0x65, 0x79, 0x12, 0x29, 0x0a, 0x05, 0x65, 0x6e, 0x74, 0x72, 0x79, 0x18, 0x02, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x13, 0x2e, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x2e, 0x53, 0x74, 0x72, 0x65,
+	0x61, 0x6d, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x05, 0x65, 0x6e, 0x74, 0x72, 0x79, 0x22, 0x4c,
+	0x0a, 0x08, 0x58, 0x54, 0x72, 0x69, 0x6d, 0x52, 0x65, 0x71, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65,
+	0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x16, 0x0a, 0x06,
+	0x6d, 0x61, 0x78, 0x4c, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x6d, 0x61,
+	0x78, 0x4c, 0x65, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x6d, 0x61, 0x78, 0x41, 0x67, 0x65, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x6d, 0x61, 0x78, 0x41, 0x67, 0x65, 0x22, 0x32, 0x0a, 0x08,
+	0x58, 0x54, 0x72, 0x69, 0x6d, 0x52, 0x73, 0x70, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x22, 0x31, 0x0a, 0x0d, 0x58, 0x44, 0x65, 0x6c, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x52, 0x65,
+	0x71, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03,
+	0x6b, 0x65, 0x79, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52,
+	0x02, 0x69, 0x64, 0x22, 0x37, 0x0a, 0x0d, 0x58, 0x44, 0x65, 0x6c, 0x4d, 0x65, 0x6d, 0x62, 0x65,
+	0x72, 0x52, 0x73, 0x70, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x1b, 0x0a, 0x07,
+	0x58, 0x44, 0x65, 0x6c, 0x52, 0x65, 0x71, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x22, 0x1b, 0x0a, 0x07, 0x58, 0x44, 0x65,
+	0x6c, 0x52, 0x73, 0x70, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x22, 0x5d, 0x0a, 0x07, 0x4a, 0x53, 0x65, 0x74, 0x52, 0x65,
+	0x71, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03,
+	0x6b, 0x65, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x16, 0x0a,
+	0x06, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x65,
+	0x78, 0x70, 0x69, 0x72, 0x65, 0x22, 0x2f, 0x0a, 0x07, 0x4a, 0x53, 0x65, 0x74, 0x52, 0x73, 0x70,
+	0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b,
+	0x65, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x22, 0x2f, 0x0a, 0x07, 0x4a, 0x47, 0x65, 0x74, 0x52, 0x65,
+	0x71, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03,
+	0x6b, 0x65, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x02, 0x20, 0x03, 0x28,
+	0x09, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x22, 0x31, 0x0a, 0x07, 0x4a, 0x47, 0x65, 0x74, 0x52,
+	0x73, 0x70, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x33, 0x0a, 0x0b, 0x4a, 0x44,
+	0x65, 0x6c, 0x50, 0x61, 0x74, 0x68, 0x52, 0x65, 0x71, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x70,
+	0x61, 0x74, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x22,
+	0x35, 0x0a, 0x0b, 0x4a, 0x44, 0x65, 0x6c, 0x50, 0x61, 0x74, 0x68, 0x52, 0x73, 0x70, 0x12, 0x10,
+	0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79,
+	0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x4b, 0x0a, 0x0d, 0x4a, 0x41, 0x72, 0x72, 0x41, 0x70,
+	0x70, 0x65, 0x6e, 0x64, 0x52, 0x65, 0x71, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x74,
+	0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x12, 0x14, 0x0a,
+	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x22, 0x33, 0x0a, 0x0d, 0x4a, 0x41, 0x72, 0x72, 0x41, 0x70, 0x70, 0x65, 0x6e,
+	0x64, 0x52, 0x73, 0x70, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6c, 0x65, 0x6e, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x03, 0x6c, 0x65, 0x6e, 0x22, 0x45, 0x0a, 0x0d, 0x4a, 0x4e, 0x75, 0x6d,
+	0x49, 0x6e, 0x63, 0x72, 0x42, 0x79, 0x52, 0x65, 0x71, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x70,
+	0x61, 0x74, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x12,
+	0x0e, 0x0a, 0x02, 0x62, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x62, 0x79, 0x22,
+	0x37, 0x0a, 0x0d, 0x4a, 0x4e, 0x75, 0x6d, 0x49, 0x6e, 0x63, 0x72, 0x42, 0x79, 0x52, 0x73, 0x70,
+	0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b,
+	0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x1b, 0x0a, 0x07, 0x4a, 0x44, 0x65, 0x6c,
+	0x52, 0x65, 0x71, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x03, 0x6b, 0x65, 0x79, 0x22, 0x1b, 0x0a, 0x07, 0x4a, 0x44, 0x65, 0x6c, 0x52, 0x73, 0x70,
+	0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b,
+	0x65, 0x79, 0x22, 0x1d, 0x0a, 0x09, 0x4a, 0x57, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x71, 0x12,
+	0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65,
+	0x79, 0x22, 0x1d, 0x0a, 0x09, 0x4a, 0x57, 0x61, 0x74, 0x63, 0x68, 0x52, 0x73, 0x70, 0x12, 0x10,
+	0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79,
+	0x22, 0x72, 0x0a, 0x0c, 0x42, 0x46, 0x52, 0x65, 0x73, 0x65, 0x72, 0x76, 0x65, 0x52, 0x65, 0x71,
+	0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b,
+	0x65, 0x79, 0x12, 0x1c, 0x0a, 0x09, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x61, 0x74, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x09, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x61, 0x74, 0x65,
+	0x12, 0x1a, 0x0a, 0x08, 0x63, 0x61, 0x70, 0x61, 0x63, 0x69, 0x74, 0x79, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x08, 0x63, 0x61, 0x70, 0x61, 0x63, 0x69, 0x74, 0x79, 0x12, 0x16, 0x0a, 0x06,
+	0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x65, 0x78,
+	0x70, 0x69, 0x72, 0x65, 0x22, 0x20, 0x0a, 0x0c, 0x42, 0x46, 0x52, 0x65, 0x73, 0x65, 0x72, 0x76,
+	0x65, 0x52, 0x73, 0x70, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x22, 0x48, 0x0a, 0x08, 0x42, 0x46, 0x41, 0x64, 0x64, 0x52,
+	0x65, 0x71, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x03, 0x6b, 0x65, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x69, 0x74, 0x65, 0x6d, 0x18, 0x02, 0x20, 0x03,
+	0x28, 0x09, 0x52, 0x04, 0x69, 0x74, 0x65, 0x6d, 0x12, 0x16, 0x0a, 0x06, 0x65, 0x78, 0x70, 0x69,
+	0x72, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65,
+	0x22, 0x32, 0x0a, 0x08, 0x42, 0x46, 0x41, 0x64, 0x64, 0x52, 0x73, 0x70, 0x12, 0x10, 0x0a, 0x03,
+	0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14,
+	0x0a, 0x05, 0x61, 0x64, 0x64, 0x65, 0x64, 0x18, 0x02, 0x20, 0x03, 0x28, 0x08, 0x52, 0x05, 0x61,
+	0x64, 0x64, 0x65, 0x64, 0x22, 0x33, 0x0a, 0x0b, 0x42, 0x46, 0x45, 0x78, 0x69, 0x73, 0x74, 0x73,
+	0x52, 0x65, 0x71, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x69, 0x74, 0x65, 0x6d, 0x18, 0x02, 0x20,
+	0x03, 0x28, 0x09, 0x52, 0x04, 0x69, 0x74, 0x65, 0x6d, 0x22, 0x37, 0x0a, 0x0b, 0x42, 0x46, 0x45,
+	0x78, 0x69, 0x73, 0x74, 0x73, 0x52, 0x73, 0x70, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x16, 0x0a, 0x06, 0x65, 0x78,
+	0x69, 0x73, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x08, 0x52, 0x06, 0x65, 0x78, 0x69, 0x73,
+	0x74, 0x73, 0x22, 0x1d, 0x0a, 0x09, 0x42, 0x46, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x71, 0x12,
+	0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65,
+	0x79, 0x22, 0xb9, 0x01, 0x0a, 0x09, 0x42, 0x46, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x73, 0x70, 0x12,
+	0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65,
+	0x79, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x61, 0x70, 0x61, 0x63, 0x69, 0x74, 0x79, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x08, 0x63, 0x61, 0x70, 0x61, 0x63, 0x69, 0x74, 0x79, 0x12, 0x12, 0x0a,
+	0x04, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x73, 0x69, 0x7a,
+	0x65, 0x12, 0x18, 0x0a, 0x07, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x73, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x07, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x69,
+	0x74, 0x65, 0x6d, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x69, 0x74, 0x65, 0x6d,
+	0x73, 0x12, 0x1c, 0x0a, 0x09, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x61, 0x74, 0x65, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x01, 0x52, 0x09, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x61, 0x74, 0x65, 0x12,
+	0x1c, 0x0a, 0x09, 0x65, 0x78, 0x70, 0x61, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x07, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x09, 0x65, 0x78, 0x70, 0x61, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x1c, 0x0a,
+	0x08, 0x42, 0x46, 0x44, 0x65, 0x6c, 0x52, 0x65, 0x71, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x22, 0x1c, 0x0a, 0x08, 0x42,
+	0x46, 0x44, 0x65, 0x6c, 0x52, 0x73, 0x70, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x22, 0x54, 0x0a, 0x0c, 0x43, 0x46, 0x52,
+	0x65, 0x73, 0x65, 0x72, 0x76, 0x65, 0x52, 0x65, 0x71, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x1a, 0x0a, 0x08, 0x63,
+	0x61, 0x70, 0x61, 0x63, 0x69, 0x74, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x63,
+	0x61, 0x70, 0x61, 0x63, 0x69, 0x74, 0x79, 0x12, 0x16, 0x0a, 0x06, 0x65, 0x78, 0x70, 0x69, 0x72,
+	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x22,
+	0x20, 0x0a, 0x0c, 0x43, 0x46, 0x52, 0x65, 0x73, 0x65, 0x72, 0x76, 0x65, 0x52, 0x73, 0x70, 0x12,
+	0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65,
+	0x79, 0x22, 0x58, 0x0a, 0x08, 0x43, 0x46, 0x41, 0x64, 0x64, 0x52, 0x65, 0x71, 0x12, 0x10, 0x0a,
+	0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12,
+	0x12, 0x0a, 0x04, 0x69, 0x74, 0x65, 0x6d, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x04, 0x69,
+	0x74, 0x65, 0x6d, 0x12, 0x0e, 0x0a, 0x02, 0x6e, 0x78, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x02, 0x6e, 0x78, 0x12, 0x16, 0x0a, 0x06, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x06, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x22, 0x32, 0x0a, 0x08, 0x43,
+	0x46, 0x41, 0x64, 0x64, 0x52, 0x73, 0x70, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x61, 0x64, 0x64,
+	0x65, 0x64, 0x18, 0x02, 0x20, 0x03, 0x28, 0x08, 0x52, 0x05, 0x61, 0x64, 0x64, 0x65, 0x64, 0x22,
+	0x33, 0x0a, 0x0b, 0x43, 0x46, 0x45, 0x78, 0x69, 0x73, 0x74, 0x73, 0x52, 0x65, 0x71, 0x12, 0x10,
+	0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79,
+	0x12, 0x12, 0x0a, 0x04, 0x69, 0x74, 0x65, 0x6d, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x04,
+	0x69, 0x74, 0x65, 0x6d, 0x22, 0x37, 0x0a, 0x0b, 0x43, 0x46, 0x45, 0x78, 0x69, 0x73, 0x74, 0x73,
+	0x52, 0x73, 0x70, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x16, 0x0a, 0x06, 0x65, 0x78, 0x69, 0x73, 0x74, 0x73, 0x18,
+	0x02, 0x20, 0x03, 0x28, 0x08, 0x52, 0x06, 0x65, 0x78, 0x69, 0x73, 0x74, 0x73, 0x22, 0x36, 0x0a,
+	0x0e, 0x43, 0x46, 0x44, 0x65, 0x6c, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x52, 0x65, 0x71, 0x12,
+	0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65,
+	0x79, 0x12, 0x12, 0x0a, 0x04, 0x69, 0x74, 0x65, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x69, 0x74, 0x65, 0x6d, 0x22, 0x3c, 0x0a, 0x0e, 0x43, 0x46, 0x44, 0x65, 0x6c, 0x4d, 0x65,
+	0x6d, 0x62, 0x65, 0x72, 0x52, 0x73, 0x70, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x18, 0x0a, 0x07, 0x64, 0x65, 0x6c,
+	0x65, 0x74, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x64, 0x65, 0x6c, 0x65,
+	0x74, 0x65, 0x64, 0x22, 0x1d, 0x0a, 0x09, 0x43, 0x46, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x71,
+	0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b,
+	0x65, 0x79, 0x22, 0x95, 0x02, 0x0a, 0x09, 0x43, 0x46, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x73, 0x70,
+	0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b,
+	0x65, 0x79, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x61, 0x70, 0x61, 0x63, 0x69, 0x74, 0x79, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x63, 0x61, 0x70, 0x61, 0x63, 0x69, 0x74, 0x79, 0x12, 0x12,
+	0x0a, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x73, 0x69,
+	0x7a, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x07, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x12, 0x18, 0x0a, 0x07,
+	0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x66,
+	0x69, 0x6c, 0x74, 0x65, 0x72, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x12, 0x18, 0x0a, 0x07,
+	0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x64,
+	0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x12, 0x1e, 0x0a, 0x0a, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74,
+	0x53, 0x69, 0x7a, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x62, 0x75, 0x63, 0x6b,
+	0x65, 0x74, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x65, 0x78, 0x70, 0x61, 0x6e, 0x73,
+	0x69, 0x6f, 0x6e, 0x18, 0x09, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x65, 0x78, 0x70, 0x61, 0x6e,
+	0x73, 0x69, 0x6f, 0x6e, 0x12, 0x24, 0x0a, 0x0d, 0x6d, 0x61, 0x78, 0x49, 0x74, 0x65, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0d, 0x6d, 0x61, 0x78,
+	0x49, 0x74, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x1c, 0x0a, 0x08, 0x43, 0x46,
+	0x44, 0x65, 0x6c, 0x52, 0x65, 0x71, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x22, 0x1c, 0x0a, 0x08, 0x43, 0x46, 0x44, 0x65,
+	0x6c, 0x52, 0x73, 0x70, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x22, 0x3e, 0x0a, 0x08, 0x54, 0x53, 0x53, 0x61, 0x6d, 0x70,
+	0x6c, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
+	0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52,
+	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x55, 0x0a, 0x0b, 0x54, 0x53, 0x43, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x52, 0x65, 0x71, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x1c, 0x0a, 0x09, 0x72, 0x65, 0x74, 0x65, 0x6e,
+	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x72, 0x65, 0x74, 0x65,
+	0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x22, 0x1f, 0x0a,
+	0x0b, 0x54, 0x53, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x73, 0x70, 0x12, 0x10, 0x0a, 0x03,
+	0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x22, 0x60,
+	0x0a, 0x08, 0x54, 0x53, 0x41, 0x64, 0x64, 0x52, 0x65, 0x71, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65,
+	0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x2a, 0x0a, 0x07,
+	0x73, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x10, 0x2e,
+	0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x2e, 0x54, 0x53, 0x53, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x52,
+	0x07, 0x73, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x65, 0x78, 0x70, 0x69,
+	0x72, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65,
+	0x22, 0x3c, 0x0a, 0x08, 0x54, 0x53, 0x41, 0x64, 0x64, 0x52, 0x73, 0x70, 0x12, 0x10, 0x0a, 0x03,
+	0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x1e,
+	0x0a, 0x0a, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x73, 0x18, 0x02, 0x20, 0x03,
+	0x28, 0x03, 0x52, 0x0a, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x73, 0x22, 0x92,
+	0x01, 0x0a, 0x0a, 0x54, 0x53, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x65, 0x71, 0x12, 0x10, 0x0a,
+	0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12,
+	0x12, 0x0a, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x66,
+	0x72, 0x6f, 0x6d, 0x12, 0x0e, 0x0a, 0x02, 0x74, 0x6f, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x02, 0x74, 0x6f, 0x12, 0x20, 0x0a, 0x0b, 0x61, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x61, 0x67, 0x67, 0x72, 0x65, 0x67,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x12, 0x14, 0x0a,
+	0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x22, 0x4a, 0x0a, 0x0a, 0x54, 0x53, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x73,
+	0x70, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03,
+	0x6b, 0x65, 0x79, 0x12, 0x2a, 0x0a, 0x07, 0x73, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x73, 0x18, 0x02,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x2e, 0x54, 0x53,
+	0x53, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x52, 0x07, 0x73, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x73, 0x22,
+	0x1c, 0x0a, 0x08, 0x54, 0x53, 0x47, 0x65, 0x74, 0x52, 0x65, 0x71, 0x12, 0x10, 0x0a, 0x03, 0x6b,
+	0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x22, 0x46, 0x0a,
+	0x08, 0x54, 0x53, 0x47, 0x65, 0x74, 0x52, 0x73, 0x70, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x28, 0x0a, 0x06, 0x73,
+	0x61, 0x6d, 0x70, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x62, 0x72,
+	0x69, 0x64, 0x67, 0x65, 0x2e, 0x54, 0x53, 0x53, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x52, 0x06, 0x73,
+	0x61, 0x6d, 0x70, 0x6c, 0x65, 0x22, 0x45, 0x0a, 0x0d, 0x54, 0x53, 0x44, 0x65, 0x6c, 0x52, 0x61,
+	0x6e, 0x67, 0x65, 0x52, 0x65, 0x71, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x66, 0x72, 0x6f, 0x6d,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x12, 0x0e, 0x0a, 0x02,
+	0x74, 0x6f, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x74, 0x6f, 0x22, 0x37, 0x0a, 0x0d,
+	0x54, 0x53, 0x44, 0x65, 0x6c, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x73, 0x70, 0x12, 0x10, 0x0a,
+	0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12,
+	0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x7d, 0x0a, 0x09, 0x54, 0x53, 0x52, 0x75, 0x6c, 0x65, 0x52,
+	0x65, 0x71, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x4b, 0x65, 0x79, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x4b, 0x65, 0x79,
+	0x12, 0x18, 0x0a, 0x07, 0x64, 0x65, 0x73, 0x74, 0x4b, 0x65, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x07, 0x64, 0x65, 0x73, 0x74, 0x4b, 0x65, 0x79, 0x12, 0x20, 0x0a, 0x0b, 0x61, 0x67,
+	0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0b, 0x61, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x06,
+	0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x62, 0x75,
+	0x63, 0x6b, 0x65, 0x74, 0x22, 0x43, 0x0a, 0x09, 0x54, 0x53, 0x52, 0x75, 0x6c, 0x65, 0x52, 0x73,
+	0x70, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x4b, 0x65, 0x79, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x4b, 0x65, 0x79, 0x12,
+	0x18, 0x0a, 0x07, 0x64, 0x65, 0x73, 0x74, 0x4b, 0x65, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x07, 0x64, 0x65, 0x73, 0x74, 0x4b, 0x65, 0x79, 0x22, 0x5c, 0x0a, 0x06, 0x54, 0x53, 0x52,
+	0x75, 0x6c, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x64, 0x65, 0x73, 0x74, 0x4b, 0x65, 0x79, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x64, 0x65, 0x73, 0x74, 0x4b, 0x65, 0x79, 0x12, 0x20, 0x0a,
+	0x0b, 0x61, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0b, 0x61, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12,
+	0x16, 0x0a, 0x06, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x06, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x22, 0x1d, 0x0a, 0x09, 0x54, 0x53, 0x49, 0x6e, 0x66,
+	0x6f, 0x52, 0x65, 0x71, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x22, 0xf1, 0x01, 0x0a, 0x09, 0x54, 0x53, 0x49, 0x6e, 0x66,
+	0x6f, 0x52, 0x73, 0x70, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x22, 0x0a, 0x0c, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x53,
+	0x61, 0x6d, 0x70, 0x6c, 0x65, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x74, 0x6f,
+	0x74, 0x61, 0x6c, 0x53, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x73, 0x12, 0x26, 0x0a, 0x0e, 0x66, 0x69,
+	0x72, 0x73, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x0e, 0x66, 0x69, 0x72, 0x73, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
+	0x6d, 0x70, 0x12, 0x24, 0x0a, 0x0d, 0x6c, 0x61, 0x73, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x6c, 0x61, 0x73, 0x74, 0x54,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x1c, 0x0a, 0x09, 0x72, 0x65, 0x74, 0x65,
+	0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x72, 0x65, 0x74,
+	0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
+	0x4b, 0x65, 0x79, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x6f, 0x75, 0x72, 0x63,
+	0x65, 0x4b, 0x65, 0x79, 0x12, 0x24, 0x0a, 0x05, 0x72, 0x75, 0x6c, 0x65, 0x73, 0x18, 0x07, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x2e, 0x54, 0x53, 0x52,
+	0x75, 0x6c, 0x65, 0x52, 0x05, 0x72, 0x75, 0x6c, 0x65, 0x73, 0x22, 0x1c, 0x0a, 0x08, 0x54, 0x53,
+	0x44, 0x65, 0x6c, 0x52, 0x65, 0x71, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x22, 0x1c, 0x0a, 0x08, 0x54, 0x53, 0x44, 0x65,
+	0x6c, 0x52, 0x73, 0x70, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x22, 0x2f, 0x0a, 0x07, 0x53, 0x63, 0x61, 0x6e, 0x4b, 0x65,
+	0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03,
+	0x6b, 0x65, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x22, 0x61, 0x0a, 0x07, 0x53, 0x63, 0x61, 0x6e, 0x52,
+	0x65, 0x71, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x06, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x6d, 0x61,
+	0x74, 0x63, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6d, 0x61, 0x74, 0x63, 0x68,
+	0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x22, 0x46, 0x0a, 0x07, 0x53, 0x63,
+	0x61, 0x6e, 0x52, 0x73, 0x70, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x12, 0x23, 0x0a,
+	0x04, 0x6b, 0x65, 0x79, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x62, 0x72,
+	0x69, 0x64, 0x67, 0x65, 0x2e, 0x53, 0x63, 0x61, 0x6e, 0x4b, 0x65, 0x79, 0x52, 0x04, 0x6b, 0x65,
+	0x79, 0x73, 0x22, 0x64, 0x0a, 0x08, 0x48, 0x53, 0x63, 0x61, 0x6e, 0x52, 0x65, 0x71, 0x12, 0x14,
+	0x0a, 0x05, 0x68, 0x6d, 0x4b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x68,
+	0x6d, 0x4b, 0x65, 0x79, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x12, 0x14, 0x0a, 0x05,
+	0x6d, 0x61, 0x74, 0x63, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6d, 0x61, 0x74,
+	0x63, 0x68, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0xa9, 0x01, 0x0a, 0x08, 0x48, 0x53, 0x63,
+	0x61, 0x6e, 0x52, 0x73, 0x70, 0x12, 0x14, 0x0a, 0x05, 0x68, 0x6d, 0x4b, 0x65, 0x79, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x68, 0x6d, 0x4b, 0x65, 0x79, 0x12, 0x16, 0x0a, 0x06, 0x63,
+	0x75, 0x72, 0x73, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x63, 0x75, 0x72,
+	0x73, 0x6f, 0x72, 0x12, 0x34, 0x0a, 0x06, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x18, 0x03, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x2e, 0x48, 0x53, 0x63,
+	0x61, 0x6e, 0x52, 0x73, 0x70, 0x2e, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x45, 0x6e, 0x74, 0x72,
+	0x79, 0x52, 0x06, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x1a, 0x39, 0x0a, 0x0b, 0x46, 0x69, 0x65,
+	0x6c, 0x64, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x3a, 0x02, 0x38, 0x01, 0x22, 0x60, 0x0a, 0x08, 0x53, 0x53, 0x63, 0x61, 0x6e, 0x52, 0x65, 0x71,
+	0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b,
+	0x65, 0x79, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x06, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x6d, 0x61,
+	0x74, 0x63, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6d, 0x61, 0x74, 0x63, 0x68,
+	0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x4e, 0x0a, 0x08, 0x53, 0x53, 0x63, 0x61, 0x6e, 0x52,
+	0x73, 0x70, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x03, 0x6b, 0x65, 0x79, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x12, 0x18, 0x0a, 0x07,
+	0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x6d,
+	0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x22, 0x2e, 0x0a, 0x06, 0x54, 0x54, 0x4c, 0x52, 0x65, 0x71,
+	0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b,
+	0x65, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x22, 0x2c, 0x0a, 0x06, 0x54, 0x54, 0x4c, 0x52, 0x73, 0x70,
+	0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b,
+	0x65, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x74, 0x74, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x03, 0x74, 0x74, 0x6c, 0x22, 0x4b, 0x0a, 0x09, 0x45, 0x78, 0x70, 0x69, 0x72, 0x65, 0x52, 0x65,
+	0x71, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03,
+	0x6b, 0x65, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x65, 0x63, 0x6f, 0x6e,
+	0x64, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64,
+	0x73, 0x22, 0x51, 0x0a, 0x0b, 0x45, 0x78, 0x70, 0x69, 0x72, 0x65, 0x41, 0x74, 0x52, 0x65, 0x71,
+	0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b,
+	0x65, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73,
+	0x74, 0x61, 0x6d, 0x70, 0x22, 0x32, 0x0a, 0x0a, 0x50, 0x65, 0x72, 0x73, 0x69, 0x73, 0x74, 0x52,
+	0x65, 0x71, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x03, 0x6b, 0x65, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x22, 0x2d, 0x0a, 0x09, 0x45, 0x78, 0x70, 0x69,
+	0x72, 0x65, 0x52, 0x73, 0x70, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x0e, 0x0a, 0x02, 0x6f, 0x6b, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x02, 0x6f, 0x6b, 0x22, 0x56, 0x0a, 0x0a, 0x50, 0x45, 0x78, 0x70, 0x69,
+	0x72, 0x65, 0x52, 0x65, 0x71, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x22, 0x0a, 0x0c, 0x6d,
+	0x69, 0x6c, 0x6c, 0x69, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x0c, 0x6d, 0x69, 0x6c, 0x6c, 0x69, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x22,
+	0x52, 0x0a, 0x0c, 0x50, 0x45, 0x78, 0x70, 0x69, 0x72, 0x65, 0x41, 0x74, 0x52, 0x65, 0x71, 0x12,
+	0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65,
+	0x79, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
+	0x6d, 0x70, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x22, 0x1b, 0x0a, 0x07, 0x54, 0x79, 0x70, 0x65, 0x52, 0x65, 0x71, 0x12, 0x10,
+	0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79,
+	0x22, 0x2f, 0x0a, 0x07, 0x54, 0x79, 0x70, 0x65, 0x52, 0x73, 0x70, 0x12, 0x10, 0x0a, 0x03, 0x6b,
+	0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x12, 0x0a,
+	0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70,
+	0x65, 0x22, 0x1f, 0x0a, 0x09, 0x45, 0x78, 0x69, 0x73, 0x74, 0x73, 0x52, 0x65, 0x71, 0x12, 0x12,
+	0x0a, 0x04, 0x6b, 0x65, 0x79, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x04, 0x6b, 0x65,
+	0x79, 0x73, 0x22, 0x21, 0x0a, 0x09, 0x45, 0x78, 0x69, 0x73, 0x74, 0x73, 0x52, 0x73, 0x70, 0x12,
+	0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x20, 0x0a, 0x0a, 0x44, 0x65, 0x6c, 0x4b, 0x65, 0x79, 0x73,
+	0x52, 0x65, 0x71, 0x12, 0x12, 0x0a, 0x04, 0x6b, 0x65, 0x79, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x09, 0x52, 0x04, 0x6b, 0x65, 0x79, 0x73, 0x22, 0x22, 0x0a, 0x0a, 0x44, 0x65, 0x6c, 0x4b, 0x65,
+	0x79, 0x73, 0x52, 0x73, 0x70, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x35, 0x0a, 0x09, 0x52,
+	0x65, 0x6e, 0x61, 0x6d, 0x65, 0x52, 0x65, 0x71, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x16, 0x0a, 0x06, 0x6e, 0x65,
+	0x77, 0x4b, 0x65, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6e, 0x65, 0x77, 0x4b,
+	0x65, 0x79, 0x22, 0x35, 0x0a, 0x09, 0x52, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x52, 0x73, 0x70, 0x12,
+	0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65,
+	0x79, 0x12, 0x16, 0x0a, 0x06, 0x6e, 0x65, 0x77, 0x4b, 0x65, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x6e, 0x65, 0x77, 0x4b, 0x65, 0x79, 0x22, 0x1b, 0x0a, 0x09, 0x53, 0x65, 0x6c,
+	0x65, 0x63, 0x74, 0x52, 0x65, 0x71, 0x12, 0x0e, 0x0a, 0x02, 0x64, 0x62, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x02, 0x64, 0x62, 0x22, 0x1b, 0x0a, 0x09, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74,
+	0x52, 0x73, 0x70, 0x12, 0x0e, 0x0a, 0x02, 0x64, 0x62, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x02, 0x64, 0x62, 0x22, 0x1d, 0x0a, 0x0b, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x44, 0x42, 0x52,
+	0x65, 0x71, 0x12, 0x0e, 0x0a, 0x02, 0x64, 0x62, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02,
+	0x64, 0x62, 0x22, 0x37, 0x0a, 0x0b, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x44, 0x42, 0x52, 0x73,
+	0x70, 0x12, 0x0e, 0x0a, 0x02, 0x64, 0x62, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x64,
+	0x62, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x22, 0x5d, 0x0a, 0x07, 0x43,
+	0x6f, 0x70, 0x79, 0x52, 0x65, 0x71, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x16, 0x0a, 0x06, 0x6e, 0x65, 0x77, 0x4b,
+	0x65, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6e, 0x65, 0x77, 0x4b, 0x65, 0x79,
+	0x12, 0x0e, 0x0a, 0x02, 0x64, 0x62, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x64, 0x62,
+	0x12, 0x18, 0x0a, 0x07, 0x72, 0x65, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x07, 0x72, 0x65, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x22, 0x43, 0x0a, 0x07, 0x43, 0x6f,
+	0x70, 0x79, 0x52, 0x73, 0x70, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x16, 0x0a, 0x06, 0x6e, 0x65, 0x77, 0x4b, 0x65,
+	0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6e, 0x65, 0x77, 0x4b, 0x65, 0x79, 0x12,
+	0x0e, 0x0a, 0x02, 0x6f, 0x6b, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x02, 0x6f, 0x6b, 0x22,
+	0x2b, 0x0a, 0x07, 0x4d, 0x6f, 0x76, 0x65, 0x52, 0x65, 0x71, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65,
+	0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x0e, 0x0a, 0x02,
+	0x64, 0x62, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x64, 0x62, 0x22, 0x3b, 0x0a, 0x07,
+	0x4d, 0x6f, 0x76, 0x65, 0x52, 0x73, 0x70, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x0e, 0x0a, 0x02, 0x64, 0x62, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x64, 0x62, 0x12, 0x0e, 0x0a, 0x02, 0x6f, 0x6b, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x02, 0x6f, 0x6b, 0x22, 0x32, 0x0a, 0x0a, 0x4b, 0x65, 0x79,
+	0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x71, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x22, 0x82, 0x02,
+	0x0a, 0x0a, 0x4b, 0x65, 0x79, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x73, 0x70, 0x12, 0x10, 0x0a, 0x03,
+	0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x12,
+	0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79,
+	0x70, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x65, 0x6e, 0x63, 0x6f, 0x64, 0x69, 0x6e, 0x67, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x65, 0x6e, 0x63, 0x6f, 0x64, 0x69, 0x6e, 0x67, 0x12, 0x12,
+	0x0a, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x73, 0x69,
+	0x7a, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x12, 0x1a, 0x0a, 0x08,
+	0x6d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x65, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08,
+	0x6d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x65, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x61, 0x63, 0x63, 0x65,
+	0x73, 0x73, 0x65, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x61, 0x63, 0x63, 0x65,
+	0x73, 0x73, 0x65, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x69, 0x64, 0x6c, 0x65, 0x18, 0x08, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x04, 0x69, 0x64, 0x6c, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x68, 0x69, 0x74, 0x73,
+	0x18, 0x09, 0x20, 0x01, 0x28, 0x04, 0x52, 0x04, 0x68, 0x69, 0x74, 0x73, 0x12, 0x10, 0x0a, 0x03,
+	0x74, 0x74, 0x6c, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x03, 0x52, 0x03, 0x74, 0x74, 0x6c, 0x12, 0x12,
+	0x0a, 0x04, 0x66, 0x72, 0x65, 0x71, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x66, 0x72,
+	0x65, 0x71, 0x22, 0x0d, 0x0a, 0x0b, 0x4b, 0x65, 0x79, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65,
+	0x71, 0x22, 0xcb, 0x02, 0x0a, 0x09, 0x54, 0x79, 0x70, 0x65, 0x53, 0x74, 0x61, 0x74, 0x73, 0x12,
+	0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74,
+	0x79, 0x70, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6b, 0x65, 0x79, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x04, 0x6b, 0x65, 0x79, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x6d,
+	0x61, 0x78, 0x53, 0x69, 0x7a, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x6d, 0x61,
+	0x78, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x76, 0x6f, 0x6c, 0x61, 0x74, 0x69, 0x6c,
+	0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x76, 0x6f, 0x6c, 0x61, 0x74, 0x69, 0x6c,
+	0x65, 0x12, 0x12, 0x0a, 0x04, 0x68, 0x69, 0x74, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x04, 0x68, 0x69, 0x74, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x65, 0x76, 0x69, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x65, 0x76, 0x69, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x76, 0x67, 0x49, 0x64, 0x6c, 0x65, 0x18, 0x08,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x61, 0x76, 0x67, 0x49, 0x64, 0x6c, 0x65, 0x12, 0x18, 0x0a,
+	0x07, 0x6d, 0x61, 0x78, 0x49, 0x64, 0x6c, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07,
+	0x6d, 0x61, 0x78, 0x49, 0x64, 0x6c, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x6f, 0x6c, 0x69, 0x63,
+	0x79, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12,
+	0x16, 0x0a, 0x06, 0x6d, 0x69, 0x73, 0x73, 0x65, 0x73, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x06, 0x6d, 0x69, 0x73, 0x73, 0x65, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x68, 0x69, 0x74, 0x52, 0x61,
+	0x74, 0x69, 0x6f, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x01, 0x52, 0x08, 0x68, 0x69, 0x74, 0x52, 0x61,
+	0x74, 0x69, 0x6f, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x65, 0x64, 0x18,
+	0x0d, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x72, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x65, 0x64, 0x22,
+	0x36, 0x0a, 0x0b, 0x4b, 0x65, 0x79, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x73, 0x70, 0x12, 0x27,
+	0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e,
+	0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x2e, 0x54, 0x79, 0x70, 0x65, 0x53, 0x74, 0x61, 0x74, 0x73,
+	0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x73, 0x22, 0x36, 0x0a, 0x0e, 0x4d, 0x65, 0x6d, 0x6f, 0x72,
+	0x79, 0x55, 0x73, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x74,
+	0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x22,
+	0x38, 0x0a, 0x0e, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x55, 0x73, 0x61, 0x67, 0x65, 0x52, 0x73,
+	0x70, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03,
+	0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x05, 0x62, 0x79, 0x74, 0x65, 0x73, 0x22, 0x20, 0x0a, 0x0e, 0x4d, 0x65, 0x6d,
+	0x6f, 0x72, 0x79, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x71, 0x12, 0x0e, 0x0a, 0x02, 0x67,
+	0x63, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x02, 0x67, 0x63, 0x22, 0xb6, 0x02, 0x0a, 0x0e,
+	0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x73, 0x70, 0x12, 0x1c,
+	0x0a, 0x09, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x09, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x65, 0x64, 0x12, 0x12, 0x0a, 0x04,
+	0x6b, 0x65, 0x79, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x6b, 0x65, 0x79, 0x73,
+	0x12, 0x1c, 0x0a, 0x09, 0x6d, 0x61, 0x78, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x09, 0x6d, 0x61, 0x78, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x12, 0x16,
+	0x0a, 0x06, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x1c, 0x0a, 0x09, 0x68, 0x65, 0x61, 0x70, 0x41, 0x6c,
+	0x6c, 0x6f, 0x63, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x68, 0x65, 0x61, 0x70, 0x41,
+	0x6c, 0x6c, 0x6f, 0x63, 0x12, 0x1c, 0x0a, 0x09, 0x68, 0x65, 0x61, 0x70, 0x49, 0x6e, 0x75, 0x73,
+	0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x68, 0x65, 0x61, 0x70, 0x49, 0x6e, 0x75,
+	0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x68, 0x65, 0x61, 0x70, 0x53, 0x79, 0x73, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x07, 0x68, 0x65, 0x61, 0x70, 0x53, 0x79, 0x73, 0x12, 0x20, 0x0a, 0x0b,
+	0x68, 0x65, 0x61, 0x70, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x0b, 0x68, 0x65, 0x61, 0x70, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x12, 0x10,
+	0x0a, 0x03, 0x73, 0x79, 0x73, 0x18, 0x09, 0x20, 0x01, 0x28, 0x04, 0x52, 0x03, 0x73, 0x79, 0x73,
+	0x12, 0x14, 0x0a, 0x05, 0x6e, 0x75, 0x6d, 0x47, 0x43, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0d, 0x52,
+	0x05, 0x6e, 0x75, 0x6d, 0x47, 0x43, 0x12, 0x1c, 0x0a, 0x09, 0x68, 0x65, 0x61, 0x70, 0x52, 0x61,
+	0x74, 0x69, 0x6f, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x01, 0x52, 0x09, 0x68, 0x65, 0x61, 0x70, 0x52,
+	0x61, 0x74, 0x69, 0x6f, 0x22, 0x20, 0x0a, 0x0a, 0x54, 0x78, 0x57, 0x61, 0x74, 0x63, 0x68, 0x52,
+	0x65, 0x71, 0x12, 0x12, 0x0a, 0x04, 0x6b, 0x65, 0x79, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09,
+	0x52, 0x04, 0x6b, 0x65, 0x79, 0x73, 0x22, 0x8b, 0x01, 0x0a, 0x0a, 0x54, 0x78, 0x57, 0x61, 0x74,
+	0x63, 0x68, 0x52, 0x73, 0x70, 0x12, 0x3f, 0x0a, 0x09, 0x72, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f,
+	0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x62, 0x72, 0x69, 0x64, 0x67,
+	0x65, 0x2e, 0x54, 0x78, 0x57, 0x61, 0x74, 0x63, 0x68, 0x52, 0x73, 0x70, 0x2e, 0x52, 0x65, 0x76,
+	0x69, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x09, 0x72, 0x65, 0x76,
+	0x69, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x1a, 0x3c, 0x0a, 0x0e, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69,
+	0x6f, 0x6e, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x3a, 0x02, 0x38, 0x01, 0x22, 0x5b, 0x0a, 0x09, 0x54, 0x78, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e,
+	0x64, 0x12, 0x10, 0x0a, 0x03, 0x63, 0x6d, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03,
+	0x63, 0x6d, 0x64, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x61, 0x72, 0x67, 0x73, 0x18, 0x03, 0x20,
+	0x03, 0x28, 0x09, 0x52, 0x04, 0x61, 0x72, 0x67, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x65, 0x78, 0x70,
+	0x69, 0x72, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x65, 0x78, 0x70, 0x69, 0x72,
+	0x65, 0x22, 0xa9, 0x01, 0x0a, 0x08, 0x54, 0x78, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x14,
+	0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x18, 0x02,
+	0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x12, 0x34, 0x0a, 0x06,
+	0x66, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x62,
+	0x72, 0x69, 0x64, 0x67, 0x65, 0x2e, 0x54, 0x78, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x2e, 0x46,
+	0x69, 0x65, 0x6c, 0x64, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x06, 0x66, 0x69, 0x65, 0x6c,
+	0x64, 0x73, 0x1a, 0x39, 0x0a, 0x0b, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x45, 0x6e, 0x74, 0x72,
+	0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03,
+	0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0xa4, 0x01,
+	0x0a, 0x07, 0x45, 0x78, 0x65, 0x63, 0x52, 0x65, 0x71, 0x12, 0x2d, 0x0a, 0x08, 0x63, 0x6f, 0x6d,
+	0x6d, 0x61, 0x6e, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x62, 0x72,
+	0x69, 0x64, 0x67, 0x65, 0x2e, 0x54, 0x78, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x52, 0x08,
+	0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x73, 0x12, 0x30, 0x0a, 0x05, 0x77, 0x61, 0x74, 0x63,
+	0x68, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65,
+	0x2e, 0x45, 0x78, 0x65, 0x63, 0x52, 0x65, 0x71, 0x2e, 0x57, 0x61, 0x74, 0x63, 0x68, 0x45, 0x6e,
+	0x74, 0x72, 0x79, 0x52, 0x05, 0x77, 0x61, 0x74, 0x63, 0x68, 0x1a, 0x38, 0x0a, 0x0a, 0x57, 0x61,
+	0x74, 0x63, 0x68, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x3a, 0x02, 0x38, 0x01, 0x22, 0x35, 0x0a, 0x07, 0x45, 0x78, 0x65, 0x63, 0x52, 0x73, 0x70, 0x12,
+	0x2a, 0x0a, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x10, 0x2e, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x2e, 0x54, 0x78, 0x52, 0x65, 0x73, 0x75,
+	0x6c, 0x74, 0x52, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x22, 0x1d, 0x0a, 0x07, 0x4d,
+	0x47, 0x65, 0x74, 0x52, 0x65, 0x71, 0x12, 0x12, 0x0a, 0x04, 0x6b, 0x65, 0x79, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x09, 0x52, 0x04, 0x6b, 0x65, 0x79, 0x73, 0x22, 0x37, 0x0a, 0x07, 0x4d, 0x47,
+	0x65, 0x74, 0x52, 0x73, 0x70, 0x12, 0x16, 0x0a, 0x06, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x12, 0x14, 0x0a,
+	0x05, 0x66, 0x6f, 0x75, 0x6e, 0x64, 0x18, 0x02, 0x20, 0x03, 0x28, 0x08, 0x52, 0x05, 0x66, 0x6f,
+	0x75, 0x6e, 0x64, 0x22, 0x81, 0x01, 0x0a, 0x07, 0x4d, 0x53, 0x65, 0x74, 0x52, 0x65, 0x71, 0x12,
+	0x12, 0x0a, 0x04, 0x6b, 0x65, 0x79, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x04, 0x6b,
+	0x65, 0x79, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x18, 0x02, 0x20,
+	0x03, 0x28, 0x09, 0x52, 0x06, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x65,
+	0x78, 0x70, 0x69, 0x72, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x65, 0x78, 0x70,
+	0x69, 0x72, 0x65, 0x12, 0x32, 0x0a, 0x0a, 0x65, 0x78, 0x70, 0x69, 0x72, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65,
+	0x2e, 0x45, 0x78, 0x70, 0x69, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0a, 0x65, 0x78, 0x70,
+	0x69, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x09, 0x0a, 0x07, 0x4d, 0x53, 0x65, 0x74, 0x52,
+	0x73, 0x70, 0x22, 0x39, 0x0a, 0x08, 0x42, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x71, 0x12, 0x2d,
+	0x0a, 0x08, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x11, 0x2e, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x2e, 0x54, 0x78, 0x43, 0x6f, 0x6d, 0x6d,
+	0x61, 0x6e, 0x64, 0x52, 0x08, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x73, 0x22, 0xc5, 0x01,
+	0x0a, 0x0b, 0x42, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x14, 0x0a,
+	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x18, 0x02, 0x20,
+	0x03, 0x28, 0x09, 0x52, 0x06, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x12, 0x37, 0x0a, 0x06, 0x66,
+	0x69, 0x65, 0x6c, 0x64, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x62, 0x72,
+	0x69, 0x64, 0x67, 0x65, 0x2e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74,
+	0x2e, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x06, 0x66, 0x69,
+	0x65, 0x6c, 0x64, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x1a, 0x39, 0x0a, 0x0b, 0x46, 0x69,
+	0x65, 0x6c, 0x64, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x39, 0x0a, 0x08, 0x42, 0x61, 0x74, 0x63, 0x68, 0x52, 0x73,
+	0x70, 0x12, 0x2d, 0x0a, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x13, 0x2e, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x2e, 0x42, 0x61, 0x74, 0x63,
+	0x68, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73,
+	0x22, 0x0a, 0x0a, 0x08, 0x43, 0x6c, 0x65, 0x61, 0x72, 0x52, 0x65, 0x71, 0x22, 0x0a, 0x0a, 0x08,
+	0x43, 0x6c, 0x65, 0x61, 0x72, 0x52, 0x73, 0x70, 0x32, 0xab, 0x2e, 0x0a, 0x09, 0x52, 0x70, 0x63,
+	0x42, 0x72, 0x69, 0x64, 0x67, 0x65, 0x12, 0x2a, 0x0a, 0x04, 0x50, 0x69, 0x6e, 0x67, 0x12, 0x0f,
+	0x2e, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x2e, 0x50, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x1a,
+	0x0f, 0x2e, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x2e, 0x50, 0x69, 0x6e, 0x67, 0x52, 0x73, 0x70,
+	0x22, 0x00, 0x12, 0x37, 0x0a, 0x07, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x12, 0x12, 0x2e,
+	0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x2e, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x52, 0x65,
+	0x71, 0x1a, 0x12, 0x2e, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x2e, 0x50, 0x75, 0x62, 0x6c, 0x69,
+	0x73, 0x68, 0x52, 0x73, 0x70, 0x22, 0x00, 0x28, 0x01, 0x30, 0x01, 0x12, 0x27, 0x0a, 0x03, 0x47,
+	0x65, 0x74, 0x12, 0x0e, 0x2e, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x2e, 0x47, 0x65, 0x74, 0x52,
+	0x65, 0x71, 0x1a, 0x0e, 0x2e, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x2e, 0x47, 0x65, 0x74, 0x52,
+	0x73, 0x70, 0x22, 0x00, 0x12, 0x27, 0x0a, 0x03, 0x50, 0x75, 0x74, 0x12, 0x0e, 0x2e, 0x62, 0x72,
+	0x69, 0x64, 0x67, 0x65, 0x2e, 0x50, 0x75, 0x74, 0x52, 0x65, 0x71, 0x1a, 0x0e, 0x2e, 0x62, 0x72,
+	0x69, 0x64, 0x67, 0x65, 0x2e, 0x50, 0x75, 0x74, 0x52, 0x73, 0x70, 0x22, 0x00, 0x12, 0x27, 0x0a,
+	0x03, 0x44, 0x65, 0x6c, 0x12, 0x0e, 0x2e, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x2e, 0x44, 0x65,
+	0x6c, 0x52, 0x65, 0x71, 0x1a, 0x0e, 0x2e, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x2e, 0x44, 0x65,
+	0x6c, 0x52, 0x73, 0x70, 0x22, 0x00, 0x12, 0x30, 0x0a, 0x08, 0x57, 0x61, 0x74, 0x63, 0x68, 0x4b,
+	0x65, 0x79, 0x12, 0x10, 0x2e, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x2e, 0x57, 0x61, 0x74, 0x63,
+	0x68, 0x52, 0x65, 0x71, 0x1a, 0x10, 0x2e, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x2e, 0x57, 0x61,
+	0x74, 0x63, 0x68, 0x52, 0x73, 0x70, 0x22, 0x00, 0x12, 0x32, 0x0a, 0x0a, 0x55, 0x6e, 0x57, 0x61,
+	0x74, 0x63, 0x68, 0x4b, 0x65, 0x79, 0x12, 0x10, 0x2e, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x2e,
+	0x57, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x71, 0x1a, 0x10, 0x2e, 0x62, 0x72, 0x69, 0x64, 0x67,
+	0x65, 0x2e, 0x57, 0x61, 0x74, 0x63, 0x68, 0x52, 0x73, 0x70, 0x22, 0x00, 0x12, 0x32, 0x0a, 0x0a,
+	0x43, 0x6c, 0x65, 0x61, 0x72, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x10, 0x2e, 0x62, 0x72, 0x69,
+	0x64, 0x67, 0x65, 0x2e, 0x43, 0x6c, 0x65, 0x61, 0x72, 0x52, 0x65, 0x71, 0x1a, 0x10, 0x2e, 0x62,
+	0x72, 0x69, 0x64, 0x67, 0x65, 0x2e, 0x43, 0x6c, 0x65, 0x61, 0x72, 0x52, 0x73, 0x70, 0x22, 0x00,
+	0x12, 0x2d, 0x0a, 0x05, 0x48, 0x4d, 0x47, 0x65, 0x74, 0x12, 0x10, 0x2e, 0x62, 0x72, 0x69, 0x64,
+	0x67, 0x65, 0x2e, 0x48, 0x4d, 0x47, 0x65, 0x74, 0x52, 0x65, 0x71, 0x1a, 0x10, 0x2e, 0x62, 0x72,
+	0x69, 0x64, 0x67, 0x65, 0x2e, 0x48, 0x4d, 0x47, 0x65, 0x74, 0x52, 0x73, 0x70, 0x22, 0x00, 0x12,
+	0x3f, 0x0a, 0x0b, 0x48, 0x4d, 0x47, 0x65, 0x74, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x16,
+	0x2e, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x2e, 0x48, 0x4d, 0x47, 0x65, 0x74, 0x4d, 0x65, 0x6d,
+	0x62, 0x65, 0x72, 0x52, 0x65, 0x71, 0x1a, 0x16, 0x2e, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x2e,
+	0x48, 0x4d, 0x47, 0x65, 0x74, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x52, 0x73, 0x70, 0x22, 0x00,
+	0x12, 0x2d, 0x0a, 0x05, 0x48, 0x4d, 0x50, 0x75, 0x74, 0x12, 0x10, 0x2e, 0x62, 0x72, 0x69, 0x64,
+	0x67, 0x65, 0x2e, 0x48, 0x4d, 0x50, 0x75, 0x74, 0x52, 0x65, 0x71, 0x1a, 0x10, 0x2e, 0x62, 0x72,
+	0x69, 0x64, 0x67, 0x65, 0x2e, 0x48, 0x4d, 0x50, 0x75, 0x74, 0x52, 0x73, 0x70, 0x22, 0x00, 0x12,
+	0x2d, 0x0a, 0x05, 0x48, 0x4d, 0x44, 0x65, 0x6c, 0x12, 0x10, 0x2e, 0x62, 0x72, 0x69, 0x64, 0x67,
+	0x65, 0x2e, 0x48, 0x4d, 0x44, 0x65, 0x6c, 0x52, 0x65, 0x71, 0x1a, 0x10, 0x2e, 0x62, 0x72, 0x69,
+	0x64, 0x67, 0x65, 0x2e, 0x48, 0x4d, 0x44, 0x65, 0x6c, 0x52, 0x73, 0x70, 0x22, 0x00, 0x12, 0x3f,
+	0x0a, 0x0b, 0x48, 0x4d, 0x44, 0x65, 0x6c, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x16, 0x2e,
+	0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x2e, 0x48, 0x4d, 0x44, 0x65, 0x6c, 0x4d, 0x65, 0x6d, 0x62,
+	0x65, 0x72, 0x52, 0x65, 0x71, 0x1a, 0x16, 0x2e, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x2e, 0x48,
+	0x4d, 0x44, 0x65, 0x6c, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x52, 0x73, 0x70, 0x22, 0x00, 0x12,
+	0x33, 0x0a, 0x07, 0x48, 0x4d, 0x57, 0x61, 0x74, 0x63, 0x68, 0x12, 0x12, 0x2e, 0x62, 0x72, 0x69,
+	0x64, 0x67, 0x65, 0x2e, 0x48, 0x4d, 0x57, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x71, 0x1a, 0x12,
+	0x2e, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x2e, 0x48, 0x4d, 0x57, 0x61, 0x74, 0x63, 0x68, 0x52,
+	0x73, 0x70, 0x22, 0x00, 0x12, 0x35, 0x0a, 0x09, 0x48, 0x4d, 0x55, 0x6e, 0x57, 0x61, 0x74, 0x63,
+	0x68, 0x12, 0x12, 0x2e, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x2e, 0x48, 0x4d, 0x57, 0x61, 0x74,
+	0x63, 0x68, 0x52, 0x65, 0x71, 0x1a, 0x12, 0x2e, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x2e, 0x48,
+	0x4d, 0x57, 0x61, 0x74, 0x63, 0x68, 0x52, 0x73, 0x70, 0x22, 0x00, 0x12, 0x30, 0x0a, 0x08, 0x43,
+	0x6c, 0x65, 0x61, 0x72, 0x4d, 0x61, 0x70, 0x12, 0x10, 0x2e, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65,
+	0x2e, 0x43, 0x6c, 0x65, 0x61, 0x72, 0x52, 0x65, 0x71, 0x1a, 0x10, 0x2e, 0x62, 0x72, 0x69, 0x64,
+	0x67, 0x65, 0x2e, 0x43, 0x6c, 0x65, 0x61, 0x72, 0x52, 0x73, 0x70, 0x22, 0x00, 0x12, 0x2a, 0x0a,
+	0x04, 0x4c, 0x47, 0x65, 0x74, 0x12, 0x0f, 0x2e, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x2e, 0x4c,
+	0x47, 0x65, 0x74, 0x52, 0x65, 0x71, 0x1a, 0x0f, 0x2e, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x2e,
+	0x4c, 0x47, 0x65, 0x74, 0x52, 0x73, 0x70, 0x22, 0x00, 0x12, 0x39, 0x0a, 0x09, 0x4c, 0x47, 0x65,
+	0x74, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x14, 0x2e, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x2e,
+	0x4c, 0x47, 0x65, 0x74, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x65, 0x71, 0x1a, 0x14, 0x2e, 0x62,
+	0x72, 0x69, 0x64, 0x67, 0x65, 0x2e, 0x4c, 0x47, 0x65, 0x74, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x52,
+	0x73, 0x70, 0x22, 0x00, 0x12, 0x2a, 0x0a, 0x04, 0x4c, 0x50, 0x75, 0x74, 0x12, 0x0f, 0x2e, 0x62,
+	0x72, 0x69, 0x64, 0x67, 0x65, 0x2e, 0x4c, 0x50, 0x75, 0x74, 0x52, 0x65, 0x71, 0x1a, 0x0f, 0x2e,
+	0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x2e, 0x4c, 0x50, 0x75, 0x74, 0x52, 0x73, 0x70, 0x22, 0x00,
+	0x12, 0x2a, 0x0a, 0x04, 0x4c, 0x44, 0x65, 0x6c, 0x12, 0x0f, 0x2e, 0x62, 0x72, 0x69, 0x64, 0x67,
+	0x65, 0x2e, 0x4c, 0x44, 0x65, 0x6c, 0x52, 0x65, 0x71, 0x1a, 0x0f, 0x2e, 0x62, 0x72, 0x69, 0x64,
+	0x67, 0x65, 0x2e, 0x4c, 0x44, 0x65, 0x6c, 0x52, 0x73, 0x70, 0x22, 0x00, 0x12, 0x39, 0x0a, 0x09,
+	0x4c, 0x44, 0x65, 0x6c, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x14, 0x2e, 0x62, 0x72, 0x69, 0x64,
+	0x67, 0x65, 0x2e, 0x4c, 0x44, 0x65, 0x6c, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x65, 0x71, 0x1a,
+	0x14, 0x2e, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x2e, 0x4c, 0x44, 0x65, 0x6c, 0x52, 0x61, 0x6e,
+	0x67, 0x65, 0x52, 0x73, 0x70, 0x22, 0x00, 0x12, 0x30, 0x0a, 0x06, 0x4c, 0x57, 0x61, 0x74, 0x63,
+	0x68, 0x12, 0x11, 0x2e, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x2e, 0x4c, 0x57, 0x61, 0x74, 0x63,
+	0x68, 0x52, 0x65, 0x71, 0x1a, 0x11, 0x2e, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x2e, 0x4c, 0x57,
+	0x61, 0x74, 0x63, 0x68, 0x52, 0x73, 0x70, 0x22, 0x00, 0x12, 0x32, 0x0a, 0x08, 0x4c, 0x55, 0x6e,
+	0x57, 0x61, 0x74, 0x63, 0x68, 0x12, 0x11, 0x2e, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x2e, 0x4c,
+	0x57, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x71, 0x1a, 0x11, 0x2e, 0x62, 0x72, 0x69, 0x64, 0x67,
+	0x65, 0x2e, 0x4c, 0x57, 0x61, 0x74, 0x63, 0x68, 0x52, 0x73, 0x70, 0x22, 0x00, 0x12, 0x31, 0x0a,
+	0x09, 0x43, 0x6c, 0x65, 0x61, 0x72, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x10, 0x2e, 0x62, 0x72, 0x69,
+	0x64, 0x67, 0x65, 0x2e, 0x43, 0x6c, 0x65, 0x61, 0x72, 0x52, 0x65, 0x71, 0x1a, 0x10, 0x2e, 0x62,
+	0x72, 0x69, 0x64, 0x67, 0x65, 0x2e, 0x43, 0x6c, 0x65, 0x61, 0x72, 0x52, 0x73, 0x70, 0x22, 0x00,
+	0x12, 0x2a, 0x0a, 0x04, 0x53, 0x47, 0x65, 0x74, 0x12, 0x0f, 0x2e, 0x62, 0x72, 0x69, 0x64, 0x67,
+	0x65, 0x2e, 0x53, 0x47, 0x65, 0x74, 0x52, 0x65, 0x71, 0x1a, 0x0f, 0x2e, 0x62, 0x72, 0x69, 0x64,
+	0x67, 0x65, 0x2e, 0x53, 0x47, 0x65, 0x74, 0x52, 0x73, 0x70, 0x22, 0x00, 0x12, 0x2a, 0x0a, 0x04,
+	0x53, 0x50, 0x75, 0x74, 0x12, 0x0f, 0x2e, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x2e, 0x53, 0x50,
+	0x75, 0x74, 0x52, 0x65, 0x71, 0x1a, 0x0f, 0x2e, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x2e, 0x53,
+	0x50, 0x75, 0x74, 0x52, 0x73, 0x70, 0x22, 0x00, 0x12, 0x2a, 0x0a, 0x04, 0x53, 0x44, 0x65, 0x6c,
+	0x12, 0x0f, 0x2e, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x2e, 0x53, 0x44, 0x65, 0x6c, 0x52, 0x65,
+	0x71, 0x1a, 0x0f, 0x2e, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x2e, 0x53, 0x44, 0x65, 0x6c, 0x52,
+	0x73, 0x70, 0x22, 0x00, 0x12, 0x3c, 0x0a, 0x0a, 0x53, 0x44, 0x65, 0x6c, 0x4d, 0x65, 0x6d, 0x62,
+	0x65, 0x72, 0x12, 0x15, 0x2e, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x2e, 0x53, 0x44, 0x65, 0x6c,
+	0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x52, 0x65, 0x71, 0x1a, 0x15, 0x2e, 0x62, 0x72, 0x69, 0x64,
+	0x67, 0x65, 0x2e, 0x53, 0x44, 0x65, 0x6c, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x52, 0x73, 0x70,
+	0x22, 0x00, 0x12, 0x30, 0x0a, 0x06, 0x53, 0x57, 0x61, 0x74, 0x63, 0x68, 0x12, 0x11, 0x2e, 0x62,
+	0x72, 0x69, 0x64, 0x67, 0x65, 0x2e, 0x53, 0x57, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x71, 0x1a,
+	0x11, 0x2e, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x2e, 0x53, 0x57, 0x61, 0x74, 0x63, 0x68, 0x52,
+	0x73, 0x70, 0x22, 0x00, 0x12, 0x32, 0x0a, 0x08, 0x53, 0x55, 0x6e, 0x57, 0x61, 0x74, 0x63, 0x68,
+	0x12, 0x11, 0x2e, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x2e, 0x53, 0x57, 0x61, 0x74, 0x63, 0x68,
+	0x52, 0x65, 0x71, 0x1a, 0x11, 0x2e, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x2e, 0x53, 0x57, 0x61,
+	0x74, 0x63, 0x68, 0x52, 0x73, 0x70, 0x22, 0x00, 0x12, 0x30, 0x0a, 0x08, 0x43, 0x6c, 0x65, 0x61,
+	0x72, 0x53, 0x65, 0x74, 0x12, 0x10, 0x2e, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x2e, 0x43, 0x6c,
+	0x65, 0x61, 0x72, 0x52, 0x65, 0x71, 0x1a, 0x10, 0x2e, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x2e,
+	0x43, 0x6c, 0x65, 0x61, 0x72, 0x52, 0x73, 0x70, 0x22, 0x00, 0x12, 0x2d, 0x0a, 0x05, 0x50, 0x46,
+	0x41, 0x64, 0x64, 0x12, 0x10, 0x2e, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x2e, 0x50, 0x46, 0x41,
+	0x64, 0x64, 0x52, 0x65, 0x71, 0x1a, 0x10, 0x2e, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x2e, 0x50,
+	0x46, 0x41, 0x64, 0x64, 0x52, 0x73, 0x70, 0x22, 0x00, 0x12, 0x33, 0x0a, 0x07, 0x50, 0x46, 0x43,
+	0x6f, 0x75, 0x6e, 0x74, 0x12, 0x12, 0x2e, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x2e, 0x50, 0x46,
+	0x43, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x1a, 0x12, 0x2e, 0x62, 0x72, 0x69, 0x64, 0x67,
+	0x65, 0x2e, 0x50, 0x46, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x73, 0x70, 0x22, 0x00, 0x12, 0x33,
+	0x0a, 0x07, 0x50, 0x46, 0x4d, 0x65, 0x72, 0x67, 0x65, 0x12, 0x12, 0x2e, 0x62, 0x72, 0x69, 0x64,
+	0x67, 0x65, 0x2e, 0x50, 0x46, 0x4d, 0x65, 0x72, 0x67, 0x65, 0x52, 0x65, 0x71, 0x1a, 0x12, 0x2e,
+	0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x2e, 0x50, 0x46, 0x4d, 0x65, 0x72, 0x67, 0x65, 0x52, 0x73,
+	0x70, 0x22, 0x00, 0x12, 0x2d, 0x0a, 0x05, 0x50, 0x46, 0x44, 0x65, 0x6c, 0x12, 0x10, 0x2e, 0x62,
+	0x72, 0x69, 0x64, 0x67, 0x65, 0x2e, 0x50, 0x46, 0x44, 0x65, 0x6c, 0x52, 0x65, 0x71, 0x1a, 0x10,
+	0x2e, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x2e, 0x50, 0x46, 0x44, 0x65, 0x6c, 0x52, 0x73, 0x70,
+	0x22, 0x00, 0x12, 0x30, 0x0a, 0x08, 0x43, 0x6c, 0x65, 0x61, 0x72, 0x48, 0x4c, 0x4c, 0x12, 0x10,
+	0x2e, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x2e, 0x43, 0x6c, 0x65, 0x61, 0x72, 0x52, 0x65, 0x71,
+	0x1a, 0x10, 0x2e, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x2e, 0x43, 0x6c, 0x65, 0x61, 0x72, 0x52,
+	0x73, 0x70, 0x22, 0x00, 0x12, 0x30, 0x0a, 0x06, 0x47, 0x65, 0x6f, 0x41, 0x64, 0x64, 0x12, 0x11,
+	0x2e, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x2e, 0x47, 0x65, 0x6f, 0x41, 0x64, 0x64, 0x52, 0x65,
+	0x71, 0x1a, 0x11, 0x2e, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x2e, 0x47, 0x65, 0x6f, 0x41, 0x64,
+	0x64, 0x52, 0x73, 0x70, 0x22, 0x00, 0x12, 0x30, 0x0a, 0x06, 0x47, 0x65, 0x6f, 0x50, 0x6f, 0x73,
+	0x12, 0x11, 0x2e, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x2e, 0x47, 0x65, 0x6f, 0x50, 0x6f, 0x73,
+	0x52, 0x65, 0x71, 0x1a, 0x11, 0x2e, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x2e, 0x47, 0x65, 0x6f,
+	0x50, 0x6f, 0x73, 0x52, 0x73, 0x70, 0x22, 0x00, 0x12, 0x33, 0x0a, 0x07, 0x47, 0x65, 0x6f, 0x44,
+	0x69, 0x73, 0x74, 0x12, 0x12, 0x2e, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x2e, 0x47, 0x65, 0x6f,
+	0x44, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x1a, 0x12, 0x2e, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65,
+	0x2e, 0x47, 0x65, 0x6f, 0x44, 0x69, 0x73, 0x74, 0x52, 0x73, 0x70, 0x22, 0x00, 0x12, 0x39, 0x0a,
+	0x09, 0x47, 0x65, 0x6f, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x12, 0x14, 0x2e, 0x62, 0x72, 0x69,
+	0x64, 0x67, 0x65, 0x2e, 0x47, 0x65, 0x6f, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x52, 0x65, 0x71,
+	0x1a, 0x14, 0x2e, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x2e, 0x47, 0x65, 0x6f, 0x53, 0x65, 0x61,
+	0x72, 0x63, 0x68, 0x52, 0x73, 0x70, 0x22, 0x00, 0x12, 0x30, 0x0a, 0x06, 0x47, 0x65, 0x6f, 0x44,
+	0x65, 0x6c, 0x12, 0x11, 0x2e, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x2e, 0x47, 0x65, 0x6f, 0x44,
+	0x65, 0x6c, 0x52, 0x65, 0x71, 0x1a, 0x11, 0x2e, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x2e, 0x47,
+	0x65, 0x6f, 0x44, 0x65, 0x6c, 0x52, 0x73, 0x70, 0x22, 0x00, 0x12, 0x42, 0x0a, 0x0c, 0x47, 0x65,
+	0x6f, 0x44, 0x65, 0x6c, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x17, 0x2e, 0x62, 0x72, 0x69,
+	0x64, 0x67, 0x65, 0x2e, 0x47, 0x65, 0x6f, 0x44, 0x65, 0x6c, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72,
+	0x52, 0x65, 0x71, 0x1a, 0x17, 0x2e, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x2e, 0x47, 0x65, 0x6f,
+	0x44, 0x65, 0x6c, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x52, 0x73, 0x70, 0x22, 0x00, 0x12, 0x30,
+	0x0a, 0x08, 0x43, 0x6c, 0x65, 0x61, 0x72, 0x47, 0x65, 0x6f, 0x12, 0x10, 0x2e, 0x62, 0x72, 0x69,
+	0x64, 0x67, 0x65, 0x2e, 0x43, 0x6c, 0x65, 0x61, 0x72, 0x52, 0x65, 0x71, 0x1a, 0x10, 0x2e, 0x62,
+	0x72, 0x69, 0x64, 0x67, 0x65, 0x2e, 0x43, 0x6c, 0x65, 0x61, 0x72, 0x52, 0x73, 0x70, 0x22, 0x00,
+	0x12, 0x2a, 0x0a, 0x04, 0x58, 0x41, 0x64, 0x64, 0x12, 0x0f, 0x2e, 0x62, 0x72, 0x69, 0x64, 0x67,
+	0x65, 0x2e, 0x58, 0x41, 0x64, 0x64, 0x52, 0x65, 0x71, 0x1a, 0x0f, 0x2e, 0x62, 0x72, 0x69, 0x64,
+	0x67, 0x65, 0x2e, 0x58, 0x41, 0x64, 0x64, 0x52, 0x73, 0x70, 0x22, 0x00, 0x12, 0x2a, 0x0a, 0x04,
+	0x58, 0x4c, 0x65, 0x6e, 0x12, 0x0f, 0x2e, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x2e, 0x58, 0x4c,
+	0x65, 0x6e, 0x52, 0x65, 0x71, 0x1a, 0x0f, 0x2e, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x2e, 0x58,
+	0x4c, 0x65, 0x6e, 0x52, 0x73, 0x70, 0x22, 0x00, 0x12, 0x30, 0x0a, 0x06, 0x58, 0x52, 0x61, 0x6e,
+	0x67, 0x65, 0x12, 0x11, 0x2e, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x2e, 0x58, 0x52, 0x61, 0x6e,
+	0x67, 0x65, 0x52, 0x65, 0x71, 0x1a, 0x11, 0x2e, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x2e, 0x58,
+	0x52, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x73, 0x70, 0x22, 0x00, 0x12, 0x2d, 0x0a, 0x05, 0x58, 0x52,
+	0x65, 0x61, 0x64, 0x12, 0x10, 0x2e, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x2e, 0x58, 0x52, 0x65,
+	0x61, 0x64, 0x52, 0x65, 0x71, 0x1a, 0x10, 0x2e, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x2e, 0x58,
+	0x52, 0x65, 0x61, 0x64, 0x52, 0x73, 0x70, 0x22, 0x00, 0x12, 0x36, 0x0a, 0x0c, 0x58, 0x47, 0x72,
+	0x6f, 0x75, 0x70, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x12, 0x11, 0x2e, 0x62, 0x72, 0x69, 0x64,
+	0x67, 0x65, 0x2e, 0x58, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x65, 0x71, 0x1a, 0x11, 0x2e, 0x62,
+	0x72, 0x69, 0x64, 0x67, 0x65, 0x2e, 0x58, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x73, 0x70, 0x22,
+	0x00, 0x12, 0x37, 0x0a, 0x0d, 0x58, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x44, 0x65, 0x73, 0x74, 0x72,
+	0x6f, 0x79, 0x12, 0x11, 0x2e, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x2e, 0x58, 0x47, 0x72, 0x6f,
+	0x75, 0x70, 0x52, 0x65, 0x71, 0x1a, 0x11, 0x2e, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x2e, 0x58,
+	0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x73, 0x70, 0x22, 0x00, 0x12, 0x37, 0x0a, 0x0a, 0x58, 0x52,
+	0x65, 0x61, 0x64, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x12, 0x15, 0x2e, 0x62, 0x72, 0x69, 0x64, 0x67,
+	0x65, 0x2e, 0x58, 0x52, 0x65, 0x61, 0x64, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x65, 0x71, 0x1a,
+	0x10, 0x2e, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x2e, 0x58, 0x52, 0x65, 0x61, 0x64, 0x52, 0x73,
+	0x70, 0x22, 0x00, 0x12, 0x2a, 0x0a, 0x04, 0x58, 0x41, 0x63, 0x6b, 0x12, 0x0f, 0x2e, 0x62, 0x72,
+	0x69, 0x64, 0x67, 0x65, 0x2e, 0x58, 0x41, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x1a, 0x0f, 0x2e, 0x62,
+	0x72, 0x69, 0x64, 0x67, 0x65, 0x2e, 0x58, 0x41, 0x63, 0x6b, 0x52, 0x73, 0x70, 0x22, 0x00, 0x12,
+	0x36, 0x0a, 0x08, 0x58, 0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x12, 0x13, 0x2e, 0x62, 0x72,
+	0x69, 0x64, 0x67, 0x65, 0x2e, 0x58, 0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71,
+	0x1a, 0x13, 0x2e, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x2e, 0x58, 0x50, 0x65, 0x6e, 0x64, 0x69,
+	0x6e, 0x67, 0x52, 0x73, 0x70, 0x22, 0x00, 0x12, 0x30, 0x0a, 0x06, 0x58, 0x43, 0x6c, 0x61, 0x69,
+	0x6d, 0x12, 0x11, 0x2e, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x2e, 0x58, 0x43, 0x6c, 0x61, 0x69,
+	0x6d, 0x52, 0x65, 0x71, 0x1a, 0x11, 0x2e, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x2e, 0x58, 0x43,
+	0x6c, 0x61, 0x69, 0x6d, 0x52, 0x73, 0x70, 0x22, 0x00, 0x12, 0x2d, 0x0a, 0x05, 0x58, 0x54, 0x72,
+	0x69, 0x6d, 0x12, 0x10, 0x2e, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x2e, 0x58, 0x54, 0x72, 0x69,
+	0x6d, 0x52, 0x65, 0x71, 0x1a, 0x10, 0x2e, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x2e, 0x58, 0x54,
+	0x72, 0x69, 0x6d, 0x52, 0x73, 0x70, 0x22, 0x00, 0x12, 0x3c, 0x0a, 0x0a, 0x58, 0x44, 0x65, 0x6c,
+	0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x15, 0x2e, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x2e,
+	0x58, 0x44, 0x65, 0x6c, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x52, 0x65, 0x71, 0x1a, 0x15, 0x2e,
+	0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x2e, 0x58, 0x44, 0x65, 0x6c, 0x4d, 0x65, 0x6d, 0x62, 0x65,
+	0x72, 0x52, 0x73, 0x70, 0x22, 0x00, 0x12, 0x2a, 0x0a, 0x04, 0x58, 0x44, 0x65, 0x6c, 0x12, 0x0f,
+	0x2e, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x2e, 0x58, 0x44, 0x65, 0x6c, 0x52, 0x65, 0x71, 0x1a,
+	0x0f, 0x2e, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x2e, 0x58, 0x44, 0x65, 0x6c, 0x52, 0x73, 0x70,
+	0x22, 0x00, 0x12, 0x33, 0x0a, 0x0b, 0x43, 0x6c, 0x65, 0x61, 0x72, 0x53, 0x74, 0x72, 0x65, 0x61,
+	0x6d, 0x12, 0x10, 0x2e, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x2e, 0x43, 0x6c, 0x65, 0x61, 0x72,
+	0x52, 0x65, 0x71, 0x1a, 0x10, 0x2e, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x2e, 0x43, 0x6c, 0x65,
+	0x61, 0x72, 0x52, 0x73, 0x70, 0x22, 0x00, 0x12, 0x2a, 0x0a, 0x04, 0x4a, 0x53, 0x65, 0x74, 0x12,
+	0x0f, 0x2e, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x2e, 0x4a, 0x53, 0x65, 0x74, 0x52, 0x65, 0x71,
+	0x1a, 0x0f, 0x2e, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x2e, 0x4a, 0x53, 0x65, 0x74, 0x52, 0x73,
+	0x70, 0x22, 0x00, 0x12, 0x2a, 0x0a, 0x04, 0x4a, 0x47, 0x65, 0x74, 0x12, 0x0f, 0x2e, 0x62, 0x72,
+	0x69, 0x64, 0x67, 0x65, 0x2e, 0x4a, 0x47, 0x65, 0x74, 0x52, 0x65, 0x71, 0x1a, 0x0f, 0x2e, 0x62,
+	0x72, 0x69, 0x64, 0x67, 0x65, 0x2e, 0x4a, 0x47, 0x65, 0x74, 0x52, 0x73, 0x70, 0x22, 0x00, 0x12,
+	0x36, 0x0a, 0x08, 0x4a, 0x44, 0x65, 0x6c, 0x50, 0x61, 0x74, 0x68, 0x12, 0x13, 0x2e, 0x62, 0x72,
+	0x69, 0x64, 0x67, 0x65, 0x2e, 0x4a, 0x44, 0x65, 0x6c, 0x50, 0x61, 0x74, 0x68, 0x52, 0x65, 0x71,
+	0x1a, 0x13, 0x2e, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x2e, 0x4a, 0x44, 0x65, 0x6c, 0x50, 0x61,
+	0x74, 0x68, 0x52, 0x73, 0x70, 0x22, 0x00, 0x12, 0x3c, 0x0a, 0x0a, 0x4a, 0x41, 0x72, 0x72, 0x41,
+	0x70, 0x70, 0x65, 0x6e, 0x64, 0x12, 0x15, 0x2e, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x2e, 0x4a,
+	0x41, 0x72, 0x72, 0x41, 0x70, 0x70, 0x65, 0x6e, 0x64, 0x52, 0x65, 0x71, 0x1a, 0x15, 0x2e, 0x62,
+	0x72, 0x69, 0x64, 0x67, 0x65, 0x2e, 0x4a, 0x41, 0x72, 0x72, 0x41, 0x70, 0x70, 0x65, 0x6e, 0x64,
+	0x52, 0x73, 0x70, 0x22, 0x00, 0x12, 0x3c, 0x0a, 0x0a, 0x4a, 0x4e, 0x75, 0x6d, 0x49, 0x6e, 0x63,
+	0x72, 0x42, 0x79, 0x12, 0x15, 0x2e, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x2e, 0x4a, 0x4e, 0x75,
+	0x6d, 0x49, 0x6e, 0x63, 0x72, 0x42, 0x79, 0x52, 0x65, 0x71, 0x1a, 0x15, 0x2e, 0x62, 0x72, 0x69,
+	0x64, 0x67, 0x65, 0x2e, 0x4a, 0x4e, 0x75, 0x6d, 0x49, 0x6e, 0x63, 0x72, 0x42, 0x79, 0x52, 0x73,
+	0x70, 0x22, 0x00, 0x12, 0x2a, 0x0a, 0x04, 0x4a, 0x44, 0x65, 0x6c, 0x12, 0x0f, 0x2e, 0x62, 0x72,
+	0x69, 0x64, 0x67, 0x65, 0x2e, 0x4a, 0x44, 0x65, 0x6c, 0x52, 0x65, 0x71, 0x1a, 0x0f, 0x2e, 0x62,
+	0x72, 0x69, 0x64, 0x67, 0x65, 0x2e, 0x4a, 0x44, 0x65, 0x6c, 0x52, 0x73, 0x70, 0x22, 0x00, 0x12,
+	0x30, 0x0a, 0x06, 0x4a, 0x57, 0x61, 0x74, 0x63, 0x68, 0x12, 0x11, 0x2e, 0x62, 0x72, 0x69, 0x64,
+	0x67, 0x65, 0x2e, 0x4a, 0x57, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x71, 0x1a, 0x11, 0x2e, 0x62,
+	0x72, 0x69, 0x64, 0x67, 0x65, 0x2e, 0x4a, 0x57, 0x61, 0x74, 0x63, 0x68, 0x52, 0x73, 0x70, 0x22,
+	0x00, 0x12, 0x32, 0x0a, 0x08, 0x4a, 0x55, 0x6e, 0x57, 0x61, 0x74, 0x63, 0x68, 0x12, 0x11, 0x2e,
+	0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x2e, 0x4a, 0x57, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x71,
+	0x1a, 0x11, 0x2e, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x2e, 0x4a, 0x57, 0x61, 0x74, 0x63, 0x68,
+	0x52, 0x73, 0x70, 0x22, 0x00, 0x12, 0x31, 0x0a, 0x09, 0x43, 0x6c, 0x65, 0x61, 0x72, 0x4a, 0x53,
+	0x4f, 0x4e, 0x12, 0x10, 0x2e, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x2e, 0x43, 0x6c, 0x65, 0x61,
+	0x72, 0x52, 0x65, 0x71, 0x1a, 0x10, 0x2e, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x2e, 0x43, 0x6c,
+	0x65, 0x61, 0x72, 0x52, 0x73, 0x70, 0x22, 0x00, 0x12, 0x39, 0x0a, 0x09, 0x42, 0x46, 0x52, 0x65,
+	0x73, 0x65, 0x72, 0x76, 0x65, 0x12, 0x14, 0x2e, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x2e, 0x42,
+	0x46, 0x52, 0x65, 0x73, 0x65, 0x72, 0x76, 0x65, 0x52, 0x65, 0x71, 0x1a, 0x14, 0x2e, 0x62, 0x72,
+	0x69, 0x64, 0x67, 0x65, 0x2e, 0x42, 0x46, 0x52, 0x65, 0x73, 0x65, 0x72, 0x76, 0x65, 0x52, 0x73,
+	0x70, 0x22, 0x00, 0x12, 0x2d, 0x0a, 0x05, 0x42, 0x46, 0x41, 0x64, 0x64, 0x12, 0x10, 0x2e, 0x62,
+	0x72, 0x69, 0x64, 0x67, 0x65, 0x2e, 0x42, 0x46, 0x41, 0x64, 0x64, 0x52, 0x65, 0x71, 0x1a, 0x10,
+	0x2e, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x2e, 0x42, 0x46, 0x41, 0x64, 0x64, 0x52, 0x73, 0x70,
+	0x22, 0x00, 0x12, 0x36, 0x0a, 0x08, 0x42, 0x46, 0x45, 0x78, 0x69, 0x73, 0x74, 0x73, 0x12, 0x13,
+	0x2e, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x2e, 0x42, 0x46, 0x45, 0x78, 0x69, 0x73, 0x74, 0x73,
+	0x52, 0x65, 0x71, 0x1a, 0x13, 0x2e, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x2e, 0x42, 0x46, 0x45,
+	0x78, 0x69, 0x73, 0x74, 0x73, 0x52, 0x73, 0x70, 0x22, 0x00, 0x12, 0x30, 0x0a, 0x06, 0x42, 0x46,
+	0x49, 0x6e, 0x66, 0x6f, 0x12, 0x11, 0x2e, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x2e, 0x42, 0x46,
+	0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x71, 0x1a, 0x11, 0x2e, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65,
+	0x2e, 0x42, 0x46, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x73, 0x70, 0x22, 0x00, 0x12, 0x2d, 0x0a, 0x05,
+	0x42, 0x46, 0x44, 0x65, 0x6c, 0x12, 0x10, 0x2e, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x2e, 0x42,
+	0x46, 0x44, 0x65, 0x6c, 0x52, 0x65, 0x71, 0x1a, 0x10, 0x2e, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65,
+	0x2e, 0x42, 0x46, 0x44, 0x65, 0x6c, 0x52, 0x73, 0x70, 0x22, 0x00, 0x12, 0x32, 0x0a, 0x0a, 0x43,
+	0x6c, 0x65, 0x61, 0x72, 0x42, 0x6c, 0x6f, 0x6f, 0x6d, 0x12, 0x10, 0x2e, 0x62, 0x72, 0x69, 0x64,
+	0x67, 0x65, 0x2e, 0x43, 0x6c, 0x65, 0x61, 0x72, 0x52, 0x65, 0x71, 0x1a, 0x10, 0x2e, 0x62, 0x72,
+	0x69, 0x64, 0x67, 0x65, 0x2e, 0x43, 0x6c, 0x65, 0x61, 0x72, 0x52, 0x73, 0x70, 0x22, 0x00, 0x12,
+	0x39, 0x0a, 0x09, 0x43, 0x46, 0x52, 0x65, 0x73, 0x65, 0x72, 0x76, 0x65, 0x12, 0x14, 0x2e, 0x62,
+	0x72, 0x69, 0x64, 0x67, 0x65, 0x2e, 0x43, 0x46, 0x52, 0x65, 0x73, 0x65, 0x72, 0x76, 0x65, 0x52,
+	0x65, 0x71, 0x1a, 0x14, 0x2e, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x2e, 0x43, 0x46, 0x52, 0x65,
+	0x73, 0x65, 0x72, 0x76, 0x65, 0x52, 0x73, 0x70, 0x22, 0x00, 0x12, 0x2d, 0x0a, 0x05, 0x43, 0x46,
+	0x41, 0x64, 0x64, 0x12, 0x10, 0x2e, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x2e, 0x43, 0x46, 0x41,
+	0x64, 0x64, 0x52, 0x65, 0x71, 0x1a, 0x10, 0x2e, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x2e, 0x43,
+	0x46, 0x41, 0x64, 0x64, 0x52, 0x73, 0x70, 0x22, 0x00, 0x12, 0x36, 0x0a, 0x08, 0x43, 0x46, 0x45,
+	0x78, 0x69, 0x73, 0x74, 0x73, 0x12, 0x13, 0x2e, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x2e, 0x43,
+	0x46, 0x45, 0x78, 0x69, 0x73, 0x74, 0x73, 0x52, 0x65, 0x71, 0x1a, 0x13, 0x2e, 0x62, 0x72, 0x69,
+	0x64, 0x67, 0x65, 0x2e, 0x43, 0x46, 0x45, 0x78, 0x69, 0x73, 0x74, 0x73, 0x52, 0x73, 0x70, 0x22,
+	0x00, 0x12, 0x3f, 0x0a, 0x0b, 0x43, 0x46, 0x44, 0x65, 0x6c, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72,
+	0x12, 0x16, 0x2e, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x2e, 0x43, 0x46, 0x44, 0x65, 0x6c, 0x4d,
+	0x65, 0x6d, 0x62, 0x65, 0x72, 0x52, 0x65, 0x71, 0x1a, 0x16, 0x2e, 0x62, 0x72, 0x69, 0x64, 0x67,
+	0x65, 0x2e, 0x43, 0x46, 0x44, 0x65, 0x6c, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x52, 0x73, 0x70,
+	0x22, 0x00, 0x12, 0x30, 0x0a, 0x06, 0x43, 0x46, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x11, 0x2e, 0x62,
+	0x72, 0x69, 0x64, 0x67, 0x65, 0x2e, 0x43, 0x46, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x71, 0x1a,
+	0x11, 0x2e, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x2e, 0x43, 0x46, 0x49, 0x6e, 0x66, 0x6f, 0x52,
+	0x73, 0x70, 0x22, 0x00, 0x12, 0x2d, 0x0a, 0x05, 0x43, 0x46, 0x44, 0x65, 0x6c, 0x12, 0x10, 0x2e,
+	0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x2e, 0x43, 0x46, 0x44, 0x65, 0x6c, 0x52, 0x65, 0x71, 0x1a,
+	0x10, 0x2e, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x2e, 0x43, 0x46, 0x44, 0x65, 0x6c, 0x52, 0x73,
+	0x70, 0x22, 0x00, 0x12, 0x33, 0x0a, 0x0b, 0x43, 0x6c, 0x65, 0x61, 0x72, 0x43, 0x75, 0x63, 0x6b,
+	0x6f, 0x6f, 0x12, 0x10, 0x2e, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x2e, 0x43, 0x6c, 0x65, 0x61,
+	0x72, 0x52, 0x65, 0x71, 0x1a, 0x10, 0x2e, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x2e, 0x43, 0x6c,
+	0x65, 0x61, 0x72, 0x52, 0x73, 0x70, 0x22, 0x00, 0x12, 0x36, 0x0a, 0x08, 0x54, 0x53, 0x43, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x12, 0x13, 0x2e, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x2e, 0x54, 0x53,
+	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x1a, 0x13, 0x2e, 0x62, 0x72, 0x69, 0x64,
+	0x67, 0x65, 0x2e, 0x54, 0x53, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x73, 0x70, 0x22, 0x00,
+	0x12, 0x2d, 0x0a, 0x05, 0x54, 0x53, 0x41, 0x64, 0x64, 0x12, 0x10, 0x2e, 0x62, 0x72, 0x69, 0x64,
+	0x67, 0x65, 0x2e, 0x54, 0x53, 0x41, 0x64, 0x64, 0x52, 0x65, 0x71, 0x1a, 0x10, 0x2e, 0x62, 0x72,
+	0x69, 0x64, 0x67, 0x65, 0x2e, 0x54, 0x53, 0x41, 0x64, 0x64, 0x52, 0x73, 0x70, 0x22, 0x00, 0x12,
+	0x33, 0x0a, 0x07, 0x54, 0x53, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x12, 0x2e, 0x62, 0x72, 0x69,
+	0x64, 0x67, 0x65, 0x2e, 0x54, 0x53, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x65, 0x71, 0x1a, 0x12,
+	0x2e, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x2e, 0x54, 0x53, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x52,
+	0x73, 0x70, 0x22, 0x00, 0x12, 0x2d, 0x0a, 0x05, 0x54, 0x53, 0x47, 0x65, 0x74, 0x12, 0x10, 0x2e,
+	0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x2e, 0x54, 0x53, 0x47, 0x65, 0x74, 0x52, 0x65, 0x71, 0x1a,
+	0x10, 0x2e, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x2e, 0x54, 0x53, 0x47, 0x65, 0x74, 0x52, 0x73,
+	0x70, 0x22, 0x00, 0x12, 0x3c, 0x0a, 0x0a, 0x54, 0x53, 0x44, 0x65, 0x6c, 0x52, 0x61, 0x6e, 0x67,
+	0x65, 0x12, 0x15, 0x2e, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x2e, 0x54, 0x53, 0x44, 0x65, 0x6c,
+	0x52, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x65, 0x71, 0x1a, 0x15, 0x2e, 0x62, 0x72, 0x69, 0x64, 0x67,
+	0x65, 0x2e, 0x54, 0x53, 0x44, 0x65, 0x6c, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x73, 0x70, 0x22,
+	0x00, 0x12, 0x36, 0x0a, 0x0c, 0x54, 0x53, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x75, 0x6c,
+	0x65, 0x12, 0x11, 0x2e, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x2e, 0x54, 0x53, 0x52, 0x75, 0x6c,
+	0x65, 0x52, 0x65, 0x71, 0x1a, 0x11, 0x2e, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x2e, 0x54, 0x53,
+	0x52, 0x75, 0x6c, 0x65, 0x52, 0x73, 0x70, 0x22, 0x00, 0x12, 0x36, 0x0a, 0x0c, 0x54, 0x53, 0x44,
+	0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x75, 0x6c, 0x65, 0x12, 0x11, 0x2e, 0x62, 0x72, 0x69, 0x64,
+	0x67, 0x65, 0x2e, 0x54, 0x53, 0x52, 0x75, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x1a, 0x11, 0x2e, 0x62,
+	0x72, 0x69, 0x64, 0x67, 0x65, 0x2e, 0x54, 0x53, 0x52, 0x75, 0x6c, 0x65, 0x52, 0x73, 0x70, 0x22,
+	0x00, 0x12, 0x30, 0x0a, 0x06, 0x54, 0x53, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x11, 0x2e, 0x62, 0x72,
+	0x69, 0x64, 0x67, 0x65, 0x2e, 0x54, 0x53, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x71, 0x1a, 0x11,
+	0x2e, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x2e, 0x54, 0x53, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x73,
+	0x70, 0x22, 0x00, 0x12, 0x2d, 0x0a, 0x05, 0x54, 0x53, 0x44, 0x65, 0x6c, 0x12, 0x10, 0x2e, 0x62,
+	0x72, 0x69, 0x64, 0x67, 0x65, 0x2e, 0x54, 0x53, 0x44, 0x65, 0x6c, 0x52, 0x65, 0x71, 0x1a, 0x10,
+	0x2e, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x2e, 0x54, 0x53, 0x44, 0x65, 0x6c, 0x52, 0x73, 0x70,
+	0x22, 0x00, 0x12, 0x2f, 0x0a, 0x07, 0x43, 0x6c, 0x65, 0x61, 0x72, 0x54, 0x53, 0x12, 0x10, 0x2e,
+	0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x2e, 0x43, 0x6c, 0x65, 0x61, 0x72, 0x52, 0x65, 0x71, 0x1a,
+	0x10, 0x2e, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x2e, 0x43, 0x6c, 0x65, 0x61, 0x72, 0x52, 0x73,
+	0x70, 0x22, 0x00, 0x12, 0x2a, 0x0a, 0x04, 0x53, 0x63, 0x61, 0x6e, 0x12, 0x0f, 0x2e, 0x62, 0x72,
+	0x69, 0x64, 0x67, 0x65, 0x2e, 0x53, 0x63, 0x61, 0x6e, 0x52, 0x65, 0x71, 0x1a, 0x0f, 0x2e, 0x62,
+	0x72, 0x69, 0x64, 0x67, 0x65, 0x2e, 0x53, 0x63, 0x61, 0x6e, 0x52, 0x73, 0x70, 0x22, 0x00, 0x12,
+	0x2d, 0x0a, 0x05, 0x48, 0x53, 0x63, 0x61, 0x6e, 0x12, 0x10, 0x2e, 0x62, 0x72, 0x69, 0x64, 0x67,
+	0x65, 0x2e, 0x48, 0x53, 0x63, 0x61, 0x6e, 0x52, 0x65, 0x71, 0x1a, 0x10, 0x2e, 0x62, 0x72, 0x69,
+	0x64, 0x67, 0x65, 0x2e, 0x48, 0x53, 0x63, 0x61, 0x6e, 0x52, 0x73, 0x70, 0x22, 0x00, 0x12, 0x2d,
+	0x0a, 0x05, 0x53, 0x53, 0x63, 0x61, 0x6e, 0x12, 0x10, 0x2e, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65,
+	0x2e, 0x53, 0x53, 0x63, 0x61, 0x6e, 0x52, 0x65, 0x71, 0x1a, 0x10, 0x2e, 0x62, 0x72, 0x69, 0x64,
+	0x67, 0x65, 0x2e, 0x53, 0x53, 0x63, 0x61, 0x6e, 0x52, 0x73, 0x70, 0x22, 0x00, 0x12, 0x27, 0x0a,
+	0x03, 0x54, 0x54, 0x4c, 0x12, 0x0e, 0x2e, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x2e, 0x54, 0x54,
+	0x4c, 0x52, 0x65, 0x71, 0x1a, 0x0e, 0x2e, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x2e, 0x54, 0x54,
+	0x4c, 0x52, 0x73, 0x70, 0x22, 0x00, 0x12, 0x28, 0x0a, 0x04, 0x50, 0x54, 0x54, 0x4c, 0x12, 0x0e,
+	0x2e, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x2e, 0x54, 0x54, 0x4c, 0x52, 0x65, 0x71, 0x1a, 0x0e,
+	0x2e, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x2e, 0x54, 0x54, 0x4c, 0x52, 0x73, 0x70, 0x22, 0x00,
+	0x12, 0x30, 0x0a, 0x06, 0x45, 0x78, 0x70, 0x69, 0x72, 0x65, 0x12, 0x11, 0x2e, 0x62, 0x72, 0x69,
+	0x64, 0x67, 0x65, 0x2e, 0x45, 0x78, 0x70, 0x69, 0x72, 0x65, 0x52, 0x65, 0x71, 0x1a, 0x11, 0x2e,
+	0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x2e, 0x45, 0x78, 0x70, 0x69, 0x72, 0x65, 0x52, 0x73, 0x70,
+	0x22, 0x00, 0x12, 0x34, 0x0a, 0x08, 0x45, 0x78, 0x70, 0x69, 0x72, 0x65, 0x41, 0x74, 0x12, 0x13,
+	0x2e, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x2e, 0x45, 0x78, 0x70, 0x69, 0x72, 0x65, 0x41, 0x74,
+	0x52, 0x65, 0x71, 0x1a, 0x11, 0x2e, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x2e, 0x45, 0x78, 0x70,
+	0x69, 0x72, 0x65, 0x52, 0x73, 0x70, 0x22, 0x00, 0x12, 0x32, 0x0a, 0x07, 0x50, 0x45, 0x78, 0x70,
+	0x69, 0x72, 0x65, 0x12, 0x12, 0x2e, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x2e, 0x50, 0x45, 0x78,
+	0x70, 0x69, 0x72, 0x65, 0x52, 0x65, 0x71, 0x1a, 0x11, 0x2e, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65,
+	0x2e, 0x45, 0x78, 0x70, 0x69, 0x72, 0x65, 0x52, 0x73, 0x70, 0x22, 0x00, 0x12, 0x36, 0x0a, 0x09,
+	0x50, 0x45, 0x78, 0x70, 0x69, 0x72, 0x65, 0x41, 0x74, 0x12, 0x14, 0x2e, 0x62, 0x72, 0x69, 0x64,
+	0x67, 0x65, 0x2e, 0x50, 0x45, 0x78, 0x70, 0x69, 0x72, 0x65, 0x41, 0x74, 0x52, 0x65, 0x71, 0x1a,
+	0x11, 0x2e, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x2e, 0x45, 0x78, 0x70, 0x69, 0x72, 0x65, 0x52,
+	0x73, 0x70, 0x22, 0x00, 0x12, 0x32, 0x0a, 0x07, 0x50, 0x65, 0x72, 0x73, 0x69, 0x73, 0x74, 0x12,
+	0x12, 0x2e, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x2e, 0x50, 0x65, 0x72, 0x73, 0x69, 0x73, 0x74,
+	0x52, 0x65, 0x71, 0x1a, 0x11, 0x2e, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x2e, 0x45, 0x78, 0x70,
+	0x69, 0x72, 0x65, 0x52, 0x73, 0x70, 0x22, 0x00, 0x12, 0x2a, 0x0a, 0x04, 0x54, 0x79, 0x70, 0x65,
+	0x12, 0x0f, 0x2e, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x2e, 0x54, 0x79, 0x70, 0x65, 0x52, 0x65,
+	0x71, 0x1a, 0x0f, 0x2e, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x2e, 0x54, 0x79, 0x70, 0x65, 0x52,
+	0x73, 0x70, 0x22, 0x00, 0x12, 0x30, 0x0a, 0x06, 0x45, 0x78, 0x69, 0x73, 0x74, 0x73, 0x12, 0x11,
+	0x2e, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x2e, 0x45, 0x78, 0x69, 0x73, 0x74, 0x73, 0x52, 0x65,
+	0x71, 0x1a, 0x11, 0x2e, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x2e, 0x45, 0x78, 0x69, 0x73, 0x74,
+	0x73, 0x52, 0x73, 0x70, 0x22, 0x00, 0x12, 0x33, 0x0a, 0x07, 0x44, 0x65, 0x6c, 0x4b, 0x65, 0x79,
+	0x73, 0x12, 0x12, 0x2e, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x2e, 0x44, 0x65, 0x6c, 0x4b, 0x65,
+	0x79, 0x73, 0x52, 0x65, 0x71, 0x1a, 0x12, 0x2e, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x2e, 0x44,
+	0x65, 0x6c, 0x4b, 0x65, 0x79, 0x73, 0x52, 0x73, 0x70, 0x22, 0x00, 0x12, 0x30, 0x0a, 0x06, 0x52,
+	0x65, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x11, 0x2e, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x2e, 0x52,
+	0x65, 0x6e, 0x61, 0x6d, 0x65, 0x52, 0x65, 0x71, 0x1a, 0x11, 0x2e, 0x62, 0x72, 0x69, 0x64, 0x67,
+	0x65, 0x2e, 0x52, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x52, 0x73, 0x70, 0x22, 0x00, 0x12, 0x30, 0x0a,
+	0x06, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x12, 0x11, 0x2e, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65,
+	0x2e, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x52, 0x65, 0x71, 0x1a, 0x11, 0x2e, 0x62, 0x72, 0x69,
+	0x64, 0x67, 0x65, 0x2e, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x52, 0x73, 0x70, 0x22, 0x00, 0x12,
+	0x36, 0x0a, 0x08, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x44, 0x42, 0x12, 0x13, 0x2e, 0x62, 0x72,
+	0x69, 0x64, 0x67, 0x65, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x44, 0x42, 0x52, 0x65, 0x71,
+	0x1a, 0x13, 0x2e, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x44, 0x42, 0x52, 0x73, 0x70, 0x22, 0x00, 0x12, 0x2a, 0x0a, 0x04, 0x43, 0x6f, 0x70, 0x79, 0x12,
+	0x0f, 0x2e, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x2e, 0x43, 0x6f, 0x70, 0x79, 0x52, 0x65, 0x71,
+	0x1a, 0x0f, 0x2e, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x2e, 0x43, 0x6f, 0x70, 0x79, 0x52, 0x73,
+	0x70, 0x22, 0x00, 0x12, 0x2a, 0x0a, 0x04, 0x4d, 0x6f, 0x76, 0x65, 0x12, 0x0f, 0x2e, 0x62, 0x72,
+	0x69, 0x64, 0x67, 0x65, 0x2e, 0x4d, 0x6f, 0x76, 0x65, 0x52, 0x65, 0x71, 0x1a, 0x0f, 0x2e, 0x62,
+	0x72, 0x69, 0x64, 0x67, 0x65, 0x2e, 0x4d, 0x6f, 0x76, 0x65, 0x52, 0x73, 0x70, 0x22, 0x00, 0x12,
+	0x33, 0x0a, 0x07, 0x4b, 0x65, 0x79, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x12, 0x2e, 0x62, 0x72, 0x69,
+	0x64, 0x67, 0x65, 0x2e, 0x4b, 0x65, 0x79, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x71, 0x1a, 0x12,
+	0x2e, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x2e, 0x4b, 0x65, 0x79, 0x49, 0x6e, 0x66, 0x6f, 0x52,
+	0x73, 0x70, 0x22, 0x00, 0x12, 0x36, 0x0a, 0x08, 0x4b, 0x65, 0x79, 0x53, 0x74, 0x61, 0x74, 0x73,
+	0x12, 0x13, 0x2e, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x2e, 0x4b, 0x65, 0x79, 0x53, 0x74, 0x61,
+	0x74, 0x73, 0x52, 0x65, 0x71, 0x1a, 0x13, 0x2e, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x2e, 0x4b,
+	0x65, 0x79, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x73, 0x70, 0x22, 0x00, 0x12, 0x3f, 0x0a, 0x0b,
+	0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x55, 0x73, 0x61, 0x67, 0x65, 0x12, 0x16, 0x2e, 0x62, 0x72,
+	0x69, 0x64, 0x67, 0x65, 0x2e, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x55, 0x73, 0x61, 0x67, 0x65,
+	0x52, 0x65, 0x71, 0x1a, 0x16, 0x2e, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x2e, 0x4d, 0x65, 0x6d,
+	0x6f, 0x72, 0x79, 0x55, 0x73, 0x61, 0x67, 0x65, 0x52, 0x73, 0x70, 0x22, 0x00, 0x12, 0x3f, 0x0a,
+	0x0b, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x53, 0x74, 0x61, 0x74, 0x73, 0x12, 0x16, 0x2e, 0x62,
+	0x72, 0x69, 0x64, 0x67, 0x65, 0x2e, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x53, 0x74, 0x61, 0x74,
+	0x73, 0x52, 0x65, 0x71, 0x1a, 0x16, 0x2e, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x2e, 0x4d, 0x65,
+	0x6d, 0x6f, 0x72, 0x79, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x73, 0x70, 0x22, 0x00, 0x12, 0x33,
+	0x0a, 0x07, 0x54, 0x78, 0x57, 0x61, 0x74, 0x63, 0x68, 0x12, 0x12, 0x2e, 0x62, 0x72, 0x69, 0x64,
+	0x67, 0x65, 0x2e, 0x54, 0x78, 0x57, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x71, 0x1a, 0x12, 0x2e,
+	0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x2e, 0x54, 0x78, 0x57, 0x61, 0x74, 0x63, 0x68, 0x52, 0x73,
+	0x70, 0x22, 0x00, 0x12, 0x2a, 0x0a, 0x04, 0x45, 0x78, 0x65, 0x63, 0x12, 0x0f, 0x2e, 0x62, 0x72,
+	0x69, 0x64, 0x67, 0x65, 0x2e, 0x45, 0x78, 0x65, 0x63, 0x52, 0x65, 0x71, 0x1a, 0x0f, 0x2e, 0x62,
+	0x72, 0x69, 0x64, 0x67, 0x65, 0x2e, 0x45, 0x78, 0x65, 0x63, 0x52, 0x73, 0x70, 0x22, 0x00, 0x12,
+	0x2a, 0x0a, 0x04, 0x4d, 0x47, 0x65, 0x74, 0x12, 0x0f, 0x2e, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65,
+	0x2e, 0x4d, 0x47, 0x65, 0x74, 0x52, 0x65, 0x71, 0x1a, 0x0f, 0x2e, 0x62, 0x72, 0x69, 0x64, 0x67,
+	0x65, 0x2e, 0x4d, 0x47, 0x65, 0x74, 0x52, 0x73, 0x70, 0x22, 0x00, 0x12, 0x2a, 0x0a, 0x04, 0x4d,
+	0x53, 0x65, 0x74, 0x12, 0x0f, 0x2e, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x2e, 0x4d, 0x53, 0x65,
+	0x74, 0x52, 0x65, 0x71, 0x1a, 0x0f, 0x2e, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x2e, 0x4d, 0x53,
+	0x65, 0x74, 0x52, 0x73, 0x70, 0x22, 0x00, 0x12, 0x2d, 0x0a, 0x05, 0x42, 0x61, 0x74, 0x63, 0x68,
+	0x12, 0x10, 0x2e, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x2e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x52,
+	0x65, 0x71, 0x1a, 0x10, 0x2e, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x2e, 0x42, 0x61, 0x74, 0x63,
+	0x68, 0x52, 0x73, 0x70, 0x22, 0x00, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_bridge_proto_rawDescOnce sync.Once
+	file_bridge_proto_rawDescData = file_bridge_proto_rawDesc
+)
+
+func file_bridge_proto_rawDescGZIP() []byte {
+	file_bridge_proto_rawDescOnce.Do(func() {
+		file_bridge_proto_rawDescData = protoimpl.X.CompressGZIP(file_bridge_proto_rawDescData)
+	})
+	return file_bridge_proto_rawDescData
+}
+
+var file_bridge_proto_msgTypes = make([]protoimpl.MessageInfo, 211)
+var file_bridge_proto_goTypes = []interface{}{
+	(*PingReq)(nil),         // 0: bridge.PingReq
+	(*PingRsp)(nil),         // 1: bridge.PingRsp
+	(*GetReq)(nil),          // 2: bridge.GetReq
+	(*GetRsp)(nil),          // 3: bridge.GetRsp
+	(*Expiration)(nil),      // 4: bridge.Expiration
+	(*Precondition)(nil),    // 5: bridge.Precondition
+	(*PutReq)(nil),          // 6: bridge.PutReq
+	(*PutRsp)(nil),          // 7: bridge.PutRsp
+	(*DelReq)(nil),          // 8: bridge.DelReq
+	(*DelRsp)(nil),          // 9: bridge.DelRsp
+	(*PublishReq)(nil),      // 10: bridge.PublishReq
+	(*PublishRsp)(nil),      // 11: bridge.PublishRsp
+	(*WatchReq)(nil),        // 12: bridge.WatchReq
+	(*WatchRsp)(nil),        // 13: bridge.WatchRsp
+	(*HMGetReq)(nil),        // 14: bridge.HMGetReq
+	(*HMGetRsp)(nil),        // 15: bridge.HMGetRsp
+	(*HMGetMemberReq)(nil),  // 16: bridge.HMGetMemberReq
+	(*HMGetMemberRsp)(nil),  // 17: bridge.HMGetMemberRsp
+	(*HMPutReq)(nil),        // 18: bridge.HMPutReq
+	(*HMPutRsp)(nil),        // 19: bridge.HMPutRsp
+	(*HMDelReq)(nil),        // 20: bridge.HMDelReq
+	(*HMDelRsp)(nil),        // 21: bridge.HMDelRsp
+	(*HMDelMemberReq)(nil),  // 22: bridge.HMDelMemberReq
+	(*HMDelMemberRsp)(nil),  // 23: bridge.HMDelMemberRsp
+	(*HMWatchReq)(nil),      // 24: bridge.HMWatchReq
+	(*HMWatchRsp)(nil),      // 25: bridge.HMWatchRsp
+	(*LGetReq)(nil),         // 26: bridge.LGetReq
+	(*LGetRsp)(nil),         // 27: bridge.LGetRsp
+	(*LGetRangeReq)(nil),    // 28: bridge.LGetRangeReq
+	(*LGetRangeRsp)(nil),    // 29: bridge.LGetRangeRsp
+	(*LPutReq)(nil),         // 30: bridge.LPutReq
+	(*LPutRsp)(nil),         // 31: bridge.LPutRsp
+	(*LDelReq)(nil),         // 32: bridge.LDelReq
+	(*LDelRsp)(nil),         // 33: bridge.LDelRsp
+	(*LDelRangeReq)(nil),    // 34: bridge.LDelRangeReq
+	(*LDelRangeRsp)(nil),    // 35: bridge.LDelRangeRsp
+	(*LWatchReq)(nil),       // 36: bridge.LWatchReq
+	(*LWatchRsp)(nil),       // 37: bridge.LWatchRsp
+	(*SGetReq)(nil),         // 38: bridge.SGetReq
+	(*SGetRsp)(nil),         // 39: bridge.SGetRsp
+	(*SPutReq)(nil),         // 40: bridge.SPutReq
+	(*SPutRsp)(nil),         // 41: bridge.SPutRsp
+	(*SDelReq)(nil),         // 42: bridge.SDelReq
+	(*SDelRsp)(nil),         // 43: bridge.SDelRsp
+	(*SDelMemberReq)(nil),   // 44: bridge.SDelMemberReq
+	(*SDelMemberRsp)(nil),   // 45: bridge.SDelMemberRsp
+	(*SWatchReq)(nil),       // 46: bridge.SWatchReq
+	(*SWatchRsp)(nil),       // 47: bridge.SWatchRsp
+	(*PFAddReq)(nil),        // 48: bridge.PFAddReq
+	(*PFAddRsp)(nil),        // 49: bridge.PFAddRsp
+	(*PFCountReq)(nil),      // 50: bridge.PFCountReq
+	(*PFCountRsp)(nil),      // 51: bridge.PFCountRsp
+	(*PFMergeReq)(nil),      // 52: bridge.PFMergeReq
+	(*PFMergeRsp)(nil),      // 53: bridge.PFMergeRsp
+	(*PFDelReq)(nil),        // 54: bridge.PFDelReq
+	(*PFDelRsp)(nil),        // 55: bridge.PFDelRsp
+	(*GeoMember)(nil),       // 56: bridge.GeoMember
+	(*GeoAddReq)(nil),       // 57: bridge.GeoAddReq
+	(*GeoAddRsp)(nil),       // 58: bridge.GeoAddRsp
+	(*GeoPosReq)(nil),       // 59: bridge.GeoPosReq
+	(*GeoPosRsp)(nil),       // 60: bridge.GeoPosRsp
+	(*GeoDistReq)(nil),      // 61: bridge.GeoDistReq
+	(*GeoDistRsp)(nil),      // 62: bridge.GeoDistRsp
+	(*GeoSearchReq)(nil),    // 63: bridge.GeoSearchReq
+	(*GeoSearchRsp)(nil),    // 64: bridge.GeoSearchRsp
+	(*GeoDelReq)(nil),       // 65: bridge.GeoDelReq
+	(*GeoDelRsp)(nil),       // 66: bridge.GeoDelRsp
+	(*GeoDelMemberReq)(nil), // 67: bridge.GeoDelMemberReq
+	(*GeoDelMemberRsp)(nil), // 68: bridge.GeoDelMemberRsp
+	(*StreamEntry)(nil),     // 69: bridge.StreamEntry
+	(*StreamEntries)(nil),   // 70: bridge.StreamEntries
+	(*StreamPending)(nil),   // 71: bridge.StreamPending
+	(*XAddReq)(nil),         // 72: bridge.XAddReq
+	(*XAddRsp)(nil),         // 73: bridge.XAddRsp
+	(*XLenReq)(nil),         // 74: bridge.XLenReq
+	(*XLenRsp)(nil),         // 75: bridge.XLenRsp
+	(*XRangeReq)(nil),       // 76: bridge.XRangeReq
+	(*XRangeRsp)(nil),       // 77: bridge.XRangeRsp
+	(*XReadReq)(nil),        // 78: bridge.XReadReq
+	(*XReadRsp)(nil),        // 79: bridge.XReadRsp
+	(*XGroupReq)(nil),       // 80: bridge.XGroupReq
+	(*XGroupRsp)(nil),       // 81: bridge.XGroupRsp
+	(*XReadGroupReq)(nil),   // 82: bridge.XReadGroupReq
+	(*XAckReq)(nil),         // 83: bridge.XAckReq
+	(*XAckRsp)(nil),         // 84: bridge.XAckRsp
+	(*XPendingReq)(nil),     // 85: bridge.XPendingReq
+	(*XPendingRsp)(nil),     // 86: bridge.XPendingRsp
+	(*XClaimReq)(nil),       // 87: bridge.XClaimReq
+	(*XClaimRsp)(nil),       // 88: bridge.XClaimRsp
+	(*XTrimReq)(nil),        // 89: bridge.XTrimReq
+	(*XTrimRsp)(nil),        // 90: bridge.XTrimRsp
+	(*XDelMemberReq)(nil),   // 91: bridge.XDelMemberReq
+	(*XDelMemberRsp)(nil),   // 92: bridge.XDelMemberRsp
+	(*XDelReq)(nil),         // 93: bridge.XDelReq
+	(*XDelRsp)(nil),         // 94: bridge.XDelRsp
+	(*JSetReq)(nil),         // 95: bridge.JSetReq
+	(*JSetRsp)(nil),         // 96: bridge.JSetRsp
+	(*JGetReq)(nil),         // 97: bridge.JGetReq
+	(*JGetRsp)(nil),         // 98: bridge.JGetRsp
+	(*JDelPathReq)(nil),     // 99: bridge.JDelPathReq
+	(*JDelPathRsp)(nil),     // 100: bridge.JDelPathRsp
+	(*JArrAppendReq)(nil),   // 101: bridge.JArrAppendReq
+	(*JArrAppendRsp)(nil),   // 102: bridge.JArrAppendRsp
+	(*JNumIncrByReq)(nil),   // 103: bridge.JNumIncrByReq
+	(*JNumIncrByRsp)(nil),   // 104: bridge.JNumIncrByRsp
+	(*JDelReq)(nil),         // 105: bridge.JDelReq
+	(*JDelRsp)(nil),         // 106: bridge.JDelRsp
+	(*JWatchReq)(nil),       // 107: bridge.JWatchReq
+	(*JWatchRsp)(nil),       // 108: bridge.JWatchRsp
+	(*BFReserveReq)(nil),    // 109: bridge.BFReserveReq
+	(*BFReserveRsp)(nil),    // 110: bridge.BFReserveRsp
+	(*BFAddReq)(nil),        // 111: bridge.BFAddReq
+	(*BFAddRsp)(nil),        // 112: bridge.BFAddRsp
+	(*BFExistsReq)(nil),     // 113: bridge.BFExistsReq
+	(*BFExistsRsp)(nil),     // 114: bridge.BFExistsRsp
+	(*BFInfoReq)(nil),       // 115: bridge.BFInfoReq
+	(*BFInfoRsp)(nil),       // 116: bridge.BFInfoRsp
+	(*BFDelReq)(nil),        // 117: bridge.BFDelReq
+	(*BFDelRsp)(nil),        // 118: bridge.BFDelRsp
+	(*CFReserveReq)(nil),    // 119: bridge.CFReserveReq
+	(*CFReserveRsp)(nil),    // 120: bridge.CFReserveRsp
+	(*CFAddReq)(nil),        // 121: bridge.CFAddReq
+	(*CFAddRsp)(nil),        // 122: bridge.CFAddRsp
+	(*CFExistsReq)(nil),     // 123: bridge.CFExistsReq
+	(*CFExistsRsp)(nil),     // 124: bridge.CFExistsRsp
+	(*CFDelMemberReq)(nil),  // 125: bridge.CFDelMemberReq
+	(*CFDelMemberRsp)(nil),  // 126: bridge.CFDelMemberRsp
+	(*CFInfoReq)(nil),       // 127: bridge.CFInfoReq
+	(*CFInfoRsp)(nil),       // 128: bridge.CFInfoRsp
+	(*CFDelReq)(nil),        // 129: bridge.CFDelReq
+	(*CFDelRsp)(nil),        // 130: bridge.CFDelRsp
+	(*TSSample)(nil),        // 131: bridge.TSSample
+	(*TSCreateReq)(nil),     // 132: bridge.TSCreateReq
+	(*TSCreateRsp)(nil),     // 133: bridge.TSCreateRsp
+	(*TSAddReq)(nil),        // 134: bridge.TSAddReq
+	(*TSAddRsp)(nil),        // 135: bridge.TSAddRsp
+	(*TSRangeReq)(nil),      // 136: bridge.TSRangeReq
+	(*TSRangeRsp)(nil),      // 137: bridge.TSRangeRsp
+	(*TSGetReq)(nil),        // 138: bridge.TSGetReq
+	(*TSGetRsp)(nil),        // 139: bridge.TSGetRsp
+	(*TSDelRangeReq)(nil),   // 140: bridge.TSDelRangeReq
+	(*TSDelRangeRsp)(nil),   // 141: bridge.TSDelRangeRsp
+	(*TSRuleReq)(nil),       // 142: bridge.TSRuleReq
+	(*TSRuleRsp)(nil),       // 143: bridge.TSRuleRsp
+	(*TSRule)(nil),          // 144: bridge.TSRule
+	(*TSInfoReq)(nil),       // 145: bridge.TSInfoReq
+	(*TSInfoRsp)(nil),       // 146: bridge.TSInfoRsp
+	(*TSDelReq)(nil),        // 147: bridge.TSDelReq
+	(*TSDelRsp)(nil),        // 148: bridge.TSDelRsp
+	(*ScanKey)(nil),         // 149: bridge.ScanKey
+	(*ScanReq)(nil),         // 150: bridge.ScanReq
+	(*ScanRsp)(nil),         // 151: bridge.ScanRsp
+	(*HScanReq)(nil),        // 152: bridge.HScanReq
+	(*HScanRsp)(nil),        // 153: bridge.HScanRsp
+	(*SScanReq)(nil),        // 154: bridge.SScanReq
+	(*SScanRsp)(nil),        // 155: bridge.SScanRsp
+	(*TTLReq)(nil),          // 156: bridge.TTLReq
+	(*TTLRsp)(nil),          // 157: bridge.TTLRsp
+	(*ExpireReq)(nil),       // 158: bridge.ExpireReq
+	(*ExpireAtReq)(nil),     // 159: bridge.ExpireAtReq
+	(*PersistReq)(nil),      // 160: bridge.PersistReq
+	(*ExpireRsp)(nil),       // 161: bridge.ExpireRsp
+	(*PExpireReq)(nil),      // 162: bridge.PExpireReq
+	(*PExpireAtReq)(nil),    // 163: bridge.PExpireAtReq
+	(*TypeReq)(nil),         // 164: bridge.TypeReq
+	(*TypeRsp)(nil),         // 165: bridge.TypeRsp
+	(*ExistsReq)(nil),       // 166: bridge.ExistsReq
+	(*ExistsRsp)(nil),       // 167: bridge.ExistsRsp
+	(*DelKeysReq)(nil),      // 168: bridge.DelKeysReq
+	(*DelKeysRsp)(nil),      // 169: bridge.DelKeysRsp
+	(*RenameReq)(nil),       // 170: bridge.RenameReq
+	(*RenameRsp)(nil),       // 171: bridge.RenameRsp
+	(*SelectReq)(nil),       // 172: bridge.SelectReq
+	(*SelectRsp)(nil),       // 173: bridge.SelectRsp
+	(*CreateDBReq)(nil),     // 174: bridge.CreateDBReq
+	(*CreateDBRsp)(nil),     // 175: bridge.CreateDBRsp
+	(*CopyReq)(nil),         // 176: bridge.CopyReq
+	(*CopyRsp)(nil),         // 177: bridge.CopyRsp
+	(*MoveReq)(nil),         // 178: bridge.MoveReq
+	(*MoveRsp)(nil),         // 179: bridge.MoveRsp
+	(*KeyInfoReq)(nil),      // 180: bridge.KeyInfoReq
+	(*KeyInfoRsp)(nil),      // 181: bridge.KeyInfoRsp
+	(*KeyStatsReq)(nil),     // 182: bridge.KeyStatsReq
+	(*TypeStats)(nil),       // 183: bridge.TypeStats
+	(*KeyStatsRsp)(nil),     // 184: bridge.KeyStatsRsp
+	(*MemoryUsageReq)(nil),  // 185: bridge.MemoryUsageReq
+	(*MemoryUsageRsp)(nil),  // 186: bridge.MemoryUsageRsp
+	(*MemoryStatsReq)(nil),  // 187: bridge.MemoryStatsReq
+	(*MemoryStatsRsp)(nil),  // 188: bridge.MemoryStatsRsp
+	(*TxWatchReq)(nil),      // 189: bridge.TxWatchReq
+	(*TxWatchRsp)(nil),      // 190: bridge.TxWatchRsp
+	(*TxCommand)(nil),       // 191: bridge.TxCommand
+	(*TxResult)(nil),        // 192: bridge.TxResult
+	(*ExecReq)(nil),         // 193: bridge.ExecReq
+	(*ExecRsp)(nil),         // 194: bridge.ExecRsp
+	(*MGetReq)(nil),         // 195: bridge.MGetReq
+	(*MGetRsp)(nil),         // 196: bridge.MGetRsp
+	(*MSetReq)(nil),         // 197: bridge.MSetReq
+	(*MSetRsp)(nil),         // 198: bridge.MSetRsp
+	(*BatchReq)(nil),        // 199: bridge.BatchReq
+	(*BatchResult)(nil),     // 200: bridge.BatchResult
+	(*BatchRsp)(nil),        // 201: bridge.BatchRsp
+	(*ClearReq)(nil),        // 202: bridge.ClearReq
+	(*ClearRsp)(nil),        // 203: bridge.ClearRsp
+	nil,                     // 204: bridge.HMGetRsp.FieldsEntry
+	nil,                     // 205: bridge.StreamEntry.FieldsEntry
+	nil,                     // 206: bridge.HScanRsp.FieldsEntry
+	nil,                     // 207: bridge.TxWatchRsp.RevisionsEntry
+	nil,                     // 208: bridge.TxResult.FieldsEntry
+	nil,                     // 209: bridge.ExecReq.WatchEntry
+	nil,                     // 210: bridge.BatchResult.FieldsEntry
+}
+var file_bridge_proto_depIdxs = []int32{
+	4,   // 0: bridge.PutReq.expiration:type_name -> bridge.Expiration
+	5,   // 1: bridge.PutReq.precondition:type_name -> bridge.Precondition
+	5,   // 2: bridge.DelReq.precondition:type_name -> bridge.Precondition
+	204, // 3: bridge.HMGetRsp.fields:type_name -> bridge.HMGetRsp.FieldsEntry
+	4,   // 4: bridge.HMPutReq.expiration:type_name -> bridge.Expiration
+	5,   // 5: bridge.HMPutReq.precondition:type_name -> bridge.Precondition
+	5,   // 6: bridge.HMDelReq.precondition:type_name -> bridge.Precondition
+	5,   // 7: bridge.HMDelMemberReq.precondition:type_name -> bridge.Precondition
+	4,   // 8: bridge.LPutReq.expiration:type_name -> bridge.Expiration
+	5,   // 9: bridge.LPutReq.precondition:type_name -> bridge.Precondition
+	5,   // 10: bridge.LDelReq.precondition:type_name -> bridge.Precondition
+	5,   // 11: bridge.LDelRangeReq.precondition:type_name -> bridge.Precondition
+	4,   // 12: bridge.SPutReq.expiration:type_name -> bridge.Expiration
+	5,   // 13: bridge.SPutReq.precondition:type_name -> bridge.Precondition
+	5,   // 14: bridge.SDelReq.precondition:type_name -> bridge.Precondition
+	5,   // 15: bridge.SDelMemberReq.precondition:type_name -> bridge.Precondition
+	4,   // 16: bridge.PFAddReq.expiration:type_name -> bridge.Expiration
+	56,  // 17: bridge.GeoAddReq.member:type_name -> bridge.GeoMember
+	4,   // 18: bridge.GeoAddReq.expiration:type_name -> bridge.Expiration
+	56,  // 19: bridge.GeoPosRsp.member:type_name -> bridge.GeoMember
+	56,  // 20: bridge.GeoSearchRsp.member:type_name -> bridge.GeoMember
+	205, // 21: bridge.StreamEntry.fields:type_name -> bridge.StreamEntry.FieldsEntry
+	69,  // 22: bridge.StreamEntries.entry:type_name -> bridge.StreamEntry
+	69,  // 23: bridge.XRangeRsp.entry:type_name -> bridge.StreamEntry
+	70,  // 24: bridge.XReadRsp.stream:type_name -> bridge.StreamEntries
+	71,  // 25: bridge.XPendingRsp.pending:type_name -> bridge.StreamPending
+	69,  // 26: bridge.XClaimRsp.entry:type_name -> bridge.StreamEntry
+	131, // 27: bridge.TSAddReq.samples:type_name -> bridge.TSSample
+	131, // 28: bridge.TSRangeRsp.samples:type_name -> bridge.TSSample
+	131, // 29: bridge.TSGetRsp.sample:type_name -> bridge.TSSample
+	144, // 30: bridge.TSInfoRsp.rules:type_name -> bridge.TSRule
+	149, // 31: bridge.ScanRsp.keys:type_name -> bridge.ScanKey
+	206, // 32: bridge.HScanRsp.fields:type_name -> bridge.HScanRsp.FieldsEntry
+	183, // 33: bridge.KeyStatsRsp.stats:type_name -> bridge.TypeStats
+	207, // 34: bridge.TxWatchRsp.revisions:type_name -> bridge.TxWatchRsp.RevisionsEntry
+	208, // 35: bridge.TxResult.fields:type_name -> bridge.TxResult.FieldsEntry
+	191, // 36: bridge.ExecReq.commands:type_name -> bridge.TxCommand
+	209, // 37: bridge.ExecReq.watch:type_name -> bridge.ExecReq.WatchEntry
+	192, // 38: bridge.ExecRsp.results:type_name -> bridge.TxResult
+	4,   // 39: bridge.MSetReq.expiration:type_name -> bridge.Expiration
+	191, // 40: bridge.BatchReq.commands:type_name -> bridge.TxCommand
+	210, // 41: bridge.BatchResult.fields:type_name -> bridge.BatchResult.FieldsEntry
+	200, // 42: bridge.BatchRsp.results:type_name -> bridge.BatchResult
+	0,   // 43: bridge.RpcBridge.Ping:input_type -> bridge.PingReq
+	10,  // 44: bridge.RpcBridge.Publish:input_type -> bridge.PublishReq
+	2,   // 45: bridge.RpcBridge.Get:input_type -> bridge.GetReq
+	6,   // 46: bridge.RpcBridge.Put:input_type -> bridge.PutReq
+	8,   // 47: bridge.RpcBridge.Del:input_type -> bridge.DelReq
+	12,  // 48: bridge.RpcBridge.WatchKey:input_type -> bridge.WatchReq
+	12,  // 49: bridge.RpcBridge.UnWatchKey:input_type -> bridge.WatchReq
+	202, // 50: bridge.RpcBridge.ClearValue:input_type -> bridge.ClearReq
+	14,  // 51: bridge.RpcBridge.HMGet:input_type -> bridge.HMGetReq
+	16,  // 52: bridge.RpcBridge.HMGetMember:input_type -> bridge.HMGetMemberReq
+	18,  // 53: bridge.RpcBridge.HMPut:input_type -> bridge.HMPutReq
+	20,  // 54: bridge.RpcBridge.HMDel:input_type -> bridge.HMDelReq
+	22,  // 55: bridge.RpcBridge.HMDelMember:input_type -> bridge.HMDelMemberReq
+	24,  // 56: bridge.RpcBridge.HMWatch:input_type -> bridge.HMWatchReq
+	24,  // 57: bridge.RpcBridge.HMUnWatch:input_type -> bridge.HMWatchReq
+	202, // 58: bridge.RpcBridge.ClearMap:input_type -> bridge.ClearReq
+	26,  // 59: bridge.RpcBridge.LGet:input_type -> bridge.LGetReq
+	28,  // 60: bridge.RpcBridge.LGetRange:input_type -> bridge.LGetRangeReq
+	30,  // 61: bridge.RpcBridge.LPut:input_type -> bridge.LPutReq
+	32,  // 62: bridge.RpcBridge.LDel:input_type -> bridge.LDelReq
+	34,  // 63: bridge.RpcBridge.LDelRange:input_type -> bridge.LDelRangeReq
+	36,  // 64: bridge.RpcBridge.LWatch:input_type -> bridge.LWatchReq
+	36,  // 65: bridge.RpcBridge.LUnWatch:input_type -> bridge.LWatchReq
+	202, // 66: bridge.RpcBridge.ClearList:input_type -> bridge.ClearReq
+	38,  // 67: bridge.RpcBridge.SGet:input_type -> bridge.SGetReq
+	40,  // 68: bridge.RpcBridge.SPut:input_type -> bridge.SPutReq
+	42,  // 69: bridge.RpcBridge.SDel:input_type -> bridge.SDelReq
+	44,  // 70: bridge.RpcBridge.SDelMember:input_type -> bridge.SDelMemberReq
+	46,  // 71: bridge.RpcBridge.SWatch:input_type -> bridge.SWatchReq
+	46,  // 72: bridge.RpcBridge.SUnWatch:input_type -> bridge.SWatchReq
+	202, // 73: bridge.RpcBridge.ClearSet:input_type -> bridge.ClearReq
+	48,  // 74: bridge.RpcBridge.PFAdd:input_type -> bridge.PFAddReq
+	50,  // 75: bridge.RpcBridge.PFCount:input_type -> bridge.PFCountReq
+	52,  // 76: bridge.RpcBridge.PFMerge:input_type -> bridge.PFMergeReq
+	54,  // 77: bridge.RpcBridge.PFDel:input_type -> bridge.PFDelReq
+	202, // 78: bridge.RpcBridge.ClearHLL:input_type -> bridge.ClearReq
+	57,  // 79: bridge.RpcBridge.GeoAdd:input_type -> bridge.GeoAddReq
+	59,  // 80: bridge.RpcBridge.GeoPos:input_type -> bridge.GeoPosReq
+	61,  // 81: bridge.RpcBridge.GeoDist:input_type -> bridge.GeoDistReq
+	63,  // 82: bridge.RpcBridge.GeoSearch:input_type -> bridge.GeoSearchReq
+	65,  // 83: bridge.RpcBridge.GeoDel:input_type -> bridge.GeoDelReq
+	67,  // 84: bridge.RpcBridge.GeoDelMember:input_type -> bridge.GeoDelMemberReq
+	202, // 85: bridge.RpcBridge.ClearGeo:input_type -> bridge.ClearReq
+	72,  // 86: bridge.RpcBridge.XAdd:input_type -> bridge.XAddReq
+	74,  // 87: bridge.RpcBridge.XLen:input_type -> bridge.XLenReq
+	76,  // 88: bridge.RpcBridge.XRange:input_type -> bridge.XRangeReq
+	78,  // 89: bridge.RpcBridge.XRead:input_type -> bridge.XReadReq
+	80,  // 90: bridge.RpcBridge.XGroupCreate:input_type -> bridge.XGroupReq
+	80,  // 91: bridge.RpcBridge.XGroupDestroy:input_type -> bridge.XGroupReq
+	82,  // 92: bridge.RpcBridge.XReadGroup:input_type -> bridge.XReadGroupReq
+	83,  // 93: bridge.RpcBridge.XAck:input_type -> bridge.XAckReq
+	85,  // 94: bridge.RpcBridge.XPending:input_type -> bridge.XPendingReq
+	87,  // 95: bridge.RpcBridge.XClaim:input_type -> bridge.XClaimReq
+	89,  // 96: bridge.RpcBridge.XTrim:input_type -> bridge.XTrimReq
+	91,  // 97: bridge.RpcBridge.XDelMember:input_type -> bridge.XDelMemberReq
+	93,  // 98: bridge.RpcBridge.XDel:input_type -> bridge.XDelReq
+	202, // 99: bridge.RpcBridge.ClearStream:input_type -> bridge.ClearReq
+	95,  // 100: bridge.RpcBridge.JSet:input_type -> bridge.JSetReq
+	97,  // 101: bridge.RpcBridge.JGet:input_type -> bridge.JGetReq
+	99,  // 102: bridge.RpcBridge.JDelPath:input_type -> bridge.JDelPathReq
+	101, // 103: bridge.RpcBridge.JArrAppend:input_type -> bridge.JArrAppendReq
+	103, // 104: bridge.RpcBridge.JNumIncrBy:input_type -> bridge.JNumIncrByReq
+	105, // 105: bridge.RpcBridge.JDel:input_type -> bridge.JDelReq
+	107, // 106: bridge.RpcBridge.JWatch:input_type -> bridge.JWatchReq
+	107, // 107: bridge.RpcBridge.JUnWatch:input_type -> bridge.JWatchReq
+	202, // 108: bridge.RpcBridge.ClearJSON:input_type -> bridge.ClearReq
+	109, // 109: bridge.RpcBridge.BFReserve:input_type -> bridge.BFReserveReq
+	111, // 110: bridge.RpcBridge.BFAdd:input_type -> bridge.BFAddReq
+	113, // 111: bridge.RpcBridge.BFExists:input_type -> bridge.BFExistsReq
+	115, // 112: bridge.RpcBridge.BFInfo:input_type -> bridge.BFInfoReq
+	117, // 113: bridge.RpcBridge.BFDel:input_type -> bridge.BFDelReq
+	202, // 114: bridge.RpcBridge.ClearBloom:input_type -> bridge.ClearReq
+	119, // 115: bridge.RpcBridge.CFReserve:input_type -> bridge.CFReserveReq
+	121, // 116: bridge.RpcBridge.CFAdd:input_type -> bridge.CFAddReq
+	123, // 117: bridge.RpcBridge.CFExists:input_type -> bridge.CFExistsReq
+	125, // 118: bridge.RpcBridge.CFDelMember:input_type -> bridge.CFDelMemberReq
+	127, // 119: bridge.RpcBridge.CFInfo:input_type -> bridge.CFInfoReq
+	129, // 120: bridge.RpcBridge.CFDel:input_type -> bridge.CFDelReq
+	202, // 121: bridge.RpcBridge.ClearCuckoo:input_type -> bridge.ClearReq
+	132, // 122: bridge.RpcBridge.TSCreate:input_type -> bridge.TSCreateReq
+	134, // 123: bridge.RpcBridge.TSAdd:input_type -> bridge.TSAddReq
+	136, // 124: bridge.RpcBridge.TSRange:input_type -> bridge.TSRangeReq
+	138, // 125: bridge.RpcBridge.TSGet:input_type -> bridge.TSGetReq
+	140, // 126: bridge.RpcBridge.TSDelRange:input_type -> bridge.TSDelRangeReq
+	142, // 127: bridge.RpcBridge.TSCreateRule:input_type -> bridge.TSRuleReq
+	142, // 128: bridge.RpcBridge.TSDeleteRule:input_type -> bridge.TSRuleReq
+	145, // 129: bridge.RpcBridge.TSInfo:input_type -> bridge.TSInfoReq
+	147, // 130: bridge.RpcBridge.TSDel:input_type -> bridge.TSDelReq
+	202, // 131: bridge.RpcBridge.ClearTS:input_type -> bridge.ClearReq
+	150, // 132: bridge.RpcBridge.Scan:input_type -> bridge.ScanReq
+	152, // 133: bridge.RpcBridge.HScan:input_type -> bridge.HScanReq
+	154, // 134: bridge.RpcBridge.SScan:input_type -> bridge.SScanReq
+	156, // 135: bridge.RpcBridge.TTL:input_type -> bridge.TTLReq
+	156, // 136: bridge.RpcBridge.PTTL:input_type -> bridge.TTLReq
+	158, // 137: bridge.RpcBridge.Expire:input_type -> bridge.ExpireReq
+	159, // 138: bridge.RpcBridge.ExpireAt:input_type -> bridge.ExpireAtReq
+	162, // 139: bridge.RpcBridge.PExpire:input_type -> bridge.PExpireReq
+	163, // 140: bridge.RpcBridge.PExpireAt:input_type -> bridge.PExpireAtReq
+	160, // 141: bridge.RpcBridge.Persist:input_type -> bridge.PersistReq
+	164, // 142: bridge.RpcBridge.Type:input_type -> bridge.TypeReq
+	166, // 143: bridge.RpcBridge.Exists:input_type -> bridge.ExistsReq
+	168, // 144: bridge.RpcBridge.DelKeys:input_type -> bridge.DelKeysReq
+	170, // 145: bridge.RpcBridge.Rename:input_type -> bridge.RenameReq
+	172, // 146: bridge.RpcBridge.Select:input_type -> bridge.SelectReq
+	174, // 147: bridge.RpcBridge.CreateDB:input_type -> bridge.CreateDBReq
+	176, // 148: bridge.RpcBridge.Copy:input_type -> bridge.CopyReq
+	178, // 149: bridge.RpcBridge.Move:input_type -> bridge.MoveReq
+	180, // 150: bridge.RpcBridge.KeyInfo:input_type -> bridge.KeyInfoReq
+	182, // 151: bridge.RpcBridge.KeyStats:input_type -> bridge.KeyStatsReq
+	185, // 152: bridge.RpcBridge.MemoryUsage:input_type -> bridge.MemoryUsageReq
+	187, // 153: bridge.RpcBridge.MemoryStats:input_type -> bridge.MemoryStatsReq
+	189, // 154: bridge.RpcBridge.TxWatch:input_type -> bridge.TxWatchReq
+	193, // 155: bridge.RpcBridge.Exec:input_type -> bridge.ExecReq
+	195, // 156: bridge.RpcBridge.MGet:input_type -> bridge.MGetReq
+	197, // 157: bridge.RpcBridge.MSet:input_type -> bridge.MSetReq
+	199, // 158: bridge.RpcBridge.Batch:input_type -> bridge.BatchReq
+	1,   // 159: bridge.RpcBridge.Ping:output_type -> bridge.PingRsp
+	11,  // 160: bridge.RpcBridge.Publish:output_type -> bridge.PublishRsp
+	3,   // 161: bridge.RpcBridge.Get:output_type -> bridge.GetRsp
+	7,   // 162: bridge.RpcBridge.Put:output_type -> bridge.PutRsp
+	9,   // 163: bridge.RpcBridge.Del:output_type -> bridge.DelRsp
+	13,  // 164: bridge.RpcBridge.WatchKey:output_type -> bridge.WatchRsp
+	13,  // 165: bridge.RpcBridge.UnWatchKey:output_type -> bridge.WatchRsp
+	203, // 166: bridge.RpcBridge.ClearValue:output_type -> bridge.ClearRsp
+	15,  // 167: bridge.RpcBridge.HMGet:output_type -> bridge.HMGetRsp
+	17,  // 168: bridge.RpcBridge.HMGetMember:output_type -> bridge.HMGetMemberRsp
+	19,  // 169: bridge.RpcBridge.HMPut:output_type -> bridge.HMPutRsp
+	21,  // 170: bridge.RpcBridge.HMDel:output_type -> bridge.HMDelRsp
+	23,  // 171: bridge.RpcBridge.HMDelMember:output_type -> bridge.HMDelMemberRsp
+	25,  // 172: bridge.RpcBridge.HMWatch:output_type -> bridge.HMWatchRsp
+	25,  // 173: bridge.RpcBridge.HMUnWatch:output_type -> bridge.HMWatchRsp
+	203, // 174: bridge.RpcBridge.ClearMap:output_type -> bridge.ClearRsp
+	27,  // 175: bridge.RpcBridge.LGet:output_type -> bridge.LGetRsp
+	29,  // 176: bridge.RpcBridge.LGetRange:output_type -> bridge.LGetRangeRsp
+	31,  // 177: bridge.RpcBridge.LPut:output_type -> bridge.LPutRsp
+	33,  // 178: bridge.RpcBridge.LDel:output_type -> bridge.LDelRsp
+	35,  // 179: bridge.RpcBridge.LDelRange:output_type -> bridge.LDelRangeRsp
+	37,  // 180: bridge.RpcBridge.LWatch:output_type -> bridge.LWatchRsp
+	37,  // 181: bridge.RpcBridge.LUnWatch:output_type -> bridge.LWatchRsp
+	203, // 182: bridge.RpcBridge.ClearList:output_type -> bridge.ClearRsp
+	39,  // 183: bridge.RpcBridge.SGet:output_type -> bridge.SGetRsp
+	41,  // 184: bridge.RpcBridge.SPut:output_type -> bridge.SPutRsp
+	43,  // 185: bridge.RpcBridge.SDel:output_type -> bridge.SDelRsp
+	45,  // 186: bridge.RpcBridge.SDelMember:output_type -> bridge.SDelMemberRsp
+	47,  // 187: bridge.RpcBridge.SWatch:output_type -> bridge.SWatchRsp
+	47,  // 188: bridge.RpcBridge.SUnWatch:output_type -> bridge.SWatchRsp
+	203, // 189: bridge.RpcBridge.ClearSet:output_type -> bridge.ClearRsp
+	49,  // 190: bridge.RpcBridge.PFAdd:output_type -> bridge.PFAddRsp
+	51,  // 191: bridge.RpcBridge.PFCount:output_type -> bridge.PFCountRsp
+	53,  // 192: bridge.RpcBridge.PFMerge:output_type -> bridge.PFMergeRsp
+	55,  // 193: bridge.RpcBridge.PFDel:output_type -> bridge.PFDelRsp
+	203, // 194: bridge.RpcBridge.ClearHLL:output_type -> bridge.ClearRsp
+	58,  // 195: bridge.RpcBridge.GeoAdd:output_type -> bridge.GeoAddRsp
+	60,  // 196: bridge.RpcBridge.GeoPos:output_type -> bridge.GeoPosRsp
+	62,  // 197: bridge.RpcBridge.GeoDist:output_type -> bridge.GeoDistRsp
+	64,  // 198: bridge.RpcBridge.GeoSearch:output_type -> bridge.GeoSearchRsp
+	66,  // 199: bridge.RpcBridge.GeoDel:output_type -> bridge.GeoDelRsp
+	68,  // 200: bridge.RpcBridge.GeoDelMember:output_type -> bridge.GeoDelMemberRsp
+	203, // 201: bridge.RpcBridge.ClearGeo:output_type -> bridge.ClearRsp
+	73,  // 202: bridge.RpcBridge.XAdd:output_type -> bridge.XAddRsp
+	75,  // 203: bridge.RpcBridge.XLen:output_type -> bridge.XLenRsp
+	77,  // 204: bridge.RpcBridge.XRange:output_type -> bridge.XRangeRsp
+	79,  // 205: bridge.RpcBridge.XRead:output_type -> bridge.XReadRsp
+	81,  // 206: bridge.RpcBridge.XGroupCreate:output_type -> bridge.XGroupRsp
+	81,  // 207: bridge.RpcBridge.XGroupDestroy:output_type -> bridge.XGroupRsp
+	79,  // 208: bridge.RpcBridge.XReadGroup:output_type -> bridge.XReadRsp
+	84,  // 209: bridge.RpcBridge.XAck:output_type -> bridge.XAckRsp
+	86,  // 210: bridge.RpcBridge.XPending:output_type -> bridge.XPendingRsp
+	88,  // 211: bridge.RpcBridge.XClaim:output_type -> bridge.XClaimRsp
+	90,  // 212: bridge.RpcBridge.XTrim:output_type -> bridge.XTrimRsp
+	92,  // 213: bridge.RpcBridge.XDelMember:output_type -> bridge.XDelMemberRsp
+	94,  // 214: bridge.RpcBridge.XDel:output_type -> bridge.XDelRsp
+	203, // 215: bridge.RpcBridge.ClearStream:output_type -> bridge.ClearRsp
+	96,  // 216: bridge.RpcBridge.JSet:output_type -> bridge.JSetRsp
+	98,  // 217: bridge.RpcBridge.JGet:output_type -> bridge.JGetRsp
+	100, // 218: bridge.RpcBridge.JDelPath:output_type -> bridge.JDelPathRsp
+	102, // 219: bridge.RpcBridge.JArrAppend:output_type -> bridge.JArrAppendRsp
+	104, // 220: bridge.RpcBridge.JNumIncrBy:output_type -> bridge.JNumIncrByRsp
+	106, // 221: bridge.RpcBridge.JDel:output_type -> bridge.JDelRsp
+	108, // 222: bridge.RpcBridge.JWatch:output_type -> bridge.JWatchRsp
+	108, // 223: bridge.RpcBridge.JUnWatch:output_type -> bridge.JWatchRsp
+	203, // 224: bridge.RpcBridge.ClearJSON:output_type -> bridge.ClearRsp
+	110, // 225: bridge.RpcBridge.BFReserve:output_type -> bridge.BFReserveRsp
+	112, // 226: bridge.RpcBridge.BFAdd:output_type -> bridge.BFAddRsp
+	114, // 227: bridge.RpcBridge.BFExists:output_type -> bridge.BFExistsRsp
+	116, // 228: bridge.RpcBridge.BFInfo:output_type -> bridge.BFInfoRsp
+	118, // 229: bridge.RpcBridge.BFDel:output_type -> bridge.BFDelRsp
+	203, // 230: bridge.RpcBridge.ClearBloom:output_type -> bridge.ClearRsp
+	120, // 231: bridge.RpcBridge.CFReserve:output_type -> bridge.CFReserveRsp
+	122, // 232: bridge.RpcBridge.CFAdd:output_type -> bridge.CFAddRsp
+	124, // 233: bridge.RpcBridge.CFExists:output_type -> bridge.CFExistsRsp
+	126, // 234: bridge.RpcBridge.CFDelMember:output_type -> bridge.CFDelMemberRsp
+	128, // 235: bridge.RpcBridge.CFInfo:output_type -> bridge.CFInfoRsp
+	130, // 236: bridge.RpcBridge.CFDel:output_type -> bridge.CFDelRsp
+	203, // 237: bridge.RpcBridge.ClearCuckoo:output_type -> bridge.ClearRsp
+	133, // 238: bridge.RpcBridge.TSCreate:output_type -> bridge.TSCreateRsp
+	135, // 239: bridge.RpcBridge.TSAdd:output_type -> bridge.TSAddRsp
+	137, // 240: bridge.RpcBridge.TSRange:output_type -> bridge.TSRangeRsp
+	139, // 241: bridge.RpcBridge.TSGet:output_type -> bridge.TSGetRsp
+	141, // 242: bridge.RpcBridge.TSDelRange:output_type -> bridge.TSDelRangeRsp
+	143, // 243: bridge.RpcBridge.TSCreateRule:output_type -> bridge.TSRuleRsp
+	143, // 244: bridge.RpcBridge.TSDeleteRule:output_type -> bridge.TSRuleRsp
+	146, // 245: bridge.RpcBridge.TSInfo:output_type -> bridge.TSInfoRsp
+	148, // 246: bridge.RpcBridge.TSDel:output_type -> bridge.TSDelRsp
+	203, // 247: bridge.RpcBridge.ClearTS:output_type -> bridge.ClearRsp
+	151, // 248: bridge.RpcBridge.Scan:output_type -> bridge.ScanRsp
+	153, // 249: bridge.RpcBridge.HScan:output_type -> bridge.HScanRsp
+	155, // 250: bridge.RpcBridge.SScan:output_type -> bridge.SScanRsp
+	157, // 251: bridge.RpcBridge.TTL:output_type -> bridge.TTLRsp
+	157, // 252: bridge.RpcBridge.PTTL:output_type -> bridge.TTLRsp
+	161, // 253: bridge.RpcBridge.Expire:output_type -> bridge.ExpireRsp
+	161, // 254: bridge.RpcBridge.ExpireAt:output_type -> bridge.ExpireRsp
+	161, // 255: bridge.RpcBridge.PExpire:output_type -> bridge.ExpireRsp
+	161, // 256: bridge.RpcBridge.PExpireAt:output_type -> bridge.ExpireRsp
+	161, // 257: bridge.RpcBridge.Persist:output_type -> bridge.ExpireRsp
+	165, // 258: bridge.RpcBridge.Type:output_type -> bridge.TypeRsp
+	167, // 259: bridge.RpcBridge.Exists:output_type -> bridge.ExistsRsp
+	169, // 260: bridge.RpcBridge.DelKeys:output_type -> bridge.DelKeysRsp
+	171, // 261: bridge.RpcBridge.Rename:output_type -> bridge.RenameRsp
+	173, // 262: bridge.RpcBridge.Select:output_type -> bridge.SelectRsp
+	175, // 263: bridge.RpcBridge.CreateDB:output_type -> bridge.CreateDBRsp
+	177, // 264: bridge.RpcBridge.Copy:output_type -> bridge.CopyRsp
+	179, // 265: bridge.RpcBridge.Move:output_type -> bridge.MoveRsp
+	181, // 266: bridge.RpcBridge.KeyInfo:output_type -> bridge.KeyInfoRsp
+	184, // 267: bridge.RpcBridge.KeyStats:output_type -> bridge.KeyStatsRsp
+	186, // 268: bridge.RpcBridge.MemoryUsage:output_type -> bridge.MemoryUsageRsp
+	188, // 269: bridge.RpcBridge.MemoryStats:output_type -> bridge.MemoryStatsRsp
+	190, // 270: bridge.RpcBridge.TxWatch:output_type -> bridge.TxWatchRsp
+	194, // 271: bridge.RpcBridge.Exec:output_type -> bridge.ExecRsp
+	196, // 272: bridge.RpcBridge.MGet:output_type -> bridge.MGetRsp
+	198, // 273: bridge.RpcBridge.MSet:output_type -> bridge.MSetRsp
+	201, // 274: bridge.RpcBridge.Batch:output_type -> bridge.BatchRsp
+	159, // [159:275] is the sub-list for method output_type
+	43,  // [43:159] is the sub-list for method input_type
+	43,  // [43:43] is the sub-list for extension type_name
+	43,  // [43:43] is the sub-list for extension extendee
+	0,   // [0:43] is the sub-list for field type_name
+}
+
+func init() { file_bridge_proto_init() }
+func file_bridge_proto_init() {
+	if File_bridge_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_bridge_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PingReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_bridge_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PingRsp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_bridge_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_bridge_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetRsp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_bridge_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Expiration); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_bridge_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Precondition); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_bridge_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PutReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_bridge_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PutRsp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_bridge_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DelReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_bridge_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DelRsp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_bridge_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PublishReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_bridge_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PublishRsp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_bridge_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WatchReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_bridge_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WatchRsp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_bridge_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*HMGetReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_bridge_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*HMGetRsp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_bridge_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*HMGetMemberReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_bridge_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*HMGetMemberRsp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_bridge_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*HMPutReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_bridge_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*HMPutRsp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_bridge_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*HMDelReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_bridge_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*HMDelRsp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_bridge_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*HMDelMemberReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_bridge_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*HMDelMemberRsp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_bridge_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*HMWatchReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_bridge_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*HMWatchRsp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_bridge_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LGetReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_bridge_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LGetRsp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_bridge_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LGetRangeReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_bridge_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LGetRangeRsp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_bridge_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LPutReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_bridge_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LPutRsp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_bridge_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LDelReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_bridge_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LDelRsp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_bridge_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LDelRangeReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_bridge_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LDelRangeRsp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_bridge_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LWatchReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_bridge_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LWatchRsp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_bridge_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SGetReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_bridge_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SGetRsp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_bridge_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SPutReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_bridge_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SPutRsp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_bridge_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SDelReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_bridge_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SDelRsp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_bridge_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SDelMemberReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_bridge_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SDelMemberRsp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_bridge_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SWatchReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_bridge_proto_msgTypes[47].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SWatchRsp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_bridge_proto_msgTypes[48].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PFAddReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_bridge_proto_msgTypes[49].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PFAddRsp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_bridge_proto_msgTypes[50].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PFCountReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_bridge_proto_msgTypes[51].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PFCountRsp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_bridge_proto_msgTypes[52].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PFMergeReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_bridge_proto_msgTypes[53].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PFMergeRsp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_bridge_proto_msgTypes[54].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PFDelReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_bridge_proto_msgTypes[55].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PFDelRsp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_bridge_proto_msgTypes[56].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GeoMember); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_bridge_proto_msgTypes[57].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GeoAddReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_bridge_proto_msgTypes[58].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GeoAddRsp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_bridge_proto_msgTypes[59].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GeoPosReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_bridge_proto_msgTypes[60].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GeoPosRsp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_bridge_proto_msgTypes[61].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GeoDistReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_bridge_proto_msgTypes[62].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GeoDistRsp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_bridge_proto_msgTypes[63].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GeoSearchReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_bridge_proto_msgTypes[64].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GeoSearchRsp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_bridge_proto_msgTypes[65].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GeoDelReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_bridge_proto_msgTypes[66].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GeoDelRsp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_bridge_proto_msgTypes[67].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GeoDelMemberReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_bridge_proto_msgTypes[68].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GeoDelMemberRsp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_bridge_proto_msgTypes[69].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StreamEntry); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_bridge_proto_msgTypes[70].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StreamEntries); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_bridge_proto_msgTypes[71].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StreamPending); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_bridge_proto_msgTypes[72].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*XAddReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_bridge_proto_msgTypes[73].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*XAddRsp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_bridge_proto_msgTypes[74].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*XLenReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_bridge_proto_msgTypes[75].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*XLenRsp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_bridge_proto_msgTypes[76].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*XRangeReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_bridge_proto_msgTypes[77].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*XRangeRsp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_bridge_proto_msgTypes[78].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*XReadReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_bridge_proto_msgTypes[79].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*XReadRsp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_bridge_proto_msgTypes[80].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*XGroupReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_bridge_proto_msgTypes[81].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*XGroupRsp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_bridge_proto_msgTypes[82].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*XReadGroupReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_bridge_proto_msgTypes[83].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*XAckReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_bridge_proto_msgTypes[84].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*XAckRsp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_bridge_proto_msgTypes[85].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*XPendingReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_bridge_proto_msgTypes[86].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*XPendingRsp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_bridge_proto_msgTypes[87].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*XClaimReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_bridge_proto_msgTypes[88].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*XClaimRsp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_bridge_proto_msgTypes[89].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*XTrimReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_bridge_proto_msgTypes[90].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*XTrimRsp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_bridge_proto_msgTypes[91].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*XDelMemberReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_bridge_proto_msgTypes[92].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*XDelMemberRsp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_bridge_proto_msgTypes[93].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*XDelReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_bridge_proto_msgTypes[94].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*XDelRsp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_bridge_proto_msgTypes[95].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*JSetReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_bridge_proto_msgTypes[96].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*JSetRsp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_bridge_proto_msgTypes[97].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*JGetReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_bridge_proto_msgTypes[98].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*JGetRsp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_bridge_proto_msgTypes[99].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*JDelPathReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_bridge_proto_msgTypes[100].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*JDelPathRsp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_bridge_proto_msgTypes[101].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*JArrAppendReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_bridge_proto_msgTypes[102].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*JArrAppendRsp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_bridge_proto_msgTypes[103].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*JNumIncrByReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_bridge_proto_msgTypes[104].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*JNumIncrByRsp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_bridge_proto_msgTypes[105].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*JDelReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_bridge_proto_msgTypes[106].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*JDelRsp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_bridge_proto_msgTypes[107].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*JWatchReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_bridge_proto_msgTypes[108].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*JWatchRsp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_bridge_proto_msgTypes[109].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BFReserveReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_bridge_proto_msgTypes[110].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BFReserveRsp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_bridge_proto_msgTypes[111].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BFAddReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_bridge_proto_msgTypes[112].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BFAddRsp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_bridge_proto_msgTypes[113].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BFExistsReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_bridge_proto_msgTypes[114].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BFExistsRsp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_bridge_proto_msgTypes[115].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BFInfoReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_bridge_proto_msgTypes[116].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BFInfoRsp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_bridge_proto_msgTypes[117].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BFDelReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_bridge_proto_msgTypes[118].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BFDelRsp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_bridge_proto_msgTypes[119].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CFReserveReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_bridge_proto_msgTypes[120].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CFReserveRsp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_bridge_proto_msgTypes[121].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CFAddReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_bridge_proto_msgTypes[122].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CFAddRsp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_bridge_proto_msgTypes[123].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CFExistsReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_bridge_proto_msgTypes[124].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CFExistsRsp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_bridge_proto_msgTypes[125].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CFDelMemberReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_bridge_proto_msgTypes[126].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CFDelMemberRsp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_bridge_proto_msgTypes[127].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CFInfoReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_bridge_proto_msgTypes[128].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CFInfoRsp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_bridge_proto_msgTypes[129].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CFDelReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_bridge_proto_msgTypes[130].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CFDelRsp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_bridge_proto_msgTypes[131].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TSSample); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_bridge_proto_msgTypes[132].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TSCreateReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_bridge_proto_msgTypes[133].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TSCreateRsp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_bridge_proto_msgTypes[134].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TSAddReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_bridge_proto_msgTypes[135].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TSAddRsp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_bridge_proto_msgTypes[136].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TSRangeReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_bridge_proto_msgTypes[137].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TSRangeRsp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_bridge_proto_msgTypes[138].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TSGetReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_bridge_proto_msgTypes[139].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TSGetRsp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_bridge_proto_msgTypes[140].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TSDelRangeReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_bridge_proto_msgTypes[141].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TSDelRangeRsp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_bridge_proto_msgTypes[142].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TSRuleReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_bridge_proto_msgTypes[143].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TSRuleRsp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_bridge_proto_msgTypes[144].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TSRule); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_bridge_proto_msgTypes[145].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TSInfoReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_bridge_proto_msgTypes[146].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TSInfoRsp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_bridge_proto_msgTypes[147].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TSDelReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_bridge_proto_msgTypes[148].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TSDelRsp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_bridge_proto_msgTypes[149].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ScanKey); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_bridge_proto_msgTypes[150].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ScanReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_bridge_proto_msgTypes[151].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ScanRsp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_bridge_proto_msgTypes[152].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*HScanReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_bridge_proto_msgTypes[153].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*HScanRsp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_bridge_proto_msgTypes[154].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SScanReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_bridge_proto_msgTypes[155].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SScanRsp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_bridge_proto_msgTypes[156].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TTLReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_bridge_proto_msgTypes[157].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TTLRsp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_bridge_proto_msgTypes[158].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExpireReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_bridge_proto_msgTypes[159].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExpireAtReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_bridge_proto_msgTypes[160].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PersistReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_bridge_proto_msgTypes[161].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExpireRsp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_bridge_proto_msgTypes[162].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PExpireReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_bridge_proto_msgTypes[163].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PExpireAtReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_bridge_proto_msgTypes[164].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TypeReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_bridge_proto_msgTypes[165].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TypeRsp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_bridge_proto_msgTypes[166].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExistsReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_bridge_proto_msgTypes[167].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExistsRsp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_bridge_proto_msgTypes[168].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DelKeysReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_bridge_proto_msgTypes[169].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DelKeysRsp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_bridge_proto_msgTypes[170].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RenameReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_bridge_proto_msgTypes[171].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RenameRsp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_bridge_proto_msgTypes[172].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SelectReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_bridge_proto_msgTypes[173].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SelectRsp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_bridge_proto_msgTypes[174].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateDBReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_bridge_proto_msgTypes[175].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateDBRsp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_bridge_proto_msgTypes[176].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CopyReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_bridge_proto_msgTypes[177].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CopyRsp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_bridge_proto_msgTypes[178].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MoveReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_bridge_proto_msgTypes[179].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MoveRsp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_bridge_proto_msgTypes[180].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*KeyInfoReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_bridge_proto_msgTypes[181].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*KeyInfoRsp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_bridge_proto_msgTypes[182].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*KeyStatsReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_bridge_proto_msgTypes[183].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TypeStats); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_bridge_proto_msgTypes[184].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*KeyStatsRsp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_bridge_proto_msgTypes[185].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MemoryUsageReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_bridge_proto_msgTypes[186].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MemoryUsageRsp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_bridge_proto_msgTypes[187].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MemoryStatsReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_bridge_proto_msgTypes[188].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MemoryStatsRsp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_bridge_proto_msgTypes[189].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TxWatchReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_bridge_proto_msgTypes[190].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TxWatchRsp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_bridge_proto_msgTypes[191].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TxCommand); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_bridge_proto_msgTypes[192].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TxResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_bridge_proto_msgTypes[193].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExecReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_bridge_proto_msgTypes[194].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExecRsp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_bridge_proto_msgTypes[195].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MGetReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_bridge_proto_msgTypes[196].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MGetRsp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_bridge_proto_msgTypes[197].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MSetReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_bridge_proto_msgTypes[198].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MSetRsp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_bridge_proto_msgTypes[199].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BatchReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_bridge_proto_msgTypes[200].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BatchResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_bridge_proto_msgTypes[201].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BatchRsp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_bridge_proto_msgTypes[202].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ClearReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_bridge_proto_msgTypes[203].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ClearRsp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_bridge_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   211,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_bridge_proto_goTypes,
+		DependencyIndexes: file_bridge_proto_depIdxs,
+		MessageInfos:      file_bridge_proto_msgTypes,
+	}.Build()
+	File_bridge_proto = out.File
+	file_bridge_proto_rawDesc = nil
+	file_bridge_proto_goTypes = nil
+	file_bridge_proto_depIdxs = nil
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConnInterface
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion6
+
+// RpcBridgeClient is the client API for RpcBridge service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type RpcBridgeClient interface {
+	Ping(ctx context.Context, in *PingReq, opts ...grpc.CallOption) (*PingRsp, error)
+	Publish(ctx context.Context, opts ...grpc.CallOption) (RpcBridge_PublishClient, error)
+	Get(ctx context.Context, in *GetReq, opts ...grpc.CallOption) (*GetRsp, error)
+	Put(ctx context.Context, in *PutReq, opts ...grpc.CallOption) (*PutRsp, error)
+	Del(ctx context.Context, in *DelReq, opts ...grpc.CallOption) (*DelRsp, error)
+	WatchKey(ctx context.Context, in *WatchReq, opts ...grpc.CallOption) (*WatchRsp, error)
+	UnWatchKey(ctx context.Context, in *WatchReq, opts ...grpc.CallOption) (*WatchRsp, error)
+	ClearValue(ctx context.Context, in *ClearReq, opts ...grpc.CallOption) (*ClearRsp, error)
+	HMGet(ctx context.Context, in *HMGetReq, opts ...grpc.CallOption) (*HMGetRsp, error)
+	HMGetMember(ctx context.Context, in *HMGetMemberReq, opts ...grpc.CallOption) (*HMGetMemberRsp, error)
+	HMPut(ctx context.Context, in *HMPutReq, opts ...grpc.CallOption) (*HMPutRsp, error)
+	HMDel(ctx context.Context, in *HMDelReq, opts ...grpc.CallOption) (*HMDelRsp, error)
+	HMDelMember(ctx context.Context, in *HMDelMemberReq, opts ...grpc.CallOption) (*HMDelMemberRsp, error)
+	HMWatch(ctx context.Context, in *HMWatchReq, opts ...grpc.CallOption) (*HMWatchRsp, error)
+	HMUnWatch(ctx context.Context, in *HMWatchReq, opts ...grpc.CallOption) (*HMWatchRsp, error)
+	ClearMap(ctx context.Context, in *ClearReq, opts ...grpc.CallOption) (*ClearRsp, error)
+	LGet(ctx context.Context, in *LGetReq, opts ...grpc.CallOption) (*LGetRsp, error)
+	LGetRange(ctx context.Context, in *LGetRangeReq, opts ...grpc.CallOption) (*LGetRangeRsp, error)
+	LPut(ctx context.Context, in *LPutReq, opts ...grpc.CallOption) (*LPutRsp, error)
+	LDel(ctx context.Context, in *LDelReq, opts ...grpc.CallOption) (*LDelRsp, error)
+	LDelRange(ctx context.Context, in *LDelRangeReq, opts ...grpc.CallOption) (*LDelRangeRsp, error)
+	LWatch(ctx context.Context, in *LWatchReq, opts ...grpc.CallOption) (*LWatchRsp, error)
+	LUnWatch(ctx context.Context, in *LWatchReq, opts ...grpc.CallOption) (*LWatchRsp, error)
+	ClearList(ctx context.Context, in *ClearReq, opts ...grpc.CallOption) (*ClearRsp, error)
+	SGet(ctx context.Context, in *SGetReq, opts ...grpc.CallOption) (*SGetRsp, error)
+	SPut(ctx context.Context, in *SPutReq, opts ...grpc.CallOption) (*SPutRsp, error)
+	SDel(ctx context.Context, in *SDelReq, opts ...grpc.CallOption) (*SDelRsp, error)
+	SDelMember(ctx context.Context, in *SDelMemberReq, opts ...grpc.CallOption) (*SDelMemberRsp, error)
+	SWatch(ctx context.Context, in *SWatchReq, opts ...grpc.CallOption) (*SWatchRsp, error)
+	SUnWatch(ctx context.Context, in *SWatchReq, opts ...grpc.CallOption) (*SWatchRsp, error)
+	ClearSet(ctx context.Context, in *ClearReq, opts ...grpc.CallOption) (*ClearRsp, error)
+	PFAdd(ctx context.Context, in *PFAddReq, opts ...grpc.CallOption) (*PFAddRsp, error)
+	PFCount(ctx context.Context, in *PFCountReq, opts ...grpc.CallOption) (*PFCountRsp, error)
+	PFMerge(ctx context.Context, in *PFMergeReq, opts ...grpc.CallOption) (*PFMergeRsp, error)
+	PFDel(ctx context.Context, in *PFDelReq, opts ...grpc.CallOption) (*PFDelRsp, error)
+	ClearHLL(ctx context.Context, in *ClearReq, opts ...grpc.CallOption) (*ClearRsp, error)
+	GeoAdd(ctx context.Context, in *GeoAddReq, opts ...grpc.CallOption) (*GeoAddRsp, error)
+	GeoPos(ctx context.Context, in *GeoPosReq, opts ...grpc.CallOption) (*GeoPosRsp, error)
+	GeoDist(ctx context.Context, in *GeoDistReq, opts ...grpc.CallOption) (*GeoDistRsp, error)
+	GeoSearch(ctx context.Context, in *GeoSearchReq, opts ...grpc.CallOption) (*GeoSearchRsp, error)
+	GeoDel(ctx context.Context, in *GeoDelReq, opts ...grpc.CallOption) (*GeoDelRsp, error)
+	GeoDelMember(ctx context.Context, in *GeoDelMemberReq, opts ...grpc.CallOption) (*GeoDelMemberRsp, error)
+	ClearGeo(ctx context.Context, in *ClearReq, opts ...grpc.CallOption) (*ClearRsp, error)
+	XAdd(ctx context.Context, in *XAddReq, opts ...grpc.CallOption) (*XAddRsp, error)
+	XLen(ctx context.Context, in *XLenReq, opts ...grpc.CallOption) (*XLenRsp, error)
+	XRange(ctx context.Context, in *XRangeReq, opts ...grpc.CallOption) (*XRangeRsp, error)
+	XRead(ctx context.Context, in *XReadReq, opts ...grpc.CallOption) (*XReadRsp, error)
+	XGroupCreate(ctx context.Context, in *XGroupReq, opts ...grpc.CallOption) (*XGroupRsp, error)
+	XGroupDestroy(ctx context.Context, in *XGroupReq, opts ...grpc.CallOption) (*XGroupRsp, error)
+	XReadGroup(ctx context.Context, in *XReadGroupReq, opts ...grpc.CallOption) (*XReadRsp, error)
+	XAck(ctx context.Context, in *XAckReq, opts ...grpc.CallOption) (*XAckRsp, error)
+	XPending(ctx context.Context, in *XPendingReq, opts ...grpc.CallOption) (*XPendingRsp, error)
+	XClaim(ctx context.Context, in *XClaimReq, opts ...grpc.CallOption) (*XClaimRsp, error)
+	XTrim(ctx context.Context, in *XTrimReq, opts ...grpc.CallOption) (*XTrimRsp, error)
+	XDelMember(ctx context.Context, in *XDelMemberReq, opts ...grpc.CallOption) (*XDelMemberRsp, error)
+	XDel(ctx context.Context, in *XDelReq, opts ...grpc.CallOption) (*XDelRsp, error)
+	ClearStream(ctx context.Context, in *ClearReq, opts ...grpc.CallOption) (*ClearRsp, error)
+	JSet(ctx context.Context, in *JSetReq, opts ...grpc.CallOption) (*JSetRsp, error)
+	JGet(ctx context.Context, in *JGetReq, opts ...grpc.CallOption) (*JGetRsp, error)
+	JDelPath(ctx context.Context, in *JDelPathReq, opts ...grpc.CallOption) (*JDelPathRsp, error)
+	JArrAppend(ctx context.Context, in *JArrAppendReq, opts ...grpc.CallOption) (*JArrAppendRsp, error)
+	JNumIncrBy(ctx context.Context, in *JNumIncrByReq, opts ...grpc.CallOption) (*JNumIncrByRsp, error)
+	JDel(ctx context.Context, in *JDelReq, opts ...grpc.CallOption) (*JDelRsp, error)
+	JWatch(ctx context.Context, in *JWatchReq, opts ...grpc.CallOption) (*JWatchRsp, error)
+	JUnWatch(ctx context.Context, in *JWatchReq, opts ...grpc.CallOption) (*JWatchRsp, error)
+	ClearJSON(ctx context.Context, in *ClearReq, opts ...grpc.CallOption) (*ClearRsp, error)
+	BFReserve(ctx context.Context, in *BFReserveReq, opts ...grpc.CallOption) (*BFReserveRsp, error)
+	BFAdd(ctx context.Context, in *BFAddReq, opts ...grpc.CallOption) (*BFAddRsp, error)
+	BFExists(ctx context.Context, in *BFExistsReq, opts ...grpc.CallOption) (*BFExistsRsp, error)
+	BFInfo(ctx context.Context, in *BFInfoReq, opts ...grpc.CallOption) (*BFInfoRsp, error)
+	BFDel(ctx context.Context, in *BFDelReq, opts ...grpc.CallOption) (*BFDelRsp, error)
+	ClearBloom(ctx context.Context, in *ClearReq, opts ...grpc.CallOption) (*ClearRsp, error)
+	CFReserve(ctx context.Context, in *CFReserveReq, opts ...grpc.CallOption) (*CFReserveRsp, error)
+	CFAdd(ctx context.Context, in *CFAddReq, opts ...grpc.CallOption) (*CFAddRsp, error)
+	CFExists(ctx context.Context, in *CFExistsReq, opts ...grpc.CallOption) (*CFExistsRsp, error)
+	CFDelMember(ctx context.Context, in *CFDelMemberReq, opts ...grpc.CallOption) (*CFDelMemberRsp, error)
+	CFInfo(ctx context.Context, in *CFInfoReq, opts ...grpc.CallOption) (*CFInfoRsp, error)
+	CFDel(ctx context.Context, in *CFDelReq, opts ...grpc.CallOption) (*CFDelRsp, error)
+	ClearCuckoo(ctx context.Context, in *ClearReq, opts ...grpc.CallOption) (*ClearRsp, error)
+	TSCreate(ctx context.Context, in *TSCreateReq, opts ...grpc.CallOption) (*TSCreateRsp, error)
+	TSAdd(ctx context.Context, in *TSAddReq, opts ...grpc.CallOption) (*TSAddRsp, error)
+	TSRange(ctx context.Context, in *TSRangeReq, opts ...grpc.CallOption) (*TSRangeRsp, error)
+	TSGet(ctx context.Context, in *TSGetReq, opts ...grpc.CallOption) (*TSGetRsp, error)
+	TSDelRange(ctx context.Context, in *TSDelRangeReq, opts ...grpc.CallOption) (*TSDelRangeRsp, error)
+	TSCreateRule(ctx context.Context, in *TSRuleReq, opts ...grpc.CallOption) (*TSRuleRsp, error)
+	TSDeleteRule(ctx context.Context, in *TSRuleReq, opts ...grpc.CallOption) (*TSRuleRsp, error)
+	TSInfo(ctx context.Context, in *TSInfoReq, opts ...grpc.CallOption) (*TSInfoRsp, error)
+	TSDel(ctx context.Context, in *TSDelReq, opts ...grpc.CallOption) (*TSDelRsp, error)
+	ClearTS(ctx context.Context, in *ClearReq, opts ...grpc.CallOption) (*ClearRsp, error)
+	Scan(ctx context.Context, in *ScanReq, opts ...grpc.CallOption) (*ScanRsp, error)
+	HScan(ctx context.Context, in *HScanReq, opts ...grpc.CallOption) (*HScanRsp, error)
+	SScan(ctx context.Context, in *SScanReq, opts ...grpc.CallOption) (*SScanRsp, error)
+	TTL(ctx context.Context, in *TTLReq, opts ...grpc.CallOption) (*TTLRsp, error)
+	PTTL(ctx context.Context, in *TTLReq, opts ...grpc.CallOption) (*TTLRsp, error)
+	Expire(ctx context.Context, in *ExpireReq, opts ...grpc.CallOption) (*ExpireRsp, error)
+	ExpireAt(ctx context.Context, in *ExpireAtReq, opts ...grpc.CallOption) (*ExpireRsp, error)
+	PExpire(ctx context.Context, in *PExpireReq, opts ...grpc.CallOption) (*ExpireRsp, error)
+	PExpireAt(ctx context.Context, in *PExpireAtReq, opts ...grpc.CallOption) (*ExpireRsp, error)
+	Persist(ctx context.Context, in *PersistReq, opts ...grpc.CallOption) (*ExpireRsp, error)
+	Type(ctx context.Context, in *TypeReq, opts ...grpc.CallOption) (*TypeRsp, error)
+	Exists(ctx context.Context, in *ExistsReq, opts ...grpc.CallOption) (*ExistsRsp, error)
+	DelKeys(ctx context.Context, in *DelKeysReq, opts ...grpc.CallOption) (*DelKeysRsp, error)
+	Rename(ctx context.Context, in *RenameReq, opts ...grpc.CallOption) (*RenameRsp, error)
+	Select(ctx context.Context, in *SelectReq, opts ...grpc.CallOption) (*SelectRsp, error)
+	CreateDB(ctx context.Context, in *CreateDBReq, opts ...grpc.CallOption) (*CreateDBRsp, error)
+	Copy(ctx context.Context, in *CopyReq, opts ...grpc.CallOption) (*CopyRsp, error)
+	Move(ctx context.Context, in *MoveReq, opts ...grpc.CallOption) (*MoveRsp, error)
+	KeyInfo(ctx context.Context, in *KeyInfoReq, opts ...grpc.CallOption) (*KeyInfoRsp, error)
+	KeyStats(ctx context.Context, in *KeyStatsReq, opts ...grpc.CallOption) (*KeyStatsRsp, error)
+	MemoryUsage(ctx context.Context, in *MemoryUsageReq, opts ...grpc.CallOption) (*MemoryUsageRsp, error)
+	MemoryStats(ctx context.Context, in *MemoryStatsReq, opts ...grpc.CallOption) (*MemoryStatsRsp, error)
+	TxWatch(ctx context.Context, in *TxWatchReq, opts ...grpc.CallOption) (*TxWatchRsp, error)
+	Exec(ctx context.Context, in *ExecReq, opts ...grpc.CallOption) (*ExecRsp, error)
+	MGet(ctx context.Context, in *MGetReq, opts ...grpc.CallOption) (*MGetRsp, error)
+	MSet(ctx context.Context, in *MSetReq, opts ...grpc.CallOption) (*MSetRsp, error)
+	Batch(ctx context.Context, in *BatchReq, opts ...grpc.CallOption) (*BatchRsp, error)
+}
+
+type rpcBridgeClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewRpcBridgeClient(cc grpc.ClientConnInterface) RpcBridgeClient {
+	return &rpcBridgeClient{cc}
+}
+
+func (c *rpcBridgeClient) Ping(ctx context.Context, in *PingReq, opts ...grpc.CallOption) (*PingRsp, error) {
+	out := new(PingRsp)
+	err := c.cc.Invoke(ctx, "/bridge.RpcBridge/Ping", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rpcBridgeClient) Publish(ctx context.Context, opts ...grpc.CallOption) (RpcBridge_PublishClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_RpcBridge_serviceDesc.Streams[0], "/bridge.RpcBridge/Publish", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &rpcBridgePublishClient{stream}
+	return x, nil
+}
+
+type RpcBridge_PublishClient interface {
+	Send(*PublishReq) error
+	Recv() (*PublishRsp, error)
+	grpc.ClientStream
+}
+
+type rpcBridgePublishClient struct {
+	grpc.ClientStream
+}
+
+func (x *rpcBridgePublishClient) Send(m *PublishReq) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *rpcBridgePublishClient) Recv() (*PublishRsp, error) {
+	m := new(PublishRsp)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *rpcBridgeClient) Get(ctx context.Context, in *GetReq, opts ...grpc.CallOption) (*GetRsp, error) {
+	out := new(GetRsp)
+	err := c.cc.Invoke(ctx, "/bridge.RpcBridge/Get", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rpcBridgeClient) Put(ctx context.Context, in *PutReq, opts ...grpc.CallOption) (*PutRsp, error) {
+	out := new(PutRsp)
+	err := c.cc.Invoke(ctx, "/bridge.RpcBridge/Put", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rpcBridgeClient) Del(ctx context.Context, in *DelReq, opts ...grpc.CallOption) (*DelRsp, error) {
+	out := new(DelRsp)
+	err := c.cc.Invoke(ctx, "/bridge.RpcBridge/Del", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rpcBridgeClient) WatchKey(ctx context.Context, in *WatchReq, opts ...grpc.CallOption) (*WatchRsp, error) {
+	out := new(WatchRsp)
+	err := c.cc.Invoke(ctx, "/bridge.RpcBridge/WatchKey", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rpcBridgeClient) UnWatchKey(ctx context.Context, in *WatchReq, opts ...grpc.CallOption) (*WatchRsp, error) {
+	out := new(WatchRsp)
+	err := c.cc.Invoke(ctx, "/bridge.RpcBridge/UnWatchKey", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rpcBridgeClient) ClearValue(ctx context.Context, in *ClearReq, opts ...grpc.CallOption) (*ClearRsp, error) {
+	out := new(ClearRsp)
+	err := c.cc.Invoke(ctx, "/bridge.RpcBridge/ClearValue", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rpcBridgeClient) HMGet(ctx context.Context, in *HMGetReq, opts ...grpc.CallOption) (*HMGetRsp, error) {
+	out := new(HMGetRsp)
+	err := c.cc.Invoke(ctx, "/bridge.RpcBridge/HMGet", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rpcBridgeClient) HMGetMember(ctx context.Context, in *HMGetMemberReq, opts ...grpc.CallOption) (*HMGetMemberRsp, error) {
+	out := new(HMGetMemberRsp)
+	err := c.cc.Invoke(ctx, "/bridge.RpcBridge/HMGetMember", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rpcBridgeClient) HMPut(ctx context.Context, in *HMPutReq, opts ...grpc.CallOption) (*HMPutRsp, error) {
+	out := new(HMPutRsp)
+	err := c.cc.Invoke(ctx, "/bridge.RpcBridge/HMPut", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rpcBridgeClient) HMDel(ctx context.Context, in *HMDelReq, opts ...grpc.CallOption) (*HMDelRsp, error) {
+	out := new(HMDelRsp)
+	err := c.cc.Invoke(ctx, "/bridge.RpcBridge/HMDel", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rpcBridgeClient) HMDelMember(ctx context.Context, in *HMDelMemberReq, opts ...grpc.CallOption) (*HMDelMemberRsp, error) {
+	out := new(HMDelMemberRsp)
+	err := c.cc.Invoke(ctx, "/bridge.RpcBridge/HMDelMember", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rpcBridgeClient) HMWatch(ctx context.Context, in *HMWatchReq, opts ...grpc.CallOption) (*HMWatchRsp, error) {
+	out := new(HMWatchRsp)
+	err := c.cc.Invoke(ctx, "/bridge.RpcBridge/HMWatch", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rpcBridgeClient) HMUnWatch(ctx context.Context, in *HMWatchReq, opts ...grpc.CallOption) (*HMWatchRsp, error) {
+	out := new(HMWatchRsp)
+	err := c.cc.Invoke(ctx, "/bridge.RpcBridge/HMUnWatch", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rpcBridgeClient) ClearMap(ctx context.Context, in *ClearReq, opts ...grpc.CallOption) (*ClearRsp, error) {
+	out := new(ClearRsp)
+	err := c.cc.Invoke(ctx, "/bridge.RpcBridge/ClearMap", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rpcBridgeClient) LGet(ctx context.Context, in *LGetReq, opts ...grpc.CallOption) (*LGetRsp, error) {
+	out := new(LGetRsp)
+	err := c.cc.Invoke(ctx, "/bridge.RpcBridge/LGet", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rpcBridgeClient) LGetRange(ctx context.Context, in *LGetRangeReq, opts ...grpc.CallOption) (*LGetRangeRsp, error) {
+	out := new(LGetRangeRsp)
+	err := c.cc.Invoke(ctx, "/bridge.RpcBridge/LGetRange", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rpcBridgeClient) LPut(ctx context.Context, in *LPutReq, opts ...grpc.CallOption) (*LPutRsp, error) {
+	out := new(LPutRsp)
+	err := c.cc.Invoke(ctx, "/bridge.RpcBridge/LPut", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rpcBridgeClient) LDel(ctx context.Context, in *LDelReq, opts ...grpc.CallOption) (*LDelRsp, error) {
+	out := new(LDelRsp)
+	err := c.cc.Invoke(ctx, "/bridge.RpcBridge/LDel", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rpcBridgeClient) LDelRange(ctx context.Context, in *LDelRangeReq, opts ...grpc.CallOption) (*LDelRangeRsp, error) {
+	out := new(LDelRangeRsp)
+	err := c.cc.Invoke(ctx, "/bridge.RpcBridge/LDelRange", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rpcBridgeClient) LWatch(ctx context.Context, in *LWatchReq, opts ...grpc.CallOption) (*LWatchRsp, error) {
+	out := new(LWatchRsp)
+	err := c.cc.Invoke(ctx, "/bridge.RpcBridge/LWatch", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rpcBridgeClient) LUnWatch(ctx context.Context, in *LWatchReq, opts ...grpc.CallOption) (*LWatchRsp, error) {
+	out := new(LWatchRsp)
+	err := c.cc.Invoke(ctx, "/bridge.RpcBridge/LUnWatch", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rpcBridgeClient) ClearList(ctx context.Context, in *ClearReq, opts ...grpc.CallOption) (*ClearRsp, error) {
+	out := new(ClearRsp)
+	err := c.cc.Invoke(ctx, "/bridge.RpcBridge/ClearList", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rpcBridgeClient) SGet(ctx context.Context, in *SGetReq, opts ...grpc.CallOption) (*SGetRsp, error) {
+	out := new(SGetRsp)
+	err := c.cc.Invoke(ctx, "/bridge.RpcBridge/SGet", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rpcBridgeClient) SPut(ctx context.Context, in *SPutReq, opts ...grpc.CallOption) (*SPutRsp, error) {
+	out := new(SPutRsp)
+	err := c.cc.Invoke(ctx, "/bridge.RpcBridge/SPut", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rpcBridgeClient) SDel(ctx context.Context, in *SDelReq, opts ...grpc.CallOption) (*SDelRsp, error) {
+	out := new(SDelRsp)
+	err := c.cc.Invoke(ctx, "/bridge.RpcBridge/SDel", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rpcBridgeClient) SDelMember(ctx context.Context, in *SDelMemberReq, opts ...grpc.CallOption) (*SDelMemberRsp, error) {
+	out := new(SDelMemberRsp)
+	err := c.cc.Invoke(ctx, "/bridge.RpcBridge/SDelMember", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rpcBridgeClient) SWatch(ctx context.Context, in *SWatchReq, opts ...grpc.CallOption) (*SWatchRsp, error) {
+	out := new(SWatchRsp)
+	err := c.cc.Invoke(ctx, "/bridge.RpcBridge/SWatch", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rpcBridgeClient) SUnWatch(ctx context.Context, in *SWatchReq, opts ...grpc.CallOption) (*SWatchRsp, error) {
+	out := new(SWatchRsp)
+	err := c.cc.Invoke(ctx, "/bridge.RpcBridge/SUnWatch", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rpcBridgeClient) ClearSet(ctx context.Context, in *ClearReq, opts ...grpc.CallOption) (*ClearRsp, error) {
+	out := new(ClearRsp)
+	err := c.cc.Invoke(ctx, "/bridge.RpcBridge/ClearSet", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rpcBridgeClient) PFAdd(ctx context.Context, in *PFAddReq, opts ...grpc.CallOption) (*PFAddRsp, error) {
+	out := new(PFAddRsp)
+	err := c.cc.Invoke(ctx, "/bridge.RpcBridge/PFAdd", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rpcBridgeClient) PFCount(ctx context.Context, in *PFCountReq, opts ...grpc.CallOption) (*PFCountRsp, error) {
+	out := new(PFCountRsp)
+	err := c.cc.Invoke(ctx, "/bridge.RpcBridge/PFCount", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rpcBridgeClient) PFMerge(ctx context.Context, in *PFMergeReq, opts ...grpc.CallOption) (*PFMergeRsp, error) {
+	out := new(PFMergeRsp)
+	err := c.cc.Invoke(ctx, "/bridge.RpcBridge/PFMerge", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rpcBridgeClient) PFDel(ctx context.Context, in *PFDelReq, opts ...grpc.CallOption) (*PFDelRsp, error) {
+	out := new(PFDelRsp)
+	err := c.cc.Invoke(ctx, "/bridge.RpcBridge/PFDel", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rpcBridgeClient) ClearHLL(ctx context.Context, in *ClearReq, opts ...grpc.CallOption) (*ClearRsp, error) {
+	out := new(ClearRsp)
+	err := c.cc.Invoke(ctx, "/bridge.RpcBridge/ClearHLL", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rpcBridgeClient) GeoAdd(ctx context.Context, in *GeoAddReq, opts ...grpc.CallOption) (*GeoAddRsp, error) {
+	out := new(GeoAddRsp)
+	err := c.cc.Invoke(ctx, "/bridge.RpcBridge/GeoAdd", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rpcBridgeClient) GeoPos(ctx context.Context, in *GeoPosReq, opts ...grpc.CallOption) (*GeoPosRsp, error) {
+	out := new(GeoPosRsp)
+	err := c.cc.Invoke(ctx, "/bridge.RpcBridge/GeoPos", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rpcBridgeClient) GeoDist(ctx context.Context, in *GeoDistReq, opts ...grpc.CallOption) (*GeoDistRsp, error) {
+	out := new(GeoDistRsp)
+	err := c.cc.Invoke(ctx, "/bridge.RpcBridge/GeoDist", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rpcBridgeClient) GeoSearch(ctx context.Context, in *GeoSearchReq, opts ...grpc.CallOption) (*GeoSearchRsp, error) {
+	out := new(GeoSearchRsp)
+	err := c.cc.Invoke(ctx, "/bridge.RpcBridge/GeoSearch", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rpcBridgeClient) GeoDel(ctx context.Context, in *GeoDelReq, opts ...grpc.CallOption) (*GeoDelRsp, error) {
+	out := new(GeoDelRsp)
+	err := c.cc.Invoke(ctx, "/bridge.RpcBridge/GeoDel", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rpcBridgeClient) GeoDelMember(ctx context.Context, in *GeoDelMemberReq, opts ...grpc.CallOption) (*GeoDelMemberRsp, error) {
+	out := new(GeoDelMemberRsp)
+	err := c.cc.Invoke(ctx, "/bridge.RpcBridge/GeoDelMember", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rpcBridgeClient) ClearGeo(ctx context.Context, in *ClearReq, opts ...grpc.CallOption) (*ClearRsp, error) {
+	out := new(ClearRsp)
+	err := c.cc.Invoke(ctx, "/bridge.RpcBridge/ClearGeo", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rpcBridgeClient) XAdd(ctx context.Context, in *XAddReq, opts ...grpc.CallOption) (*XAddRsp, error) {
+	out := new(XAddRsp)
+	err := c.cc.Invoke(ctx, "/bridge.RpcBridge/XAdd", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rpcBridgeClient) XLen(ctx context.Context, in *XLenReq, opts ...grpc.CallOption) (*XLenRsp, error) {
+	out := new(XLenRsp)
+	err := c.cc.Invoke(ctx, "/bridge.RpcBridge/XLen", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rpcBridgeClient) XRange(ctx context.Context, in *XRangeReq, opts ...grpc.CallOption) (*XRangeRsp, error) {
+	out := new(XRangeRsp)
+	err := c.cc.Invoke(ctx, "/bridge.RpcBridge/XRange", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rpcBridgeClient) XRead(ctx context.Context, in *XReadReq, opts ...grpc.CallOption) (*XReadRsp, error) {
+	out := new(XReadRsp)
+	err := c.cc.Invoke(ctx, "/bridge.RpcBridge/XRead", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rpcBridgeClient) XGroupCreate(ctx context.Context, in *XGroupReq, opts ...grpc.CallOption) (*XGroupRsp, error) {
+	out := new(XGroupRsp)
+	err := c.cc.Invoke(ctx, "/bridge.RpcBridge/XGroupCreate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rpcBridgeClient) XGroupDestroy(ctx context.Context, in *XGroupReq, opts ...grpc.CallOption) (*XGroupRsp, error) {
+	out := new(XGroupRsp)
+	err := c.cc.Invoke(ctx, "/bridge.RpcBridge/XGroupDestroy", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rpcBridgeClient) XReadGroup(ctx context.Context, in *XReadGroupReq, opts ...grpc.CallOption) (*XReadRsp, error) {
+	out := new(XReadRsp)
+	err := c.cc.Invoke(ctx, "/bridge.RpcBridge/XReadGroup", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rpcBridgeClient) XAck(ctx context.Context, in *XAckReq, opts ...grpc.CallOption) (*XAckRsp, error) {
+	out := new(XAckRsp)
+	err := c.cc.Invoke(ctx, "/bridge.RpcBridge/XAck", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rpcBridgeClient) XPending(ctx context.Context, in *XPendingReq, opts ...grpc.CallOption) (*XPendingRsp, error) {
+	out := new(XPendingRsp)
+	err := c.cc.Invoke(ctx, "/bridge.RpcBridge/XPending", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rpcBridgeClient) XClaim(ctx context.Context, in *XClaimReq, opts ...grpc.CallOption) (*XClaimRsp, error) {
+	out := new(XClaimRsp)
+	err := c.cc.Invoke(ctx, "/bridge.RpcBridge/XClaim", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rpcBridgeClient) XTrim(ctx context.Context, in *XTrimReq, opts ...grpc.CallOption) (*XTrimRsp, error) {
+	out := new(XTrimRsp)
+	err := c.cc.Invoke(ctx, "/bridge.RpcBridge/XTrim", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rpcBridgeClient) XDelMember(ctx context.Context, in *XDelMemberReq, opts ...grpc.CallOption) (*XDelMemberRsp, error) {
+	out := new(XDelMemberRsp)
+	err := c.cc.Invoke(ctx, "/bridge.RpcBridge/XDelMember", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rpcBridgeClient) XDel(ctx context.Context, in *XDelReq, opts ...grpc.CallOption) (*XDelRsp, error) {
+	out := new(XDelRsp)
+	err := c.cc.Invoke(ctx, "/bridge.RpcBridge/XDel", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rpcBridgeClient) ClearStream(ctx context.Context, in *ClearReq, opts ...grpc.CallOption) (*ClearRsp, error) {
+	out := new(ClearRsp)
+	err := c.cc.Invoke(ctx, "/bridge.RpcBridge/ClearStream", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rpcBridgeClient) JSet(ctx context.Context, in *JSetReq, opts ...grpc.CallOption) (*JSetRsp, error) {
+	out := new(JSetRsp)
+	err := c.cc.Invoke(ctx, "/bridge.RpcBridge/JSet", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rpcBridgeClient) JGet(ctx context.Context, in *JGetReq, opts ...grpc.CallOption) (*JGetRsp, error) {
+	out := new(JGetRsp)
+	err := c.cc.Invoke(ctx, "/bridge.RpcBridge/JGet", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rpcBridgeClient) JDelPath(ctx context.Context, in *JDelPathReq, opts ...grpc.CallOption) (*JDelPathRsp, error) {
+	out := new(JDelPathRsp)
+	err := c.cc.Invoke(ctx, "/bridge.RpcBridge/JDelPath", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rpcBridgeClient) JArrAppend(ctx context.Context, in *JArrAppendReq, opts ...grpc.CallOption) (*JArrAppendRsp, error) {
+	out := new(JArrAppendRsp)
+	err := c.cc.Invoke(ctx, "/bridge.RpcBridge/JArrAppend", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rpcBridgeClient) JNumIncrBy(ctx context.Context, in *JNumIncrByReq, opts ...grpc.CallOption) (*JNumIncrByRsp, error) {
+	out := new(JNumIncrByRsp)
+	err := c.cc.Invoke(ctx, "/bridge.RpcBridge/JNumIncrBy", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rpcBridgeClient) JDel(ctx context.Context, in *JDelReq, opts ...grpc.CallOption) (*JDelRsp, error) {
+	out := new(JDelRsp)
+	err := c.cc.Invoke(ctx, "/bridge.RpcBridge/JDel", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rpcBridgeClient) JWatch(ctx context.Context, in *JWatchReq, opts ...grpc.CallOption) (*JWatchRsp, error) {
+	out := new(JWatchRsp)
+	err := c.cc.Invoke(ctx, "/bridge.RpcBridge/JWatch", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rpcBridgeClient) JUnWatch(ctx context.Context, in *JWatchReq, opts ...grpc.CallOption) (*JWatchRsp, error) {
+	out := new(JWatchRsp)
+	err := c.cc.Invoke(ctx, "/bridge.RpcBridge/JUnWatch", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rpcBridgeClient) ClearJSON(ctx context.Context, in *ClearReq, opts ...grpc.CallOption) (*ClearRsp, error) {
+	out := new(ClearRsp)
+	err := c.cc.Invoke(ctx, "/bridge.RpcBridge/ClearJSON", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rpcBridgeClient) BFReserve(ctx context.Context, in *BFReserveReq, opts ...grpc.CallOption) (*BFReserveRsp, error) {
+	out := new(BFReserveRsp)
+	err := c.cc.Invoke(ctx, "/bridge.RpcBridge/BFReserve", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rpcBridgeClient) BFAdd(ctx context.Context, in *BFAddReq, opts ...grpc.CallOption) (*BFAddRsp, error) {
+	out := new(BFAddRsp)
+	err := c.cc.Invoke(ctx, "/bridge.RpcBridge/BFAdd", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rpcBridgeClient) BFExists(ctx context.Context, in *BFExistsReq, opts ...grpc.CallOption) (*BFExistsRsp, error) {
+	out := new(BFExistsRsp)
+	err := c.cc.Invoke(ctx, "/bridge.RpcBridge/BFExists", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rpcBridgeClient) BFInfo(ctx context.Context, in *BFInfoReq, opts ...grpc.CallOption) (*BFInfoRsp, error) {
+	out := new(BFInfoRsp)
+	err := c.cc.Invoke(ctx, "/bridge.RpcBridge/BFInfo", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rpcBridgeClient) BFDel(ctx context.Context, in *BFDelReq, opts ...grpc.CallOption) (*BFDelRsp, error) {
+	out := new(BFDelRsp)
+	err := c.cc.Invoke(ctx, "/bridge.RpcBridge/BFDel", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rpcBridgeClient) ClearBloom(ctx context.Context, in *ClearReq, opts ...grpc.CallOption) (*ClearRsp, error) {
+	out := new(ClearRsp)
+	err := c.cc.Invoke(ctx, "/bridge.RpcBridge/ClearBloom", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rpcBridgeClient) CFReserve(ctx context.Context, in *CFReserveReq, opts ...grpc.CallOption) (*CFReserveRsp, error) {
+	out := new(CFReserveRsp)
+	err := c.cc.Invoke(ctx, "/bridge.RpcBridge/CFReserve", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rpcBridgeClient) CFAdd(ctx context.Context, in *CFAddReq, opts ...grpc.CallOption) (*CFAddRsp, error) {
+	out := new(CFAddRsp)
+	err := c.cc.Invoke(ctx, "/bridge.RpcBridge/CFAdd", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rpcBridgeClient) CFExists(ctx context.Context, in *CFExistsReq, opts ...grpc.CallOption) (*CFExistsRsp, error) {
+	out := new(CFExistsRsp)
+	err := c.cc.Invoke(ctx, "/bridge.RpcBridge/CFExists", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rpcBridgeClient) CFDelMember(ctx context.Context, in *CFDelMemberReq, opts ...grpc.CallOption) (*CFDelMemberRsp, error) {
+	out := new(CFDelMemberRsp)
+	err := c.cc.Invoke(ctx, "/bridge.RpcBridge/CFDelMember", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rpcBridgeClient) CFInfo(ctx context.Context, in *CFInfoReq, opts ...grpc.CallOption) (*CFInfoRsp, error) {
+	out := new(CFInfoRsp)
+	err := c.cc.Invoke(ctx, "/bridge.RpcBridge/CFInfo", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rpcBridgeClient) CFDel(ctx context.Context, in *CFDelReq, opts ...grpc.CallOption) (*CFDelRsp, error) {
+	out := new(CFDelRsp)
+	err := c.cc.Invoke(ctx, "/bridge.RpcBridge/CFDel", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rpcBridgeClient) ClearCuckoo(ctx context.Context, in *ClearReq, opts ...grpc.CallOption) (*ClearRsp, error) {
+	out := new(ClearRsp)
+	err := c.cc.Invoke(ctx, "/bridge.RpcBridge/ClearCuckoo", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rpcBridgeClient) TSCreate(ctx context.Context, in *TSCreateReq, opts ...grpc.CallOption) (*TSCreateRsp, error) {
+	out := new(TSCreateRsp)
+	err := c.cc.Invoke(ctx, "/bridge.RpcBridge/TSCreate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rpcBridgeClient) TSAdd(ctx context.Context, in *TSAddReq, opts ...grpc.CallOption) (*TSAddRsp, error) {
+	out := new(TSAddRsp)
+	err := c.cc.Invoke(ctx, "/bridge.RpcBridge/TSAdd", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rpcBridgeClient) TSRange(ctx context.Context, in *TSRangeReq, opts ...grpc.CallOption) (*TSRangeRsp, error) {
+	out := new(TSRangeRsp)
+	err := c.cc.Invoke(ctx, "/bridge.RpcBridge/TSRange", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rpcBridgeClient) TSGet(ctx context.Context, in *TSGetReq, opts ...grpc.CallOption) (*TSGetRsp, error) {
+	out := new(TSGetRsp)
+	err := c.cc.Invoke(ctx, "/bridge.RpcBridge/TSGet", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rpcBridgeClient) TSDelRange(ctx context.Context, in *TSDelRangeReq, opts ...grpc.CallOption) (*TSDelRangeRsp, error) {
+	out := new(TSDelRangeRsp)
+	err := c.cc.Invoke(ctx, "/bridge.RpcBridge/TSDelRange", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rpcBridgeClient) TSCreateRule(ctx context.Context, in *TSRuleReq, opts ...grpc.CallOption) (*TSRuleRsp, error) {
+	out := new(TSRuleRsp)
+	err := c.cc.Invoke(ctx, "/bridge.RpcBridge/TSCreateRule", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rpcBridgeClient) TSDeleteRule(ctx context.Context, in *TSRuleReq, opts ...grpc.CallOption) (*TSRuleRsp, error) {
+	out := new(TSRuleRsp)
+	err := c.cc.Invoke(ctx, "/bridge.RpcBridge/TSDeleteRule", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rpcBridgeClient) TSInfo(ctx context.Context, in *TSInfoReq, opts ...grpc.CallOption) (*TSInfoRsp, error) {
+	out := new(TSInfoRsp)
+	err := c.cc.Invoke(ctx, "/bridge.RpcBridge/TSInfo", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rpcBridgeClient) TSDel(ctx context.Context, in *TSDelReq, opts ...grpc.CallOption) (*TSDelRsp, error) {
+	out := new(TSDelRsp)
+	err := c.cc.Invoke(ctx, "/bridge.RpcBridge/TSDel", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rpcBridgeClient) ClearTS(ctx context.Context, in *ClearReq, opts ...grpc.CallOption) (*ClearRsp, error) {
+	out := new(ClearRsp)
+	err := c.cc.Invoke(ctx, "/bridge.RpcBridge/ClearTS", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rpcBridgeClient) Scan(ctx context.Context, in *ScanReq, opts ...grpc.CallOption) (*ScanRsp, error) {
+	out := new(ScanRsp)
+	err := c.cc.Invoke(ctx, "/bridge.RpcBridge/Scan", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rpcBridgeClient) HScan(ctx context.Context, in *HScanReq, opts ...grpc.CallOption) (*HScanRsp, error) {
+	out := new(HScanRsp)
+	err := c.cc.Invoke(ctx, "/bridge.RpcBridge/HScan", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rpcBridgeClient) SScan(ctx context.Context, in *SScanReq, opts ...grpc.CallOption) (*SScanRsp, error) {
+	out := new(SScanRsp)
+	err := c.cc.Invoke(ctx, "/bridge.RpcBridge/SScan", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rpcBridgeClient) TTL(ctx context.Context, in *TTLReq, opts ...grpc.CallOption) (*TTLRsp, error) {
+	out := new(TTLRsp)
+	err := c.cc.Invoke(ctx, "/bridge.RpcBridge/TTL", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rpcBridgeClient) PTTL(ctx context.Context, in *TTLReq, opts ...grpc.CallOption) (*TTLRsp, error) {
+	out := new(TTLRsp)
+	err := c.cc.Invoke(ctx, "/bridge.RpcBridge/PTTL", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rpcBridgeClient) Expire(ctx context.Context, in *ExpireReq, opts ...grpc.CallOption) (*ExpireRsp, error) {
+	out := new(ExpireRsp)
+	err := c.cc.Invoke(ctx, "/bridge.RpcBridge/Expire", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rpcBridgeClient) ExpireAt(ctx context.Context, in *ExpireAtReq, opts ...grpc.CallOption) (*ExpireRsp, error) {
+	out := new(ExpireRsp)
+	err := c.cc.Invoke(ctx, "/bridge.RpcBridge/ExpireAt", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rpcBridgeClient) PExpire(ctx context.Context, in *PExpireReq, opts ...grpc.CallOption) (*ExpireRsp, error) {
+	out := new(ExpireRsp)
+	err := c.cc.Invoke(ctx, "/bridge.RpcBridge/PExpire", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rpcBridgeClient) PExpireAt(ctx context.Context, in *PExpireAtReq, opts ...grpc.CallOption) (*ExpireRsp, error) {
+	out := new(ExpireRsp)
+	err := c.cc.Invoke(ctx, "/bridge.RpcBridge/PExpireAt", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rpcBridgeClient) Persist(ctx context.Context, in *PersistReq, opts ...grpc.CallOption) (*ExpireRsp, error) {
+	out := new(ExpireRsp)
+	err := c.cc.Invoke(ctx, "/bridge.RpcBridge/Persist", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rpcBridgeClient) Type(ctx context.Context, in *TypeReq, opts ...grpc.CallOption) (*TypeRsp, error) {
+	out := new(TypeRsp)
+	err := c.cc.Invoke(ctx, "/bridge.RpcBridge/Type", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rpcBridgeClient) Exists(ctx context.Context, in *ExistsReq, opts ...grpc.CallOption) (*ExistsRsp, error) {
+	out := new(ExistsRsp)
+	err := c.cc.Invoke(ctx, "/bridge.RpcBridge/Exists", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rpcBridgeClient) DelKeys(ctx context.Context, in *DelKeysReq, opts ...grpc.CallOption) (*DelKeysRsp, error) {
+	out := new(DelKeysRsp)
+	err := c.cc.Invoke(ctx, "/bridge.RpcBridge/DelKeys", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rpcBridgeClient) Rename(ctx context.Context, in *RenameReq, opts ...grpc.CallOption) (*RenameRsp, error) {
+	out := new(RenameRsp)
+	err := c.cc.Invoke(ctx, "/bridge.RpcBridge/Rename", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rpcBridgeClient) Select(ctx context.Context, in *SelectReq, opts ...grpc.CallOption) (*SelectRsp, error) {
+	out := new(SelectRsp)
+	err := c.cc.Invoke(ctx, "/bridge.RpcBridge/Select", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rpcBridgeClient) CreateDB(ctx context.Context, in *CreateDBReq, opts ...grpc.CallOption) (*CreateDBRsp, error) {
+	out := new(CreateDBRsp)
+	err := c.cc.Invoke(ctx, "/bridge.RpcBridge/CreateDB", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rpcBridgeClient) Copy(ctx context.Context, in *CopyReq, opts ...grpc.CallOption) (*CopyRsp, error) {
+	out := new(CopyRsp)
+	err := c.cc.Invoke(ctx, "/bridge.RpcBridge/Copy", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rpcBridgeClient) Move(ctx context.Context, in *MoveReq, opts ...grpc.CallOption) (*MoveRsp, error) {
+	out := new(MoveRsp)
+	err := c.cc.Invoke(ctx, "/bridge.RpcBridge/Move", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rpcBridgeClient) KeyInfo(ctx context.Context, in *KeyInfoReq, opts ...grpc.CallOption) (*KeyInfoRsp, error) {
+	out := new(KeyInfoRsp)
+	err := c.cc.Invoke(ctx, "/bridge.RpcBridge/KeyInfo", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rpcBridgeClient) KeyStats(ctx context.Context, in *KeyStatsReq, opts ...grpc.CallOption) (*KeyStatsRsp, error) {
+	out := new(KeyStatsRsp)
+	err := c.cc.Invoke(ctx, "/bridge.RpcBridge/KeyStats", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rpcBridgeClient) MemoryUsage(ctx context.Context, in *MemoryUsageReq, opts ...grpc.CallOption) (*MemoryUsageRsp, error) {
+	out := new(MemoryUsageRsp)
+	err := c.cc.Invoke(ctx, "/bridge.RpcBridge/MemoryUsage", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rpcBridgeClient) MemoryStats(ctx context.Context, in *MemoryStatsReq, opts ...grpc.CallOption) (*MemoryStatsRsp, error) {
+	out := new(MemoryStatsRsp)
+	err := c.cc.Invoke(ctx, "/bridge.RpcBridge/MemoryStats", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rpcBridgeClient) TxWatch(ctx context.Context, in *TxWatchReq, opts ...grpc.CallOption) (*TxWatchRsp, error) {
+	out := new(TxWatchRsp)
+	err := c.cc.Invoke(ctx, "/bridge.RpcBridge/TxWatch", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rpcBridgeClient) Exec(ctx context.Context, in *ExecReq, opts ...grpc.CallOption) (*ExecRsp, error) {
+	out := new(ExecRsp)
+	err := c.cc.Invoke(ctx, "/bridge.RpcBridge/Exec", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rpcBridgeClient) MGet(ctx context.Context, in *MGetReq, opts ...grpc.CallOption) (*MGetRsp, error) {
+	out := new(MGetRsp)
+	err := c.cc.Invoke(ctx, "/bridge.RpcBridge/MGet", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rpcBridgeClient) MSet(ctx context.Context, in *MSetReq, opts ...grpc.CallOption) (*MSetRsp, error) {
+	out := new(MSetRsp)
+	err := c.cc.Invoke(ctx, "/bridge.RpcBridge/MSet", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rpcBridgeClient) Batch(ctx context.Context, in *BatchReq, opts ...grpc.CallOption) (*BatchRsp, error) {
+	out := new(BatchRsp)
+	err := c.cc.Invoke(ctx, "/bridge.RpcBridge/Batch", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// RpcBridgeServer is the server API for RpcBridge service.
+type RpcBridgeServer interface {
+	Ping(context.Context, *PingReq) (*PingRsp, error)
+	Publish(RpcBridge_PublishServer) error
+	Get(context.Context, *GetReq) (*GetRsp, error)
+	Put(context.Context, *PutReq) (*PutRsp, error)
+	Del(context.Context, *DelReq) (*DelRsp, error)
+	WatchKey(context.Context, *WatchReq) (*WatchRsp, error)
+	UnWatchKey(context.Context, *WatchReq) (*WatchRsp, error)
+	ClearValue(context.Context, *ClearReq) (*ClearRsp, error)
+	HMGet(context.Context, *HMGetReq) (*HMGetRsp, error)
+	HMGetMember(context.Context, *HMGetMemberReq) (*HMGetMemberRsp, error)
+	HMPut(context.Context, *HMPutReq) (*HMPutRsp, error)
+	HMDel(context.Context, *HMDelReq) (*HMDelRsp, error)
+	HMDelMember(context.Context, *HMDelMemberReq) (*HMDelMemberRsp, error)
+	HMWatch(context.Context, *HMWatchReq) (*HMWatchRsp, error)
+	HMUnWatch(context.Context, *HMWatchReq) (*HMWatchRsp, error)
+	ClearMap(context.Context, *ClearReq) (*ClearRsp, error)
+	LGet(context.Context, *LGetReq) (*LGetRsp, error)
+	LGetRange(context.Context, *LGetRangeReq) (*LGetRangeRsp, error)
+	LPut(context.Context, *LPutReq) (*LPutRsp, error)
+	LDel(context.Context, *LDelReq) (*LDelRsp, error)
+	LDelRange(context.Context, *LDelRangeReq) (*LDelRangeRsp, error)
+	LWatch(context.Context, *LWatchReq) (*LWatchRsp, error)
+	LUnWatch(context.Context, *LWatchReq) (*LWatchRsp, error)
+	ClearList(context.Context, *ClearReq) (*ClearRsp, error)
+	SGet(context.Context, *SGetReq) (*SGetRsp, error)
+	SPut(context.Context, *SPutReq) (*SPutRsp, error)
+	SDel(context.Context, *SDelReq) (*SDelRsp, error)
+	SDelMember(context.Context, *SDelMemberReq) (*SDelMemberRsp, error)
+	SWatch(context.Context, *SWatchReq) (*SWatchRsp, error)
+	SUnWatch(context.Context, *SWatchReq) (*SWatchRsp, error)
+	ClearSet(context.Context, *ClearReq) (*ClearRsp, error)
+	PFAdd(context.Context, *PFAddReq) (*PFAddRsp, error)
+	PFCount(context.Context, *PFCountReq) (*PFCountRsp, error)
+	PFMerge(context.Context, *PFMergeReq) (*PFMergeRsp, error)
+	PFDel(context.Context, *PFDelReq) (*PFDelRsp, error)
+	ClearHLL(context.Context, *ClearReq) (*ClearRsp, error)
+	GeoAdd(context.Context, *GeoAddReq) (*GeoAddRsp, error)
+	GeoPos(context.Context, *GeoPosReq) (*GeoPosRsp, error)
+	GeoDist(context.Context, *GeoDistReq) (*GeoDistRsp, error)
+	GeoSearch(context.Context, *GeoSearchReq) (*GeoSearchRsp, error)
+	GeoDel(context.Context, *GeoDelReq) (*GeoDelRsp, error)
+	GeoDelMember(context.Context, *GeoDelMemberReq) (*GeoDelMemberRsp, error)
+	ClearGeo(context.Context, *ClearReq) (*ClearRsp, error)
+	XAdd(context.Context, *XAddReq) (*XAddRsp, error)
+	XLen(context.Context, *XLenReq) (*XLenRsp, error)
+	XRange(context.Context, *XRangeReq) (*XRangeRsp, error)
+	XRead(context.Context, *XReadReq) (*XReadRsp, error)
+	XGroupCreate(context.Context, *XGroupReq) (*XGroupRsp, error)
+	XGroupDestroy(context.Context, *XGroupReq) (*XGroupRsp, error)
+	XReadGroup(context.Context, *XReadGroupReq) (*XReadRsp, error)
+	XAck(context.Context, *XAckReq) (*XAckRsp, error)
+	XPending(context.Context, *XPendingReq) (*XPendingRsp, error)
+	XClaim(context.Context, *XClaimReq) (*XClaimRsp, error)
+	XTrim(context.Context, *XTrimReq) (*XTrimRsp, error)
+	XDelMember(context.Context, *XDelMemberReq) (*XDelMemberRsp, error)
+	XDel(context.Context, *XDelReq) (*XDelRsp, error)
+	ClearStream(context.Context, *ClearReq) (*ClearRsp, error)
+	JSet(context.Context, *JSetReq) (*JSetRsp, error)
+	JGet(context.Context, *JGetReq) (*JGetRsp, error)
+	JDelPath(context.Context, *JDelPathReq) (*JDelPathRsp, error)
+	JArrAppend(context.Context, *JArrAppendReq) (*JArrAppendRsp, error)
+	JNumIncrBy(context.Context, *JNumIncrByReq) (*JNumIncrByRsp, error)
+	JDel(context.Context, *JDelReq) (*JDelRsp, error)
+	JWatch(context.Context, *JWatchReq) (*JWatchRsp, error)
+	JUnWatch(context.Context, *JWatchReq) (*JWatchRsp, error)
+	ClearJSON(context.Context, *ClearReq) (*ClearRsp, error)
+	BFReserve(context.Context, *BFReserveReq) (*BFReserveRsp, error)
+	BFAdd(context.Context, *BFAddReq) (*BFAddRsp, error)
+	BFExists(context.Context, *BFExistsReq) (*BFExistsRsp, error)
+	BFInfo(context.Context, *BFInfoReq) (*BFInfoRsp, error)
+	BFDel(context.Context, *BFDelReq) (*BFDelRsp, error)
+	ClearBloom(context.Context, *ClearReq) (*ClearRsp, error)
+	CFReserve(context.Context, *CFReserveReq) (*CFReserveRsp, error)
+	CFAdd(context.Context, *CFAddReq) (*CFAddRsp, error)
+	CFExists(context.Context, *CFExistsReq) (*CFExistsRsp, error)
+	CFDelMember(context.Context, *CFDelMemberReq) (*CFDelMemberRsp, error)
+	CFInfo(context.Context, *CFInfoReq) (*CFInfoRsp, error)
+	CFDel(context.Context, *CFDelReq) (*CFDelRsp, error)
+	ClearCuckoo(context.Context, *ClearReq) (*ClearRsp, error)
+	TSCreate(context.Context, *TSCreateReq) (*TSCreateRsp, error)
+	TSAdd(context.Context, *TSAddReq) (*TSAddRsp, error)
+	TSRange(context.Context, *TSRangeReq) (*TSRangeRsp, error)
+	TSGet(context.Context, *TSGetReq) (*TSGetRsp, error)
+	TSDelRange(context.Context, *TSDelRangeReq) (*TSDelRangeRsp, error)
+	TSCreateRule(context.Context, *TSRuleReq) (*TSRuleRsp, error)
+	TSDeleteRule(context.Context, *TSRuleReq) (*TSRuleRsp, error)
+	TSInfo(context.Context, *TSInfoReq) (*TSInfoRsp, error)
+	TSDel(context.Context, *TSDelReq) (*TSDelRsp, error)
+	ClearTS(context.Context, *ClearReq) (*ClearRsp, error)
+	Scan(context.Context, *ScanReq) (*ScanRsp, error)
+	HScan(context.Context, *HScanReq) (*HScanRsp, error)
+	SScan(context.Context, *SScanReq) (*SScanRsp, error)
+	TTL(context.Context, *TTLReq) (*TTLRsp, error)
+	PTTL(context.Context, *TTLReq) (*TTLRsp, error)
+	Expire(context.Context, *ExpireReq) (*ExpireRsp, error)
+	ExpireAt(context.Context, *ExpireAtReq) (*ExpireRsp, error)
+	PExpire(context.Context, *PExpireReq) (*ExpireRsp, error)
+	PExpireAt(context.Context, *PExpireAtReq) (*ExpireRsp, error)
+	Persist(context.Context, *PersistReq) (*ExpireRsp, error)
+	Type(context.Context, *TypeReq) (*TypeRsp, error)
+	Exists(context.Context, *ExistsReq) (*ExistsRsp, error)
+	DelKeys(context.Context, *DelKeysReq) (*DelKeysRsp, error)
+	Rename(context.Context, *RenameReq) (*RenameRsp, error)
+	Select(context.Context, *SelectReq) (*SelectRsp, error)
+	CreateDB(context.Context, *CreateDBReq) (*CreateDBRsp, error)
+	Copy(context.Context, *CopyReq) (*CopyRsp, error)
+	Move(context.Context, *MoveReq) (*MoveRsp, error)
+	KeyInfo(context.Context, *KeyInfoReq) (*KeyInfoRsp, error)
+	KeyStats(context.Context, *KeyStatsReq) (*KeyStatsRsp, error)
+	MemoryUsage(context.Context, *MemoryUsageReq) (*MemoryUsageRsp, error)
+	MemoryStats(context.Context, *MemoryStatsReq) (*MemoryStatsRsp, error)
+	TxWatch(context.Context, *TxWatchReq) (*TxWatchRsp, error)
+	Exec(context.Context, *ExecReq) (*ExecRsp, error)
+	MGet(context.Context, *MGetReq) (*MGetRsp, error)
+	MSet(context.Context, *MSetReq) (*MSetRsp, error)
+	Batch(context.Context, *BatchReq) (*BatchRsp, error)
+}
+
+// UnimplementedRpcBridgeServer can be embedded to have forward compatible implementations.
+type UnimplementedRpcBridgeServer struct {
+}
+
+func (*UnimplementedRpcBridgeServer) Ping(context.Context, *PingReq) (*PingRsp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Ping not implemented")
+}
+func (*UnimplementedRpcBridgeServer) Publish(RpcBridge_PublishServer) error {
+	return status.Errorf(codes.Unimplemented, "method Publish not implemented")
+}
+func (*UnimplementedRpcBridgeServer) Get(context.Context, *GetReq) (*GetRsp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Get not implemented")
+}
+func (*UnimplementedRpcBridgeServer) Put(context.Context, *PutReq) (*PutRsp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Put not implemented")
+}
+func (*UnimplementedRpcBridgeServer) Del(context.Context, *DelReq) (*DelRsp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Del not implemented")
+}
+func (*UnimplementedRpcBridgeServer) WatchKey(context.Context, *WatchReq) (*WatchRsp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WatchKey not implemented")
+}
+func (*UnimplementedRpcBridgeServer) UnWatchKey(context.Context, *WatchReq) (*WatchRsp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnWatchKey not implemented")
+}
+func (*UnimplementedRpcBridgeServer) ClearValue(context.Context, *ClearReq) (*ClearRsp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClearValue not implemented")
+}
+func (*UnimplementedRpcBridgeServer) HMGet(context.Context, *HMGetReq) (*HMGetRsp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method HMGet not implemented")
+}
+func (*UnimplementedRpcBridgeServer) HMGetMember(context.Context, *HMGetMemberReq) (*HMGetMemberRsp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method HMGetMember not implemented")
+}
+func (*UnimplementedRpcBridgeServer) HMPut(context.Context, *HMPutReq) (*HMPutRsp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method HMPut not implemented")
+}
+func (*UnimplementedRpcBridgeServer) HMDel(context.Context, *HMDelReq) (*HMDelRsp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method HMDel not implemented")
+}
+func (*UnimplementedRpcBridgeServer) HMDelMember(context.Context, *HMDelMemberReq) (*HMDelMemberRsp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method HMDelMember not implemented")
+}
+func (*UnimplementedRpcBridgeServer) HMWatch(context.Context, *HMWatchReq) (*HMWatchRsp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method HMWatch not implemented")
+}
+func (*UnimplementedRpcBridgeServer) HMUnWatch(context.Context, *HMWatchReq) (*HMWatchRsp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method HMUnWatch not implemented")
+}
+func (*UnimplementedRpcBridgeServer) ClearMap(context.Context, *ClearReq) (*ClearRsp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClearMap not implemented")
+}
+func (*UnimplementedRpcBridgeServer) LGet(context.Context, *LGetReq) (*LGetRsp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LGet not implemented")
+}
+func (*UnimplementedRpcBridgeServer) LGetRange(context.Context, *LGetRangeReq) (*LGetRangeRsp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LGetRange not implemented")
+}
+func (*UnimplementedRpcBridgeServer) LPut(context.Context, *LPutReq) (*LPutRsp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LPut not implemented")
+}
+func (*UnimplementedRpcBridgeServer) LDel(context.Context, *LDelReq) (*LDelRsp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LDel not implemented")
+}
+func (*UnimplementedRpcBridgeServer) LDelRange(context.Context, *LDelRangeReq) (*LDelRangeRsp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LDelRange not implemented")
+}
+func (*UnimplementedRpcBridgeServer) LWatch(context.Context, *LWatchReq) (*LWatchRsp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LWatch not implemented")
+}
+func (*UnimplementedRpcBridgeServer) LUnWatch(context.Context, *LWatchReq) (*LWatchRsp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LUnWatch not implemented")
+}
+func (*UnimplementedRpcBridgeServer) ClearList(context.Context, *ClearReq) (*ClearRsp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClearList not implemented")
+}
+func (*UnimplementedRpcBridgeServer) SGet(context.Context, *SGetReq) (*SGetRsp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SGet not implemented")
+}
+func (*UnimplementedRpcBridgeServer) SPut(context.Context, *SPutReq) (*SPutRsp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SPut not implemented")
+}
+func (*UnimplementedRpcBridgeServer) SDel(context.Context, *SDelReq) (*SDelRsp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SDel not implemented")
+}
+func (*UnimplementedRpcBridgeServer) SDelMember(context.Context, *SDelMemberReq) (*SDelMemberRsp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SDelMember not implemented")
+}
+func (*UnimplementedRpcBridgeServer) SWatch(context.Context, *SWatchReq) (*SWatchRsp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SWatch not implemented")
+}
+func (*UnimplementedRpcBridgeServer) SUnWatch(context.Context, *SWatchReq) (*SWatchRsp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SUnWatch not implemented")
+}
+func (*UnimplementedRpcBridgeServer) ClearSet(context.Context, *ClearReq) (*ClearRsp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClearSet not implemented")
+}
+func (*UnimplementedRpcBridgeServer) PFAdd(context.Context, *PFAddReq) (*PFAddRsp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PFAdd not implemented")
+}
+func (*UnimplementedRpcBridgeServer) PFCount(context.Context, *PFCountReq) (*PFCountRsp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PFCount not implemented")
+}
+func (*UnimplementedRpcBridgeServer) PFMerge(context.Context, *PFMergeReq) (*PFMergeRsp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PFMerge not implemented")
+}
+func (*UnimplementedRpcBridgeServer) PFDel(context.Context, *PFDelReq) (*PFDelRsp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PFDel not implemented")
+}
+func (*UnimplementedRpcBridgeServer) ClearHLL(context.Context, *ClearReq) (*ClearRsp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClearHLL not implemented")
+}
+func (*UnimplementedRpcBridgeServer) GeoAdd(context.Context, *GeoAddReq) (*GeoAddRsp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GeoAdd not implemented")
+}
+func (*UnimplementedRpcBridgeServer) GeoPos(context.Context, *GeoPosReq) (*GeoPosRsp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GeoPos not implemented")
+}
+func (*UnimplementedRpcBridgeServer) GeoDist(context.Context, *GeoDistReq) (*GeoDistRsp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GeoDist not implemented")
+}
+func (*UnimplementedRpcBridgeServer) GeoSearch(context.Context, *GeoSearchReq) (*GeoSearchRsp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GeoSearch not implemented")
+}
+func (*UnimplementedRpcBridgeServer) GeoDel(context.Context, *GeoDelReq) (*GeoDelRsp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GeoDel not implemented")
+}
+func (*UnimplementedRpcBridgeServer) GeoDelMember(context.Context, *GeoDelMemberReq) (*GeoDelMemberRsp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GeoDelMember not implemented")
+}
+func (*UnimplementedRpcBridgeServer) ClearGeo(context.Context, *ClearReq) (*ClearRsp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClearGeo not implemented")
+}
+func (*UnimplementedRpcBridgeServer) XAdd(context.Context, *XAddReq) (*XAddRsp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method XAdd not implemented")
+}
+func (*UnimplementedRpcBridgeServer) XLen(context.Context, *XLenReq) (*XLenRsp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method XLen not implemented")
+}
+func (*UnimplementedRpcBridgeServer) XRange(context.Context, *XRangeReq) (*XRangeRsp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method XRange not implemented")
+}
+func (*UnimplementedRpcBridgeServer) XRead(context.Context, *XReadReq) (*XReadRsp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method XRead not implemented")
+}
+func (*UnimplementedRpcBridgeServer) XGroupCreate(context.Context, *XGroupReq) (*XGroupRsp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method XGroupCreate not implemented")
+}
+func (*UnimplementedRpcBridgeServer) XGroupDestroy(context.Context, *XGroupReq) (*XGroupRsp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method XGroupDestroy not implemented")
+}
+func (*UnimplementedRpcBridgeServer) XReadGroup(context.Context, *XReadGroupReq) (*XReadRsp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method XReadGroup not implemented")
+}
+func (*UnimplementedRpcBridgeServer) XAck(context.Context, *XAckReq) (*XAckRsp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method XAck not implemented")
+}
+func (*UnimplementedRpcBridgeServer) XPending(context.Context, *XPendingReq) (*XPendingRsp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method XPending not implemented")
+}
+func (*UnimplementedRpcBridgeServer) XClaim(context.Context, *XClaimReq) (*XClaimRsp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method XClaim not implemented")
+}
+func (*UnimplementedRpcBridgeServer) XTrim(context.Context, *XTrimReq) (*XTrimRsp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method XTrim not implemented")
+}
+func (*UnimplementedRpcBridgeServer) XDelMember(context.Context, *XDelMemberReq) (*XDelMemberRsp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method XDelMember not implemented")
+}
+func (*UnimplementedRpcBridgeServer) XDel(context.Context, *XDelReq) (*XDelRsp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method XDel not implemented")
+}
+func (*UnimplementedRpcBridgeServer) ClearStream(context.Context, *ClearReq) (*ClearRsp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClearStream not implemented")
+}
+func (*UnimplementedRpcBridgeServer) JSet(context.Context, *JSetReq) (*JSetRsp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method JSet not implemented")
+}
+func (*UnimplementedRpcBridgeServer) JGet(context.Context, *JGetReq) (*JGetRsp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method JGet not implemented")
+}
+func (*UnimplementedRpcBridgeServer) JDelPath(context.Context, *JDelPathReq) (*JDelPathRsp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method JDelPath not implemented")
+}
+func (*UnimplementedRpcBridgeServer) JArrAppend(context.Context, *JArrAppendReq) (*JArrAppendRsp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method JArrAppend not implemented")
+}
+func (*UnimplementedRpcBridgeServer) JNumIncrBy(context.Context, *JNumIncrByReq) (*JNumIncrByRsp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method JNumIncrBy not implemented")
+}
+func (*UnimplementedRpcBridgeServer) JDel(context.Context, *JDelReq) (*JDelRsp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method JDel not implemented")
+}
+func (*UnimplementedRpcBridgeServer) JWatch(context.Context, *JWatchReq) (*JWatchRsp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method JWatch not implemented")
+}
+func (*UnimplementedRpcBridgeServer) JUnWatch(context.Context, *JWatchReq) (*JWatchRsp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method JUnWatch not implemented")
+}
+func (*UnimplementedRpcBridgeServer) ClearJSON(context.Context, *ClearReq) (*ClearRsp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClearJSON not implemented")
+}
+func (*UnimplementedRpcBridgeServer) BFReserve(context.Context, *BFReserveReq) (*BFReserveRsp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BFReserve not implemented")
+}
+func (*UnimplementedRpcBridgeServer) BFAdd(context.Context, *BFAddReq) (*BFAddRsp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BFAdd not implemented")
+}
+func (*UnimplementedRpcBridgeServer) BFExists(context.Context, *BFExistsReq) (*BFExistsRsp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BFExists not implemented")
+}
+func (*UnimplementedRpcBridgeServer) BFInfo(context.Context, *BFInfoReq) (*BFInfoRsp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BFInfo not implemented")
+}
+func (*UnimplementedRpcBridgeServer) BFDel(context.Context, *BFDelReq) (*BFDelRsp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BFDel not implemented")
+}
+func (*UnimplementedRpcBridgeServer) ClearBloom(context.Context, *ClearReq) (*ClearRsp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClearBloom not implemented")
+}
+func (*UnimplementedRpcBridgeServer) CFReserve(context.Context, *CFReserveReq) (*CFReserveRsp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CFReserve not implemented")
+}
+func (*UnimplementedRpcBridgeServer) CFAdd(context.Context, *CFAddReq) (*CFAddRsp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CFAdd not implemented")
+}
+func (*UnimplementedRpcBridgeServer) CFExists(context.Context, *CFExistsReq) (*CFExistsRsp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CFExists not implemented")
+}
+func (*UnimplementedRpcBridgeServer) CFDelMember(context.Context, *CFDelMemberReq) (*CFDelMemberRsp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CFDelMember not implemented")
+}
+func (*UnimplementedRpcBridgeServer) CFInfo(context.Context, *CFInfoReq) (*CFInfoRsp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CFInfo not implemented")
+}
+func (*UnimplementedRpcBridgeServer) CFDel(context.Context, *CFDelReq) (*CFDelRsp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CFDel not implemented")
+}
+func (*UnimplementedRpcBridgeServer) ClearCuckoo(context.Context, *ClearReq) (*ClearRsp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClearCuckoo not implemented")
+}
+func (*UnimplementedRpcBridgeServer) TSCreate(context.Context, *TSCreateReq) (*TSCreateRsp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TSCreate not implemented")
+}
+func (*UnimplementedRpcBridgeServer) TSAdd(context.Context, *TSAddReq) (*TSAddRsp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TSAdd not implemented")
+}
+func (*UnimplementedRpcBridgeServer) TSRange(context.Context, *TSRangeReq) (*TSRangeRsp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TSRange not implemented")
+}
+func (*UnimplementedRpcBridgeServer) TSGet(context.Context, *TSGetReq) (*TSGetRsp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TSGet not implemented")
+}
+func (*UnimplementedRpcBridgeServer) TSDelRange(context.Context, *TSDelRangeReq) (*TSDelRangeRsp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TSDelRange not implemented")
+}
+func (*UnimplementedRpcBridgeServer) TSCreateRule(context.Context, *TSRuleReq) (*TSRuleRsp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TSCreateRule not implemented")
+}
+func (*UnimplementedRpcBridgeServer) TSDeleteRule(context.Context, *TSRuleReq) (*TSRuleRsp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TSDeleteRule not implemented")
+}
+func (*UnimplementedRpcBridgeServer) TSInfo(context.Context, *TSInfoReq) (*TSInfoRsp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TSInfo not implemented")
+}
+func (*UnimplementedRpcBridgeServer) TSDel(context.Context, *TSDelReq) (*TSDelRsp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TSDel not implemented")
+}
+func (*UnimplementedRpcBridgeServer) ClearTS(context.Context, *ClearReq) (*ClearRsp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClearTS not implemented")
+}
+func (*UnimplementedRpcBridgeServer) Scan(context.Context, *ScanReq) (*ScanRsp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Scan not implemented")
+}
+func (*UnimplementedRpcBridgeServer) HScan(context.Context, *HScanReq) (*HScanRsp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method HScan not implemented")
+}
+func (*UnimplementedRpcBridgeServer) SScan(context.Context, *SScanReq) (*SScanRsp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SScan not implemented")
+}
+func (*UnimplementedRpcBridgeServer) TTL(context.Context, *TTLReq) (*TTLRsp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TTL not implemented")
+}
+func (*UnimplementedRpcBridgeServer) PTTL(context.Context, *TTLReq) (*TTLRsp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PTTL not implemented")
+}
+func (*UnimplementedRpcBridgeServer) Expire(context.Context, *ExpireReq) (*ExpireRsp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Expire not implemented")
+}
+func (*UnimplementedRpcBridgeServer) ExpireAt(context.Context, *ExpireAtReq) (*ExpireRsp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExpireAt not implemented")
+}
+func (*UnimplementedRpcBridgeServer) PExpire(context.Context, *PExpireReq) (*ExpireRsp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PExpire not implemented")
+}
+func (*UnimplementedRpcBridgeServer) PExpireAt(context.Context, *PExpireAtReq) (*ExpireRsp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PExpireAt not implemented")
+}
+func (*UnimplementedRpcBridgeServer) Persist(context.Context, *PersistReq) (*ExpireRsp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Persist not implemented")
+}
+func (*UnimplementedRpcBridgeServer) Type(context.Context, *TypeReq) (*TypeRsp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Type not implemented")
+}
+func (*UnimplementedRpcBridgeServer) Exists(context.Context, *ExistsReq) (*ExistsRsp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Exists not implemented")
+}
+func (*UnimplementedRpcBridgeServer) DelKeys(context.Context, *DelKeysReq) (*DelKeysRsp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DelKeys not implemented")
+}
+func (*UnimplementedRpcBridgeServer) Rename(context.Context, *RenameReq) (*RenameRsp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Rename not implemented")
+}
+func (*UnimplementedRpcBridgeServer) Select(context.Context, *SelectReq) (*SelectRsp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Select not implemented")
+}
+func (*UnimplementedRpcBridgeServer) CreateDB(context.Context, *CreateDBReq) (*CreateDBRsp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateDB not implemented")
+}
+func (*UnimplementedRpcBridgeServer) Copy(context.Context, *CopyReq) (*CopyRsp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Copy not implemented")
+}
+func (*UnimplementedRpcBridgeServer) Move(context.Context, *MoveReq) (*MoveRsp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Move not implemented")
+}
+func (*UnimplementedRpcBridgeServer) KeyInfo(context.Context, *KeyInfoReq) (*KeyInfoRsp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method KeyInfo not implemented")
+}
+func (*UnimplementedRpcBridgeServer) KeyStats(context.Context, *KeyStatsReq) (*KeyStatsRsp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method KeyStats not implemented")
+}
+func (*UnimplementedRpcBridgeServer) MemoryUsage(context.Context, *MemoryUsageReq) (*MemoryUsageRsp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MemoryUsage not implemented")
+}
+func (*UnimplementedRpcBridgeServer) MemoryStats(context.Context, *MemoryStatsReq) (*MemoryStatsRsp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MemoryStats not implemented")
+}
+func (*UnimplementedRpcBridgeServer) TxWatch(context.Context, *TxWatchReq) (*TxWatchRsp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TxWatch not implemented")
+}
+func (*UnimplementedRpcBridgeServer) Exec(context.Context, *ExecReq) (*ExecRsp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Exec not implemented")
+}
+func (*UnimplementedRpcBridgeServer) MGet(context.Context, *MGetReq) (*MGetRsp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MGet not implemented")
+}
+func (*UnimplementedRpcBridgeServer) MSet(context.Context, *MSetReq) (*MSetRsp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MSet not implemented")
+}
+func (*UnimplementedRpcBridgeServer) Batch(context.Context, *BatchReq) (*BatchRsp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Batch not implemented")
+}
+
+func RegisterRpcBridgeServer(s *grpc.Server, srv RpcBridgeServer) {
+	s.RegisterService(&_RpcBridge_serviceDesc, srv)
+}
+
+func _RpcBridge_Ping_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PingReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RpcBridgeServer).Ping(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/bridge.RpcBridge/Ping",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RpcBridgeServer).Ping(ctx, req.(*PingReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RpcBridge_Publish_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(RpcBridgeServer).Publish(&rpcBridgePublishServer{stream})
+}
+
+type RpcBridge_PublishServer interface {
+	Send(*PublishRsp) error
+	Recv() (*PublishReq, error)
+	grpc.ServerStream
+}
+
+type rpcBridgePublishServer struct {
+	grpc.ServerStream
+}
+
+func (x *rpcBridgePublishServer) Send(m *PublishRsp) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *rpcBridgePublishServer) Recv() (*PublishReq, error) {
+	m := new(PublishReq)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func _RpcBridge_Get_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RpcBridgeServer).Get(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/bridge.RpcBridge/Get",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RpcBridgeServer).Get(ctx, req.(*GetReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RpcBridge_Put_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PutReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RpcBridgeServer).Put(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/bridge.RpcBridge/Put",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RpcBridgeServer).Put(ctx, req.(*PutReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RpcBridge_Del_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DelReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RpcBridgeServer).Del(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/bridge.RpcBridge/Del",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RpcBridgeServer).Del(ctx, req.(*DelReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RpcBridge_WatchKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WatchReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RpcBridgeServer).WatchKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/bridge.RpcBridge/WatchKey",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RpcBridgeServer).WatchKey(ctx, req.(*WatchReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RpcBridge_UnWatchKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WatchReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RpcBridgeServer).UnWatchKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/bridge.RpcBridge/UnWatchKey",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RpcBridgeServer).UnWatchKey(ctx, req.(*WatchReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RpcBridge_ClearValue_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ClearReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RpcBridgeServer).ClearValue(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/bridge.RpcBridge/ClearValue",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RpcBridgeServer).ClearValue(ctx, req.(*ClearReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RpcBridge_HMGet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(HMGetReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RpcBridgeServer).HMGet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/bridge.RpcBridge/HMGet",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RpcBridgeServer).HMGet(ctx, req.(*HMGetReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RpcBridge_HMGetMember_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(HMGetMemberReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RpcBridgeServer).HMGetMember(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/bridge.RpcBridge/HMGetMember",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RpcBridgeServer).HMGetMember(ctx, req.(*HMGetMemberReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RpcBridge_HMPut_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(HMPutReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RpcBridgeServer).HMPut(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/bridge.RpcBridge/HMPut",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RpcBridgeServer).HMPut(ctx, req.(*HMPutReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RpcBridge_HMDel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(HMDelReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RpcBridgeServer).HMDel(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/bridge.RpcBridge/HMDel",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RpcBridgeServer).HMDel(ctx, req.(*HMDelReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RpcBridge_HMDelMember_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(HMDelMemberReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RpcBridgeServer).HMDelMember(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/bridge.RpcBridge/HMDelMember",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RpcBridgeServer).HMDelMember(ctx, req.(*HMDelMemberReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RpcBridge_HMWatch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(HMWatchReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RpcBridgeServer).HMWatch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/bridge.RpcBridge/HMWatch",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RpcBridgeServer).HMWatch(ctx, req.(*HMWatchReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RpcBridge_HMUnWatch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(HMWatchReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RpcBridgeServer).HMUnWatch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/bridge.RpcBridge/HMUnWatch",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RpcBridgeServer).HMUnWatch(ctx, req.(*HMWatchReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RpcBridge_ClearMap_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ClearReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RpcBridgeServer).ClearMap(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/bridge.RpcBridge/ClearMap",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RpcBridgeServer).ClearMap(ctx, req.(*ClearReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RpcBridge_LGet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LGetReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RpcBridgeServer).LGet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/bridge.RpcBridge/LGet",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RpcBridgeServer).LGet(ctx, req.(*LGetReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RpcBridge_LGetRange_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LGetRangeReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RpcBridgeServer).LGetRange(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/bridge.RpcBridge/LGetRange",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RpcBridgeServer).LGetRange(ctx, req.(*LGetRangeReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RpcBridge_LPut_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LPutReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RpcBridgeServer).LPut(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/bridge.RpcBridge/LPut",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RpcBridgeServer).LPut(ctx, req.(*LPutReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RpcBridge_LDel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LDelReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RpcBridgeServer).LDel(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/bridge.RpcBridge/LDel",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RpcBridgeServer).LDel(ctx, req.(*LDelReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RpcBridge_LDelRange_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LDelRangeReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RpcBridgeServer).LDelRange(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/bridge.RpcBridge/LDelRange",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RpcBridgeServer).LDelRange(ctx, req.(*LDelRangeReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RpcBridge_LWatch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LWatchReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RpcBridgeServer).LWatch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/bridge.RpcBridge/LWatch",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RpcBridgeServer).LWatch(ctx, req.(*LWatchReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RpcBridge_LUnWatch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LWatchReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RpcBridgeServer).LUnWatch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/bridge.RpcBridge/LUnWatch",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RpcBridgeServer).LUnWatch(ctx, req.(*LWatchReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RpcBridge_ClearList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ClearReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RpcBridgeServer).ClearList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/bridge.RpcBridge/ClearList",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RpcBridgeServer).ClearList(ctx, req.(*ClearReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RpcBridge_SGet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SGetReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RpcBridgeServer).SGet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/bridge.RpcBridge/SGet",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RpcBridgeServer).SGet(ctx, req.(*SGetReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RpcBridge_SPut_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SPutReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RpcBridgeServer).SPut(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/bridge.RpcBridge/SPut",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RpcBridgeServer).SPut(ctx, req.(*SPutReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RpcBridge_SDel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SDelReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RpcBridgeServer).SDel(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/bridge.RpcBridge/SDel",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RpcBridgeServer).SDel(ctx, req.(*SDelReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RpcBridge_SDelMember_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SDelMemberReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RpcBridgeServer).SDelMember(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/bridge.RpcBridge/SDelMember",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RpcBridgeServer).SDelMember(ctx, req.(*SDelMemberReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RpcBridge_SWatch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SWatchReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RpcBridgeServer).SWatch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/bridge.RpcBridge/SWatch",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RpcBridgeServer).SWatch(ctx, req.(*SWatchReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RpcBridge_SUnWatch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SWatchReq)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	type x struct{}
-	out := protoimpl.TypeBuilder{
-		File: protoimpl.DescBuilder{
-			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
-			RawDescriptor: file_bridge_proto_rawDesc,
-			NumEnums:      0,
-			NumMessages:   48,
-			NumExtensions: 0,
-			NumServices:   1,
-		},
-		GoTypes:           file_bridge_proto_goTypes,
-		DependencyIndexes: file_bridge_proto_depIdxs,
-		MessageInfos:      file_bridge_proto_msgTypes,
-	}.Build()
-	File_bridge_proto = out.File
-	file_bridge_proto_rawDesc = nil
-	file_bridge_proto_goTypes = nil
-	file_bridge_proto_depIdxs = nil
+	if interceptor == nil {
+		return srv.(RpcBridgeServer).SUnWatch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/bridge.RpcBridge/SUnWatch",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RpcBridgeServer).SUnWatch(ctx, req.(*SWatchReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConnInterface
+func _RpcBridge_ClearSet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ClearReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RpcBridgeServer).ClearSet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/bridge.RpcBridge/ClearSet",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RpcBridgeServer).ClearSet(ctx, req.(*ClearReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
 
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion6
+func _RpcBridge_PFAdd_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PFAddReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RpcBridgeServer).PFAdd(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/bridge.RpcBridge/PFAdd",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RpcBridgeServer).PFAdd(ctx, req.(*PFAddReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
 
-// RpcBridgeClient is the client API for RpcBridge service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type RpcBridgeClient interface {
-	Ping(ctx context.Context, in *PingReq, opts ...grpc.CallOption) (*PingRsp, error)
-	Publish(ctx context.Context, opts ...grpc.CallOption) (RpcBridge_PublishClient, error)
-	Get(ctx context.Context, in *GetReq, opts ...grpc.CallOption) (*GetRsp, error)
-	Put(ctx context.Context, in *PutReq, opts ...grpc.CallOption) (*PutRsp, error)
-	Del(ctx context.Context, in *DelReq, opts ...grpc.CallOption) (*DelRsp, error)
-	WatchKey(ctx context.Context, in *WatchReq, opts ...grpc.CallOption) (*WatchRsp, error)
-	UnWatchKey(ctx context.Context, in *WatchReq, opts ...grpc.CallOption) (*WatchRsp, error)
-	ClearValue(ctx context.Context, in *ClearReq, opts ...grpc.CallOption) (*ClearRsp, error)
-	HMGet(ctx context.Context, in *HMGetReq, opts ...grpc.CallOption) (*HMGetRsp, error)
-	HMGetMember(ctx context.Context, in *HMGetMemberReq, opts ...grpc.CallOption) (*HMGetMemberRsp, error)
-	HMPut(ctx context.Context, in *HMPutReq, opts ...grpc.CallOption) (*HMPutRsp, error)
-	HMDel(ctx context.Context, in *HMDelReq, opts ...grpc.CallOption) (*HMDelRsp, error)
-	HMDelMember(ctx context.Context, in *HMDelMemberReq, opts ...grpc.CallOption) (*HMDelMemberRsp, error)
-	HMWatch(ctx context.Context, in *HMWatchReq, opts ...grpc.CallOption) (*HMWatchRsp, error)
-	HMUnWatch(ctx context.Context, in *HMWatchReq, opts ...grpc.CallOption) (*HMWatchRsp, error)
-	ClearMap(ctx context.Context, in *ClearReq, opts ...grpc.CallOption) (*ClearRsp, error)
-	LGet(ctx context.Context, in *LGetReq, opts ...grpc.CallOption) (*LGetRsp, error)
-	LGetRange(ctx context.Context, in *LGetRangeReq, opts ...grpc.CallOption) (*LGetRangeRsp, error)
-	LPut(ctx context.Context, in *LPutReq, opts ...grpc.CallOption) (*LPutRsp, error)
-	LDel(ctx context.Context, in *LDelReq, opts ...grpc.CallOption) (*LDelRsp, error)
-	LDelRange(ctx context.Context, in *LDelRangeReq, opts ...grpc.CallOption) (*LDelRangeRsp, error)
-	LWatch(ctx context.Context, in *LWatchReq, opts ...grpc.CallOption) (*LWatchRsp, error)
-	LUnWatch(ctx context.Context, in *LWatchReq, opts ...grpc.CallOption) (*LWatchRsp, error)
-	ClearList(ctx context.Context, in *ClearReq, opts ...grpc.CallOption) (*ClearRsp, error)
-	SGet(ctx context.Context, in *SGetReq, opts ...grpc.CallOption) (*SGetRsp, error)
-	SPut(ctx context.Context, in *SPutReq, opts ...grpc.CallOption) (*SPutRsp, error)
-	SDel(ctx context.Context, in *SDelReq, opts ...grpc.CallOption) (*SDelRsp, error)
-	SDelMember(ctx context.Context, in *SDelMemberReq, opts ...grpc.CallOption) (*SDelMemberRsp, error)
-	SWatch(ctx context.Context, in *SWatchReq, opts ...grpc.CallOption) (*SWatchRsp, error)
-	SUnWatch(ctx context.Context, in *SWatchReq, opts ...grpc.CallOption) (*SWatchRsp, error)
-	ClearSet(ctx context.Context, in *ClearReq, opts ...grpc.CallOption) (*ClearRsp, error)
+func _RpcBridge_PFCount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PFCountReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RpcBridgeServer).PFCount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/bridge.RpcBridge/PFCount",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RpcBridgeServer).PFCount(ctx, req.(*PFCountReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-type rpcBridgeClient struct {
-	cc grpc.ClientConnInterface
+func _RpcBridge_PFMerge_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PFMergeReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RpcBridgeServer).PFMerge(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/bridge.RpcBridge/PFMerge",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RpcBridgeServer).PFMerge(ctx, req.(*PFMergeReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func NewRpcBridgeClient(cc grpc.ClientConnInterface) RpcBridgeClient {
-	return &rpcBridgeClient{cc}
+func _RpcBridge_PFDel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PFDelReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RpcBridgeServer).PFDel(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/bridge.RpcBridge/PFDel",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RpcBridgeServer).PFDel(ctx, req.(*PFDelReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *rpcBridgeClient) Ping(ctx context.Context, in *PingReq, opts ...grpc.CallOption) (*PingRsp, error) {
-	out := new(PingRsp)
-	err := c.cc.Invoke(ctx, "/bridge.RpcBridge/Ping", in, out, opts...)
-	if err != nil {
+func _RpcBridge_ClearHLL_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ClearReq)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(RpcBridgeServer).ClearHLL(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/bridge.RpcBridge/ClearHLL",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RpcBridgeServer).ClearHLL(ctx, req.(*ClearReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *rpcBridgeClient) Publish(ctx context.Context, opts ...grpc.CallOption) (RpcBridge_PublishClient, error) {
-	stream, err := c.cc.NewStream(ctx, &_RpcBridge_serviceDesc.Streams[0], "/bridge.RpcBridge/Publish", opts...)
-	if err != nil {
+func _RpcBridge_GeoAdd_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GeoAddReq)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	x := &rpcBridgePublishClient{stream}
-	return x, nil
+	if interceptor == nil {
+		return srv.(RpcBridgeServer).GeoAdd(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/bridge.RpcBridge/GeoAdd",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RpcBridgeServer).GeoAdd(ctx, req.(*GeoAddReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-type RpcBridge_PublishClient interface {
-	Send(*PublishReq) error
-	Recv() (*PublishRsp, error)
-	grpc.ClientStream
+func _RpcBridge_GeoPos_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GeoPosReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RpcBridgeServer).GeoPos(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/bridge.RpcBridge/GeoPos",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RpcBridgeServer).GeoPos(ctx, req.(*GeoPosReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-type rpcBridgePublishClient struct {
-	grpc.ClientStream
+func _RpcBridge_GeoDist_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GeoDistReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RpcBridgeServer).GeoDist(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/bridge.RpcBridge/GeoDist",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RpcBridgeServer).GeoDist(ctx, req.(*GeoDistReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (x *rpcBridgePublishClient) Send(m *PublishReq) error {
-	return x.ClientStream.SendMsg(m)
+func _RpcBridge_GeoSearch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GeoSearchReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RpcBridgeServer).GeoSearch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/bridge.RpcBridge/GeoSearch",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RpcBridgeServer).GeoSearch(ctx, req.(*GeoSearchReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (x *rpcBridgePublishClient) Recv() (*PublishRsp, error) {
-	m := new(PublishRsp)
-	if err := x.ClientStream.RecvMsg(m); err != nil {
+func _RpcBridge_GeoDel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GeoDelReq)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return m, nil
+	if interceptor == nil {
+		return srv.(RpcBridgeServer).GeoDel(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/bridge.RpcBridge/GeoDel",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RpcBridgeServer).GeoDel(ctx, req.(*GeoDelReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *rpcBridgeClient) Get(ctx context.Context, in *GetReq, opts ...grpc.CallOption) (*GetRsp, error) {
-	out := new(GetRsp)
-	err := c.cc.Invoke(ctx, "/bridge.RpcBridge/Get", in, out, opts...)
-	if err != nil {
+func _RpcBridge_GeoDelMember_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GeoDelMemberReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RpcBridgeServer).GeoDelMember(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/bridge.RpcBridge/GeoDelMember",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RpcBridgeServer).GeoDelMember(ctx, req.(*GeoDelMemberReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RpcBridge_ClearGeo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ClearReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RpcBridgeServer).ClearGeo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/bridge.RpcBridge/ClearGeo",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RpcBridgeServer).ClearGeo(ctx, req.(*ClearReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RpcBridge_XAdd_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(XAddReq)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(RpcBridgeServer).XAdd(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/bridge.RpcBridge/XAdd",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RpcBridgeServer).XAdd(ctx, req.(*XAddReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *rpcBridgeClient) Put(ctx context.Context, in *PutReq, opts ...grpc.CallOption) (*PutRsp, error) {
-	out := new(PutRsp)
-	err := c.cc.Invoke(ctx, "/bridge.RpcBridge/Put", in, out, opts...)
-	if err != nil {
+func _RpcBridge_XLen_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(XLenReq)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(RpcBridgeServer).XLen(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/bridge.RpcBridge/XLen",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RpcBridgeServer).XLen(ctx, req.(*XLenReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *rpcBridgeClient) Del(ctx context.Context, in *DelReq, opts ...grpc.CallOption) (*DelRsp, error) {
-	out := new(DelRsp)
-	err := c.cc.Invoke(ctx, "/bridge.RpcBridge/Del", in, out, opts...)
-	if err != nil {
+func _RpcBridge_XRange_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(XRangeReq)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(RpcBridgeServer).XRange(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/bridge.RpcBridge/XRange",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RpcBridgeServer).XRange(ctx, req.(*XRangeReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *rpcBridgeClient) WatchKey(ctx context.Context, in *WatchReq, opts ...grpc.CallOption) (*WatchRsp, error) {
-	out := new(WatchRsp)
-	err := c.cc.Invoke(ctx, "/bridge.RpcBridge/WatchKey", in, out, opts...)
-	if err != nil {
+func _RpcBridge_XRead_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(XReadReq)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(RpcBridgeServer).XRead(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/bridge.RpcBridge/XRead",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RpcBridgeServer).XRead(ctx, req.(*XReadReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *rpcBridgeClient) UnWatchKey(ctx context.Context, in *WatchReq, opts ...grpc.CallOption) (*WatchRsp, error) {
-	out := new(WatchRsp)
-	err := c.cc.Invoke(ctx, "/bridge.RpcBridge/UnWatchKey", in, out, opts...)
-	if err != nil {
+func _RpcBridge_XGroupCreate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(XGroupReq)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(RpcBridgeServer).XGroupCreate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/bridge.RpcBridge/XGroupCreate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RpcBridgeServer).XGroupCreate(ctx, req.(*XGroupReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *rpcBridgeClient) ClearValue(ctx context.Context, in *ClearReq, opts ...grpc.CallOption) (*ClearRsp, error) {
-	out := new(ClearRsp)
-	err := c.cc.Invoke(ctx, "/bridge.RpcBridge/ClearString", in, out, opts...)
-	if err != nil {
+func _RpcBridge_XGroupDestroy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(XGroupReq)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(RpcBridgeServer).XGroupDestroy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/bridge.RpcBridge/XGroupDestroy",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RpcBridgeServer).XGroupDestroy(ctx, req.(*XGroupReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *rpcBridgeClient) HMGet(ctx context.Context, in *HMGetReq, opts ...grpc.CallOption) (*HMGetRsp, error) {
-	out := new(HMGetRsp)
-	err := c.cc.Invoke(ctx, "/bridge.RpcBridge/HMGet", in, out, opts...)
-	if err != nil {
+func _RpcBridge_XReadGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(XReadGroupReq)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(RpcBridgeServer).XReadGroup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/bridge.RpcBridge/XReadGroup",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RpcBridgeServer).XReadGroup(ctx, req.(*XReadGroupReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *rpcBridgeClient) HMGetMember(ctx context.Context, in *HMGetMemberReq, opts ...grpc.CallOption) (*HMGetMemberRsp, error) {
-	out := new(HMGetMemberRsp)
-	err := c.cc.Invoke(ctx, "/bridge.RpcBridge/HMGetMember", in, out, opts...)
-	if err != nil {
+func _RpcBridge_XAck_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(XAckReq)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(RpcBridgeServer).XAck(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/bridge.RpcBridge/XAck",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RpcBridgeServer).XAck(ctx, req.(*XAckReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *rpcBridgeClient) HMPut(ctx context.Context, in *HMPutReq, opts ...grpc.CallOption) (*HMPutRsp, error) {
-	out := new(HMPutRsp)
-	err := c.cc.Invoke(ctx, "/bridge.RpcBridge/HMPut", in, out, opts...)
-	if err != nil {
+func _RpcBridge_XPending_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(XPendingReq)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(RpcBridgeServer).XPending(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/bridge.RpcBridge/XPending",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RpcBridgeServer).XPending(ctx, req.(*XPendingReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *rpcBridgeClient) HMDel(ctx context.Context, in *HMDelReq, opts ...grpc.CallOption) (*HMDelRsp, error) {
-	out := new(HMDelRsp)
-	err := c.cc.Invoke(ctx, "/bridge.RpcBridge/HMDel", in, out, opts...)
-	if err != nil {
+func _RpcBridge_XClaim_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(XClaimReq)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(RpcBridgeServer).XClaim(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/bridge.RpcBridge/XClaim",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RpcBridgeServer).XClaim(ctx, req.(*XClaimReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *rpcBridgeClient) HMDelMember(ctx context.Context, in *HMDelMemberReq, opts ...grpc.CallOption) (*HMDelMemberRsp, error) {
-	out := new(HMDelMemberRsp)
-	err := c.cc.Invoke(ctx, "/bridge.RpcBridge/HMDelMember", in, out, opts...)
-	if err != nil {
+func _RpcBridge_XTrim_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(XTrimReq)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(RpcBridgeServer).XTrim(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/bridge.RpcBridge/XTrim",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RpcBridgeServer).XTrim(ctx, req.(*XTrimReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *rpcBridgeClient) HMWatch(ctx context.Context, in *HMWatchReq, opts ...grpc.CallOption) (*HMWatchRsp, error) {
-	out := new(HMWatchRsp)
-	err := c.cc.Invoke(ctx, "/bridge.RpcBridge/HMWatch", in, out, opts...)
-	if err != nil {
+func _RpcBridge_XDelMember_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(XDelMemberReq)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(RpcBridgeServer).XDelMember(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/bridge.RpcBridge/XDelMember",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RpcBridgeServer).XDelMember(ctx, req.(*XDelMemberReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *rpcBridgeClient) HMUnWatch(ctx context.Context, in *HMWatchReq, opts ...grpc.CallOption) (*HMWatchRsp, error) {
-	out := new(HMWatchRsp)
-	err := c.cc.Invoke(ctx, "/bridge.RpcBridge/HMUnWatch", in, out, opts...)
-	if err != nil {
+func _RpcBridge_XDel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(XDelReq)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(RpcBridgeServer).XDel(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/bridge.RpcBridge/XDel",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RpcBridgeServer).XDel(ctx, req.(*XDelReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *rpcBridgeClient) ClearMap(ctx context.Context, in *ClearReq, opts ...grpc.CallOption) (*ClearRsp, error) {
-	out := new(ClearRsp)
-	err := c.cc.Invoke(ctx, "/bridge.RpcBridge/ClearMap", in, out, opts...)
-	if err != nil {
+func _RpcBridge_ClearStream_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ClearReq)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(RpcBridgeServer).ClearStream(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/bridge.RpcBridge/ClearStream",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RpcBridgeServer).ClearStream(ctx, req.(*ClearReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *rpcBridgeClient) LGet(ctx context.Context, in *LGetReq, opts ...grpc.CallOption) (*LGetRsp, error) {
-	out := new(LGetRsp)
-	err := c.cc.Invoke(ctx, "/bridge.RpcBridge/LGet", in, out, opts...)
-	if err != nil {
+func _RpcBridge_JSet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(JSetReq)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(RpcBridgeServer).JSet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/bridge.RpcBridge/JSet",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RpcBridgeServer).JSet(ctx, req.(*JSetReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *rpcBridgeClient) LGetRange(ctx context.Context, in *LGetRangeReq, opts ...grpc.CallOption) (*LGetRangeRsp, error) {
-	out := new(LGetRangeRsp)
-	err := c.cc.Invoke(ctx, "/bridge.RpcBridge/LGetRange", in, out, opts...)
-	if err != nil {
+func _RpcBridge_JGet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(JGetReq)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(RpcBridgeServer).JGet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/bridge.RpcBridge/JGet",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RpcBridgeServer).JGet(ctx, req.(*JGetReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *rpcBridgeClient) LPut(ctx context.Context, in *LPutReq, opts ...grpc.CallOption) (*LPutRsp, error) {
-	out := new(LPutRsp)
-	err := c.cc.Invoke(ctx, "/bridge.RpcBridge/LPut", in, out, opts...)
-	if err != nil {
+func _RpcBridge_JDelPath_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(JDelPathReq)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(RpcBridgeServer).JDelPath(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/bridge.RpcBridge/JDelPath",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RpcBridgeServer).JDelPath(ctx, req.(*JDelPathReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *rpcBridgeClient) LDel(ctx context.Context, in *LDelReq, opts ...grpc.CallOption) (*LDelRsp, error) {
-	out := new(LDelRsp)
-	err := c.cc.Invoke(ctx, "/bridge.RpcBridge/LDel", in, out, opts...)
-	if err != nil {
+func _RpcBridge_JArrAppend_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(JArrAppendReq)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(RpcBridgeServer).JArrAppend(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/bridge.RpcBridge/JArrAppend",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RpcBridgeServer).JArrAppend(ctx, req.(*JArrAppendReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *rpcBridgeClient) LDelRange(ctx context.Context, in *LDelRangeReq, opts ...grpc.CallOption) (*LDelRangeRsp, error) {
-	out := new(LDelRangeRsp)
-	err := c.cc.Invoke(ctx, "/bridge.RpcBridge/LDelRange", in, out, opts...)
-	if err != nil {
+func _RpcBridge_JNumIncrBy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(JNumIncrByReq)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(RpcBridgeServer).JNumIncrBy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/bridge.RpcBridge/JNumIncrBy",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RpcBridgeServer).JNumIncrBy(ctx, req.(*JNumIncrByReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *rpcBridgeClient) LWatch(ctx context.Context, in *LWatchReq, opts ...grpc.CallOption) (*LWatchRsp, error) {
-	out := new(LWatchRsp)
-	err := c.cc.Invoke(ctx, "/bridge.RpcBridge/LWatch", in, out, opts...)
-	if err != nil {
+func _RpcBridge_JDel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(JDelReq)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(RpcBridgeServer).JDel(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/bridge.RpcBridge/JDel",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RpcBridgeServer).JDel(ctx, req.(*JDelReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *rpcBridgeClient) LUnWatch(ctx context.Context, in *LWatchReq, opts ...grpc.CallOption) (*LWatchRsp, error) {
-	out := new(LWatchRsp)
-	err := c.cc.Invoke(ctx, "/bridge.RpcBridge/LUnWatch", in, out, opts...)
-	if err != nil {
+func _RpcBridge_JWatch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(JWatchReq)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(RpcBridgeServer).JWatch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/bridge.RpcBridge/JWatch",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RpcBridgeServer).JWatch(ctx, req.(*JWatchReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *rpcBridgeClient) ClearList(ctx context.Context, in *ClearReq, opts ...grpc.CallOption) (*ClearRsp, error) {
-	out := new(ClearRsp)
-	err := c.cc.Invoke(ctx, "/bridge.RpcBridge/ClearList", in, out, opts...)
-	if err != nil {
+func _RpcBridge_JUnWatch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(JWatchReq)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(RpcBridgeServer).JUnWatch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/bridge.RpcBridge/JUnWatch",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RpcBridgeServer).JUnWatch(ctx, req.(*JWatchReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *rpcBridgeClient) SGet(ctx context.Context, in *SGetReq, opts ...grpc.CallOption) (*SGetRsp, error) {
-	out := new(SGetRsp)
-	err := c.cc.Invoke(ctx, "/bridge.RpcBridge/SGet", in, out, opts...)
-	if err != nil {
+func _RpcBridge_ClearJSON_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ClearReq)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(RpcBridgeServer).ClearJSON(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/bridge.RpcBridge/ClearJSON",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RpcBridgeServer).ClearJSON(ctx, req.(*ClearReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *rpcBridgeClient) SPut(ctx context.Context, in *SPutReq, opts ...grpc.CallOption) (*SPutRsp, error) {
-	out := new(SPutRsp)
-	err := c.cc.Invoke(ctx, "/bridge.RpcBridge/SPut", in, out, opts...)
-	if err != nil {
+func _RpcBridge_BFReserve_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BFReserveReq)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(RpcBridgeServer).BFReserve(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/bridge.RpcBridge/BFReserve",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RpcBridgeServer).BFReserve(ctx, req.(*BFReserveReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *rpcBridgeClient) SDel(ctx context.Context, in *SDelReq, opts ...grpc.CallOption) (*SDelRsp, error) {
-	out := new(SDelRsp)
-	err := c.cc.Invoke(ctx, "/bridge.RpcBridge/SDel", in, out, opts...)
-	if err != nil {
+func _RpcBridge_BFAdd_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BFAddReq)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(RpcBridgeServer).BFAdd(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/bridge.RpcBridge/BFAdd",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RpcBridgeServer).BFAdd(ctx, req.(*BFAddReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *rpcBridgeClient) SDelMember(ctx context.Context, in *SDelMemberReq, opts ...grpc.CallOption) (*SDelMemberRsp, error) {
-	out := new(SDelMemberRsp)
-	err := c.cc.Invoke(ctx, "/bridge.RpcBridge/SDelMember", in, out, opts...)
-	if err != nil {
+func _RpcBridge_BFExists_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BFExistsReq)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(RpcBridgeServer).BFExists(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/bridge.RpcBridge/BFExists",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RpcBridgeServer).BFExists(ctx, req.(*BFExistsReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *rpcBridgeClient) SWatch(ctx context.Context, in *SWatchReq, opts ...grpc.CallOption) (*SWatchRsp, error) {
-	out := new(SWatchRsp)
-	err := c.cc.Invoke(ctx, "/bridge.RpcBridge/SWatch", in, out, opts...)
-	if err != nil {
+func _RpcBridge_BFInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BFInfoReq)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(RpcBridgeServer).BFInfo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/bridge.RpcBridge/BFInfo",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RpcBridgeServer).BFInfo(ctx, req.(*BFInfoReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *rpcBridgeClient) SUnWatch(ctx context.Context, in *SWatchReq, opts ...grpc.CallOption) (*SWatchRsp, error) {
-	out := new(SWatchRsp)
-	err := c.cc.Invoke(ctx, "/bridge.RpcBridge/SUnWatch", in, out, opts...)
-	if err != nil {
+func _RpcBridge_BFDel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BFDelReq)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(RpcBridgeServer).BFDel(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/bridge.RpcBridge/BFDel",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RpcBridgeServer).BFDel(ctx, req.(*BFDelReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *rpcBridgeClient) ClearSet(ctx context.Context, in *ClearReq, opts ...grpc.CallOption) (*ClearRsp, error) {
-	out := new(ClearRsp)
-	err := c.cc.Invoke(ctx, "/bridge.RpcBridge/ClearSet", in, out, opts...)
-	if err != nil {
+func _RpcBridge_ClearBloom_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ClearReq)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
-}
-
-// RpcBridgeServer is the server API for RpcBridge service.
-type RpcBridgeServer interface {
-	Ping(context.Context, *PingReq) (*PingRsp, error)
-	Publish(RpcBridge_PublishServer) error
-	Get(context.Context, *GetReq) (*GetRsp, error)
-	Put(context.Context, *PutReq) (*PutRsp, error)
-	Del(context.Context, *DelReq) (*DelRsp, error)
-	WatchKey(context.Context, *WatchReq) (*WatchRsp, error)
-	UnWatchKey(context.Context, *WatchReq) (*WatchRsp, error)
-	ClearValue(context.Context, *ClearReq) (*ClearRsp, error)
-	HMGet(context.Context, *HMGetReq) (*HMGetRsp, error)
-	HMGetMember(context.Context, *HMGetMemberReq) (*HMGetMemberRsp, error)
-	HMPut(context.Context, *HMPutReq) (*HMPutRsp, error)
-	HMDel(context.Context, *HMDelReq) (*HMDelRsp, error)
-	HMDelMember(context.Context, *HMDelMemberReq) (*HMDelMemberRsp, error)
-	HMWatch(context.Context, *HMWatchReq) (*HMWatchRsp, error)
-	HMUnWatch(context.Context, *HMWatchReq) (*HMWatchRsp, error)
-	ClearMap(context.Context, *ClearReq) (*ClearRsp, error)
-	LGet(context.Context, *LGetReq) (*LGetRsp, error)
-	LGetRange(context.Context, *LGetRangeReq) (*LGetRangeRsp, error)
-	LPut(context.Context, *LPutReq) (*LPutRsp, error)
-	LDel(context.Context, *LDelReq) (*LDelRsp, error)
-	LDelRange(context.Context, *LDelRangeReq) (*LDelRangeRsp, error)
-	LWatch(context.Context, *LWatchReq) (*LWatchRsp, error)
-	LUnWatch(context.Context, *LWatchReq) (*LWatchRsp, error)
-	ClearList(context.Context, *ClearReq) (*ClearRsp, error)
-	SGet(context.Context, *SGetReq) (*SGetRsp, error)
-	SPut(context.Context, *SPutReq) (*SPutRsp, error)
-	SDel(context.Context, *SDelReq) (*SDelRsp, error)
-	SDelMember(context.Context, *SDelMemberReq) (*SDelMemberRsp, error)
-	SWatch(context.Context, *SWatchReq) (*SWatchRsp, error)
-	SUnWatch(context.Context, *SWatchReq) (*SWatchRsp, error)
-	ClearSet(context.Context, *ClearReq) (*ClearRsp, error)
-}
-
-// UnimplementedRpcBridgeServer can be embedded to have forward compatible implementations.
-type UnimplementedRpcBridgeServer struct {
-}
-
-func (*UnimplementedRpcBridgeServer) Ping(context.Context, *PingReq) (*PingRsp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Ping not implemented")
-}
-func (*UnimplementedRpcBridgeServer) Publish(RpcBridge_PublishServer) error {
-	return status.Errorf(codes.Unimplemented, "method Publish not implemented")
-}
-func (*UnimplementedRpcBridgeServer) Get(context.Context, *GetReq) (*GetRsp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Get not implemented")
-}
-func (*UnimplementedRpcBridgeServer) Put(context.Context, *PutReq) (*PutRsp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Put not implemented")
-}
-func (*UnimplementedRpcBridgeServer) Del(context.Context, *DelReq) (*DelRsp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Del not implemented")
-}
-func (*UnimplementedRpcBridgeServer) WatchKey(context.Context, *WatchReq) (*WatchRsp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method WatchKey not implemented")
-}
-func (*UnimplementedRpcBridgeServer) UnWatchKey(context.Context, *WatchReq) (*WatchRsp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UnWatchKey not implemented")
-}
-func (*UnimplementedRpcBridgeServer) ClearValue(context.Context, *ClearReq) (*ClearRsp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ClearString not implemented")
-}
-func (*UnimplementedRpcBridgeServer) HMGet(context.Context, *HMGetReq) (*HMGetRsp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method HMGet not implemented")
-}
-func (*UnimplementedRpcBridgeServer) HMGetMember(context.Context, *HMGetMemberReq) (*HMGetMemberRsp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method HMGetMember not implemented")
-}
-func (*UnimplementedRpcBridgeServer) HMPut(context.Context, *HMPutReq) (*HMPutRsp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method HMPut not implemented")
-}
-func (*UnimplementedRpcBridgeServer) HMDel(context.Context, *HMDelReq) (*HMDelRsp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method HMDel not implemented")
-}
-func (*UnimplementedRpcBridgeServer) HMDelMember(context.Context, *HMDelMemberReq) (*HMDelMemberRsp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method HMDelMember not implemented")
-}
-func (*UnimplementedRpcBridgeServer) HMWatch(context.Context, *HMWatchReq) (*HMWatchRsp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method HMWatch not implemented")
-}
-func (*UnimplementedRpcBridgeServer) HMUnWatch(context.Context, *HMWatchReq) (*HMWatchRsp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method HMUnWatch not implemented")
-}
-func (*UnimplementedRpcBridgeServer) ClearMap(context.Context, *ClearReq) (*ClearRsp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ClearMap not implemented")
-}
-func (*UnimplementedRpcBridgeServer) LGet(context.Context, *LGetReq) (*LGetRsp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method LGet not implemented")
-}
-func (*UnimplementedRpcBridgeServer) LGetRange(context.Context, *LGetRangeReq) (*LGetRangeRsp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method LGetRange not implemented")
-}
-func (*UnimplementedRpcBridgeServer) LPut(context.Context, *LPutReq) (*LPutRsp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method LPut not implemented")
-}
-func (*UnimplementedRpcBridgeServer) LDel(context.Context, *LDelReq) (*LDelRsp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method LDel not implemented")
-}
-func (*UnimplementedRpcBridgeServer) LDelRange(context.Context, *LDelRangeReq) (*LDelRangeRsp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method LDelRange not implemented")
-}
-func (*UnimplementedRpcBridgeServer) LWatch(context.Context, *LWatchReq) (*LWatchRsp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method LWatch not implemented")
-}
-func (*UnimplementedRpcBridgeServer) LUnWatch(context.Context, *LWatchReq) (*LWatchRsp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method LUnWatch not implemented")
-}
-func (*UnimplementedRpcBridgeServer) ClearList(context.Context, *ClearReq) (*ClearRsp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ClearList not implemented")
+	if interceptor == nil {
+		return srv.(RpcBridgeServer).ClearBloom(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/bridge.RpcBridge/ClearBloom",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RpcBridgeServer).ClearBloom(ctx, req.(*ClearReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (*UnimplementedRpcBridgeServer) SGet(context.Context, *SGetReq) (*SGetRsp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SGet not implemented")
+
+func _RpcBridge_CFReserve_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CFReserveReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RpcBridgeServer).CFReserve(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/bridge.RpcBridge/CFReserve",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RpcBridgeServer).CFReserve(ctx, req.(*CFReserveReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (*UnimplementedRpcBridgeServer) SPut(context.Context, *SPutReq) (*SPutRsp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SPut not implemented")
+
+func _RpcBridge_CFAdd_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CFAddReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RpcBridgeServer).CFAdd(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/bridge.RpcBridge/CFAdd",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RpcBridgeServer).CFAdd(ctx, req.(*CFAddReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (*UnimplementedRpcBridgeServer) SDel(context.Context, *SDelReq) (*SDelRsp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SDel not implemented")
+
+func _RpcBridge_CFExists_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CFExistsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RpcBridgeServer).CFExists(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/bridge.RpcBridge/CFExists",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RpcBridgeServer).CFExists(ctx, req.(*CFExistsReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (*UnimplementedRpcBridgeServer) SDelMember(context.Context, *SDelMemberReq) (*SDelMemberRsp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SDelMember not implemented")
+
+func _RpcBridge_CFDelMember_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CFDelMemberReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RpcBridgeServer).CFDelMember(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/bridge.RpcBridge/CFDelMember",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RpcBridgeServer).CFDelMember(ctx, req.(*CFDelMemberReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (*UnimplementedRpcBridgeServer) SWatch(context.Context, *SWatchReq) (*SWatchRsp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SWatch not implemented")
+
+func _RpcBridge_CFInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CFInfoReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RpcBridgeServer).CFInfo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/bridge.RpcBridge/CFInfo",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RpcBridgeServer).CFInfo(ctx, req.(*CFInfoReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (*UnimplementedRpcBridgeServer) SUnWatch(context.Context, *SWatchReq) (*SWatchRsp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SUnWatch not implemented")
+
+func _RpcBridge_CFDel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CFDelReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RpcBridgeServer).CFDel(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/bridge.RpcBridge/CFDel",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RpcBridgeServer).CFDel(ctx, req.(*CFDelReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (*UnimplementedRpcBridgeServer) ClearSet(context.Context, *ClearReq) (*ClearRsp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ClearSet not implemented")
+
+func _RpcBridge_ClearCuckoo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ClearReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RpcBridgeServer).ClearCuckoo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/bridge.RpcBridge/ClearCuckoo",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RpcBridgeServer).ClearCuckoo(ctx, req.(*ClearReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func RegisterRpcBridgeServer(s *grpc.Server, srv RpcBridgeServer) {
-	s.RegisterService(&_RpcBridge_serviceDesc, srv)
+func _RpcBridge_TSCreate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TSCreateReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RpcBridgeServer).TSCreate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/bridge.RpcBridge/TSCreate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RpcBridgeServer).TSCreate(ctx, req.(*TSCreateReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func _RpcBridge_Ping_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(PingReq)
+func _RpcBridge_TSAdd_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TSAddReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(RpcBridgeServer).Ping(ctx, in)
+		return srv.(RpcBridgeServer).TSAdd(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/bridge.RpcBridge/Ping",
+		FullMethod: "/bridge.RpcBridge/TSAdd",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(RpcBridgeServer).Ping(ctx, req.(*PingReq))
+		return srv.(RpcBridgeServer).TSAdd(ctx, req.(*TSAddReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _RpcBridge_Publish_Handler(srv interface{}, stream grpc.ServerStream) error {
-	return srv.(RpcBridgeServer).Publish(&rpcBridgePublishServer{stream})
+func _RpcBridge_TSRange_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TSRangeReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RpcBridgeServer).TSRange(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/bridge.RpcBridge/TSRange",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RpcBridgeServer).TSRange(ctx, req.(*TSRangeReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-type RpcBridge_PublishServer interface {
-	Send(*PublishRsp) error
-	Recv() (*PublishReq, error)
-	grpc.ServerStream
+func _RpcBridge_TSGet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TSGetReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RpcBridgeServer).TSGet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/bridge.RpcBridge/TSGet",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RpcBridgeServer).TSGet(ctx, req.(*TSGetReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-type rpcBridgePublishServer struct {
-	grpc.ServerStream
+func _RpcBridge_TSDelRange_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TSDelRangeReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RpcBridgeServer).TSDelRange(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/bridge.RpcBridge/TSDelRange",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RpcBridgeServer).TSDelRange(ctx, req.(*TSDelRangeReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (x *rpcBridgePublishServer) Send(m *PublishRsp) error {
-	return x.ServerStream.SendMsg(m)
+func _RpcBridge_TSCreateRule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TSRuleReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RpcBridgeServer).TSCreateRule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/bridge.RpcBridge/TSCreateRule",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RpcBridgeServer).TSCreateRule(ctx, req.(*TSRuleReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (x *rpcBridgePublishServer) Recv() (*PublishReq, error) {
-	m := new(PublishReq)
-	if err := x.ServerStream.RecvMsg(m); err != nil {
+func _RpcBridge_TSDeleteRule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TSRuleReq)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return m, nil
+	if interceptor == nil {
+		return srv.(RpcBridgeServer).TSDeleteRule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/bridge.RpcBridge/TSDeleteRule",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RpcBridgeServer).TSDeleteRule(ctx, req.(*TSRuleReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func _RpcBridge_Get_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetReq)
+func _RpcBridge_TSInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TSInfoReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(RpcBridgeServer).Get(ctx, in)
+		return srv.(RpcBridgeServer).TSInfo(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/bridge.RpcBridge/Get",
+		FullMethod: "/bridge.RpcBridge/TSInfo",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(RpcBridgeServer).Get(ctx, req.(*GetReq))
+		return srv.(RpcBridgeServer).TSInfo(ctx, req.(*TSInfoReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _RpcBridge_Put_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(PutReq)
+func _RpcBridge_TSDel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TSDelReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(RpcBridgeServer).Put(ctx, in)
+		return srv.(RpcBridgeServer).TSDel(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/bridge.RpcBridge/Put",
+		FullMethod: "/bridge.RpcBridge/TSDel",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(RpcBridgeServer).Put(ctx, req.(*PutReq))
+		return srv.(RpcBridgeServer).TSDel(ctx, req.(*TSDelReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _RpcBridge_Del_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DelReq)
+func _RpcBridge_ClearTS_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ClearReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(RpcBridgeServer).Del(ctx, in)
+		return srv.(RpcBridgeServer).ClearTS(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/bridge.RpcBridge/Del",
+		FullMethod: "/bridge.RpcBridge/ClearTS",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(RpcBridgeServer).Del(ctx, req.(*DelReq))
+		return srv.(RpcBridgeServer).ClearTS(ctx, req.(*ClearReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _RpcBridge_WatchKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(WatchReq)
+func _RpcBridge_Scan_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ScanReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(RpcBridgeServer).WatchKey(ctx, in)
+		return srv.(RpcBridgeServer).Scan(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/bridge.RpcBridge/WatchKey",
+		FullMethod: "/bridge.RpcBridge/Scan",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(RpcBridgeServer).WatchKey(ctx, req.(*WatchReq))
+		return srv.(RpcBridgeServer).Scan(ctx, req.(*ScanReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _RpcBridge_UnWatchKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(WatchReq)
+func _RpcBridge_HScan_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(HScanReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(RpcBridgeServer).UnWatchKey(ctx, in)
+		return srv.(RpcBridgeServer).HScan(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/bridge.RpcBridge/UnWatchKey",
+		FullMethod: "/bridge.RpcBridge/HScan",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(RpcBridgeServer).UnWatchKey(ctx, req.(*WatchReq))
+		return srv.(RpcBridgeServer).HScan(ctx, req.(*HScanReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _RpcBridge_ClearValue_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ClearReq)
+func _RpcBridge_SScan_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SScanReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(RpcBridgeServer).ClearValue(ctx, in)
+		return srv.(RpcBridgeServer).SScan(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/bridge.RpcBridge/ClearString",
+		FullMethod: "/bridge.RpcBridge/SScan",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(RpcBridgeServer).ClearValue(ctx, req.(*ClearReq))
+		return srv.(RpcBridgeServer).SScan(ctx, req.(*SScanReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _RpcBridge_HMGet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(HMGetReq)
+func _RpcBridge_TTL_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TTLReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(RpcBridgeServer).HMGet(ctx, in)
+		return srv.(RpcBridgeServer).TTL(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/bridge.RpcBridge/HMGet",
+		FullMethod: "/bridge.RpcBridge/TTL",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(RpcBridgeServer).HMGet(ctx, req.(*HMGetReq))
+		return srv.(RpcBridgeServer).TTL(ctx, req.(*TTLReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _RpcBridge_HMGetMember_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(HMGetMemberReq)
+func _RpcBridge_PTTL_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TTLReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(RpcBridgeServer).HMGetMember(ctx, in)
+		return srv.(RpcBridgeServer).PTTL(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/bridge.RpcBridge/HMGetMember",
+		FullMethod: "/bridge.RpcBridge/PTTL",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(RpcBridgeServer).HMGetMember(ctx, req.(*HMGetMemberReq))
+		return srv.(RpcBridgeServer).PTTL(ctx, req.(*TTLReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _RpcBridge_HMPut_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(HMPutReq)
+func _RpcBridge_Expire_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExpireReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(RpcBridgeServer).HMPut(ctx, in)
+		return srv.(RpcBridgeServer).Expire(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/bridge.RpcBridge/HMPut",
+		FullMethod: "/bridge.RpcBridge/Expire",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(RpcBridgeServer).HMPut(ctx, req.(*HMPutReq))
+		return srv.(RpcBridgeServer).Expire(ctx, req.(*ExpireReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _RpcBridge_HMDel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(HMDelReq)
+func _RpcBridge_ExpireAt_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExpireAtReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(RpcBridgeServer).HMDel(ctx, in)
+		return srv.(RpcBridgeServer).ExpireAt(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/bridge.RpcBridge/HMDel",
+		FullMethod: "/bridge.RpcBridge/ExpireAt",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(RpcBridgeServer).HMDel(ctx, req.(*HMDelReq))
+		return srv.(RpcBridgeServer).ExpireAt(ctx, req.(*ExpireAtReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _RpcBridge_HMDelMember_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(HMDelMemberReq)
+func _RpcBridge_PExpire_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PExpireReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(RpcBridgeServer).HMDelMember(ctx, in)
+		return srv.(RpcBridgeServer).PExpire(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/bridge.RpcBridge/HMDelMember",
+		FullMethod: "/bridge.RpcBridge/PExpire",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(RpcBridgeServer).HMDelMember(ctx, req.(*HMDelMemberReq))
+		return srv.(RpcBridgeServer).PExpire(ctx, req.(*PExpireReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _RpcBridge_HMWatch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(HMWatchReq)
+func _RpcBridge_PExpireAt_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PExpireAtReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(RpcBridgeServer).HMWatch(ctx, in)
+		return srv.(RpcBridgeServer).PExpireAt(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/bridge.RpcBridge/HMWatch",
+		FullMethod: "/bridge.RpcBridge/PExpireAt",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RpcBridgeServer).PExpireAt(ctx, req.(*PExpireAtReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RpcBridge_Persist_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PersistReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RpcBridgeServer).Persist(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/bridge.RpcBridge/Persist",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(RpcBridgeServer).HMWatch(ctx, req.(*HMWatchReq))
+		return srv.(RpcBridgeServer).Persist(ctx, req.(*PersistReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _RpcBridge_HMUnWatch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(HMWatchReq)
+func _RpcBridge_Type_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TypeReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(RpcBridgeServer).HMUnWatch(ctx, in)
+		return srv.(RpcBridgeServer).Type(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/bridge.RpcBridge/HMUnWatch",
+		FullMethod: "/bridge.RpcBridge/Type",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(RpcBridgeServer).HMUnWatch(ctx, req.(*HMWatchReq))
+		return srv.(RpcBridgeServer).Type(ctx, req.(*TypeReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _RpcBridge_ClearMap_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ClearReq)
+func _RpcBridge_Exists_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExistsReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(RpcBridgeServer).ClearMap(ctx, in)
+		return srv.(RpcBridgeServer).Exists(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/bridge.RpcBridge/ClearMap",
+		FullMethod: "/bridge.RpcBridge/Exists",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(RpcBridgeServer).ClearMap(ctx, req.(*ClearReq))
+		return srv.(RpcBridgeServer).Exists(ctx, req.(*ExistsReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _RpcBridge_LGet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(LGetReq)
+func _RpcBridge_DelKeys_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DelKeysReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(RpcBridgeServer).LGet(ctx, in)
+		return srv.(RpcBridgeServer).DelKeys(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/bridge.RpcBridge/LGet",
+		FullMethod: "/bridge.RpcBridge/DelKeys",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(RpcBridgeServer).LGet(ctx, req.(*LGetReq))
+		return srv.(RpcBridgeServer).DelKeys(ctx, req.(*DelKeysReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _RpcBridge_LGetRange_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(LGetRangeReq)
+func _RpcBridge_Rename_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RenameReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(RpcBridgeServer).LGetRange(ctx, in)
+		return srv.(RpcBridgeServer).Rename(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/bridge.RpcBridge/LGetRange",
+		FullMethod: "/bridge.RpcBridge/Rename",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(RpcBridgeServer).LGetRange(ctx, req.(*LGetRangeReq))
+		return srv.(RpcBridgeServer).Rename(ctx, req.(*RenameReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _RpcBridge_LPut_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(LPutReq)
+func _RpcBridge_Select_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SelectReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(RpcBridgeServer).LPut(ctx, in)
+		return srv.(RpcBridgeServer).Select(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/bridge.RpcBridge/LPut",
+		FullMethod: "/bridge.RpcBridge/Select",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(RpcBridgeServer).LPut(ctx, req.(*LPutReq))
+		return srv.(RpcBridgeServer).Select(ctx, req.(*SelectReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _RpcBridge_LDel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(LDelReq)
+func _RpcBridge_CreateDB_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateDBReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(RpcBridgeServer).LDel(ctx, in)
+		return srv.(RpcBridgeServer).CreateDB(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/bridge.RpcBridge/LDel",
+		FullMethod: "/bridge.RpcBridge/CreateDB",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(RpcBridgeServer).LDel(ctx, req.(*LDelReq))
+		return srv.(RpcBridgeServer).CreateDB(ctx, req.(*CreateDBReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _RpcBridge_LDelRange_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(LDelRangeReq)
+func _RpcBridge_Copy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CopyReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(RpcBridgeServer).LDelRange(ctx, in)
+		return srv.(RpcBridgeServer).Copy(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/bridge.RpcBridge/LDelRange",
+		FullMethod: "/bridge.RpcBridge/Copy",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(RpcBridgeServer).LDelRange(ctx, req.(*LDelRangeReq))
+		return srv.(RpcBridgeServer).Copy(ctx, req.(*CopyReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _RpcBridge_LWatch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(LWatchReq)
+func _RpcBridge_Move_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MoveReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(RpcBridgeServer).LWatch(ctx, in)
+		return srv.(RpcBridgeServer).Move(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/bridge.RpcBridge/LWatch",
+		FullMethod: "/bridge.RpcBridge/Move",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(RpcBridgeServer).LWatch(ctx, req.(*LWatchReq))
+		return srv.(RpcBridgeServer).Move(ctx, req.(*MoveReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _RpcBridge_LUnWatch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(LWatchReq)
+func _RpcBridge_KeyInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(KeyInfoReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(RpcBridgeServer).LUnWatch(ctx, in)
+		return srv.(RpcBridgeServer).KeyInfo(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/bridge.RpcBridge/LUnWatch",
+		FullMethod: "/bridge.RpcBridge/KeyInfo",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(RpcBridgeServer).LUnWatch(ctx, req.(*LWatchReq))
+		return srv.(RpcBridgeServer).KeyInfo(ctx, req.(*KeyInfoReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _RpcBridge_ClearList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ClearReq)
+func _RpcBridge_KeyStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(KeyStatsReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(RpcBridgeServer).ClearList(ctx, in)
+		return srv.(RpcBridgeServer).KeyStats(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/bridge.RpcBridge/ClearList",
+		FullMethod: "/bridge.RpcBridge/KeyStats",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(RpcBridgeServer).ClearList(ctx, req.(*ClearReq))
+		return srv.(RpcBridgeServer).KeyStats(ctx, req.(*KeyStatsReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _RpcBridge_SGet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SGetReq)
+func _RpcBridge_MemoryUsage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MemoryUsageReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(RpcBridgeServer).SGet(ctx, in)
+		return srv.(RpcBridgeServer).MemoryUsage(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/bridge.RpcBridge/SGet",
+		FullMethod: "/bridge.RpcBridge/MemoryUsage",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(RpcBridgeServer).SGet(ctx, req.(*SGetReq))
+		return srv.(RpcBridgeServer).MemoryUsage(ctx, req.(*MemoryUsageReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _RpcBridge_SPut_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SPutReq)
+func _RpcBridge_MemoryStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MemoryStatsReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(RpcBridgeServer).SPut(ctx, in)
+		return srv.(RpcBridgeServer).MemoryStats(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/bridge.RpcBridge/SPut",
+		FullMethod: "/bridge.RpcBridge/MemoryStats",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(RpcBridgeServer).SPut(ctx, req.(*SPutReq))
+		return srv.(RpcBridgeServer).MemoryStats(ctx, req.(*MemoryStatsReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _RpcBridge_SDel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SDelReq)
+func _RpcBridge_TxWatch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TxWatchReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(RpcBridgeServer).SDel(ctx, in)
+		return srv.(RpcBridgeServer).TxWatch(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/bridge.RpcBridge/SDel",
+		FullMethod: "/bridge.RpcBridge/TxWatch",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(RpcBridgeServer).SDel(ctx, req.(*SDelReq))
+		return srv.(RpcBridgeServer).TxWatch(ctx, req.(*TxWatchReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _RpcBridge_SDelMember_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SDelMemberReq)
+func _RpcBridge_Exec_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExecReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(RpcBridgeServer).SDelMember(ctx, in)
+		return srv.(RpcBridgeServer).Exec(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/bridge.RpcBridge/SDelMember",
+		FullMethod: "/bridge.RpcBridge/Exec",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(RpcBridgeServer).SDelMember(ctx, req.(*SDelMemberReq))
+		return srv.(RpcBridgeServer).Exec(ctx, req.(*ExecReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _RpcBridge_SWatch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SWatchReq)
+func _RpcBridge_MGet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MGetReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(RpcBridgeServer).SWatch(ctx, in)
+		return srv.(RpcBridgeServer).MGet(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/bridge.RpcBridge/SWatch",
+		FullMethod: "/bridge.RpcBridge/MGet",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(RpcBridgeServer).SWatch(ctx, req.(*SWatchReq))
+		return srv.(RpcBridgeServer).MGet(ctx, req.(*MGetReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _RpcBridge_SUnWatch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SWatchReq)
+func _RpcBridge_MSet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MSetReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(RpcBridgeServer).SUnWatch(ctx, in)
+		return srv.(RpcBridgeServer).MSet(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/bridge.RpcBridge/SUnWatch",
+		FullMethod: "/bridge.RpcBridge/MSet",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(RpcBridgeServer).SUnWatch(ctx, req.(*SWatchReq))
+		return srv.(RpcBridgeServer).MSet(ctx, req.(*MSetReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _RpcBridge_ClearSet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ClearReq)
+func _RpcBridge_Batch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(RpcBridgeServer).ClearSet(ctx, in)
+		return srv.(RpcBridgeServer).Batch(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/bridge.RpcBridge/ClearSet",
+		FullMethod: "/bridge.RpcBridge/Batch",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(RpcBridgeServer).ClearSet(ctx, req.(*ClearReq))
+		return srv.(RpcBridgeServer).Batch(ctx, req.(*BatchReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -4676,7 +20227,7 @@ var _RpcBridge_serviceDesc = grpc.ServiceDesc{
 			Handler:    _RpcBridge_UnWatchKey_Handler,
 		},
 		{
-			MethodName: "ClearString",
+			MethodName: "ClearValue",
 			Handler:    _RpcBridge_ClearValue_Handler,
 		},
 		{
@@ -4771,6 +20322,346 @@ var _RpcBridge_serviceDesc = grpc.ServiceDesc{
 			MethodName: "ClearSet",
 			Handler:    _RpcBridge_ClearSet_Handler,
 		},
+		{
+			MethodName: "PFAdd",
+			Handler:    _RpcBridge_PFAdd_Handler,
+		},
+		{
+			MethodName: "PFCount",
+			Handler:    _RpcBridge_PFCount_Handler,
+		},
+		{
+			MethodName: "PFMerge",
+			Handler:    _RpcBridge_PFMerge_Handler,
+		},
+		{
+			MethodName: "PFDel",
+			Handler:    _RpcBridge_PFDel_Handler,
+		},
+		{
+			MethodName: "ClearHLL",
+			Handler:    _RpcBridge_ClearHLL_Handler,
+		},
+		{
+			MethodName: "GeoAdd",
+			Handler:    _RpcBridge_GeoAdd_Handler,
+		},
+		{
+			MethodName: "GeoPos",
+			Handler:    _RpcBridge_GeoPos_Handler,
+		},
+		{
+			MethodName: "GeoDist",
+			Handler:    _RpcBridge_GeoDist_Handler,
+		},
+		{
+			MethodName: "GeoSearch",
+			Handler:    _RpcBridge_GeoSearch_Handler,
+		},
+		{
+			MethodName: "GeoDel",
+			Handler:    _RpcBridge_GeoDel_Handler,
+		},
+		{
+			MethodName: "GeoDelMember",
+			Handler:    _RpcBridge_GeoDelMember_Handler,
+		},
+		{
+			MethodName: "ClearGeo",
+			Handler:    _RpcBridge_ClearGeo_Handler,
+		},
+		{
+			MethodName: "XAdd",
+			Handler:    _RpcBridge_XAdd_Handler,
+		},
+		{
+			MethodName: "XLen",
+			Handler:    _RpcBridge_XLen_Handler,
+		},
+		{
+			MethodName: "XRange",
+			Handler:    _RpcBridge_XRange_Handler,
+		},
+		{
+			MethodName: "XRead",
+			Handler:    _RpcBridge_XRead_Handler,
+		},
+		{
+			MethodName: "XGroupCreate",
+			Handler:    _RpcBridge_XGroupCreate_Handler,
+		},
+		{
+			MethodName: "XGroupDestroy",
+			Handler:    _RpcBridge_XGroupDestroy_Handler,
+		},
+		{
+			MethodName: "XReadGroup",
+			Handler:    _RpcBridge_XReadGroup_Handler,
+		},
+		{
+			MethodName: "XAck",
+			Handler:    _RpcBridge_XAck_Handler,
+		},
+		{
+			MethodName: "XPending",
+			Handler:    _RpcBridge_XPending_Handler,
+		},
+		{
+			MethodName: "XClaim",
+			Handler:    _RpcBridge_XClaim_Handler,
+		},
+		{
+			MethodName: "XTrim",
+			Handler:    _RpcBridge_XTrim_Handler,
+		},
+		{
+			MethodName: "XDelMember",
+			Handler:    _RpcBridge_XDelMember_Handler,
+		},
+		{
+			MethodName: "XDel",
+			Handler:    _RpcBridge_XDel_Handler,
+		},
+		{
+			MethodName: "ClearStream",
+			Handler:    _RpcBridge_ClearStream_Handler,
+		},
+		{
+			MethodName: "JSet",
+			Handler:    _RpcBridge_JSet_Handler,
+		},
+		{
+			MethodName: "JGet",
+			Handler:    _RpcBridge_JGet_Handler,
+		},
+		{
+			MethodName: "JDelPath",
+			Handler:    _RpcBridge_JDelPath_Handler,
+		},
+		{
+			MethodName: "JArrAppend",
+			Handler:    _RpcBridge_JArrAppend_Handler,
+		},
+		{
+			MethodName: "JNumIncrBy",
+			Handler:    _RpcBridge_JNumIncrBy_Handler,
+		},
+		{
+			MethodName: "JDel",
+			Handler:    _RpcBridge_JDel_Handler,
+		},
+		{
+			MethodName: "JWatch",
+			Handler:    _RpcBridge_JWatch_Handler,
+		},
+		{
+			MethodName: "JUnWatch",
+			Handler:    _RpcBridge_JUnWatch_Handler,
+		},
+		{
+			MethodName: "ClearJSON",
+			Handler:    _RpcBridge_ClearJSON_Handler,
+		},
+		{
+			MethodName: "BFReserve",
+			Handler:    _RpcBridge_BFReserve_Handler,
+		},
+		{
+			MethodName: "BFAdd",
+			Handler:    _RpcBridge_BFAdd_Handler,
+		},
+		{
+			MethodName: "BFExists",
+			Handler:    _RpcBridge_BFExists_Handler,
+		},
+		{
+			MethodName: "BFInfo",
+			Handler:    _RpcBridge_BFInfo_Handler,
+		},
+		{
+			MethodName: "BFDel",
+			Handler:    _RpcBridge_BFDel_Handler,
+		},
+		{
+			MethodName: "ClearBloom",
+			Handler:    _RpcBridge_ClearBloom_Handler,
+		},
+		{
+			MethodName: "CFReserve",
+			Handler:    _RpcBridge_CFReserve_Handler,
+		},
+		{
+			MethodName: "CFAdd",
+			Handler:    _RpcBridge_CFAdd_Handler,
+		},
+		{
+			MethodName: "CFExists",
+			Handler:    _RpcBridge_CFExists_Handler,
+		},
+		{
+			MethodName: "CFDelMember",
+			Handler:    _RpcBridge_CFDelMember_Handler,
+		},
+		{
+			MethodName: "CFInfo",
+			Handler:    _RpcBridge_CFInfo_Handler,
+		},
+		{
+			MethodName: "CFDel",
+			Handler:    _RpcBridge_CFDel_Handler,
+		},
+		{
+			MethodName: "ClearCuckoo",
+			Handler:    _RpcBridge_ClearCuckoo_Handler,
+		},
+		{
+			MethodName: "TSCreate",
+			Handler:    _RpcBridge_TSCreate_Handler,
+		},
+		{
+			MethodName: "TSAdd",
+			Handler:    _RpcBridge_TSAdd_Handler,
+		},
+		{
+			MethodName: "TSRange",
+			Handler:    _RpcBridge_TSRange_Handler,
+		},
+		{
+			MethodName: "TSGet",
+			Handler:    _RpcBridge_TSGet_Handler,
+		},
+		{
+			MethodName: "TSDelRange",
+			Handler:    _RpcBridge_TSDelRange_Handler,
+		},
+		{
+			MethodName: "TSCreateRule",
+			Handler:    _RpcBridge_TSCreateRule_Handler,
+		},
+		{
+			MethodName: "TSDeleteRule",
+			Handler:    _RpcBridge_TSDeleteRule_Handler,
+		},
+		{
+			MethodName: "TSInfo",
+			Handler:    _RpcBridge_TSInfo_Handler,
+		},
+		{
+			MethodName: "TSDel",
+			Handler:    _RpcBridge_TSDel_Handler,
+		},
+		{
+			MethodName: "ClearTS",
+			Handler:    _RpcBridge_ClearTS_Handler,
+		},
+		{
+			MethodName: "Scan",
+			Handler:    _RpcBridge_Scan_Handler,
+		},
+		{
+			MethodName: "HScan",
+			Handler:    _RpcBridge_HScan_Handler,
+		},
+		{
+			MethodName: "SScan",
+			Handler:    _RpcBridge_SScan_Handler,
+		},
+		{
+			MethodName: "TTL",
+			Handler:    _RpcBridge_TTL_Handler,
+		},
+		{
+			MethodName: "PTTL",
+			Handler:    _RpcBridge_PTTL_Handler,
+		},
+		{
+			MethodName: "Expire",
+			Handler:    _RpcBridge_Expire_Handler,
+		},
+		{
+			MethodName: "ExpireAt",
+			Handler:    _RpcBridge_ExpireAt_Handler,
+		},
+		{
+			MethodName: "PExpire",
+			Handler:    _RpcBridge_PExpire_Handler,
+		},
+		{
+			MethodName: "PExpireAt",
+			Handler:    _RpcBridge_PExpireAt_Handler,
+		},
+		{
+			MethodName: "Persist",
+			Handler:    _RpcBridge_Persist_Handler,
+		},
+		{
+			MethodName: "Type",
+			Handler:    _RpcBridge_Type_Handler,
+		},
+		{
+			MethodName: "Exists",
+			Handler:    _RpcBridge_Exists_Handler,
+		},
+		{
+			MethodName: "DelKeys",
+			Handler:    _RpcBridge_DelKeys_Handler,
+		},
+		{
+			MethodName: "Rename",
+			Handler:    _RpcBridge_Rename_Handler,
+		},
+		{
+			MethodName: "Select",
+			Handler:    _RpcBridge_Select_Handler,
+		},
+		{
+			MethodName: "CreateDB",
+			Handler:    _RpcBridge_CreateDB_Handler,
+		},
+		{
+			MethodName: "Copy",
+			Handler:    _RpcBridge_Copy_Handler,
+		},
+		{
+			MethodName: "Move",
+			Handler:    _RpcBridge_Move_Handler,
+		},
+		{
+			MethodName: "KeyInfo",
+			Handler:    _RpcBridge_KeyInfo_Handler,
+		},
+		{
+			MethodName: "KeyStats",
+			Handler:    _RpcBridge_KeyStats_Handler,
+		},
+		{
+			MethodName: "MemoryUsage",
+			Handler:    _RpcBridge_MemoryUsage_Handler,
+		},
+		{
+			MethodName: "MemoryStats",
+			Handler:    _RpcBridge_MemoryStats_Handler,
+		},
+		{
+			MethodName: "TxWatch",
+			Handler:    _RpcBridge_TxWatch_Handler,
+		},
+		{
+			MethodName: "Exec",
+			Handler:    _RpcBridge_Exec_Handler,
+		},
+		{
+			MethodName: "MGet",
+			Handler:    _RpcBridge_MGet_Handler,
+		},
+		{
+			MethodName: "MSet",
+			Handler:    _RpcBridge_MSet_Handler,
+		},
+		{
+			MethodName: "Batch",
+			Handler:    _RpcBridge_Batch_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
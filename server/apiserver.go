@@ -1,11 +1,15 @@
 package server
 
 import (
+	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"github.com/llr104/lightkv/cache"
 	"github.com/llr104/lightkv/cache/kv"
+	"math"
 	"net/http"
+	"net/url"
 	"strconv"
 	"strings"
 )
@@ -35,22 +39,163 @@ const SDel = "/sdel/"
 const SDelMember = "/sdelm/"
 const SDump = "/sdump"
 
+const PFAdd = "/pfadd"
+const PFCount = "/pfcount/"
+const PFMerge = "/pfmerge"
+const PFDel = "/pfdel/"
+const PFDump = "/pfdump"
+
+const GeoAdd = "/geoadd"
+const GeoPos = "/geopos/"
+const GeoDist = "/geodist/"
+const GeoSearch = "/geosearch/"
+const GeoDel = "/geodel/"
+const GeoDelMember = "/geodelm/"
+const GeoDump = "/geodump"
+
+const XAdd = "/xadd"
+const XLen = "/xlen/"
+const XRange = "/xrange/"
+const XRevRange = "/xrevrange/"
+const XRead = "/xread"
+const XReadGroup = "/xreadgroup"
+const XGroupCreate = "/xgroupcreate"
+const XGroupDestroy = "/xgroupdestroy"
+const XAck = "/xack"
+const XPending = "/xpending"
+const XClaim = "/xclaim"
+const XTrim = "/xtrim"
+const XDel = "/xdel/"
+const XDelMember = "/xdelm"
+const XDump = "/xdump"
+
+const JSet = "/jset"
+const JGet = "/jget/"
+const JDelPath = "/jdelp"
+const JArrAppend = "/jarrappend"
+const JNumIncrBy = "/jnumincrby"
+const JDel = "/jdel/"
+const JDump = "/jdump"
+
+const BFReserve = "/bfreserve"
+const BFAdd = "/bfadd"
+const BFExists = "/bfexists"
+const BFInfo = "/bfinfo/"
+const BFDel = "/bfdel/"
+const BFDump = "/bfdump"
+
+const CFReserve = "/cfreserve"
+const CFAdd = "/cfadd"
+const CFExists = "/cfexists"
+const CFDelMember = "/cfdelm"
+const CFInfo = "/cfinfo/"
+const CFDel = "/cfdel/"
+const CFDump = "/cfdump"
+
+const TSCreateRule = "/tscreaterule"
+const TSDeleteRule = "/tsdeleterule"
+const TSCreate = "/tscreate"
+const TSAdd = "/tsadd"
+const TSRange = "/tsrange/"
+const TSGet = "/tsget/"
+const TSDelRange = "/tsdelrange"
+const TSInfo = "/tsinfo/"
+const TSDel = "/tsdel/"
+const TSDump = "/tsdump"
+
+const Scan = "/scan"
+const HScan = "/hscan/"
+const SScan = "/sscan/"
+
+const TTL = "/ttl/"
+const PTTL = "/pttl/"
+const ExpireAt = "/expireat"
+const Expire = "/expire"
+const PExpireAt = "/pexpireat"
+const PExpire = "/pexpire"
+const Persist = "/persist"
+
+const KeyType = "/type/"
+const Exists = "/exists"
+const DelKeys = "/delkeys"
+const Rename = "/rename"
+const Copy = "/copy"
+const Move = "/move"
+const KeyInfo = "/keyinfo/"
+const KeyStats = "/keystats"
+const MemUsage = "/memusage/"
+const MemStats = "/memstats"
+
+const TxWatch = "/txwatch"
+const Exec = "/exec"
+
+const MGet = "/mget"
+const MSet = "/mset"
+const Batch = "/batch"
+
+/*
+选择数据库的路径前缀 /db/<name>/...，或者请求头
+*/
+const DBPrefix = "/db/"
+const CreateDB = "/createdb"
+const DBHeader = "X-LightKV-DB"
+
 
 type apiServer struct {
-	cache * cache.Cache
+	dbs * cache.Databases
 }
 
+/*
+Version 为 string、map、list、set 读取和 CAS 写入返回的修改版本
+*/
 type Rsp struct {
 	Success bool 		`json:"success"`
 	Key     string 		`json:"key"`
 	Value   interface{}	`json:"value"`
+	Version uint64		`json:"version,omitempty"`
 }
 
-func NewApi(c *cache.Cache) *apiServer {
-	h := apiServer{cache: c}
+func NewApi(dbs *cache.Databases) *apiServer {
+	h := apiServer{dbs: dbs}
 	return &h
 }
 
+/*
+请求所在的数据库，由 ServeHTTP 放入请求的ctx
+*/
+func (s *apiServer) db(r *http.Request) *cache.Cache {
+	if c, ok := r.Context().Value(dbKey{}).(*cache.Cache); ok {
+		return c
+	}
+	c, _ := s.dbs.Get(cache.DefaultDB)
+	return c
+}
+
+/*
+路径前缀 /db/<name>/ 优先于请求头，选择后去掉路径前缀
+*/
+func (s *apiServer) selectDB(r *http.Request) (*http.Request, error) {
+	name := r.Header.Get(DBHeader)
+	if strings.HasPrefix(strings.ToLower(r.URL.Path), DBPrefix) {
+		rest := r.URL.Path[len(DBPrefix):]
+		i := strings.Index(rest, "/")
+		if i < 0 {
+			i = len(rest)
+		}
+		name = rest[:i]
+		if name == "" {
+			return r, errors.New("invalid db path")
+		}
+		r.URL.Path = rest[i:]
+	}
+
+	c, err := s.dbs.Get(name)
+	if err != nil {
+		return r, err
+	}
+	return r.WithContext(context.WithValue(r.Context(), dbKey{}, c)), nil
+}
+
 func (s *apiServer) Start()  {
 	fmt.Println(http.ListenAndServe(cache.Conf.ApiHost, s))
 }
@@ -58,6 +203,18 @@ func (s *apiServer) Start()  {
 
 func (s *apiServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 
+	r, err := s.selectDB(r)
+	if err != nil {
+		rsp := Rsp{Key: "", Value: err.Error(), Success: false}
+		data, _ := json.Marshal(rsp)
+		if cache.IsDBNotFound(err) {
+			http.Error(w, string(data), http.StatusNotFound)
+			return
+		}
+		http.Error(w, string(data), http.StatusBadRequest)
+		return
+	}
+
 	pathLower := strings.ToLower(r.URL.Path)
 	if strings.HasPrefix(pathLower, Get) {
 		s.get(w, r)
@@ -101,6 +258,172 @@ func (s *apiServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 		s.sDelMember(w, r)
 	}else if pathLower == SDump{
 		s.sDump(w, r)
+	}else if strings.HasPrefix(pathLower, PFAdd) {
+		s.pfAdd(w, r)
+	}else if strings.HasPrefix(pathLower, PFCount) {
+		s.pfCount(w, r)
+	}else if strings.HasPrefix(pathLower, PFMerge) {
+		s.pfMerge(w, r)
+	}else if strings.HasPrefix(pathLower, PFDel) {
+		s.pfDel(w, r)
+	}else if pathLower == PFDump{
+		s.pfDump(w, r)
+	}else if strings.HasPrefix(pathLower, GeoAdd) {
+		s.geoAdd(w, r)
+	}else if strings.HasPrefix(pathLower, GeoPos) {
+		s.geoPos(w, r)
+	}else if strings.HasPrefix(pathLower, GeoDist) {
+		s.geoDist(w, r)
+	}else if strings.HasPrefix(pathLower, GeoSearch) {
+		s.geoSearch(w, r)
+	}else if strings.HasPrefix(pathLower, GeoDel) {
+		s.geoDel(w, r)
+	}else if strings.HasPrefix(pathLower, GeoDelMember) {
+		s.geoDelMember(w, r)
+	}else if pathLower == GeoDump{
+		s.geoDump(w, r)
+	}else if strings.HasPrefix(pathLower, XAdd) {
+		s.xAdd(w, r)
+	}else if strings.HasPrefix(pathLower, XLen) {
+		s.xLen(w, r)
+	}else if strings.HasPrefix(pathLower, XRange) {
+		s.xRange(w, r, XRange, false)
+	}else if strings.HasPrefix(pathLower, XRevRange) {
+		s.xRange(w, r, XRevRange, true)
+	}else if strings.HasPrefix(pathLower, XReadGroup) {
+		s.xReadGroup(w, r)
+	}else if strings.HasPrefix(pathLower, XRead) {
+		s.xRead(w, r)
+	}else if strings.HasPrefix(pathLower, XGroupCreate) {
+		s.xGroupCreate(w, r)
+	}else if strings.HasPrefix(pathLower, XGroupDestroy) {
+		s.xGroupDestroy(w, r)
+	}else if strings.HasPrefix(pathLower, XAck) {
+		s.xAck(w, r)
+	}else if strings.HasPrefix(pathLower, XPending) {
+		s.xPending(w, r)
+	}else if strings.HasPrefix(pathLower, XClaim) {
+		s.xClaim(w, r)
+	}else if strings.HasPrefix(pathLower, XTrim) {
+		s.xTrim(w, r)
+	}else if strings.HasPrefix(pathLower, XDel) {
+		s.xDel(w, r)
+	}else if strings.HasPrefix(pathLower, XDelMember) {
+		s.xDelMember(w, r)
+	}else if pathLower == XDump{
+		s.xDump(w, r)
+	}else if strings.HasPrefix(pathLower, JSet) {
+		s.jSet(w, r)
+	}else if strings.HasPrefix(pathLower, JGet) {
+		s.jGet(w, r)
+	}else if strings.HasPrefix(pathLower, JDelPath) {
+		s.jDelPath(w, r)
+	}else if strings.HasPrefix(pathLower, JArrAppend) {
+		s.jArrAppend(w, r)
+	}else if strings.HasPrefix(pathLower, JNumIncrBy) {
+		s.jNumIncrBy(w, r)
+	}else if strings.HasPrefix(pathLower, JDel) {
+		s.jDel(w, r)
+	}else if pathLower == JDump{
+		s.jDump(w, r)
+	}else if strings.HasPrefix(pathLower, BFReserve) {
+		s.bfReserve(w, r)
+	}else if strings.HasPrefix(pathLower, BFAdd) {
+		s.bfAdd(w, r)
+	}else if strings.HasPrefix(pathLower, BFExists) {
+		s.bfExists(w, r)
+	}else if strings.HasPrefix(pathLower, BFInfo) {
+		s.bfInfo(w, r)
+	}else if strings.HasPrefix(pathLower, BFDel) {
+		s.bfDel(w, r)
+	}else if pathLower == BFDump{
+		s.bfDump(w, r)
+	}else if strings.HasPrefix(pathLower, CFReserve) {
+		s.cfReserve(w, r)
+	}else if strings.HasPrefix(pathLower, CFAdd) {
+		s.cfAdd(w, r)
+	}else if strings.HasPrefix(pathLower, CFExists) {
+		s.cfExists(w, r)
+	}else if strings.HasPrefix(pathLower, CFDelMember) {
+		s.cfDelMember(w, r)
+	}else if strings.HasPrefix(pathLower, CFInfo) {
+		s.cfInfo(w, r)
+	}else if strings.HasPrefix(pathLower, CFDel) {
+		s.cfDel(w, r)
+	}else if pathLower == CFDump{
+		s.cfDump(w, r)
+	}else if strings.HasPrefix(pathLower, TSCreateRule) {
+		s.tsCreateRule(w, r)
+	}else if strings.HasPrefix(pathLower, TSDeleteRule) {
+		s.tsDeleteRule(w, r)
+	}else if strings.HasPrefix(pathLower, TSCreate) {
+		s.tsCreate(w, r)
+	}else if strings.HasPrefix(pathLower, TSAdd) {
+		s.tsAdd(w, r)
+	}else if strings.HasPrefix(pathLower, TSRange) {
+		s.tsRange(w, r)
+	}else if strings.HasPrefix(pathLower, TSGet) {
+		s.tsGet(w, r)
+	}else if strings.HasPrefix(pathLower, TSDelRange) {
+		s.tsDelRange(w, r)
+	}else if strings.HasPrefix(pathLower, TSInfo) {
+		s.tsInfo(w, r)
+	}else if strings.HasPrefix(pathLower, TSDel) {
+		s.tsDel(w, r)
+	}else if pathLower == TSDump{
+		s.tsDump(w, r)
+	}else if pathLower == Scan{
+		s.scan(w, r)
+	}else if strings.HasPrefix(pathLower, HScan) {
+		s.hScan(w, r)
+	}else if strings.HasPrefix(pathLower, SScan) {
+		s.sScan(w, r)
+	}else if strings.HasPrefix(pathLower, TTL) {
+		s.ttl(w, r, TTL)
+	}else if strings.HasPrefix(pathLower, PTTL) {
+		s.ttl(w, r, PTTL)
+	}else if strings.HasPrefix(pathLower, ExpireAt) {
+		s.expireAt(w, r)
+	}else if strings.HasPrefix(pathLower, Expire) {
+		s.expire(w, r)
+	}else if strings.HasPrefix(pathLower, PExpireAt) {
+		s.pExpireAt(w, r)
+	}else if strings.HasPrefix(pathLower, PExpire) {
+		s.pExpire(w, r)
+	}else if strings.HasPrefix(pathLower, Persist) {
+		s.persist(w, r)
+	}else if strings.HasPrefix(pathLower, KeyType) {
+		s.keyType(w, r)
+	}else if strings.HasPrefix(pathLower, Exists) {
+		s.exists(w, r)
+	}else if strings.HasPrefix(pathLower, DelKeys) {
+		s.delKeys(w, r)
+	}else if strings.HasPrefix(pathLower, Rename) {
+		s.rename(w, r)
+	}else if strings.HasPrefix(pathLower, Copy) {
+		s.copy(w, r)
+	}else if strings.HasPrefix(pathLower, Move) {
+		s.move(w, r)
+	}else if strings.HasPrefix(pathLower, KeyInfo) {
+		s.keyInfo(w, r)
+	}else if pathLower == KeyStats {
+		s.keyStats(w, r)
+	}else if strings.HasPrefix(pathLower, MemUsage) {
+		s.memUsage(w, r)
+	}else if pathLower == MemStats {
+		s.memStats(w, r)
+	}else if pathLower == TxWatch {
+		s.txWatch(w, r)
+	}else if pathLower == Exec {
+		s.exec(w, r)
+	}else if pathLower == CreateDB {
+		s.createDB(w, r)
+	}else if pathLower == MGet {
+		s.mGet(w, r)
+	}else if pathLower == MSet {
+		s.mSet(w, r)
+	}else if pathLower == Batch {
+		s.batch(w, r)
 	}else{
 		r := Rsp{Key: "", Value: "", Success: false}
 		data, _ := json.Marshal(r)
@@ -115,9 +438,9 @@ func (s *apiServer) get(w http.ResponseWriter, r *http.Request){
 		data, _ := json.Marshal(r)
 		http.Error(w, string(data), http.StatusBadRequest)
 	}else{
-		v, err := s.cache.Get(parts[0])
+		v, ver, err := s.db(r).GetVersion(parts[0])
 		if err == nil {
-			r := Rsp{Key: parts[0], Value:string(v), Success: true}
+			r := Rsp{Key: parts[0], Value:string(v), Version:ver, Success: true}
 			data, _ := json.Marshal(r)
 			w.Write(data)
 		}else{
@@ -134,7 +457,6 @@ func (s *apiServer) put(w http.ResponseWriter, r *http.Request){
 	vars := r.URL.Query()
 	key, ok1 := vars["key"]
 	value, ok2 := vars["value"]
-	expire, ok3 := vars["expire"]
 
 	if ok1 == false {
 		r := Rsp{Key: "", Value:"", Success: false}
@@ -150,15 +472,22 @@ func (s *apiServer) put(w http.ResponseWriter, r *http.Request){
 		return
 	}
 
-	if ok3{
-		int64, err := strconv.ParseInt(expire[0], 10, 64)
-		if err == nil{
-			s.cache.Put(key[0], value[0], int64)
-		}else{
-			s.cache.Put(key[0], value[0], kv.ExpireForever)
-		}
-	}else{
-		s.cache.Put(key[0], value[0], kv.ExpireForever)
+	e, err := parseExpiration(vars)
+	version, cas := uint64(0), false
+	if err == nil {
+		version, cas, err = parseVersion(vars)
+	}
+	if err == nil && cas {
+		ver, err := s.db(r).PutCAS(key[0], value[0], e, version)
+		writeCASRsp(w, key[0], value[0], ver, err)
+		return
+	}
+	if err == nil {
+		err = s.db(r).PutEx(key[0], value[0], e)
+	}
+	if err != nil {
+		writeRsp(w, key[0], nil, err)
+		return
 	}
 	rsp := Rsp{Key: key[0], Value:value[0], Success: true}
 	data, _ := json.Marshal(rsp)
@@ -171,8 +500,13 @@ func (s *apiServer) del(w http.ResponseWriter, r *http.Request){
 		r := Rsp{Key: parts[0], Value:"", Success: false}
 		data, _ := json.Marshal(r)
 		http.Error(w, string(data), http.StatusBadRequest)
+	}else if version, cas, err := parseVersion(r.URL.Query()); err != nil || cas {
+		if err == nil {
+			err = s.db(r).DeleteCAS(parts[0], version)
+		}
+		writeRsp(w, parts[0], "", err)
 	}else{
-		s.cache.Delete(parts[0])
+		s.db(r).Delete(parts[0])
 		r := Rsp{Key: parts[0], Value:"", Success: true}
 		data, _ := json.Marshal(r)
 		w.Write(data)
@@ -180,7 +514,7 @@ func (s *apiServer) del(w http.ResponseWriter, r *http.Request){
 }
 
 func (s *apiServer) dump(w http.ResponseWriter, r *http.Request){
-	data, _ := s.cache.StringCaches()
+	data, _ := s.db(r).StringCaches()
 	w.Write(data)
 }
 
@@ -191,9 +525,9 @@ func (s *apiServer) hGet(w http.ResponseWriter, r *http.Request){
 		data, _ := json.Marshal(r)
 			http.Error(w, string(data), http.StatusBadRequest)
 		}else{
-			v, err := s.cache.HMGet(parts[0])
+			v, ver, err := s.db(r).HMGetVersion(parts[0])
 			if err == nil {
-				r := Rsp{Key: parts[0], Value:v, Success: true}
+				r := Rsp{Key: parts[0], Value:v, Version:ver, Success: true}
 				data, _ := json.Marshal(r)
 				w.Write(data)
 			}else{
@@ -211,7 +545,7 @@ func (s *apiServer) hGetM(w http.ResponseWriter, r *http.Request){
 		data, _ := json.Marshal(r)
 		http.Error(w, string(data), http.StatusBadRequest)
 	}else{
-		v, err := s.cache.HMGetMember(parts[0], parts[1])
+		v, err := s.db(r).HMGetMember(parts[0], parts[1])
 		if err == nil {
 			r := Rsp{Key: parts[1], Value:v, Success: true}
 			data, _ := json.Marshal(r)
@@ -231,7 +565,6 @@ func (s *apiServer) hPush(w http.ResponseWriter, r *http.Request){
 	hmkey, ok0 := vars["hmkey"]
 	key, ok1 := vars["key"]
 	value, ok2 := vars["value"]
-	expire, ok3 := vars["expire"]
 
 	if ok0 == false{
 		r := Rsp{Key: "", Value:"", Success: false}
@@ -254,19 +587,31 @@ func (s *apiServer) hPush(w http.ResponseWriter, r *http.Request){
 		return
 	}
 
-	if ok3{
-		int64, err := strconv.ParseInt(expire[0], 10, 64)
-		if err == nil{
-			s.cache.HMPut(hmkey[0], key, value, int64)
-		}else{
-			s.cache.HMPut(hmkey[0], key, value, kv.ExpireForever)
+	e, err := parseExpiration(vars)
+	version, cas := uint64(0), false
+	if err == nil {
+		version, cas, err = parseVersion(vars)
+	}
+	if err == nil && cas {
+		ver, err := s.db(r).HMPutCAS(hmkey[0], key, value, e, version)
+		if err != nil {
+			writeRsp(w, hmkey[0], nil, err)
+			return
 		}
-	}else{
-		s.cache.HMPut(hmkey[0], key, value, kv.ExpireForever)
+		m, _ := s.db(r).HMGet(hmkey[0])
+		writeCASRsp(w, hmkey[0], m, ver, err)
+		return
+	}
+	if err == nil {
+		err = s.db(r).HMPutEx(hmkey[0], key, value, e)
+	}
+	if err != nil {
+		writeRsp(w, hmkey[0], nil, err)
+		return
 	}
 
-	str, _ := s.cache.HMGet(hmkey[0])
-	rsp := Rsp{Key: hmkey[0], Value:str, Success: true}
+	m, _ := s.db(r).HMGet(hmkey[0])
+	rsp := Rsp{Key: hmkey[0], Value:m, Success: true}
 	data, _ := json.Marshal(rsp)
 	w.Write(data)
 }
@@ -277,8 +622,13 @@ func (s *apiServer) hDel(w http.ResponseWriter, r *http.Request){
 		r := Rsp{Key: parts[0], Value:"", Success: false}
 		data, _ := json.Marshal(r)
 		http.Error(w, string(data), http.StatusBadRequest)
+	}else if version, cas, err := parseVersion(r.URL.Query()); err != nil || cas {
+		if err == nil {
+			err = s.db(r).HMDelCAS(parts[0], version)
+		}
+		writeRsp(w, parts[0], "", err)
 	}else{
-		s.cache.HMDel(parts[0])
+		s.db(r).HMDel(parts[0])
 		r := Rsp{Key: parts[0], Value:"", Success: true}
 		data, _ := json.Marshal(r)
 		w.Write(data)
@@ -291,8 +641,14 @@ func (s *apiServer) hDelM(w http.ResponseWriter, r *http.Request){
 		r := Rsp{Key: parts[0], Value:"", Success: false}
 		data, _ := json.Marshal(r)
 		http.Error(w, string(data), http.StatusBadRequest)
+	}else if version, cas, err := parseVersion(r.URL.Query()); err != nil || cas {
+		ver := uint64(0)
+		if err == nil {
+			ver, err = s.db(r).HMDelMemberCAS(parts[0], parts[1], version)
+		}
+		writeCASRsp(w, parts[0], "", ver, err)
 	}else{
-		s.cache.HMDelMember(parts[0], parts[1])
+		s.db(r).HMDelMember(parts[0], parts[1])
 		r := Rsp{Key: parts[0], Value:"", Success: true}
 		data, _ := json.Marshal(r)
 		w.Write(data)
@@ -300,7 +656,7 @@ func (s *apiServer) hDelM(w http.ResponseWriter, r *http.Request){
 }
 
 func (s *apiServer) hDump(w http.ResponseWriter, r *http.Request){
-	data, _ := s.cache.MapCaches()
+	data, _ := s.db(r).MapCaches()
 	w.Write(data)
 }
 
@@ -311,9 +667,9 @@ func (s *apiServer) lGet(w http.ResponseWriter, r *http.Request) {
 		data, _ := json.Marshal(r)
 		http.Error(w, string(data), http.StatusBadRequest)
 	}else{
-		v, err := s.cache.LGet(parts[0])
+		v, ver, err := s.db(r).LGetVersion(parts[0])
 		if err == nil {
-			r := Rsp{Key: parts[0], Value:v, Success: true}
+			r := Rsp{Key: parts[0], Value:v, Version:ver, Success: true}
 			data, _ := json.Marshal(r)
 			w.Write(data)
 		}else{
@@ -347,7 +703,7 @@ func (s *apiServer) lGetRange(w http.ResponseWriter, r *http.Request) {
 				data, _ := json.Marshal(r)
 				http.Error(w, string(data), http.StatusBadRequest)
 			}else{
-				v, err := s.cache.LGetRange(parts[0], int32(begInt), int32(endInt))
+				v, err := s.db(r).LGetRange(parts[0], int32(begInt), int32(endInt))
 				if err == nil {
 					r := Rsp{Key: parts[0], Value:v, Success: true}
 					data, _ := json.Marshal(r)
@@ -366,7 +722,6 @@ func (s *apiServer) lPush(w http.ResponseWriter, r *http.Request) {
 	vars := r.URL.Query()
 	key, ok1 := vars["key"]
 	value, ok2 := vars["value"]
-	expire, ok3 := vars["expire"]
 
 	if ok1 == false {
 		r := Rsp{Key: "", Value: "", Success: false}
@@ -382,15 +737,22 @@ func (s *apiServer) lPush(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	if ok3{
-		int64, err := strconv.ParseInt(expire[0], 10, 64)
-		if err == nil{
-			s.cache.LPut(key[0], value, int64)
-		}else{
-			s.cache.LPut(key[0], value, kv.ExpireForever)
-		}
-	}else{
-		s.cache.LPut(key[0], value, kv.ExpireForever)
+	e, err := parseExpiration(vars)
+	version, cas := uint64(0), false
+	if err == nil {
+		version, cas, err = parseVersion(vars)
+	}
+	if err == nil && cas {
+		ver, err := s.db(r).LPutCAS(key[0], value, e, version)
+		writeCASRsp(w, key[0], value, ver, err)
+		return
+	}
+	if err == nil {
+		err = s.db(r).LPutEx(key[0], value, e)
+	}
+	if err != nil {
+		writeRsp(w, key[0], nil, err)
+		return
 	}
 
 	rsp := Rsp{Key: key[0], Value:value, Success: true}
@@ -404,8 +766,13 @@ func (s *apiServer) lDel(w http.ResponseWriter, r *http.Request) {
 		r := Rsp{Key: parts[0], Value: "", Success: false}
 		data, _ := json.Marshal(r)
 		http.Error(w, string(data), http.StatusBadRequest)
+	}else if version, cas, err := parseVersion(r.URL.Query()); err != nil || cas {
+		if err == nil {
+			err = s.db(r).LDelCAS(parts[0], version)
+		}
+		writeRsp(w, parts[0], "", err)
 	}else{
-		s.cache.LDel(parts[0])
+		s.db(r).LDel(parts[0])
 		r := Rsp{Key: parts[0], Value: "", Success: true}
 		data, _ := json.Marshal(r)
 		w.Write(data)
@@ -434,8 +801,14 @@ func (s *apiServer) lDelRange(w http.ResponseWriter, r *http.Request) {
 				r := Rsp{Key: "", Value: "", Success: false}
 				data, _ := json.Marshal(r)
 				http.Error(w, string(data), http.StatusBadRequest)
+			}else if version, cas, err := parseVersion(vars); err != nil || cas {
+				ver := uint64(0)
+				if err == nil {
+					ver, err = s.db(r).LDelRangeCAS(parts[0], int32(begInt), int32(endInt), version)
+				}
+				writeCASRsp(w, parts[0], "", ver, err)
 			}else{
-				err := s.cache.LDelRange(parts[0], int32(begInt), int32(endInt))
+				err := s.db(r).LDelRange(parts[0], int32(begInt), int32(endInt))
 				if err == nil {
 					r := Rsp{Key: parts[0], Value: "", Success: true}
 					data, _ := json.Marshal(r)
@@ -451,7 +824,7 @@ func (s *apiServer) lDelRange(w http.ResponseWriter, r *http.Request) {
 }
 
 func (s *apiServer) lDump(w http.ResponseWriter, r *http.Request){
-	data, _ := s.cache.ListCaches()
+	data, _ := s.db(r).ListCaches()
 	w.Write(data)
 }
 
@@ -462,9 +835,9 @@ func (s *apiServer) sGet(w http.ResponseWriter, r *http.Request){
 		data, _ := json.Marshal(r)
 		http.Error(w, string(data), http.StatusBadRequest)
 	}else{
-		v, err := s.cache.SGet(parts[0])
+		v, ver, err := s.db(r).SGetVersion(parts[0])
 		if err == nil {
-			r := Rsp{Key: parts[0], Value:v, Success: true}
+			r := Rsp{Key: parts[0], Value:v, Version:ver, Success: true}
 			data, _ := json.Marshal(r)
 			w.Write(data)
 		}else{
@@ -482,7 +855,6 @@ func (s *apiServer) sPush(w http.ResponseWriter, r *http.Request){
 	vars := r.URL.Query()
 	key, ok1 := vars["key"]
 	value, ok2 := vars["value"]
-	expire, ok3 := vars["expire"]
 
 
 	if ok1 == false {
@@ -499,18 +871,30 @@ func (s *apiServer) sPush(w http.ResponseWriter, r *http.Request){
 		return
 	}
 
-	if ok3{
-		int64, err := strconv.ParseInt(expire[0], 10, 64)
-		if err == nil{
-			s.cache.SPut(key[0], value, int64)
-		}else{
-			s.cache.SPut(key[0], value, kv.ExpireForever)
+	e, err := parseExpiration(vars)
+	version, cas := uint64(0), false
+	if err == nil {
+		version, cas, err = parseVersion(vars)
+	}
+	if err == nil && cas {
+		ver, err := s.db(r).SPutCAS(key[0], value, e, version)
+		if err != nil {
+			writeRsp(w, key[0], nil, err)
+			return
 		}
-	}else{
-		s.cache.SPut(key[0], value, kv.ExpireForever)
+		str, _ := s.db(r).SGet(key[0])
+		writeCASRsp(w, key[0], str, ver, err)
+		return
+	}
+	if err == nil {
+		err = s.db(r).SPutEx(key[0], value, e)
+	}
+	if err != nil {
+		writeRsp(w, key[0], nil, err)
+		return
 	}
 
-	str, _ := s.cache.SGet(key[0])
+	str, _ := s.db(r).SGet(key[0])
 	rsp := Rsp{Key: key[0], Value:str, Success: true}
 	data, _ := json.Marshal(rsp)
 	w.Write(data)
@@ -522,8 +906,13 @@ func (s *apiServer) sDel(w http.ResponseWriter, r *http.Request){
 		r := Rsp{Key: parts[0], Value: "", Success: false}
 		data, _ := json.Marshal(r)
 		http.Error(w, string(data), http.StatusBadRequest)
+	}else if version, cas, err := parseVersion(r.URL.Query()); err != nil || cas {
+		if err == nil {
+			err = s.db(r).SDelCAS(parts[0], version)
+		}
+		writeRsp(w, parts[0], "", err)
 	}else{
-		s.cache.SDel(parts[0])
+		s.db(r).SDel(parts[0])
 		r := Rsp{Key: parts[0], Value: "", Success: true}
 		data, _ := json.Marshal(r)
 		w.Write(data)
@@ -539,8 +928,15 @@ func (s *apiServer) sDelMember(w http.ResponseWriter, r *http.Request){
 	}else{
 		vars := r.URL.Query()
 		value, ok := vars["value"]
-		if ok {
-			s.cache.SDelMember(parts[0], value[0])
+		version, cas, err := parseVersion(vars)
+		if ok && (err != nil || cas) {
+			ver := uint64(0)
+			if err == nil {
+				ver, err = s.db(r).SDelMemberCAS(parts[0], value[0], version)
+			}
+			writeCASRsp(w, parts[0], value[0], ver, err)
+		}else if ok {
+			s.db(r).SDelMember(parts[0], value[0])
 			r := Rsp{Key: parts[0], Value: value[0], Success: true}
 			data, _ := json.Marshal(r)
 			w.Write(data)
@@ -554,6 +950,1466 @@ func (s *apiServer) sDelMember(w http.ResponseWriter, r *http.Request){
 }
 
 func (s *apiServer) sDump(w http.ResponseWriter, r *http.Request){
-	data, _ := s.cache.SetCaches()
+	data, _ := s.db(r).SetCaches()
+	w.Write(data)
+}
+
+func (s *apiServer) pfAdd(w http.ResponseWriter, r *http.Request){
+	vars := r.URL.Query()
+	key, ok1 := vars["key"]
+	value, ok2 := vars["value"]
+
+	if ok1 == false {
+		r := Rsp{Key: "", Value: "", Success: false}
+		data, _ := json.Marshal(r)
+		http.Error(w, string(data), http.StatusBadRequest)
+		return
+	}
+
+	if ok2 == false {
+		r := Rsp{Key: key[0], Value: "", Success: false}
+		data, _ := json.Marshal(r)
+		http.Error(w, string(data), http.StatusBadRequest)
+		return
+	}
+
+	changed := false
+	e, err := parseKeepExpiration(vars)
+	if err == nil {
+		changed, err = s.db(r).PFAddEx(key[0], value, e)
+	}
+	writeRsp(w, key[0], changed, err)
+}
+
+func (s *apiServer) pfCount(w http.ResponseWriter, r *http.Request){
+	parts := strings.Split(r.URL.Path[len(PFCount):], "/")
+	if len(parts) == 0 || parts[0] == ""{
+		r := Rsp{Key: "", Value: "", Success: false}
+		data, _ := json.Marshal(r)
+		http.Error(w, string(data), http.StatusBadRequest)
+	}else{
+		v, err := s.db(r).PFCount(parts)
+		if err == nil {
+			r := Rsp{Key: strings.Join(parts, ","), Value:v, Success: true}
+			data, _ := json.Marshal(r)
+			w.Write(data)
+		}else{
+			r := Rsp{Key: strings.Join(parts, ","), Value: "", Success: false}
+			data, _ := json.Marshal(r)
+			w.Write(data)
+		}
+	}
+}
+
+func (s *apiServer) pfMerge(w http.ResponseWriter, r *http.Request){
+	vars := r.URL.Query()
+	destKey, ok1 := vars["destkey"]
+	key, ok2 := vars["key"]
+
+	if ok1 == false || ok2 == false {
+		r := Rsp{Key: "", Value: "", Success: false}
+		data, _ := json.Marshal(r)
+		http.Error(w, string(data), http.StatusBadRequest)
+		return
+	}
+
+	if err := s.db(r).PFMerge(destKey[0], key); err != nil {
+		writeRsp(w, destKey[0], nil, err)
+		return
+	}
+	v, _ := s.db(r).PFCount(destKey[:1])
+	rsp := Rsp{Key: destKey[0], Value:v, Success: true}
+	data, _ := json.Marshal(rsp)
+	w.Write(data)
+}
+
+func (s *apiServer) pfDel(w http.ResponseWriter, r *http.Request){
+	parts := strings.Split(r.URL.Path[len(PFDel):], "/")
+	if len(parts) != 1{
+		r := Rsp{Key: parts[0], Value: "", Success: false}
+		data, _ := json.Marshal(r)
+		http.Error(w, string(data), http.StatusBadRequest)
+	}else{
+		s.db(r).PFDel(parts[0])
+		r := Rsp{Key: parts[0], Value: "", Success: true}
+		data, _ := json.Marshal(r)
+		w.Write(data)
+	}
+}
+
+func (s *apiServer) pfDump(w http.ResponseWriter, r *http.Request){
+	data, _ := s.db(r).HLLCaches()
+	w.Write(data)
+}
+
+func (s *apiServer) geoAdd(w http.ResponseWriter, r *http.Request){
+	vars := r.URL.Query()
+	key, ok1 := vars["key"]
+	member, ok2 := vars["member"]
+	lon := vars["lon"]
+	lat := vars["lat"]
+
+	if ok1 == false || ok2 == false || len(member) != len(lon) || len(member) != len(lat) {
+		r := Rsp{Key: "", Value: "", Success: false}
+		data, _ := json.Marshal(r)
+		http.Error(w, string(data), http.StatusBadRequest)
+		return
+	}
+
+	members := make([]kv.GeoMember, len(member))
+	for i := range member {
+		lonF, err1 := strconv.ParseFloat(lon[i], 64)
+		latF, err2 := strconv.ParseFloat(lat[i], 64)
+		if err1 != nil || err2 != nil {
+			r := Rsp{Key: key[0], Value: "", Success: false}
+			data, _ := json.Marshal(r)
+			http.Error(w, string(data), http.StatusBadRequest)
+			return
+		}
+		members[i] = kv.GeoMember{Name: member[i], Longitude: lonF, Latitude: latF}
+	}
+
+	n := 0
+	e, err := parseKeepExpiration(vars)
+	if err == nil {
+		n, err = s.db(r).GeoAddEx(key[0], members, e)
+	}
+	writeRsp(w, key[0], n, err)
+}
+
+func (s *apiServer) geoPos(w http.ResponseWriter, r *http.Request){
+	parts := strings.Split(r.URL.Path[len(GeoPos):], "/")
+	if len(parts) < 2{
+		r := Rsp{Key: "", Value: "", Success: false}
+		data, _ := json.Marshal(r)
+		http.Error(w, string(data), http.StatusBadRequest)
+	}else{
+		v, err := s.db(r).GeoPos(parts[0], parts[1:])
+		if err == nil {
+			r := Rsp{Key: parts[0], Value:v, Success: true}
+			data, _ := json.Marshal(r)
+			w.Write(data)
+		}else{
+			r := Rsp{Key: parts[0], Value: "", Success: false}
+			data, _ := json.Marshal(r)
+			w.Write(data)
+		}
+	}
+}
+
+func (s *apiServer) geoDist(w http.ResponseWriter, r *http.Request){
+	parts := strings.Split(r.URL.Path[len(GeoDist):], "/")
+	if len(parts) != 3{
+		r := Rsp{Key: "", Value: "", Success: false}
+		data, _ := json.Marshal(r)
+		http.Error(w, string(data), http.StatusBadRequest)
+	}else{
+		unit := r.URL.Query().Get("unit")
+		v, err := s.db(r).GeoDist(parts[0], parts[1], parts[2], unit)
+		if err == nil {
+			r := Rsp{Key: parts[0], Value:v, Success: true}
+			data, _ := json.Marshal(r)
+			w.Write(data)
+		}else{
+			r := Rsp{Key: parts[0], Value: "", Success: false}
+			data, _ := json.Marshal(r)
+			w.Write(data)
+		}
+	}
+}
+
+func (s *apiServer) geoSearch(w http.ResponseWriter, r *http.Request){
+	parts := strings.Split(r.URL.Path[len(GeoSearch):], "/")
+	if len(parts) != 1{
+		r := Rsp{Key: "", Value: "", Success: false}
+		data, _ := json.Marshal(r)
+		http.Error(w, string(data), http.StatusBadRequest)
+		return
+	}
+
+	vars := r.URL.Query()
+	opt := kv.GeoSearchOption{Member: vars.Get("member"), Unit: vars.Get("unit"), Sort: strings.ToLower(vars.Get("sort"))}
+	opt.Longitude, _ = strconv.ParseFloat(vars.Get("lon"), 64)
+	opt.Latitude, _ = strconv.ParseFloat(vars.Get("lat"), 64)
+	opt.Radius, _ = strconv.ParseFloat(vars.Get("radius"), 64)
+	opt.Width, _ = strconv.ParseFloat(vars.Get("width"), 64)
+	opt.Height, _ = strconv.ParseFloat(vars.Get("height"), 64)
+	opt.Count, _ = strconv.Atoi(vars.Get("count"))
+
+	v, err := s.db(r).GeoSearch(parts[0], opt)
+	if err == nil {
+		r := Rsp{Key: parts[0], Value:v, Success: true}
+		data, _ := json.Marshal(r)
+		w.Write(data)
+	}else{
+		r := Rsp{Key: parts[0], Value: err.Error(), Success: false}
+		data, _ := json.Marshal(r)
+		w.Write(data)
+	}
+}
+
+func (s *apiServer) geoDel(w http.ResponseWriter, r *http.Request){
+	parts := strings.Split(r.URL.Path[len(GeoDel):], "/")
+	if len(parts) != 1{
+		r := Rsp{Key: parts[0], Value: "", Success: false}
+		data, _ := json.Marshal(r)
+		http.Error(w, string(data), http.StatusBadRequest)
+	}else{
+		s.db(r).GeoDel(parts[0])
+		r := Rsp{Key: parts[0], Value: "", Success: true}
+		data, _ := json.Marshal(r)
+		w.Write(data)
+	}
+}
+
+func (s *apiServer) geoDelMember(w http.ResponseWriter, r *http.Request){
+	parts := strings.Split(r.URL.Path[len(GeoDelMember):], "/")
+	if len(parts) != 2{
+		r := Rsp{Key: parts[0], Value: "", Success: false}
+		data, _ := json.Marshal(r)
+		http.Error(w, string(data), http.StatusBadRequest)
+	}else{
+		s.db(r).GeoDelMember(parts[0], parts[1])
+		r := Rsp{Key: parts[0], Value: parts[1], Success: true}
+		data, _ := json.Marshal(r)
+		w.Write(data)
+	}
+}
+
+func (s *apiServer) geoDump(w http.ResponseWriter, r *http.Request){
+	data, _ := s.db(r).GeoCaches()
 	w.Write(data)
-}
\ No newline at end of file
+}
+func (s *apiServer) xAdd(w http.ResponseWriter, r *http.Request){
+	vars := r.URL.Query()
+	key, ok1 := vars["key"]
+	field, ok2 := vars["field"]
+	value := vars["value"]
+
+	if ok1 == false || ok2 == false || len(field) != len(value) {
+		r := Rsp{Key: "", Value: "", Success: false}
+		data, _ := json.Marshal(r)
+		http.Error(w, string(data), http.StatusBadRequest)
+		return
+	}
+
+	id := vars.Get("id")
+	if id == "" {
+		id = kv.StreamAutoID
+	}
+
+	trim := kv.StreamTrim{}
+	trim.MaxLen, _ = strconv.ParseInt(vars.Get("maxlen"), 10, 64)
+	trim.MaxAge, _ = strconv.ParseInt(vars.Get("maxage"), 10, 64)
+
+	v, err := s.db(r).XAdd(key[0], id, field, value, trim)
+	writeRsp(w, key[0], v, err)
+}
+
+func (s *apiServer) xLen(w http.ResponseWriter, r *http.Request){
+	parts := strings.Split(r.URL.Path[len(XLen):], "/")
+	if len(parts) != 1{
+		r := Rsp{Key: "", Value: "", Success: false}
+		data, _ := json.Marshal(r)
+		http.Error(w, string(data), http.StatusBadRequest)
+	}else{
+		v, err := s.db(r).XLen(parts[0])
+		writeRsp(w, parts[0], v, err)
+	}
+}
+
+func (s *apiServer) xRange(w http.ResponseWriter, r *http.Request, prefix string, rev bool){
+	parts := strings.Split(r.URL.Path[len(prefix):], "/")
+	if len(parts) != 1{
+		r := Rsp{Key: "", Value: "", Success: false}
+		data, _ := json.Marshal(r)
+		http.Error(w, string(data), http.StatusBadRequest)
+		return
+	}
+
+	vars := r.URL.Query()
+	start := vars.Get("start")
+	if start == "" {
+		start = kv.StreamMinID
+	}
+	end := vars.Get("end")
+	if end == "" {
+		end = kv.StreamMaxID
+	}
+	count, _ := strconv.Atoi(vars.Get("count"))
+
+	var v []kv.StreamEntry
+	var err error
+	if rev {
+		v, err = s.db(r).XRevRange(parts[0], end, start, count)
+	}else{
+		v, err = s.db(r).XRange(parts[0], start, end, count)
+	}
+	writeRsp(w, parts[0], v, err)
+}
+
+func (s *apiServer) xRead(w http.ResponseWriter, r *http.Request){
+	vars := r.URL.Query()
+	key, ok1 := vars["key"]
+	id, ok2 := vars["id"]
+
+	if ok1 == false || ok2 == false {
+		r := Rsp{Key: "", Value: "", Success: false}
+		data, _ := json.Marshal(r)
+		http.Error(w, string(data), http.StatusBadRequest)
+		return
+	}
+
+	count, _ := strconv.Atoi(vars.Get("count"))
+	block, _ := strconv.ParseInt(vars.Get("block"), 10, 64)
+
+	v, err := s.db(r).XRead(r.Context(), key, id, count, block)
+	writeRsp(w, strings.Join(key, ","), v, err)
+}
+
+func (s *apiServer) xReadGroup(w http.ResponseWriter, r *http.Request){
+	vars := r.URL.Query()
+	key, ok1 := vars["key"]
+	id, ok2 := vars["id"]
+	group := vars.Get("group")
+	consumer := vars.Get("consumer")
+
+	if ok1 == false || ok2 == false || group == "" || consumer == "" {
+		r := Rsp{Key: "", Value: "", Success: false}
+		data, _ := json.Marshal(r)
+		http.Error(w, string(data), http.StatusBadRequest)
+		return
+	}
+
+	count, _ := strconv.Atoi(vars.Get("count"))
+	block, _ := strconv.ParseInt(vars.Get("block"), 10, 64)
+	noAck, _ := strconv.ParseBool(vars.Get("noack"))
+
+	v, err := s.db(r).XReadGroup(r.Context(), group, consumer, key, id, count, block, noAck)
+	writeRsp(w, strings.Join(key, ","), v, err)
+}
+
+func (s *apiServer) xGroupCreate(w http.ResponseWriter, r *http.Request){
+	vars := r.URL.Query()
+	key := vars.Get("key")
+	group := vars.Get("group")
+
+	if key == "" || group == "" {
+		r := Rsp{Key: "", Value: "", Success: false}
+		data, _ := json.Marshal(r)
+		http.Error(w, string(data), http.StatusBadRequest)
+		return
+	}
+
+	id := vars.Get("id")
+	if id == "" {
+		id = kv.StreamLastID
+	}
+	mkStream, _ := strconv.ParseBool(vars.Get("mkstream"))
+
+	err := s.db(r).XGroupCreate(key, group, id, mkStream)
+	writeRsp(w, key, group, err)
+}
+
+func (s *apiServer) xGroupDestroy(w http.ResponseWriter, r *http.Request){
+	vars := r.URL.Query()
+	key := vars.Get("key")
+	group := vars.Get("group")
+
+	if key == "" || group == "" {
+		r := Rsp{Key: "", Value: "", Success: false}
+		data, _ := json.Marshal(r)
+		http.Error(w, string(data), http.StatusBadRequest)
+		return
+	}
+
+	v, err := s.db(r).XGroupDestroy(key, group)
+	writeRsp(w, key, v, err)
+}
+
+func (s *apiServer) xAck(w http.ResponseWriter, r *http.Request){
+	vars := r.URL.Query()
+	key := vars.Get("key")
+	group := vars.Get("group")
+	id, ok := vars["id"]
+
+	if key == "" || group == "" || ok == false {
+		r := Rsp{Key: "", Value: "", Success: false}
+		data, _ := json.Marshal(r)
+		http.Error(w, string(data), http.StatusBadRequest)
+		return
+	}
+
+	v, err := s.db(r).XAck(key, group, id)
+	writeRsp(w, key, v, err)
+}
+
+func (s *apiServer) xPending(w http.ResponseWriter, r *http.Request){
+	vars := r.URL.Query()
+	key := vars.Get("key")
+	group := vars.Get("group")
+
+	if key == "" || group == "" {
+		r := Rsp{Key: "", Value: "", Success: false}
+		data, _ := json.Marshal(r)
+		http.Error(w, string(data), http.StatusBadRequest)
+		return
+	}
+
+	count, _ := strconv.Atoi(vars.Get("count"))
+	v, err := s.db(r).XPending(key, group, vars.Get("consumer"), count)
+	writeRsp(w, key, v, err)
+}
+
+func (s *apiServer) xClaim(w http.ResponseWriter, r *http.Request){
+	vars := r.URL.Query()
+	key := vars.Get("key")
+	group := vars.Get("group")
+	consumer := vars.Get("consumer")
+	id, ok := vars["id"]
+
+	if key == "" || group == "" || consumer == "" || ok == false {
+		r := Rsp{Key: "", Value: "", Success: false}
+		data, _ := json.Marshal(r)
+		http.Error(w, string(data), http.StatusBadRequest)
+		return
+	}
+
+	minIdle, _ := strconv.ParseInt(vars.Get("minidle"), 10, 64)
+	v, err := s.db(r).XClaim(key, group, consumer, minIdle, id)
+	writeRsp(w, key, v, err)
+}
+
+func (s *apiServer) xTrim(w http.ResponseWriter, r *http.Request){
+	vars := r.URL.Query()
+	key := vars.Get("key")
+
+	if key == "" {
+		r := Rsp{Key: "", Value: "", Success: false}
+		data, _ := json.Marshal(r)
+		http.Error(w, string(data), http.StatusBadRequest)
+		return
+	}
+
+	trim := kv.StreamTrim{}
+	trim.MaxLen, _ = strconv.ParseInt(vars.Get("maxlen"), 10, 64)
+	trim.MaxAge, _ = strconv.ParseInt(vars.Get("maxage"), 10, 64)
+
+	v, err := s.db(r).XTrim(key, trim)
+	writeRsp(w, key, v, err)
+}
+
+func (s *apiServer) xDel(w http.ResponseWriter, r *http.Request){
+	parts := strings.Split(r.URL.Path[len(XDel):], "/")
+	if len(parts) != 1{
+		r := Rsp{Key: parts[0], Value: "", Success: false}
+		data, _ := json.Marshal(r)
+		http.Error(w, string(data), http.StatusBadRequest)
+	}else{
+		s.db(r).XDel(parts[0])
+		r := Rsp{Key: parts[0], Value: "", Success: true}
+		data, _ := json.Marshal(r)
+		w.Write(data)
+	}
+}
+
+func (s *apiServer) xDelMember(w http.ResponseWriter, r *http.Request){
+	vars := r.URL.Query()
+	key := vars.Get("key")
+	id, ok := vars["id"]
+
+	if key == "" || ok == false {
+		r := Rsp{Key: "", Value: "", Success: false}
+		data, _ := json.Marshal(r)
+		http.Error(w, string(data), http.StatusBadRequest)
+		return
+	}
+
+	v, err := s.db(r).XDelMember(key, id)
+	writeRsp(w, key, v, err)
+}
+
+func (s *apiServer) xDump(w http.ResponseWriter, r *http.Request){
+	data, _ := s.db(r).StreamCaches()
+	w.Write(data)
+}
+
+func writeRsp(w http.ResponseWriter, key string, v interface{}, err error){
+	var rsp Rsp
+	if err == nil {
+		rsp = Rsp{Key: key, Value:v, Success: true}
+	}else{
+		rsp = Rsp{Key: key, Value:err.Error(), Success: false}
+	}
+	data, _ := json.Marshal(rsp)
+	if err != nil && cache.IsOOM(err) {
+		http.Error(w, string(data), http.StatusInsufficientStorage)
+		return
+	}
+	if err != nil && (cache.IsWatchError(err) || cache.IsVersionError(err)) {
+		http.Error(w, string(data), http.StatusConflict)
+		return
+	}
+	w.Write(data)
+}
+
+/*
+CAS 写入的响应，成功时带上写入后的版本
+*/
+func writeCASRsp(w http.ResponseWriter, key string, v interface{}, version uint64, err error){
+	if err != nil {
+		writeRsp(w, key, nil, err)
+		return
+	}
+	rsp := Rsp{Key: key, Value:v, Version:version, Success: true}
+	data, _ := json.Marshal(rsp)
+	w.Write(data)
+}
+
+func (s *apiServer) jSet(w http.ResponseWriter, r *http.Request){
+	vars := r.URL.Query()
+	key, ok1 := vars["key"]
+	value, ok2 := vars["value"]
+	expire, ok3 := vars["expire"]
+
+	if ok1 == false || ok2 == false {
+		r := Rsp{Key: "", Value: "", Success: false}
+		data, _ := json.Marshal(r)
+		http.Error(w, string(data), http.StatusBadRequest)
+		return
+	}
+
+	path := vars.Get("path")
+	var err error
+	if ok3{
+		int64, e := strconv.ParseInt(expire[0], 10, 64)
+		if e == nil{
+			err = s.db(r).JSet(key[0], path, value[0], int64)
+		}else{
+			err = s.db(r).JSet(key[0], path, value[0], kv.ExpireForever)
+		}
+	}else{
+		err = s.db(r).JSet(key[0], path, value[0], kv.ExpireForever)
+	}
+	writeRsp(w, key[0], path, err)
+}
+
+func (s *apiServer) jGet(w http.ResponseWriter, r *http.Request){
+	parts := strings.Split(r.URL.Path[len(JGet):], "/")
+	if len(parts) != 1{
+		r := Rsp{Key: "", Value: "", Success: false}
+		data, _ := json.Marshal(r)
+		http.Error(w, string(data), http.StatusBadRequest)
+		return
+	}
+
+	v, err := s.db(r).JGet(parts[0], r.URL.Query()["path"])
+	if err == nil {
+		writeRsp(w, parts[0], json.RawMessage(v), nil)
+	}else{
+		writeRsp(w, parts[0], nil, err)
+	}
+}
+
+func (s *apiServer) jDelPath(w http.ResponseWriter, r *http.Request){
+	vars := r.URL.Query()
+	key := vars.Get("key")
+
+	if key == "" {
+		r := Rsp{Key: "", Value: "", Success: false}
+		data, _ := json.Marshal(r)
+		http.Error(w, string(data), http.StatusBadRequest)
+		return
+	}
+
+	v, err := s.db(r).JDelPath(key, vars.Get("path"))
+	writeRsp(w, key, v, err)
+}
+
+func (s *apiServer) jArrAppend(w http.ResponseWriter, r *http.Request){
+	vars := r.URL.Query()
+	key := vars.Get("key")
+	value, ok := vars["value"]
+
+	if key == "" || ok == false {
+		r := Rsp{Key: "", Value: "", Success: false}
+		data, _ := json.Marshal(r)
+		http.Error(w, string(data), http.StatusBadRequest)
+		return
+	}
+
+	v, err := s.db(r).JArrAppend(key, vars.Get("path"), value)
+	writeRsp(w, key, v, err)
+}
+
+func (s *apiServer) jNumIncrBy(w http.ResponseWriter, r *http.Request){
+	vars := r.URL.Query()
+	key := vars.Get("key")
+	by := vars.Get("by")
+
+	if key == "" || by == "" {
+		r := Rsp{Key: "", Value: "", Success: false}
+		data, _ := json.Marshal(r)
+		http.Error(w, string(data), http.StatusBadRequest)
+		return
+	}
+
+	v, err := s.db(r).JNumIncrBy(key, vars.Get("path"), by)
+	if err == nil {
+		writeRsp(w, key, json.RawMessage(v), nil)
+	}else{
+		writeRsp(w, key, nil, err)
+	}
+}
+
+func (s *apiServer) jDel(w http.ResponseWriter, r *http.Request){
+	parts := strings.Split(r.URL.Path[len(JDel):], "/")
+	if len(parts) != 1{
+		r := Rsp{Key: parts[0], Value: "", Success: false}
+		data, _ := json.Marshal(r)
+		http.Error(w, string(data), http.StatusBadRequest)
+	}else{
+		s.db(r).JDel(parts[0])
+		r := Rsp{Key: parts[0], Value: "", Success: true}
+		data, _ := json.Marshal(r)
+		w.Write(data)
+	}
+}
+
+func (s *apiServer) jDump(w http.ResponseWriter, r *http.Request){
+	data, _ := s.db(r).JSONCaches()
+	w.Write(data)
+}
+
+func (s *apiServer) bfReserve(w http.ResponseWriter, r *http.Request){
+	vars := r.URL.Query()
+	key := vars.Get("key")
+	errorRate, err1 := strconv.ParseFloat(vars.Get("errorrate"), 64)
+	capacity, err2 := strconv.ParseUint(vars.Get("capacity"), 10, 64)
+
+	if key == "" || err1 != nil || err2 != nil {
+		r := Rsp{Key: "", Value: "", Success: false}
+		data, _ := json.Marshal(r)
+		http.Error(w, string(data), http.StatusBadRequest)
+		return
+	}
+
+	expire, _ := strconv.ParseInt(vars.Get("expire"), 10, 64)
+	err := s.db(r).BFReserve(key, errorRate, capacity, expire)
+	writeRsp(w, key, "", err)
+}
+
+func (s *apiServer) bfAdd(w http.ResponseWriter, r *http.Request){
+	vars := r.URL.Query()
+	key := vars.Get("key")
+	item, ok := vars["item"]
+
+	if key == "" || ok == false {
+		r := Rsp{Key: "", Value: "", Success: false}
+		data, _ := json.Marshal(r)
+		http.Error(w, string(data), http.StatusBadRequest)
+		return
+	}
+
+	expire, _ := strconv.ParseInt(vars.Get("expire"), 10, 64)
+	v, err := s.db(r).BFAdd(key, item, expire)
+	writeRsp(w, key, v, err)
+}
+
+func (s *apiServer) bfExists(w http.ResponseWriter, r *http.Request){
+	vars := r.URL.Query()
+	key := vars.Get("key")
+	item, ok := vars["item"]
+
+	if key == "" || ok == false {
+		r := Rsp{Key: "", Value: "", Success: false}
+		data, _ := json.Marshal(r)
+		http.Error(w, string(data), http.StatusBadRequest)
+		return
+	}
+
+	v, err := s.db(r).BFExists(key, item)
+	writeRsp(w, key, v, err)
+}
+
+func (s *apiServer) bfInfo(w http.ResponseWriter, r *http.Request){
+	parts := strings.Split(r.URL.Path[len(BFInfo):], "/")
+	if len(parts) != 1{
+		r := Rsp{Key: "", Value: "", Success: false}
+		data, _ := json.Marshal(r)
+		http.Error(w, string(data), http.StatusBadRequest)
+	}else{
+		v, err := s.db(r).BFInfo(parts[0])
+		writeRsp(w, parts[0], v, err)
+	}
+}
+
+func (s *apiServer) bfDel(w http.ResponseWriter, r *http.Request){
+	parts := strings.Split(r.URL.Path[len(BFDel):], "/")
+	if len(parts) != 1{
+		r := Rsp{Key: parts[0], Value: "", Success: false}
+		data, _ := json.Marshal(r)
+		http.Error(w, string(data), http.StatusBadRequest)
+	}else{
+		s.db(r).BFDel(parts[0])
+		r := Rsp{Key: parts[0], Value: "", Success: true}
+		data, _ := json.Marshal(r)
+		w.Write(data)
+	}
+}
+
+func (s *apiServer) bfDump(w http.ResponseWriter, r *http.Request){
+	data, _ := s.db(r).BloomCaches()
+	w.Write(data)
+}
+
+func (s *apiServer) cfReserve(w http.ResponseWriter, r *http.Request){
+	vars := r.URL.Query()
+	key := vars.Get("key")
+	capacity, err := strconv.ParseUint(vars.Get("capacity"), 10, 64)
+
+	if key == "" || err != nil {
+		r := Rsp{Key: "", Value: "", Success: false}
+		data, _ := json.Marshal(r)
+		http.Error(w, string(data), http.StatusBadRequest)
+		return
+	}
+
+	expire, _ := strconv.ParseInt(vars.Get("expire"), 10, 64)
+	err = s.db(r).CFReserve(key, capacity, expire)
+	writeRsp(w, key, "", err)
+}
+
+func (s *apiServer) cfAdd(w http.ResponseWriter, r *http.Request){
+	vars := r.URL.Query()
+	key := vars.Get("key")
+	item, ok := vars["item"]
+
+	if key == "" || ok == false {
+		r := Rsp{Key: "", Value: "", Success: false}
+		data, _ := json.Marshal(r)
+		http.Error(w, string(data), http.StatusBadRequest)
+		return
+	}
+
+	nx, _ := strconv.ParseBool(vars.Get("nx"))
+	expire, _ := strconv.ParseInt(vars.Get("expire"), 10, 64)
+	v, err := s.db(r).CFAdd(key, item, nx, expire)
+	writeRsp(w, key, v, err)
+}
+
+func (s *apiServer) cfExists(w http.ResponseWriter, r *http.Request){
+	vars := r.URL.Query()
+	key := vars.Get("key")
+	item, ok := vars["item"]
+
+	if key == "" || ok == false {
+		r := Rsp{Key: "", Value: "", Success: false}
+		data, _ := json.Marshal(r)
+		http.Error(w, string(data), http.StatusBadRequest)
+		return
+	}
+
+	v, err := s.db(r).CFExists(key, item)
+	writeRsp(w, key, v, err)
+}
+
+func (s *apiServer) cfDelMember(w http.ResponseWriter, r *http.Request){
+	vars := r.URL.Query()
+	key := vars.Get("key")
+	item := vars.Get("item")
+
+	if key == "" || item == "" {
+		r := Rsp{Key: "", Value: "", Success: false}
+		data, _ := json.Marshal(r)
+		http.Error(w, string(data), http.StatusBadRequest)
+		return
+	}
+
+	v, err := s.db(r).CFDelMember(key, item)
+	writeRsp(w, key, v, err)
+}
+
+func (s *apiServer) cfInfo(w http.ResponseWriter, r *http.Request){
+	parts := strings.Split(r.URL.Path[len(CFInfo):], "/")
+	if len(parts) != 1{
+		r := Rsp{Key: "", Value: "", Success: false}
+		data, _ := json.Marshal(r)
+		http.Error(w, string(data), http.StatusBadRequest)
+	}else{
+		v, err := s.db(r).CFInfo(parts[0])
+		writeRsp(w, parts[0], v, err)
+	}
+}
+
+func (s *apiServer) cfDel(w http.ResponseWriter, r *http.Request){
+	parts := strings.Split(r.URL.Path[len(CFDel):], "/")
+	if len(parts) != 1{
+		r := Rsp{Key: parts[0], Value: "", Success: false}
+		data, _ := json.Marshal(r)
+		http.Error(w, string(data), http.StatusBadRequest)
+	}else{
+		s.db(r).CFDel(parts[0])
+		r := Rsp{Key: parts[0], Value: "", Success: true}
+		data, _ := json.Marshal(r)
+		w.Write(data)
+	}
+}
+
+func (s *apiServer) cfDump(w http.ResponseWriter, r *http.Request){
+	data, _ := s.db(r).CuckooCaches()
+	w.Write(data)
+}
+
+func (s *apiServer) tsCreate(w http.ResponseWriter, r *http.Request){
+	vars := r.URL.Query()
+	key := vars.Get("key")
+
+	if key == "" {
+		r := Rsp{Key: "", Value: "", Success: false}
+		data, _ := json.Marshal(r)
+		http.Error(w, string(data), http.StatusBadRequest)
+		return
+	}
+
+	retention, _ := strconv.ParseInt(vars.Get("retention"), 10, 64)
+	expire, _ := strconv.ParseInt(vars.Get("expire"), 10, 64)
+	err := s.db(r).TSCreate(key, retention, expire)
+	writeRsp(w, key, "", err)
+}
+
+/*
+value 可以有多个，timestamp 不传时使用当前时间，传的话个数要和 value 一致
+*/
+func (s *apiServer) tsAdd(w http.ResponseWriter, r *http.Request){
+	vars := r.URL.Query()
+	key := vars.Get("key")
+	values, ok := vars["value"]
+	timestamps := vars["timestamp"]
+
+	if key == "" || ok == false || (len(timestamps) != 0 && len(timestamps) != len(values)) {
+		r := Rsp{Key: "", Value: "", Success: false}
+		data, _ := json.Marshal(r)
+		http.Error(w, string(data), http.StatusBadRequest)
+		return
+	}
+
+	samples := make([]kv.TSSample, len(values))
+	for i, value := range values {
+		v, err := strconv.ParseFloat(value, 64)
+		if err != nil {
+			writeRsp(w, key, "", err)
+			return
+		}
+		samples[i].Value = v
+		if len(timestamps) != 0 {
+			samples[i].Time, _ = strconv.ParseInt(timestamps[i], 10, 64)
+		}
+	}
+
+	expire, _ := strconv.ParseInt(vars.Get("expire"), 10, 64)
+	v, err := s.db(r).TSAdd(key, samples, expire)
+	writeRsp(w, key, v, err)
+}
+
+func (s *apiServer) tsRange(w http.ResponseWriter, r *http.Request){
+	parts := strings.Split(r.URL.Path[len(TSRange):], "/")
+	if len(parts) != 1{
+		r := Rsp{Key: "", Value: "", Success: false}
+		data, _ := json.Marshal(r)
+		http.Error(w, string(data), http.StatusBadRequest)
+		return
+	}
+
+	vars := r.URL.Query()
+	from, _ := strconv.ParseInt(vars.Get("from"), 10, 64)
+	to, err := strconv.ParseInt(vars.Get("to"), 10, 64)
+	if err != nil {
+		to = math.MaxInt64
+	}
+	bucket, _ := strconv.ParseInt(vars.Get("bucket"), 10, 64)
+	count, _ := strconv.Atoi(vars.Get("count"))
+
+	v, err := s.db(r).TSRange(parts[0], from, to, vars.Get("aggregation"), bucket, count)
+	writeRsp(w, parts[0], v, err)
+}
+
+func (s *apiServer) tsGet(w http.ResponseWriter, r *http.Request){
+	parts := strings.Split(r.URL.Path[len(TSGet):], "/")
+	if len(parts) != 1{
+		r := Rsp{Key: "", Value: "", Success: false}
+		data, _ := json.Marshal(r)
+		http.Error(w, string(data), http.StatusBadRequest)
+	}else{
+		v, err := s.db(r).TSGet(parts[0])
+		writeRsp(w, parts[0], v, err)
+	}
+}
+
+func (s *apiServer) tsDelRange(w http.ResponseWriter, r *http.Request){
+	vars := r.URL.Query()
+	key := vars.Get("key")
+	from, err1 := strconv.ParseInt(vars.Get("from"), 10, 64)
+	to, err2 := strconv.ParseInt(vars.Get("to"), 10, 64)
+
+	if key == "" || err1 != nil || err2 != nil {
+		r := Rsp{Key: "", Value: "", Success: false}
+		data, _ := json.Marshal(r)
+		http.Error(w, string(data), http.StatusBadRequest)
+		return
+	}
+
+	v, err := s.db(r).TSDelRange(key, from, to)
+	writeRsp(w, key, v, err)
+}
+
+func (s *apiServer) tsCreateRule(w http.ResponseWriter, r *http.Request){
+	vars := r.URL.Query()
+	source := vars.Get("source")
+	dest := vars.Get("dest")
+	bucket, err := strconv.ParseInt(vars.Get("bucket"), 10, 64)
+
+	if source == "" || dest == "" || err != nil {
+		r := Rsp{Key: "", Value: "", Success: false}
+		data, _ := json.Marshal(r)
+		http.Error(w, string(data), http.StatusBadRequest)
+		return
+	}
+
+	err = s.db(r).TSCreateRule(source, dest, vars.Get("aggregation"), bucket)
+	writeRsp(w, source, dest, err)
+}
+
+func (s *apiServer) tsDeleteRule(w http.ResponseWriter, r *http.Request){
+	vars := r.URL.Query()
+	source := vars.Get("source")
+	dest := vars.Get("dest")
+
+	if source == "" || dest == "" {
+		r := Rsp{Key: "", Value: "", Success: false}
+		data, _ := json.Marshal(r)
+		http.Error(w, string(data), http.StatusBadRequest)
+		return
+	}
+
+	err := s.db(r).TSDeleteRule(source, dest)
+	writeRsp(w, source, dest, err)
+}
+
+func (s *apiServer) tsInfo(w http.ResponseWriter, r *http.Request){
+	parts := strings.Split(r.URL.Path[len(TSInfo):], "/")
+	if len(parts) != 1{
+		r := Rsp{Key: "", Value: "", Success: false}
+		data, _ := json.Marshal(r)
+		http.Error(w, string(data), http.StatusBadRequest)
+	}else{
+		v, err := s.db(r).TSInfo(parts[0])
+		writeRsp(w, parts[0], v, err)
+	}
+}
+
+func (s *apiServer) tsDel(w http.ResponseWriter, r *http.Request){
+	parts := strings.Split(r.URL.Path[len(TSDel):], "/")
+	if len(parts) != 1{
+		r := Rsp{Key: parts[0], Value: "", Success: false}
+		data, _ := json.Marshal(r)
+		http.Error(w, string(data), http.StatusBadRequest)
+	}else{
+		s.db(r).TSDel(parts[0])
+		r := Rsp{Key: parts[0], Value: "", Success: true}
+		data, _ := json.Marshal(r)
+		w.Write(data)
+	}
+}
+
+func (s *apiServer) tsDump(w http.ResponseWriter, r *http.Request){
+	data, _ := s.db(r).TSCaches()
+	w.Write(data)
+}
+
+type ScanRsp struct {
+	Success bool        `json:"success"`
+	Key     string      `json:"key"`
+	Cursor  uint64      `json:"cursor"`
+	Value   interface{} `json:"value"`
+}
+
+func writeScanRsp(w http.ResponseWriter, key string, cursor uint64, v interface{}, err error){
+	var rsp ScanRsp
+	if err == nil {
+		rsp = ScanRsp{Key: key, Cursor: cursor, Value:v, Success: true}
+	}else{
+		rsp = ScanRsp{Key: key, Value:err.Error(), Success: false}
+	}
+	data, _ := json.Marshal(rsp)
+	w.Write(data)
+}
+
+func (s *apiServer) scan(w http.ResponseWriter, r *http.Request){
+	vars := r.URL.Query()
+	cursor, _ := strconv.ParseUint(vars.Get("cursor"), 10, 64)
+	count, _ := strconv.Atoi(vars.Get("count"))
+
+	v, next, err := s.db(r).Scan(cursor, vars.Get("match"), count, vars.Get("type"))
+	writeScanRsp(w, "", next, v, err)
+}
+
+func (s *apiServer) hScan(w http.ResponseWriter, r *http.Request){
+	parts := strings.Split(r.URL.Path[len(HScan):], "/")
+	if len(parts) != 1{
+		r := Rsp{Key: "", Value: "", Success: false}
+		data, _ := json.Marshal(r)
+		http.Error(w, string(data), http.StatusBadRequest)
+		return
+	}
+
+	vars := r.URL.Query()
+	cursor, _ := strconv.ParseUint(vars.Get("cursor"), 10, 64)
+	count, _ := strconv.Atoi(vars.Get("count"))
+
+	v, next, err := s.db(r).HScan(parts[0], cursor, vars.Get("match"), count)
+	writeScanRsp(w, parts[0], next, v, err)
+}
+
+func (s *apiServer) sScan(w http.ResponseWriter, r *http.Request){
+	parts := strings.Split(r.URL.Path[len(SScan):], "/")
+	if len(parts) != 1{
+		r := Rsp{Key: "", Value: "", Success: false}
+		data, _ := json.Marshal(r)
+		http.Error(w, string(data), http.StatusBadRequest)
+		return
+	}
+
+	vars := r.URL.Query()
+	cursor, _ := strconv.ParseUint(vars.Get("cursor"), 10, 64)
+	count, _ := strconv.Atoi(vars.Get("count"))
+
+	v, next, err := s.db(r).SScan(parts[0], cursor, vars.Get("match"), count)
+	writeScanRsp(w, parts[0], next, v, err)
+}
+
+func (s *apiServer) ttl(w http.ResponseWriter, r *http.Request, prefix string){
+	parts := strings.Split(r.URL.Path[len(prefix):], "/")
+	if len(parts) != 1{
+		r := Rsp{Key: "", Value: "", Success: false}
+		data, _ := json.Marshal(r)
+		http.Error(w, string(data), http.StatusBadRequest)
+		return
+	}
+
+	var v int64
+	var err error
+	if prefix == PTTL {
+		v, err = s.db(r).PTTL(parts[0], r.URL.Query().Get("type"))
+	}else{
+		v, err = s.db(r).TTL(parts[0], r.URL.Query().Get("type"))
+	}
+	writeRsp(w, parts[0], v, err)
+}
+
+func (s *apiServer) keyType(w http.ResponseWriter, r *http.Request){
+	parts := strings.Split(r.URL.Path[len(KeyType):], "/")
+	if len(parts) != 1{
+		r := Rsp{Key: "", Value: "", Success: false}
+		data, _ := json.Marshal(r)
+		http.Error(w, string(data), http.StatusBadRequest)
+		return
+	}
+
+	t, err := s.db(r).Type(parts[0])
+	writeRsp(w, parts[0], t, err)
+}
+
+func (s *apiServer) exists(w http.ResponseWriter, r *http.Request){
+	keys, ok := r.URL.Query()["key"]
+	if ok == false {
+		r := Rsp{Key: "", Value: "", Success: false}
+		data, _ := json.Marshal(r)
+		http.Error(w, string(data), http.StatusBadRequest)
+		return
+	}
+
+	writeRsp(w, "", s.db(r).Exists(keys), nil)
+}
+
+func (s *apiServer) delKeys(w http.ResponseWriter, r *http.Request){
+	keys, ok := r.URL.Query()["key"]
+	if ok == false {
+		r := Rsp{Key: "", Value: "", Success: false}
+		data, _ := json.Marshal(r)
+		http.Error(w, string(data), http.StatusBadRequest)
+		return
+	}
+
+	writeRsp(w, "", s.db(r).DelKeys(keys), nil)
+}
+
+func (s *apiServer) rename(w http.ResponseWriter, r *http.Request){
+	vars := r.URL.Query()
+	key := vars.Get("key")
+	newKey := vars.Get("newkey")
+
+	if key == "" || newKey == "" {
+		r := Rsp{Key: "", Value: "", Success: false}
+		data, _ := json.Marshal(r)
+		http.Error(w, string(data), http.StatusBadRequest)
+		return
+	}
+
+	err := s.db(r).Rename(key, newKey)
+	writeRsp(w, newKey, err == nil, err)
+}
+
+func (s *apiServer) copy(w http.ResponseWriter, r *http.Request){
+	vars := r.URL.Query()
+	key := vars.Get("key")
+	newKey := vars.Get("newkey")
+	replace := vars.Get("replace")
+
+	if key == "" || newKey == "" || (replace != "" && replace != "true" && replace != "false") {
+		r := Rsp{Key: "", Value: "", Success: false}
+		data, _ := json.Marshal(r)
+		http.Error(w, string(data), http.StatusBadRequest)
+		return
+	}
+
+	c := s.db(r)
+	dest := c
+	var err error
+	if db := vars.Get("db"); db != "" {
+		dest, err = s.dbs.Get(db)
+	}
+	ok := false
+	if err == nil {
+		ok, err = c.CopyTo(dest, key, newKey, replace == "true")
+	}
+	writeRsp(w, newKey, ok, err)
+}
+
+func (s *apiServer) keyInfo(w http.ResponseWriter, r *http.Request){
+	parts := strings.Split(r.URL.Path[len(KeyInfo):], "/")
+	if len(parts) != 1 || parts[0] == "" {
+		r := Rsp{Key: "", Value: "", Success: false}
+		data, _ := json.Marshal(r)
+		http.Error(w, string(data), http.StatusBadRequest)
+		return
+	}
+
+	info, err := s.db(r).KeyInfo(parts[0], r.URL.Query().Get("type"))
+	writeRsp(w, parts[0], info, err)
+}
+
+func (s *apiServer) keyStats(w http.ResponseWriter, r *http.Request){
+	writeRsp(w, "", s.db(r).KeyStats(), nil)
+}
+
+func (s *apiServer) memUsage(w http.ResponseWriter, r *http.Request){
+	parts := strings.Split(r.URL.Path[len(MemUsage):], "/")
+	if len(parts) != 1 || parts[0] == "" {
+		r := Rsp{Key: "", Value: "", Success: false}
+		data, _ := json.Marshal(r)
+		http.Error(w, string(data), http.StatusBadRequest)
+		return
+	}
+
+	n, err := s.db(r).MemoryUsage(parts[0], r.URL.Query().Get("type"))
+	writeRsp(w, parts[0], n, err)
+}
+
+func (s *apiServer) memStats(w http.ResponseWriter, r *http.Request){
+	writeRsp(w, "", cache.MemoryStats(r.URL.Query().Get("gc") == "true"), nil)
+}
+
+func (s *apiServer) move(w http.ResponseWriter, r *http.Request){
+	vars := r.URL.Query()
+	key := vars.Get("key")
+	db := vars.Get("db")
+
+	if key == "" || db == "" {
+		r := Rsp{Key: "", Value: "", Success: false}
+		data, _ := json.Marshal(r)
+		http.Error(w, string(data), http.StatusBadRequest)
+		return
+	}
+
+	ok := false
+	dest, err := s.dbs.Get(db)
+	if err == nil {
+		ok, err = s.db(r).MoveTo(dest, key)
+	}
+	writeRsp(w, key, ok, err)
+}
+
+func (s *apiServer) expire(w http.ResponseWriter, r *http.Request){
+	vars := r.URL.Query()
+	key := vars.Get("key")
+	seconds, err := strconv.ParseInt(vars.Get("seconds"), 10, 64)
+
+	if key == "" || err != nil {
+		r := Rsp{Key: "", Value: "", Success: false}
+		data, _ := json.Marshal(r)
+		http.Error(w, string(data), http.StatusBadRequest)
+		return
+	}
+
+	v, err := s.db(r).Expire(key, vars.Get("type"), seconds)
+	writeRsp(w, key, v, err)
+}
+
+func (s *apiServer) expireAt(w http.ResponseWriter, r *http.Request){
+	vars := r.URL.Query()
+	key := vars.Get("key")
+	timestamp, err := strconv.ParseInt(vars.Get("timestamp"), 10, 64)
+
+	if key == "" || err != nil {
+		r := Rsp{Key: "", Value: "", Success: false}
+		data, _ := json.Marshal(r)
+		http.Error(w, string(data), http.StatusBadRequest)
+		return
+	}
+
+	v, err := s.db(r).ExpireAt(key, vars.Get("type"), timestamp)
+	writeRsp(w, key, v, err)
+}
+
+func (s *apiServer) pExpire(w http.ResponseWriter, r *http.Request){
+	vars := r.URL.Query()
+	key := vars.Get("key")
+	milliseconds, err := strconv.ParseInt(vars.Get("milliseconds"), 10, 64)
+
+	if key == "" || err != nil {
+		r := Rsp{Key: "", Value: "", Success: false}
+		data, _ := json.Marshal(r)
+		http.Error(w, string(data), http.StatusBadRequest)
+		return
+	}
+
+	v, err := s.db(r).PExpire(key, vars.Get("type"), milliseconds)
+	writeRsp(w, key, v, err)
+}
+
+func (s *apiServer) pExpireAt(w http.ResponseWriter, r *http.Request){
+	vars := r.URL.Query()
+	key := vars.Get("key")
+	timestamp, err := strconv.ParseInt(vars.Get("timestamp"), 10, 64)
+
+	if key == "" || err != nil {
+		r := Rsp{Key: "", Value: "", Success: false}
+		data, _ := json.Marshal(r)
+		http.Error(w, string(data), http.StatusBadRequest)
+		return
+	}
+
+	v, err := s.db(r).PExpireAt(key, vars.Get("type"), timestamp)
+	writeRsp(w, key, v, err)
+}
+
+/*
+写入时的过期参数，最多只能设置一个
+expire 过期的秒数，px 过期的毫秒数，exat 过期的unix时间戳(秒)，pxat 过期的unix时间戳(毫秒)，keepttl=1 保留原有的过期时间
+*/
+/*
+请求带了 version 参数时为 CAS 写入，key当前的修改版本等于 version 时才执行，0表示key必须不存在
+*/
+func parseVersion(vars url.Values) (uint64, bool, error){
+	v := vars.Get("version")
+	if v == "" {
+		return 0, false, nil
+	}
+	version, err := strconv.ParseUint(v, 10, 64)
+	if err != nil {
+		str := fmt.Sprintf("invalid version:%s", v)
+		return 0, false, errors.New(str)
+	}
+	return version, true, nil
+}
+
+var expireParams = []string{"expire", "px", "exat", "pxat", "keepttl"}
+
+/*
+没有设置任何过期参数时保留key原有的过期时间，用于 pfadd、geoadd
+*/
+func parseKeepExpiration(vars url.Values) (kv.Expiration, error){
+	for _, name := range expireParams {
+		if vars.Get(name) != "" {
+			return parseExpiration(vars)
+		}
+	}
+	return kv.KeepTTL(), nil
+}
+
+func parseExpiration(vars url.Values) (kv.Expiration, error){
+	set := ""
+	for _, name := range expireParams {
+		if vars.Get(name) == "" {
+			continue
+		}
+		if set != "" {
+			return kv.Expiration{}, errors.New("only one of expire, px, exat, pxat, keepttl can be set")
+		}
+		set = name
+	}
+
+	if set == "" {
+		return kv.Expiration{}, nil
+	}
+
+	if set == "keepttl" {
+		keep, err := strconv.ParseBool(vars.Get(set))
+		if err != nil {
+			str := fmt.Sprintf("invalid keepttl:%s", vars.Get(set))
+			return kv.Expiration{}, errors.New(str)
+		}
+		if keep {
+			return kv.KeepTTL(), nil
+		}
+		return kv.Expiration{}, nil
+	}
+
+	n, err := strconv.ParseInt(vars.Get(set), 10, 64)
+	if err != nil {
+		str := fmt.Sprintf("invalid %s:%s", set, vars.Get(set))
+		return kv.Expiration{}, errors.New(str)
+	}
+
+	switch set {
+	case "px":
+		return kv.ExpireMillis(n), nil
+	case "exat":
+		return kv.ExpireAtMillis(n*1000), nil
+	case "pxat":
+		return kv.ExpireAtMillis(n), nil
+	}
+	return kv.ExpireSeconds(n), nil
+}
+
+func (s *apiServer) persist(w http.ResponseWriter, r *http.Request){
+	vars := r.URL.Query()
+	key := vars.Get("key")
+
+	if key == "" {
+		r := Rsp{Key: "", Value: "", Success: false}
+		data, _ := json.Marshal(r)
+		http.Error(w, string(data), http.StatusBadRequest)
+		return
+	}
+
+	v, err := s.db(r).Persist(key, vars.Get("type"))
+	writeRsp(w, key, v, err)
+}
+
+func (s *apiServer) txWatch(w http.ResponseWriter, r *http.Request){
+	keys, ok := r.URL.Query()["key"]
+	if ok == false {
+		r := Rsp{Key: "", Value: "", Success: false}
+		data, _ := json.Marshal(r)
+		http.Error(w, string(data), http.StatusBadRequest)
+		return
+	}
+
+	writeRsp(w, "", s.db(r).Revisions(keys), nil)
+}
+
+/*
+POST的body为json {"watch":{"k1":3},"commands":[{"cmd":"lput","key":"l1","args":["a"]}]}
+*/
+type execReq struct {
+	Watch    map[string]uint64 `json:"watch"`
+	Commands []kv.TxCommand    `json:"commands"`
+}
+
+func (s *apiServer) exec(w http.ResponseWriter, r *http.Request){
+	var req execReq
+	if r.Method != http.MethodPost || json.NewDecoder(r.Body).Decode(&req) != nil {
+		r := Rsp{Key: "", Value: "", Success: false}
+		data, _ := json.Marshal(r)
+		http.Error(w, string(data), http.StatusBadRequest)
+		return
+	}
+
+	results, err := s.db(r).Exec(req.Commands, req.Watch)
+	writeRsp(w, "", results, err)
+}
+
+type mGetValue struct {
+	Key   string `json:"key"`
+	Value string `json:"value"`
+	Found bool   `json:"found"`
+}
+
+func (s *apiServer) mGet(w http.ResponseWriter, r *http.Request){
+	keys, ok := r.URL.Query()["key"]
+	if ok == false {
+		r := Rsp{Key: "", Value: "", Success: false}
+		data, _ := json.Marshal(r)
+		http.Error(w, string(data), http.StatusBadRequest)
+		return
+	}
+
+	values, found := s.db(r).MGet(keys)
+	arr := make([]mGetValue, len(keys))
+	for i, key := range keys {
+		arr[i] = mGetValue{Key: key, Value: values[i], Found: found[i]}
+	}
+	writeRsp(w, "", arr, nil)
+}
+
+/*
+/mset?key=k1&value=v1&key=k2&value=v2，过期设置和 /put 相同
+*/
+func (s *apiServer) mSet(w http.ResponseWriter, r *http.Request){
+	vars := r.URL.Query()
+	keys, ok1 := vars["key"]
+	values, ok2 := vars["value"]
+	if ok1 == false || ok2 == false {
+		r := Rsp{Key: "", Value: "", Success: false}
+		data, _ := json.Marshal(r)
+		http.Error(w, string(data), http.StatusBadRequest)
+		return
+	}
+
+	e, err := parseExpiration(vars)
+	if err == nil {
+		err = s.db(r).MSet(keys, values, e)
+	}
+	writeRsp(w, "", len(keys), err)
+}
+
+/*
+POST的body为命令的json数组 [{"cmd":"get","key":"k1"},{"cmd":"pfadd","key":"h1","args":["a","b"]}]
+返回和命令一一对应的结果，失败的命令在结果的 error 中
+*/
+func (s *apiServer) batch(w http.ResponseWriter, r *http.Request){
+	var cmds []kv.TxCommand
+	if r.Method != http.MethodPost || json.NewDecoder(r.Body).Decode(&cmds) != nil {
+		r := Rsp{Key: "", Value: "", Success: false}
+		data, _ := json.Marshal(r)
+		http.Error(w, string(data), http.StatusBadRequest)
+		return
+	}
+
+	results, err := s.db(r).Batch(cmds)
+	if err != nil {
+		rsp := Rsp{Key: "", Value: err.Error(), Success: false}
+		data, _ := json.Marshal(rsp)
+		http.Error(w, string(data), http.StatusBadRequest)
+		return
+	}
+	writeRsp(w, "", results, nil)
+}
+
+/*
+/createdb?db=name 创建数据库，已经存在时 value 为false
+*/
+func (s *apiServer) createDB(w http.ResponseWriter, r *http.Request){
+	name := r.URL.Query().Get("db")
+	if name == "" {
+		r := Rsp{Key: "", Value: "", Success: false}
+		data, _ := json.Marshal(r)
+		http.Error(w, string(data), http.StatusBadRequest)
+		return
+	}
+
+	created, err := s.dbs.Create(name)
+	writeRsp(w, name, created, err)
+}
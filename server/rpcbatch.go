@@ -0,0 +1,350 @@
+package server
+
+import (
+	"errors"
+	"github.com/llr104/lightkv/cache"
+	"github.com/llr104/lightkv/cache/kv"
+	bridge "github.com/llr104/lightkv/pb"
+	"golang.org/x/net/context"
+	"log"
+	"strconv"
+)
+
+/*
+批量读取string，found 为false表示对应的key不存在、已过期或者不是string
+*/
+func (s *rpcClient) MGet(keys []string) ([]string, []bool, error){
+	rsp, err := s.c.MGet(context.Background(), &bridge.MGetReq{Keys:keys})
+	if err != nil{
+		log.Printf("MGet error: %s\n", err.Error())
+		return nil, nil, err
+	}
+	return rsp.Values, rsp.Found, nil
+}
+
+/*
+批量写入string，全部写入或者全部不写入
+*/
+func (s *rpcClient) MSet(keys []string, values []string, expire int64) error{
+	_, err := s.c.MSet(context.Background(), &bridge.MSetReq{Keys:keys, Values:values, Expire:expire})
+	if err != nil{
+		log.Printf("MSet error: %s\n", err.Error())
+	}
+	return err
+}
+
+func (s *rpcClient) MSetEx(keys []string, values []string, e kv.Expiration) error{
+	_, err := s.c.MSet(context.Background(), &bridge.MSetReq{Keys:keys, Values:values, Expiration:toExpiration(e)})
+	if err != nil{
+		log.Printf("MSetEx error: %s\n", err.Error())
+	}
+	return err
+}
+
+/*
+客户端的管道，命令先保存在本地，Exec 时一次请求发送到服务端按顺序执行
+和事务不同，命令之间不是原子的，每条命令的结果中单独返回错误
+*/
+type rpcPipeline struct {
+	c        *rpcClient
+	commands []*bridge.TxCommand
+	err      error
+}
+
+func (s *rpcClient) Pipeline() *rpcPipeline{
+	return &rpcPipeline{c: s}
+}
+
+func (s *rpcPipeline) add(cmd string, key string, expire int64, args ...string) *rpcPipeline{
+	s.commands = append(s.commands, &bridge.TxCommand{Cmd:cmd, Key:key, Args:args, Expire:expire})
+	return s
+}
+
+/*
+保存的命令个数
+*/
+func (s *rpcPipeline) Len() int{
+	return len(s.commands)
+}
+
+/*
+string
+*/
+func (s *rpcPipeline) Put(key string, value string, expire int64) *rpcPipeline{
+	return s.add(cache.TxPut, key, expire, value)
+}
+
+func (s *rpcPipeline) Get(key string) *rpcPipeline{
+	return s.add(cache.TxGet, key, 0)
+}
+
+func (s *rpcPipeline) IncrBy(key string, delta int64) *rpcPipeline{
+	return s.add(cache.TxIncrBy, key, 0, strconv.FormatInt(delta, 10))
+}
+
+/*
+删除任意类型的key，Value 为删除的个数
+*/
+func (s *rpcPipeline) Del(key string) *rpcPipeline{
+	return s.add(cache.TxDel, key, 0)
+}
+
+/*
+map
+*/
+func (s *rpcPipeline) HMPut(hmKey string, key []string, val []string, expire int64) *rpcPipeline{
+	if len(key) != len(val) {
+		s.err = errors.New("map keys len not equal fields len")
+		return s
+	}
+	args := make([]string, 0, len(key)*2)
+	for i := range key {
+		args = append(args, key[i], val[i])
+	}
+	return s.add(cache.TxHMPut, hmKey, expire, args...)
+}
+
+func (s *rpcPipeline) HMGet(hmKey string) *rpcPipeline{
+	return s.add(cache.TxHMGet, hmKey, 0)
+}
+
+func (s *rpcPipeline) HMGetMember(hmKey string, key string) *rpcPipeline{
+	return s.add(cache.TxHMGetMember, hmKey, 0, key)
+}
+
+func (s *rpcPipeline) HMDelMember(hmKey string, key string) *rpcPipeline{
+	return s.add(cache.TxHMDelMember, hmKey, 0, key)
+}
+
+func (s *rpcPipeline) HMDel(hmKey string) *rpcPipeline{
+	return s.add(cache.TxHMDel, hmKey, 0)
+}
+
+/*
+list
+*/
+func (s *rpcPipeline) LPut(key string, value []string, expire int64) *rpcPipeline{
+	return s.add(cache.TxLPut, key, expire, value...)
+}
+
+func (s *rpcPipeline) LGet(key string) *rpcPipeline{
+	return s.add(cache.TxLGet, key, 0)
+}
+
+func (s *rpcPipeline) LGetRange(key string, beg int32, end int32) *rpcPipeline{
+	return s.add(cache.TxLGetRange, key, 0, strconv.Itoa(int(beg)), strconv.Itoa(int(end)))
+}
+
+func (s *rpcPipeline) LDelRange(key string, beg int32, end int32) *rpcPipeline{
+	return s.add(cache.TxLDelRange, key, 0, strconv.Itoa(int(beg)), strconv.Itoa(int(end)))
+}
+
+func (s *rpcPipeline) LDel(key string) *rpcPipeline{
+	return s.add(cache.TxLDel, key, 0)
+}
+
+/*
+set
+*/
+func (s *rpcPipeline) SPut(key string, value []string, expire int64) *rpcPipeline{
+	return s.add(cache.TxSPut, key, expire, value...)
+}
+
+func (s *rpcPipeline) SGet(key string) *rpcPipeline{
+	return s.add(cache.TxSGet, key, 0)
+}
+
+func (s *rpcPipeline) SDelMember(key string, value string) *rpcPipeline{
+	return s.add(cache.TxSDelMember, key, 0, value)
+}
+
+func (s *rpcPipeline) SDel(key string) *rpcPipeline{
+	return s.add(cache.TxSDel, key, 0)
+}
+
+/*
+hll
+*/
+func (s *rpcPipeline) PFAdd(key string, elements []string, expire int64) *rpcPipeline{
+	return s.add(cache.BatchPFAdd, key, expire, elements...)
+}
+
+func (s *rpcPipeline) PFCount(keys []string) *rpcPipeline{
+	if len(keys) == 0 {
+		s.err = errors.New("PFCount need at least one key")
+		return s
+	}
+	return s.add(cache.BatchPFCount, keys[0], 0, keys[1:]...)
+}
+
+func (s *rpcPipeline) PFDel(key string) *rpcPipeline{
+	return s.add(cache.BatchPFDel, key, 0)
+}
+
+/*
+geo
+*/
+func (s *rpcPipeline) GeoAdd(key string, members []kv.GeoMember, expire int64) *rpcPipeline{
+	args := make([]string, 0, len(members)*3)
+	for _, m := range members {
+		args = append(args, strconv.FormatFloat(m.Longitude, 'f', -1, 64),
+			strconv.FormatFloat(m.Latitude, 'f', -1, 64), m.Name)
+	}
+	return s.add(cache.BatchGeoAdd, key, expire, args...)
+}
+
+func (s *rpcPipeline) GeoDist(key string, member1 string, member2 string, unit string) *rpcPipeline{
+	return s.add(cache.BatchGeoDist, key, 0, member1, member2, unit)
+}
+
+func (s *rpcPipeline) GeoDelMember(key string, member string) *rpcPipeline{
+	return s.add(cache.BatchGeoDelMember, key, 0, member)
+}
+
+func (s *rpcPipeline) GeoDel(key string) *rpcPipeline{
+	return s.add(cache.BatchGeoDel, key, 0)
+}
+
+/*
+stream
+*/
+func (s *rpcPipeline) XAdd(key string, id string, keys []string, values []string) *rpcPipeline{
+	if len(keys) != len(values) {
+		s.err = errors.New("stream fields len not equal values len")
+		return s
+	}
+	args := make([]string, 0, len(keys)*2+1)
+	args = append(args, id)
+	for i := range keys {
+		args = append(args, keys[i], values[i])
+	}
+	return s.add(cache.BatchXAdd, key, 0, args...)
+}
+
+func (s *rpcPipeline) XLen(key string) *rpcPipeline{
+	return s.add(cache.BatchXLen, key, 0)
+}
+
+func (s *rpcPipeline) XDel(key string) *rpcPipeline{
+	return s.add(cache.BatchXDel, key, 0)
+}
+
+/*
+json
+*/
+func (s *rpcPipeline) JSet(key string, path string, value string, expire int64) *rpcPipeline{
+	return s.add(cache.BatchJSet, key, expire, path, value)
+}
+
+func (s *rpcPipeline) JGet(key string, paths []string) *rpcPipeline{
+	return s.add(cache.BatchJGet, key, 0, paths...)
+}
+
+func (s *rpcPipeline) JNumIncrBy(key string, path string, by string) *rpcPipeline{
+	return s.add(cache.BatchJNumIncrBy, key, 0, path, by)
+}
+
+func (s *rpcPipeline) JDel(key string) *rpcPipeline{
+	return s.add(cache.BatchJDel, key, 0)
+}
+
+/*
+bloom、cuckoo
+*/
+func (s *rpcPipeline) BFAdd(key string, items []string, expire int64) *rpcPipeline{
+	return s.add(cache.BatchBFAdd, key, expire, items...)
+}
+
+func (s *rpcPipeline) BFExists(key string, items []string) *rpcPipeline{
+	return s.add(cache.BatchBFExists, key, 0, items...)
+}
+
+func (s *rpcPipeline) BFDel(key string) *rpcPipeline{
+	return s.add(cache.BatchBFDel, key, 0)
+}
+
+func (s *rpcPipeline) CFAdd(key string, items []string, expire int64) *rpcPipeline{
+	return s.add(cache.BatchCFAdd, key, expire, items...)
+}
+
+func (s *rpcPipeline) CFExists(key string, items []string) *rpcPipeline{
+	return s.add(cache.BatchCFExists, key, 0, items...)
+}
+
+func (s *rpcPipeline) CFDel(key string) *rpcPipeline{
+	return s.add(cache.BatchCFDel, key, 0)
+}
+
+/*
+timeseries
+*/
+func (s *rpcPipeline) TSAdd(key string, samples []kv.TSSample, expire int64) *rpcPipeline{
+	args := make([]string, 0, len(samples)*2)
+	for _, t := range samples {
+		args = append(args, strconv.FormatInt(t.Time, 10), strconv.FormatFloat(t.Value, 'f', -1, 64))
+	}
+	return s.add(cache.BatchTSAdd, key, expire, args...)
+}
+
+func (s *rpcPipeline) TSGet(key string) *rpcPipeline{
+	return s.add(cache.BatchTSGet, key, 0)
+}
+
+func (s *rpcPipeline) TSDel(key string) *rpcPipeline{
+	return s.add(cache.BatchTSDel, key, 0)
+}
+
+/*
+key
+*/
+func (s *rpcPipeline) Expire(key string, seconds int64) *rpcPipeline{
+	return s.add(cache.BatchExpire, key, 0, strconv.FormatInt(seconds, 10))
+}
+
+func (s *rpcPipeline) TTL(key string) *rpcPipeline{
+	return s.add(cache.BatchTTL, key, 0)
+}
+
+func (s *rpcPipeline) Persist(key string) *rpcPipeline{
+	return s.add(cache.BatchPersist, key, 0)
+}
+
+func (s *rpcPipeline) Type(key string) *rpcPipeline{
+	return s.add(cache.BatchType, key, 0)
+}
+
+func (s *rpcPipeline) Exists(key string) *rpcPipeline{
+	return s.add(cache.BatchExists, key, 0)
+}
+
+/*
+一次请求发送所有命令，返回和命令一一对应的结果，Err 不为空表示这条命令失败
+执行后清空保存的命令，管道可以继续使用
+*/
+func (s *rpcPipeline) Exec() ([]kv.BatchResult, error){
+	if s.err != nil {
+		err := s.err
+		s.Discard()
+		return nil, err
+	}
+
+	rsp, err := s.c.c.Batch(context.Background(), &bridge.BatchReq{Commands:s.commands})
+	s.Discard()
+	if err != nil{
+		log.Printf("Batch error: %s\n", err.Error())
+		return nil, err
+	}
+	r := make([]kv.BatchResult, len(rsp.Results))
+	for i, t := range rsp.Results {
+		r[i] = kv.BatchResult{TxResult:kv.TxResult{Value:t.Value, Values:t.Values, Fields:t.Fields}, Err:t.Error}
+	}
+	return r, nil
+}
+
+/*
+丢弃保存的命令
+*/
+func (s *rpcPipeline) Discard() {
+	s.commands = nil
+	s.err = nil
+}
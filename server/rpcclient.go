@@ -2,12 +2,17 @@ package server
 
 import (
 	"encoding/json"
+	"github.com/llr104/lightkv/cache"
 	"github.com/llr104/lightkv/cache/kv"
 	bridge "github.com/llr104/lightkv/pb"
 	"golang.org/x/net/context"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/connectivity"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
 	"log"
+	"strings"
 	"sync"
 	"time"
 )
@@ -17,6 +22,11 @@ type WatchMapFunc func(string, string, string, string, kv.OpType)
 type WatchListFunc func(string, []string, []string, kv.OpType)
 type WatchSetFunc func(string, []string, []string, kv.OpType)
 
+/*
+参数依次为 key、变化的路径、路径上修改前的值、修改后的值，值为json格式
+*/
+type WatchJSONFunc func(string, string, string, string, kv.OpType)
+
 type rpcClient struct {
 	c          bridge.RpcBridgeClient
 	conn       *grpc.ClientConn
@@ -24,11 +34,15 @@ type rpcClient struct {
 	mapMutex   sync.Mutex
 	listMutex  sync.Mutex
 	setMutex   sync.Mutex
+	jsonMutex  sync.Mutex
+	dbMutex    sync.Mutex
+	db         string
 
 	watchKey 	map[string]WatchKeyFunc
 	watchMap 	map[string]map[string]WatchMapFunc
 	watchList 	map[string]WatchListFunc
 	watchSet 	map[string]WatchSetFunc
+	watchJSON 	map[string]WatchJSONFunc
 }
 
 func NewClient(host string) *rpcClient{
@@ -37,9 +51,10 @@ func NewClient(host string) *rpcClient{
 		watchMap:make(map[string]map[string]WatchMapFunc),
 		watchList:make(map[string]WatchListFunc),
 		watchSet:make(map[string]WatchSetFunc),
+		watchJSON:make(map[string]WatchJSONFunc),
 	}
 
-	conn, err := grpc.Dial(host, grpc.WithInsecure())
+	conn, err := grpc.Dial(host, grpc.WithInsecure(), grpc.WithUnaryInterceptor(s.dbInterceptor))
 
 	log.Printf("conn addr:%p", &conn)
 	if err != nil {
@@ -167,6 +182,14 @@ func (s*rpcClient) Start() {
 							f(data.Key, b, a, kv.OpType(data.Type))
 						}
 						s.setMutex.Unlock()
+					}else if data.DataType == kv.JSONData {
+
+						s.jsonMutex.Lock()
+						f, ok := s.watchJSON[data.Key]
+						if ok {
+							f(data.Key, data.Path, data.BeforeValue, data.AfterValue, kv.OpType(data.Type))
+						}
+						s.jsonMutex.Unlock()
 					}
 
 				}
@@ -198,6 +221,17 @@ func (s*rpcClient) Put(key string, value string, expire int64) error{
 	return err
 }
 
+/*
+e 可以是毫秒的相对时间、绝对时间或者保留原有的过期时间，见 kv.ExpireMillis、kv.ExpireAtMillis、kv.KeepTTL
+*/
+func (s*rpcClient) PutEx(key string, value string, e kv.Expiration) error{
+	_, err := s.c.Put(context.Background(), &bridge.PutReq{Key:key,  Value:value, Expiration:toExpiration(e)})
+	if err != nil{
+		log.Printf("PutEx error: %s\n", err.Error())
+	}
+	return err
+}
+
 func (s*rpcClient) Get(key string) string{
 	rsp, err := s.c.Get(context.Background(), &bridge.GetReq{Key: key})
 	if err == nil{
@@ -244,13 +278,13 @@ func (s*rpcClient) UnWatchKey(key string) error{
 /*
 map
 */
-func (s *rpcClient) HMGet(hmKey string) string {
+func (s *rpcClient) HMGet(hmKey string) (map[string]string, error) {
 	rsp, err := s.c.HMGet(context.Background(), &bridge.HMGetReq{HmKey:hmKey})
 	if err != nil{
 		log.Printf("HMGet error: %s\n", err.Error())
-		return ""
+		return map[string]string{}, err
 	}else{
-		return rsp.GetValue()
+		return rsp.GetFields(), nil
 	}
 }
 
@@ -271,6 +305,14 @@ func (s *rpcClient) HMPut(hmKey string, key []string, val [] string, expire int6
 	return err
 }
 
+func (s *rpcClient) HMPutEx(hmKey string, key []string, val [] string, e kv.Expiration) error{
+	_, err := s.c.HMPut(context.Background(), &bridge.HMPutReq{HmKey:hmKey, Key:key, Value:val, Expiration:toExpiration(e)})
+	if err != nil{
+		log.Printf("HMPutEx error: %s\n", err.Error())
+	}
+	return err
+}
+
 func (s *rpcClient) HMDel(hmKey string)  error {
 	_, err := s.c.HMDel(context.Background(), &bridge.HMDelReq{HmKey: hmKey})
 	if err != nil{
@@ -338,6 +380,14 @@ func (s*rpcClient) LPut(key string, value [] string, expire int64) error{
 	return err
 }
 
+func (s*rpcClient) LPutEx(key string, value [] string, e kv.Expiration) error{
+	_, err := s.c.LPut(context.Background(), &bridge.LPutReq{Key:key,  Value:value, Expiration:toExpiration(e)})
+	if err != nil{
+		log.Printf("LPutEx error: %s\n", err.Error())
+	}
+	return err
+}
+
 func (s*rpcClient) LGet(key string)([]string, error){
 	rsp, err := s.c.LGet(context.Background(), &bridge.LGetReq{Key:key})
 	if err != nil{
@@ -411,6 +461,14 @@ func (s*rpcClient) SPut(key string, value [] string, expire int64) error{
 	return err
 }
 
+func (s*rpcClient) SPutEx(key string, value [] string, e kv.Expiration) error{
+	_, err := s.c.SPut(context.Background(), &bridge.SPutReq{Key:key,  Value:value, Expiration:toExpiration(e)})
+	if err != nil{
+		log.Printf("SPutEx error: %s\n", err.Error())
+	}
+	return err
+}
+
 func (s*rpcClient) SGet(key string)([]string, error){
 	rsp, err := s.c.SGet(context.Background(), &bridge.SGetReq{Key:key})
 	if err != nil{
@@ -466,6 +524,867 @@ func (s*rpcClient) SUnWatchKey(key string) error{
 	return err
 }
 
+/*
+HyperLogLog
+expire 为0时保留key原有的过期时间
+*/
+func (s*rpcClient) PFAdd(key string, value []string, expire int64) (bool, error){
+	rsp, err := s.c.PFAdd(context.Background(), &bridge.PFAddReq{Key:key, Value:value, Expire:expire})
+	if err != nil{
+		log.Printf("PFAdd error: %s\n", err.Error())
+		return false, err
+	}
+	return rsp.Changed, nil
+}
+
+func (s*rpcClient) PFAddEx(key string, value []string, e kv.Expiration) (bool, error){
+	rsp, err := s.c.PFAdd(context.Background(), &bridge.PFAddReq{Key:key, Value:value, Expiration:toExpiration(e)})
+	if err != nil{
+		log.Printf("PFAddEx error: %s\n", err.Error())
+		return false, err
+	}
+	return rsp.Changed, nil
+}
+
+func (s*rpcClient) PFCount(keys ...string) (uint64, error){
+	rsp, err := s.c.PFCount(context.Background(), &bridge.PFCountReq{Key:keys})
+	if err != nil{
+		log.Printf("PFCount error: %s\n", err.Error())
+		return 0, err
+	}
+	return rsp.Count, nil
+}
+
+func (s*rpcClient) PFMerge(destKey string, srcKeys ...string) error{
+	_, err := s.c.PFMerge(context.Background(), &bridge.PFMergeReq{DestKey:destKey, SrcKey:srcKeys})
+	if err != nil{
+		log.Printf("PFMerge error: %s\n", err.Error())
+	}
+	return err
+}
+
+func (s*rpcClient) PFDel(key string) error{
+	_, err := s.c.PFDel(context.Background(), &bridge.PFDelReq{Key:key})
+	if err != nil{
+		log.Printf("PFDel error: %s\n", err.Error())
+	}
+	return err
+}
+
+/*
+geo
+expire 为0时保留key原有的过期时间
+*/
+func (s*rpcClient) GeoAdd(key string, members []kv.GeoMember, expire int64) (int, error){
+	rsp, err := s.c.GeoAdd(context.Background(), &bridge.GeoAddReq{Key:key, Member:toPbGeoMembers(members), Expire:expire})
+	if err != nil{
+		log.Printf("GeoAdd error: %s\n", err.Error())
+		return 0, err
+	}
+	return int(rsp.Added), nil
+}
+
+func (s*rpcClient) GeoAddEx(key string, members []kv.GeoMember, e kv.Expiration) (int, error){
+	rsp, err := s.c.GeoAdd(context.Background(), &bridge.GeoAddReq{Key:key, Member:toPbGeoMembers(members), Expiration:toExpiration(e)})
+	if err != nil{
+		log.Printf("GeoAddEx error: %s\n", err.Error())
+		return 0, err
+	}
+	return int(rsp.Added), nil
+}
+
+func (s*rpcClient) GeoPos(key string, members ...string) ([]kv.GeoMember, error){
+	rsp, err := s.c.GeoPos(context.Background(), &bridge.GeoPosReq{Key:key, Member:members})
+	if err != nil{
+		log.Printf("GeoPos error: %s\n", err.Error())
+		return []kv.GeoMember{}, err
+	}
+	return fromPbGeoMembers(rsp.Member), nil
+}
+
+func (s*rpcClient) GeoDist(key string, member1 string, member2 string, unit string) (float64, error){
+	rsp, err := s.c.GeoDist(context.Background(), &bridge.GeoDistReq{Key:key, Member1:member1, Member2:member2, Unit:unit})
+	if err != nil{
+		log.Printf("GeoDist error: %s\n", err.Error())
+		return 0, err
+	}
+	return rsp.Dist, nil
+}
+
+func (s*rpcClient) GeoSearch(key string, opt kv.GeoSearchOption) ([]kv.GeoMember, error){
+	req := bridge.GeoSearchReq{Key:key, Member:opt.Member, Longitude:opt.Longitude, Latitude:opt.Latitude,
+		Radius:opt.Radius, Width:opt.Width, Height:opt.Height, Unit:opt.Unit, Sort:opt.Sort, Count:int32(opt.Count)}
+	rsp, err := s.c.GeoSearch(context.Background(), &req)
+	if err != nil{
+		log.Printf("GeoSearch error: %s\n", err.Error())
+		return []kv.GeoMember{}, err
+	}
+	return fromPbGeoMembers(rsp.Member), nil
+}
+
+func (s*rpcClient) GeoDelMember(key string, member string) error{
+	_, err := s.c.GeoDelMember(context.Background(), &bridge.GeoDelMemberReq{Key:key, Member:member})
+	if err != nil{
+		log.Printf("GeoDelMember error: %s\n", err.Error())
+	}
+	return err
+}
+
+func (s*rpcClient) GeoDel(key string) error{
+	_, err := s.c.GeoDel(context.Background(), &bridge.GeoDelReq{Key:key})
+	if err != nil{
+		log.Printf("GeoDel error: %s\n", err.Error())
+	}
+	return err
+}
+
+func fromPbGeoMembers(arr []*bridge.GeoMember) []kv.GeoMember{
+	r := make([]kv.GeoMember, len(arr))
+	for i, m := range arr {
+		r[i] = kv.GeoMember{Name:m.Name, Longitude:m.Longitude, Latitude:m.Latitude, Dist:m.Dist}
+	}
+	return r
+}
+
+//stream
+func (s*rpcClient) XAdd(key string, id string, fields map[string]string, trim kv.StreamTrim) (string, error){
+	req := bridge.XAddReq{Key:key, Id:id, MaxLen:trim.MaxLen, MaxAge:trim.MaxAge}
+	for k, v := range fields {
+		req.Field = append(req.Field, k)
+		req.Value = append(req.Value, v)
+	}
+	rsp, err := s.c.XAdd(context.Background(), &req)
+	if err != nil{
+		log.Printf("XAdd error: %s\n", err.Error())
+		return "", err
+	}
+	return rsp.Id, nil
+}
+
+func (s*rpcClient) XLen(key string) (int64, error){
+	rsp, err := s.c.XLen(context.Background(), &bridge.XLenReq{Key:key})
+	if err != nil{
+		log.Printf("XLen error: %s\n", err.Error())
+		return 0, err
+	}
+	return rsp.Len, nil
+}
+
+func (s*rpcClient) XRange(key string, start string, end string, count int) ([]kv.StreamEntry, error){
+	rsp, err := s.c.XRange(context.Background(), &bridge.XRangeReq{Key:key, Start:start, End:end, Count:int32(count)})
+	if err != nil{
+		log.Printf("XRange error: %s\n", err.Error())
+		return []kv.StreamEntry{}, err
+	}
+	return fromPbStreamEntries(rsp.Entry), nil
+}
+
+func (s*rpcClient) XRevRange(key string, end string, start string, count int) ([]kv.StreamEntry, error){
+	rsp, err := s.c.XRange(context.Background(), &bridge.XRangeReq{Key:key, Start:start, End:end, Count:int32(count), Rev:true})
+	if err != nil{
+		log.Printf("XRevRange error: %s\n", err.Error())
+		return []kv.StreamEntry{}, err
+	}
+	return fromPbStreamEntries(rsp.Entry), nil
+}
+
+/*
+block 为阻塞等待的毫秒数，0不阻塞，小于0一直等待
+*/
+func (s*rpcClient) XRead(keys []string, ids []string, count int, block int64) ([]kv.StreamRead, error){
+	rsp, err := s.c.XRead(context.Background(), &bridge.XReadReq{Key:keys, Id:ids, Count:int32(count), Block:block})
+	if err != nil{
+		log.Printf("XRead error: %s\n", err.Error())
+		return []kv.StreamRead{}, err
+	}
+	return fromPbStreamReads(rsp.Stream), nil
+}
+
+func (s*rpcClient) XGroupCreate(key string, group string, id string, mkStream bool) error{
+	_, err := s.c.XGroupCreate(context.Background(), &bridge.XGroupReq{Key:key, Group:group, Id:id, MkStream:mkStream})
+	if err != nil{
+		log.Printf("XGroupCreate error: %s\n", err.Error())
+	}
+	return err
+}
+
+func (s*rpcClient) XGroupDestroy(key string, group string) error{
+	_, err := s.c.XGroupDestroy(context.Background(), &bridge.XGroupReq{Key:key, Group:group})
+	if err != nil{
+		log.Printf("XGroupDestroy error: %s\n", err.Error())
+	}
+	return err
+}
+
+func (s*rpcClient) XReadGroup(group string, consumer string, keys []string, ids []string,
+	count int, block int64, noAck bool) ([]kv.StreamRead, error){
+	req := bridge.XReadGroupReq{Group:group, Consumer:consumer, Key:keys, Id:ids, Count:int32(count), Block:block, NoAck:noAck}
+	rsp, err := s.c.XReadGroup(context.Background(), &req)
+	if err != nil{
+		log.Printf("XReadGroup error: %s\n", err.Error())
+		return []kv.StreamRead{}, err
+	}
+	return fromPbStreamReads(rsp.Stream), nil
+}
+
+func (s*rpcClient) XAck(key string, group string, ids ...string) (int64, error){
+	rsp, err := s.c.XAck(context.Background(), &bridge.XAckReq{Key:key, Group:group, Id:ids})
+	if err != nil{
+		log.Printf("XAck error: %s\n", err.Error())
+		return 0, err
+	}
+	return rsp.Count, nil
+}
+
+func (s*rpcClient) XPending(key string, group string, consumer string, count int) ([]*bridge.StreamPending, error){
+	rsp, err := s.c.XPending(context.Background(), &bridge.XPendingReq{Key:key, Group:group, Consumer:consumer, Count:int32(count)})
+	if err != nil{
+		log.Printf("XPending error: %s\n", err.Error())
+		return []*bridge.StreamPending{}, err
+	}
+	return rsp.Pending, nil
+}
+
+func (s*rpcClient) XClaim(key string, group string, consumer string, minIdle int64, ids ...string) ([]kv.StreamEntry, error){
+	rsp, err := s.c.XClaim(context.Background(), &bridge.XClaimReq{Key:key, Group:group, Consumer:consumer, MinIdle:minIdle, Id:ids})
+	if err != nil{
+		log.Printf("XClaim error: %s\n", err.Error())
+		return []kv.StreamEntry{}, err
+	}
+	return fromPbStreamEntries(rsp.Entry), nil
+}
+
+func (s*rpcClient) XTrim(key string, trim kv.StreamTrim) (int64, error){
+	rsp, err := s.c.XTrim(context.Background(), &bridge.XTrimReq{Key:key, MaxLen:trim.MaxLen, MaxAge:trim.MaxAge})
+	if err != nil{
+		log.Printf("XTrim error: %s\n", err.Error())
+		return 0, err
+	}
+	return rsp.Count, nil
+}
+
+func (s*rpcClient) XDelMember(key string, ids ...string) (int64, error){
+	rsp, err := s.c.XDelMember(context.Background(), &bridge.XDelMemberReq{Key:key, Id:ids})
+	if err != nil{
+		log.Printf("XDelMember error: %s\n", err.Error())
+		return 0, err
+	}
+	return rsp.Count, nil
+}
+
+func (s*rpcClient) XDel(key string) error{
+	_, err := s.c.XDel(context.Background(), &bridge.XDelReq{Key:key})
+	if err != nil{
+		log.Printf("XDel error: %s\n", err.Error())
+	}
+	return err
+}
+
+func fromPbStreamEntries(arr []*bridge.StreamEntry) []kv.StreamEntry{
+	r := make([]kv.StreamEntry, 0, len(arr))
+	for _, e := range arr {
+		id, err := kv.ParseStreamID(e.Id, 0)
+		if err != nil {
+			continue
+		}
+		r = append(r, kv.StreamEntry{ID:id, Fields:e.Fields})
+	}
+	return r
+}
+
+func fromPbStreamReads(arr []*bridge.StreamEntries) []kv.StreamRead{
+	r := make([]kv.StreamRead, len(arr))
+	for i, e := range arr {
+		r[i] = kv.StreamRead{Key:e.Key, Entries:fromPbStreamEntries(e.Entry)}
+	}
+	return r
+}
+
+//json
+func (s*rpcClient) JSet(key string, path string, value string, expire int64) error{
+	_, err := s.c.JSet(context.Background(), &bridge.JSetReq{Key:key, Path:path, Value:value, Expire:expire})
+	if err != nil{
+		log.Printf("JSet error: %s\n", err.Error())
+	}
+	return err
+}
+
+func (s*rpcClient) JGet(key string, paths ...string) (string, error){
+	rsp, err := s.c.JGet(context.Background(), &bridge.JGetReq{Key:key, Path:paths})
+	if err != nil{
+		log.Printf("JGet error: %s\n", err.Error())
+		return "", err
+	}
+	return rsp.Value, nil
+}
+
+func (s*rpcClient) JDelPath(key string, path string) (int64, error){
+	rsp, err := s.c.JDelPath(context.Background(), &bridge.JDelPathReq{Key:key, Path:path})
+	if err != nil{
+		log.Printf("JDelPath error: %s\n", err.Error())
+		return 0, err
+	}
+	return rsp.Count, nil
+}
+
+func (s*rpcClient) JArrAppend(key string, path string, values ...string) (int64, error){
+	rsp, err := s.c.JArrAppend(context.Background(), &bridge.JArrAppendReq{Key:key, Path:path, Value:values})
+	if err != nil{
+		log.Printf("JArrAppend error: %s\n", err.Error())
+		return 0, err
+	}
+	return rsp.Len, nil
+}
+
+func (s*rpcClient) JNumIncrBy(key string, path string, by string) (string, error){
+	rsp, err := s.c.JNumIncrBy(context.Background(), &bridge.JNumIncrByReq{Key:key, Path:path, By:by})
+	if err != nil{
+		log.Printf("JNumIncrBy error: %s\n", err.Error())
+		return "", err
+	}
+	return rsp.Value, nil
+}
+
+func (s*rpcClient) JDel(key string) error{
+	_, err := s.c.JDel(context.Background(), &bridge.JDelReq{Key:key})
+	if err != nil{
+		log.Printf("JDel error: %s\n", err.Error())
+	}
+	return err
+}
+
+func (s*rpcClient) JWatchKey(key string, watchFunc WatchJSONFunc) error{
+	_, err := s.c.JWatch(context.Background(), &bridge.JWatchReq{Key: key})
+	if err != nil{
+		log.Printf("JWatchKey error: %s\n", err.Error())
+	}else{
+		s.jsonMutex.Lock()
+		s.watchJSON[key] = watchFunc
+		s.jsonMutex.Unlock()
+	}
+	return err
+}
+
+func (s*rpcClient) JUnWatchKey(key string) error{
+	_, err := s.c.JUnWatch(context.Background(), &bridge.JWatchReq{Key: key})
+	if err != nil{
+		log.Printf("JUnWatchKey error: %s\n", err.Error())
+	}else{
+		s.jsonMutex.Lock()
+		delete(s.watchJSON, key)
+		s.jsonMutex.Unlock()
+	}
+	return err
+}
+
+//bloom filter
+func (s*rpcClient) BFReserve(key string, errorRate float64, capacity uint64, expire int64) error{
+	_, err := s.c.BFReserve(context.Background(), &bridge.BFReserveReq{Key:key, ErrorRate:errorRate, Capacity:capacity, Expire:expire})
+	if err != nil{
+		log.Printf("BFReserve error: %s\n", err.Error())
+	}
+	return err
+}
+
+func (s*rpcClient) BFAdd(key string, items []string, expire int64) ([]bool, error){
+	rsp, err := s.c.BFAdd(context.Background(), &bridge.BFAddReq{Key:key, Item:items, Expire:expire})
+	if err != nil{
+		log.Printf("BFAdd error: %s\n", err.Error())
+		return []bool{}, err
+	}
+	return rsp.Added, nil
+}
+
+func (s*rpcClient) BFExists(key string, items ...string) ([]bool, error){
+	rsp, err := s.c.BFExists(context.Background(), &bridge.BFExistsReq{Key:key, Item:items})
+	if err != nil{
+		log.Printf("BFExists error: %s\n", err.Error())
+		return []bool{}, err
+	}
+	return rsp.Exists, nil
+}
+
+func (s*rpcClient) BFInfo(key string) (*bridge.BFInfoRsp, error){
+	rsp, err := s.c.BFInfo(context.Background(), &bridge.BFInfoReq{Key:key})
+	if err != nil{
+		log.Printf("BFInfo error: %s\n", err.Error())
+	}
+	return rsp, err
+}
+
+func (s*rpcClient) BFDel(key string) error{
+	_, err := s.c.BFDel(context.Background(), &bridge.BFDelReq{Key:key})
+	if err != nil{
+		log.Printf("BFDel error: %s\n", err.Error())
+	}
+	return err
+}
+
+//cuckoo filter
+func (s*rpcClient) CFReserve(key string, capacity uint64, expire int64) error{
+	_, err := s.c.CFReserve(context.Background(), &bridge.CFReserveReq{Key:key, Capacity:capacity, Expire:expire})
+	if err != nil{
+		log.Printf("CFReserve error: %s\n", err.Error())
+	}
+	return err
+}
+
+func (s*rpcClient) CFAdd(key string, items []string, nx bool, expire int64) ([]bool, error){
+	rsp, err := s.c.CFAdd(context.Background(), &bridge.CFAddReq{Key:key, Item:items, Nx:nx, Expire:expire})
+	if err != nil{
+		log.Printf("CFAdd error: %s\n", err.Error())
+		return []bool{}, err
+	}
+	return rsp.Added, nil
+}
+
+func (s*rpcClient) CFExists(key string, items ...string) ([]bool, error){
+	rsp, err := s.c.CFExists(context.Background(), &bridge.CFExistsReq{Key:key, Item:items})
+	if err != nil{
+		log.Printf("CFExists error: %s\n", err.Error())
+		return []bool{}, err
+	}
+	return rsp.Exists, nil
+}
+
+func (s*rpcClient) CFDelMember(key string, item string) (bool, error){
+	rsp, err := s.c.CFDelMember(context.Background(), &bridge.CFDelMemberReq{Key:key, Item:item})
+	if err != nil{
+		log.Printf("CFDelMember error: %s\n", err.Error())
+		return false, err
+	}
+	return rsp.Deleted, nil
+}
+
+func (s*rpcClient) CFInfo(key string) (*bridge.CFInfoRsp, error){
+	rsp, err := s.c.CFInfo(context.Background(), &bridge.CFInfoReq{Key:key})
+	if err != nil{
+		log.Printf("CFInfo error: %s\n", err.Error())
+	}
+	return rsp, err
+}
+
+func (s*rpcClient) CFDel(key string) error{
+	_, err := s.c.CFDel(context.Background(), &bridge.CFDelReq{Key:key})
+	if err != nil{
+		log.Printf("CFDel error: %s\n", err.Error())
+	}
+	return err
+}
+
+//time series
+func (s*rpcClient) TSCreate(key string, retention int64, expire int64) error{
+	_, err := s.c.TSCreate(context.Background(), &bridge.TSCreateReq{Key:key, Retention:retention, Expire:expire})
+	if err != nil{
+		log.Printf("TSCreate error: %s\n", err.Error())
+	}
+	return err
+}
+
+/*
+timestamp 小于等于0时由服务端使用当前时间，返回实际使用的时间戳
+*/
+func (s*rpcClient) TSAdd(key string, timestamp int64, value float64, expire int64) (int64, error){
+	r, err := s.TSMAdd(key, []kv.TSSample{{Time: timestamp, Value: value}}, expire)
+	if err != nil || len(r) == 0{
+		return 0, err
+	}
+	return r[0], nil
+}
+
+func (s*rpcClient) TSMAdd(key string, samples []kv.TSSample, expire int64) ([]int64, error){
+	arr := make([]*bridge.TSSample, len(samples))
+	for i, sample := range samples {
+		arr[i] = &bridge.TSSample{Timestamp: sample.Time, Value: sample.Value}
+	}
+
+	rsp, err := s.c.TSAdd(context.Background(), &bridge.TSAddReq{Key:key, Samples:arr, Expire:expire})
+	if err != nil{
+		log.Printf("TSAdd error: %s\n", err.Error())
+		return []int64{}, err
+	}
+	return rsp.Timestamps, nil
+}
+
+/*
+aggregation 为空时返回原始样本，否则按 bucket 毫秒的时间桶聚合
+*/
+func (s*rpcClient) TSRange(key string, from int64, to int64, aggregation string, bucket int64, count int) ([]kv.TSSample, error){
+	rsp, err := s.c.TSRange(context.Background(), &bridge.TSRangeReq{Key:key, From:from, To:to,
+		Aggregation:aggregation, Bucket:bucket, Count:int32(count)})
+	if err != nil{
+		log.Printf("TSRange error: %s\n", err.Error())
+		return []kv.TSSample{}, err
+	}
+
+	r := make([]kv.TSSample, len(rsp.Samples))
+	for i, sample := range rsp.Samples {
+		r[i] = kv.TSSample{Time: sample.Timestamp, Value: sample.Value}
+	}
+	return r, nil
+}
+
+func (s*rpcClient) TSGet(key string) (kv.TSSample, error){
+	rsp, err := s.c.TSGet(context.Background(), &bridge.TSGetReq{Key:key})
+	if err != nil{
+		log.Printf("TSGet error: %s\n", err.Error())
+		return kv.TSSample{}, err
+	}
+	return kv.TSSample{Time: rsp.Sample.Timestamp, Value: rsp.Sample.Value}, nil
+}
+
+func (s*rpcClient) TSDelRange(key string, from int64, to int64) (int, error){
+	rsp, err := s.c.TSDelRange(context.Background(), &bridge.TSDelRangeReq{Key:key, From:from, To:to})
+	if err != nil{
+		log.Printf("TSDelRange error: %s\n", err.Error())
+		return 0, err
+	}
+	return int(rsp.Count), nil
+}
+
+func (s*rpcClient) TSCreateRule(sourceKey string, destKey string, aggregation string, bucket int64) error{
+	_, err := s.c.TSCreateRule(context.Background(), &bridge.TSRuleReq{SourceKey:sourceKey, DestKey:destKey,
+		Aggregation:aggregation, Bucket:bucket})
+	if err != nil{
+		log.Printf("TSCreateRule error: %s\n", err.Error())
+	}
+	return err
+}
+
+func (s*rpcClient) TSDeleteRule(sourceKey string, destKey string) error{
+	_, err := s.c.TSDeleteRule(context.Background(), &bridge.TSRuleReq{SourceKey:sourceKey, DestKey:destKey})
+	if err != nil{
+		log.Printf("TSDeleteRule error: %s\n", err.Error())
+	}
+	return err
+}
+
+func (s*rpcClient) TSInfo(key string) (*bridge.TSInfoRsp, error){
+	rsp, err := s.c.TSInfo(context.Background(), &bridge.TSInfoReq{Key:key})
+	if err != nil{
+		log.Printf("TSInfo error: %s\n", err.Error())
+	}
+	return rsp, err
+}
+
+func (s*rpcClient) TSDel(key string) error{
+	_, err := s.c.TSDel(context.Background(), &bridge.TSDelReq{Key:key})
+	if err != nil{
+		log.Printf("TSDel error: %s\n", err.Error())
+	}
+	return err
+}
+
+/*
+scan，cursor 从0开始，返回的游标为0时表示遍历结束，dataType 为空时遍历所有类型
+*/
+func (s*rpcClient) Scan(cursor uint64, match string, count int, dataType string) ([]kv.ScanKey, uint64, error){
+	rsp, err := s.c.Scan(context.Background(), &bridge.ScanReq{Cursor:cursor, Match:match, Count:int32(count), Type:dataType})
+	if err != nil{
+		log.Printf("Scan error: %s\n", err.Error())
+		return []kv.ScanKey{}, 0, err
+	}
+
+	r := make([]kv.ScanKey, len(rsp.Keys))
+	for i, k := range rsp.Keys {
+		r[i] = kv.ScanKey{Key: k.Key, Type: k.Type}
+	}
+	return r, rsp.Cursor, nil
+}
+
+func (s*rpcClient) HScan(hmKey string, cursor uint64, match string, count int) (map[string]string, uint64, error){
+	rsp, err := s.c.HScan(context.Background(), &bridge.HScanReq{HmKey:hmKey, Cursor:cursor, Match:match, Count:int32(count)})
+	if err != nil{
+		log.Printf("HScan error: %s\n", err.Error())
+		return map[string]string{}, 0, err
+	}
+	return rsp.Fields, rsp.Cursor, nil
+}
+
+func (s*rpcClient) SScan(key string, cursor uint64, match string, count int) ([]string, uint64, error){
+	rsp, err := s.c.SScan(context.Background(), &bridge.SScanReq{Key:key, Cursor:cursor, Match:match, Count:int32(count)})
+	if err != nil{
+		log.Printf("SScan error: %s\n", err.Error())
+		return []string{}, 0, err
+	}
+	return rsp.Members, rsp.Cursor, nil
+}
+
+/*
+ttl，dataType 为空时key只能存在于一种类型中
+返回剩余的秒数，key不存在时返回-2，没有过期时间时返回-1
+*/
+func (s*rpcClient) TTL(key string, dataType string) (int64, error){
+	rsp, err := s.c.TTL(context.Background(), &bridge.TTLReq{Key:key, Type:dataType})
+	if err != nil{
+		log.Printf("TTL error: %s\n", err.Error())
+		return cache.TTLNotFound, err
+	}
+	return rsp.Ttl, nil
+}
+
+func (s*rpcClient) PTTL(key string, dataType string) (int64, error){
+	rsp, err := s.c.PTTL(context.Background(), &bridge.TTLReq{Key:key, Type:dataType})
+	if err != nil{
+		log.Printf("PTTL error: %s\n", err.Error())
+		return cache.TTLNotFound, err
+	}
+	return rsp.Ttl, nil
+}
+
+func (s*rpcClient) Expire(key string, dataType string, seconds int64) (bool, error){
+	rsp, err := s.c.Expire(context.Background(), &bridge.ExpireReq{Key:key, Type:dataType, Seconds:seconds})
+	if err != nil{
+		log.Printf("Expire error: %s\n", err.Error())
+		return false, err
+	}
+	return rsp.Ok, nil
+}
+
+func (s*rpcClient) ExpireAt(key string, dataType string, timestamp int64) (bool, error){
+	rsp, err := s.c.ExpireAt(context.Background(), &bridge.ExpireAtReq{Key:key, Type:dataType, Timestamp:timestamp})
+	if err != nil{
+		log.Printf("ExpireAt error: %s\n", err.Error())
+		return false, err
+	}
+	return rsp.Ok, nil
+}
+
+func (s*rpcClient) PExpire(key string, dataType string, milliseconds int64) (bool, error){
+	rsp, err := s.c.PExpire(context.Background(), &bridge.PExpireReq{Key:key, Type:dataType, Milliseconds:milliseconds})
+	if err != nil{
+		log.Printf("PExpire error: %s\n", err.Error())
+		return false, err
+	}
+	return rsp.Ok, nil
+}
+
+func (s*rpcClient) PExpireAt(key string, dataType string, timestamp int64) (bool, error){
+	rsp, err := s.c.PExpireAt(context.Background(), &bridge.PExpireAtReq{Key:key, Type:dataType, Timestamp:timestamp})
+	if err != nil{
+		log.Printf("PExpireAt error: %s\n", err.Error())
+		return false, err
+	}
+	return rsp.Ok, nil
+}
+
+func (s*rpcClient) Persist(key string, dataType string) (bool, error){
+	rsp, err := s.c.Persist(context.Background(), &bridge.PersistReq{Key:key, Type:dataType})
+	if err != nil{
+		log.Printf("Persist error: %s\n", err.Error())
+		return false, err
+	}
+	return rsp.Ok, nil
+}
+
+/*
+key的类型，key不存在时返回 cache.KeyTypeNone
+*/
+func (s*rpcClient) Type(key string) (string, error){
+	rsp, err := s.c.Type(context.Background(), &bridge.TypeReq{Key:key})
+	if err != nil{
+		log.Printf("Type error: %s\n", err.Error())
+		return "", err
+	}
+	return rsp.Type, nil
+}
+
+func (s*rpcClient) Exists(keys ...string) (int, error){
+	rsp, err := s.c.Exists(context.Background(), &bridge.ExistsReq{Keys:keys})
+	if err != nil{
+		log.Printf("Exists error: %s\n", err.Error())
+		return 0, err
+	}
+	return int(rsp.Count), nil
+}
+
+/*
+删除任意类型的key，返回删除的key的个数
+*/
+func (s*rpcClient) DelKeys(keys ...string) (int, error){
+	rsp, err := s.c.DelKeys(context.Background(), &bridge.DelKeysReq{Keys:keys})
+	if err != nil{
+		log.Printf("DelKeys error: %s\n", err.Error())
+		return 0, err
+	}
+	return int(rsp.Count), nil
+}
+
+func (s*rpcClient) Rename(key string, newKey string) error{
+	_, err := s.c.Rename(context.Background(), &bridge.RenameReq{Key:key, NewKey:newKey})
+	if err != nil{
+		log.Printf("Rename error: %s\n", err.Error())
+	}
+	return err
+}
+
+/*
+复制key到newKey，保留过期时间，newKey 已经存在且 replace 为false时返回false
+*/
+func (s*rpcClient) Copy(key string, newKey string, replace bool) (bool, error){
+	return s.CopyTo("", key, newKey, replace)
+}
+
+/*
+复制key到数据库db的newKey，db 为空时复制到当前数据库
+*/
+func (s*rpcClient) CopyTo(db string, key string, newKey string, replace bool) (bool, error){
+	rsp, err := s.c.Copy(context.Background(), &bridge.CopyReq{Key:key, NewKey:newKey, Db:db, Replace:replace})
+	if err != nil{
+		log.Printf("Copy error: %s\n", err.Error())
+		return false, err
+	}
+	return rsp.Ok, nil
+}
+
+/*
+把key移动到数据库db，db中已经存在同名key时返回false
+*/
+func (s*rpcClient) Move(key string, db string) (bool, error){
+	rsp, err := s.c.Move(context.Background(), &bridge.MoveReq{Key:key, Db:db})
+	if err != nil{
+		log.Printf("Move error: %s\n", err.Error())
+		return false, err
+	}
+	return rsp.Ok, nil
+}
+
+/*
+key的元数据，dataType 为空时key只能存在于一种类型中
+*/
+func (s*rpcClient) KeyInfo(key string, dataType string) (kv.KeyInfo, error){
+	rsp, err := s.c.KeyInfo(context.Background(), &bridge.KeyInfoReq{Key:key, Type:dataType})
+	if err != nil{
+		log.Printf("KeyInfo error: %s\n", err.Error())
+		return kv.KeyInfo{}, err
+	}
+	return kv.KeyInfo{Key:rsp.Key, Type:rsp.Type, Encoding:rsp.Encoding, Size:int(rsp.Size),
+		Created:rsp.Created, Modified:rsp.Modified, Accessed:rsp.Accessed, Idle:rsp.Idle,
+		Hits:rsp.Hits, Freq:int(rsp.Freq), TTL:rsp.Ttl}, nil
+}
+
+/*
+当前数据库中各类型的汇总信息
+*/
+func (s*rpcClient) KeyStats() ([]kv.TypeStats, error){
+	rsp, err := s.c.KeyStats(context.Background(), &bridge.KeyStatsReq{})
+	if err != nil{
+		log.Printf("KeyStats error: %s\n", err.Error())
+		return nil, err
+	}
+	r := make([]kv.TypeStats, len(rsp.Stats))
+	for i, t := range rsp.Stats {
+		r[i] = kv.TypeStats{Type:t.Type, Keys:int(t.Keys), Size:int(t.Size), MaxSize:int(t.MaxSize),
+			Volatile:int(t.Volatile), Hits:t.Hits, Evictions:t.Evictions, AvgIdle:t.AvgIdle, MaxIdle:t.MaxIdle,
+			Policy:t.Policy, Misses:t.Misses, HitRatio:t.HitRatio, Rejected:t.Rejected}
+	}
+	return r, nil
+}
+
+/*
+key占用的内存字节数，dataType 为空时key只能存在于一种类型中
+*/
+func (s*rpcClient) MemoryUsage(key string, dataType string) (int64, error){
+	rsp, err := s.c.MemoryUsage(context.Background(), &bridge.MemoryUsageReq{Key:key, Type:dataType})
+	if err != nil{
+		log.Printf("MemoryUsage error: %s\n", err.Error())
+		return 0, err
+	}
+	return rsp.Bytes, nil
+}
+
+/*
+服务端所有数据库计入的内存和go运行时统计的内存，gc 为true时服务端先执行一次gc
+*/
+func (s*rpcClient) MemoryStats(gc bool) (kv.MemoryStats, error){
+	rsp, err := s.c.MemoryStats(context.Background(), &bridge.MemoryStatsReq{Gc:gc})
+	if err != nil{
+		log.Printf("MemoryStats error: %s\n", err.Error())
+		return kv.MemoryStats{}, err
+	}
+	return kv.MemoryStats{Accounted:rsp.Accounted, Keys:int(rsp.Keys), MaxMemory:rsp.MaxMemory, Policy:rsp.Policy,
+		HeapAlloc:rsp.HeapAlloc, HeapInuse:rsp.HeapInuse, HeapSys:rsp.HeapSys, HeapObjects:rsp.HeapObjects,
+		Sys:rsp.Sys, NumGC:rsp.NumGC, HeapRatio:rsp.HeapRatio}, nil
+}
+
+/*
+统一键空间模式下操作了其他类型的key时返回true
+*/
+func IsWrongType(err error) bool{
+	if st, ok := status.FromError(err); ok && st.Code() == codes.FailedPrecondition {
+		return strings.HasPrefix(st.Message(), "WRONGTYPE")
+	}
+	return cache.IsWrongType(err)
+}
+
+/*
+设置了 maxmemory 并且内存不足时写操作返回true
+*/
+func IsOOM(err error) bool{
+	if st, ok := status.FromError(err); ok && st.Code() == codes.ResourceExhausted {
+		return strings.HasPrefix(st.Message(), "OOM")
+	}
+	return cache.IsOOM(err)
+}
+
+/*
+数据库没有创建时返回true
+*/
+func IsDBNotFound(err error) bool{
+	if st, ok := status.FromError(err); ok && st.Code() == codes.NotFound {
+		return strings.HasPrefix(st.Message(), "NODB")
+	}
+	return cache.IsDBNotFound(err)
+}
+
+/*
+选择数据库，之后的请求都在这个数据库中执行，监听也只收到这个数据库中的变化
+*/
+func (s*rpcClient) Select(db string) error{
+	rsp, err := s.c.Select(context.Background(), &bridge.SelectReq{Db:db})
+	if err != nil{
+		log.Printf("Select error: %s\n", err.Error())
+		return err
+	}
+
+	s.dbMutex.Lock()
+	s.db = rsp.Db
+	s.dbMutex.Unlock()
+	return nil
+}
+
+/*
+创建数据库，Select 和其他请求只能使用已经创建的数据库，已经存在时 created 为false
+*/
+func (s*rpcClient) CreateDB(db string) (bool, error){
+	rsp, err := s.c.CreateDB(context.Background(), &bridge.CreateDBReq{Db:db})
+	if err != nil{
+		log.Printf("CreateDB error: %s\n", err.Error())
+		return false, err
+	}
+	return rsp.Created, nil
+}
+
+/*
+当前选择的数据库，没有选择时为空，使用默认数据库
+*/
+func (s*rpcClient) DB() string{
+	s.dbMutex.Lock()
+	defer s.dbMutex.Unlock()
+	return s.db
+}
+
+/*
+每个请求都在metadata中带上选择的数据库，连接断开重连后也不会回到默认数据库
+*/
+func (s*rpcClient) dbInterceptor(ctx context.Context, method string, req, reply interface{},
+	cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+	if db := s.DB(); db != "" {
+		ctx = metadata.AppendToOutgoingContext(ctx, DBMetadataKey, db)
+	}
+	return invoker(ctx, method, req, reply, cc, opts...)
+}
+
 func (s*rpcClient) ClearValue() error{
 	_, err := s.c.ClearValue(context.Background(), &bridge.ClearReq{})
 	return err
@@ -486,5 +1405,45 @@ func (s*rpcClient) ClearSet() error{
 	return err
 }
 
+func (s*rpcClient) ClearHLL() error{
+	_, err := s.c.ClearHLL(context.Background(), &bridge.ClearReq{})
+	return err
+}
 
+func (s*rpcClient) ClearGeo() error{
+	_, err := s.c.ClearGeo(context.Background(), &bridge.ClearReq{})
+	return err
+}
+
+func (s*rpcClient) ClearStream() error{
+	_, err := s.c.ClearStream(context.Background(), &bridge.ClearReq{})
+	return err
+}
+
+func (s*rpcClient) ClearJSON() error{
+	_, err := s.c.ClearJSON(context.Background(), &bridge.ClearReq{})
+	return err
+}
 
+func (s*rpcClient) ClearBloom() error{
+	_, err := s.c.ClearBloom(context.Background(), &bridge.ClearReq{})
+	return err
+}
+
+func (s*rpcClient) ClearCuckoo() error{
+	_, err := s.c.ClearCuckoo(context.Background(), &bridge.ClearReq{})
+	return err
+}
+
+func (s*rpcClient) ClearTS() error{
+	_, err := s.c.ClearTS(context.Background(), &bridge.ClearReq{})
+	return err
+}
+
+
+
+
+
+func toExpiration(e kv.Expiration) *bridge.Expiration {
+	return &bridge.Expiration{Mode: int32(e.Mode), Value: e.Value}
+}
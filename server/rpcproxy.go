@@ -5,22 +5,32 @@ import (
 	bridge "github.com/llr104/lightkv/pb"
 )
 
+/*
+监听的key，不同数据库中的同名key分开监听
+*/
+type scopedKey struct {
+	db  string
+	key string
+}
 
 type rpcProxy struct {
 	sendCancel context.CancelFunc
 	recvCancel context.CancelFunc
 	sendChan chan bridge.PublishRsp
-	watchKey map[string]string
-	watchMap map[string]map[string]string
-	watchList map[string]string
-	watchSet map[string]string
+	db string
+	watchKey map[scopedKey]string
+	watchMap map[scopedKey]map[string]string
+	watchList map[scopedKey]string
+	watchSet map[scopedKey]string
+	watchJSON map[scopedKey]string
 }
 
 func newProxy() *rpcProxy{
 	return &rpcProxy{
-					watchKey:make(map[string]string),
-					watchMap:make(map[string]map[string]string),
-					watchList:make(map[string]string),
-					watchSet:make(map[string]string),
+					watchKey:make(map[scopedKey]string),
+					watchMap:make(map[scopedKey]map[string]string),
+					watchList:make(map[scopedKey]string),
+					watchSet:make(map[scopedKey]string),
+					watchJSON:make(map[scopedKey]string),
 	}
 }
@@ -7,13 +7,26 @@ import (
 	"github.com/llr104/lightkv/pb"
 	"golang.org/x/net/context"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/stats"
+	"google.golang.org/grpc/status"
 	"log"
 	"net"
 	"sync"
 	"time"
 )
 
+/*
+请求metadata中指定数据库的key
+*/
+const DBMetadataKey = "db"
+
+/*
+ctx中保存请求所在数据库的key
+*/
+type dbKey struct{}
+
 type rpcHandler struct {
 	mutex    sync.Mutex
 	curID    uint16
@@ -91,7 +104,7 @@ func (s* rpcHandler) HandleConn(ctx context.Context, stat stats.ConnStats)  {
 
 }
 
-func (s *rpcHandler) onOP(op kv.OpType, before kv.ValueCache, after kv.ValueCache)  {
+func (s *rpcHandler) onOP(db string, op kv.OpType, before kv.ValueCache, after kv.ValueCache)  {
 	//fmt.Printf("key onOP:%s\n", item.Key)
 
 	switch before.(type) {
@@ -109,12 +122,12 @@ func (s *rpcHandler) onOP(op kv.OpType, before kv.ValueCache, after kv.ValueCach
 					}
 				}
 
-				_, ok := proxy.watchKey[key]
+				_, ok := proxy.watchKey[scopedKey{db, key}]
 				if ok {
 					//通知推送
 					log.Printf("public watch")
 					rsp := bridge.PublishRsp{DataType: kv.ValueData, HmKey:"", Key: key,
-						BeforeValue: b.ToString(), AfterValue:afterStr, Type:int32(op)}
+						BeforeValue: b.ToString(), AfterValue:afterStr, Type:int32(op), Db:db}
 					proxy.sendChan <- rsp
 				}
 			}
@@ -132,11 +145,11 @@ func (s *rpcHandler) onOP(op kv.OpType, before kv.ValueCache, after kv.ValueCach
 						key = a.Key
 					}
 				}
-				_, ok := proxy.watchMap[b.Key]
+				_, ok := proxy.watchMap[scopedKey{db, b.Key}]
 				if ok {
 					//通知推送
 					rsp := bridge.PublishRsp{DataType: kv.MapData, HmKey:key, Key: "",
-						BeforeValue: b.ToString(), AfterValue:afterStr, Type:int32(op)}
+						BeforeValue: b.ToString(), AfterValue:afterStr, Type:int32(op), Db:db}
 					proxy.sendChan <- rsp
 				}
 			}
@@ -155,11 +168,11 @@ func (s *rpcHandler) onOP(op kv.OpType, before kv.ValueCache, after kv.ValueCach
 						key = a.Key
 					}
 				}
-				_, ok := proxy.watchList[key]
+				_, ok := proxy.watchList[scopedKey{db, key}]
 				if ok {
 					//通知推送
 					rsp := bridge.PublishRsp{DataType: kv.ListData, HmKey:"", Key: key,
-						BeforeValue: b.ToString(), AfterValue:afterStr, Type:int32(op)}
+						BeforeValue: b.ToString(), AfterValue:afterStr, Type:int32(op), Db:db}
 					proxy.sendChan <- rsp
 				}
 			}
@@ -178,11 +191,33 @@ func (s *rpcHandler) onOP(op kv.OpType, before kv.ValueCache, after kv.ValueCach
 						key = a.Key
 					}
 				}
-				_, ok := proxy.watchSet[key]
+				_, ok := proxy.watchSet[scopedKey{db, key}]
 				if ok {
 					//通知推送
 					rsp := bridge.PublishRsp{DataType: kv.SetData, HmKey:"", Key: key,
-						BeforeValue: b.ToString(), AfterValue:afterStr, Type:int32(op)}
+						BeforeValue: b.ToString(), AfterValue:afterStr, Type:int32(op), Db:db}
+					proxy.sendChan <- rsp
+				}
+			}
+			s.mutex.Unlock()
+		}
+		case kv.JSONValue:{
+			s.mutex.Lock()
+			for _, proxy := range s.proxyMap{
+				b := before.(kv.JSONValue)
+				afterStr := ""
+				if after != nil{
+					afterStr = after.(kv.JSONValue).ToString()
+				}
+				path := b.Path
+				if path == "" {
+					path = kv.JSONRootPath
+				}
+				_, ok := proxy.watchJSON[scopedKey{db, b.Key}]
+				if ok {
+					//通知推送，值为变化路径上修改前后的值
+					rsp := bridge.PublishRsp{DataType: kv.JSONData, HmKey:"", Key: b.Key, Path: path,
+						BeforeValue: b.ToString(), AfterValue:afterStr, Type:int32(op), Db:db}
 					proxy.sendChan <- rsp
 				}
 			}
@@ -192,27 +227,85 @@ func (s *rpcHandler) onOP(op kv.OpType, before kv.ValueCache, after kv.ValueCach
 }
 
 type server struct{
-	cache *cache.Cache
+	dbs *cache.Databases
 	handler *rpcHandler
 }
 
-func (s *server) ClearValue(context.Context, *bridge.ClearReq) (*bridge.ClearRsp, error) {
-	s.cache.ClearString()
+/*
+请求所在的数据库，由拦截器放入ctx
+*/
+func (s *server) db(ctx context.Context) *cache.Cache {
+	if c, ok := ctx.Value(dbKey{}).(*cache.Cache); ok {
+		return c
+	}
+	c, _ := s.dbs.Get(cache.DefaultDB)
+	return c
+}
+
+func (s *server) scopedKey(ctx context.Context, key string) scopedKey {
+	return scopedKey{s.db(ctx).Name(), key}
+}
+
+/*
+metadata中带有db时使用它，否则使用连接上 Select 选择的数据库
+*/
+func (s *server) selectDB(ctx context.Context) (*cache.Cache, error) {
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		if v := md.Get(DBMetadataKey); len(v) > 0 {
+			return s.dbs.Get(v[0])
+		}
+	}
+
+	name := ""
+	s.handler.mutex.Lock()
+	if cid, ok := ctx.Value("curID").(string); ok {
+		if proxy, ok := s.handler.proxyMap[cid]; ok {
+			name = proxy.db
+		}
+	}
+	s.handler.mutex.Unlock()
+	return s.dbs.Get(name)
+}
+
+func (s *server) Select(ctx context.Context, in *bridge.SelectReq) (*bridge.SelectRsp, error) {
+	c, err := s.dbs.Get(in.Db)
+	if err != nil {
+		return &bridge.SelectRsp{Db:in.Db}, err
+	}
+
+	s.handler.mutex.Lock()
+	cid := ctx.Value("curID")
+	proxy, ok := s.handler.proxyMap[cid.(string)]
+	if ok {
+		proxy.db = c.Name()
+	}
+	s.handler.mutex.Unlock()
+
+	return &bridge.SelectRsp{Db:c.Name()}, nil
+}
+
+func (s *server) CreateDB(ctx context.Context, in *bridge.CreateDBReq) (*bridge.CreateDBRsp, error) {
+	created, err := s.dbs.Create(in.Db)
+	return &bridge.CreateDBRsp{Db:in.Db, Created:created}, err
+}
+
+func (s *server) ClearValue(ctx context.Context, in *bridge.ClearReq) (*bridge.ClearRsp, error) {
+	s.db(ctx).ClearString()
 	return &bridge.ClearRsp{}, nil
 }
 
-func (s *server) ClearMap(context.Context, *bridge.ClearReq) (*bridge.ClearRsp, error) {
-	s.cache.ClearMap()
+func (s *server) ClearMap(ctx context.Context, in *bridge.ClearReq) (*bridge.ClearRsp, error) {
+	s.db(ctx).ClearMap()
 	return &bridge.ClearRsp{}, nil
 }
 
-func (s *server) ClearList(context.Context, *bridge.ClearReq) (*bridge.ClearRsp, error) {
-	s.cache.ClearList()
+func (s *server) ClearList(ctx context.Context, in *bridge.ClearReq) (*bridge.ClearRsp, error) {
+	s.db(ctx).ClearList()
 	return &bridge.ClearRsp{}, nil
 }
 
-func (s *server) ClearSet(context.Context, *bridge.ClearReq) (*bridge.ClearRsp, error) {
-	s.cache.ClearSet()
+func (s *server) ClearSet(ctx context.Context, in *bridge.ClearReq) (*bridge.ClearRsp, error) {
+	s.db(ctx).ClearSet()
 	return &bridge.ClearRsp{}, nil
 }
 
@@ -288,21 +381,32 @@ func (s *server) Ping(ctx context.Context, in *bridge.PingReq) (*bridge.PingRsp,
 }
 
 func (s *server) Get(ctx context.Context, in *bridge.GetReq) (*bridge.GetRsp, error) {
-	v, err := s.cache.Get(in.Key)
+	v, ver, err := s.db(ctx).GetVersion(in.Key)
 	if err == nil {
-		return &bridge.GetRsp{Key:in.Key, Value:v}, nil
+		return &bridge.GetRsp{Key:in.Key, Value:v, Version:ver}, nil
 	}else{
 		return &bridge.GetRsp{Key:in.Key, Value:""}, err
 	}
 }
 
 func (s *server) Put(ctx context.Context, in *bridge.PutReq) (*bridge.PutRsp, error) {
-	s.cache.Put(in.Key, in.Value, in.Expire)
-	return &bridge.PutRsp{Key:in.Key,Value:in.Value,Expire:in.Expire}, nil
+	if in.Precondition != nil {
+		ver, err := s.db(ctx).PutCAS(in.Key, in.Value, expiration(in.Expire, in.Expiration), in.Precondition.Version)
+		return &bridge.PutRsp{Key:in.Key,Value:in.Value,Expire:in.Expire,Version:ver}, err
+	}
+	err := s.db(ctx).PutEx(in.Key, in.Value, expiration(in.Expire, in.Expiration))
+	return &bridge.PutRsp{Key:in.Key,Value:in.Value,Expire:in.Expire}, err
 }
 
+/*
+不带前提条件时忽略删除的错误，带前提条件时需要返回版本冲突
+*/
 func (s *server) Del(ctx context.Context, in *bridge.DelReq) (*bridge.DelRsp, error) {
-	s.cache.Delete(in.Key)
+	if in.Precondition != nil {
+		err := s.db(ctx).DeleteCAS(in.Key, in.Precondition.Version)
+		return &bridge.DelRsp{Key:in.Key}, err
+	}
+	s.db(ctx).Delete(in.Key)
 	return &bridge.DelRsp{Key:in.Key}, nil
 }
 
@@ -311,7 +415,7 @@ func (s *server) WatchKey(ctx context.Context, in *bridge.WatchReq) (*bridge.Wat
 	cid := ctx.Value("curID")
 	proxy, ok := s.handler.proxyMap[cid.(string)]
 	if ok {
-		proxy.watchKey[in.Key] = in.Key
+		proxy.watchKey[s.scopedKey(ctx, in.Key)] = in.Key
 	}
 
 	s.handler.mutex.Unlock()
@@ -324,7 +428,7 @@ func (s *server) UnWatchKey(ctx context.Context, in *bridge.WatchReq) (*bridge.W
 	cid := ctx.Value("curID")
 	proxy, ok := s.handler.proxyMap[cid.(string)]
 	if ok {
-		delete(proxy.watchKey, in.Key)
+		delete(proxy.watchKey, s.scopedKey(ctx, in.Key))
 	}
 	s.handler.mutex.Unlock()
 
@@ -332,41 +436,59 @@ func (s *server) UnWatchKey(ctx context.Context, in *bridge.WatchReq) (*bridge.W
 }
 
 func (s *server) HMGet(ctx context.Context, in *bridge.HMGetReq) (*bridge.HMGetRsp, error) {
-	str, err := s.cache.HMGet(in.HmKey)
-	return &bridge.HMGetRsp{HmKey:in.HmKey, Value:str}, err
+	m, ver, err := s.db(ctx).HMGetVersion(in.HmKey)
+	rsp := &bridge.HMGetRsp{HmKey:in.HmKey, Fields:m, Version:ver}
+	if err == nil {
+		//兼容只读取 value 的旧客户端
+		rsp.Value = kv.MapValue{Key: in.HmKey, Data: m}.ToString()
+	}
+	return rsp, err
 }
 
 func (s *server) HMGetMember(ctx context.Context, in *bridge.HMGetMemberReq) (*bridge.HMGetMemberRsp, error) {
-	str, err := s.cache.HMGetMember(in.HmKey, in.Key)
+	str, err := s.db(ctx).HMGetMember(in.HmKey, in.Key)
 	return &bridge.HMGetMemberRsp{HmKey:in.HmKey, Key:in.Key,  Value:str}, err
 }
 
 func (s *server) HMPut(ctx context.Context, in *bridge.HMPutReq) (*bridge.HMPutRsp, error) {
-	err := s.cache.HMPut(in.HmKey, in.GetKey(), in.GetValue(), in.Expire)
+	if in.Precondition != nil {
+		ver, err := s.db(ctx).HMPutCAS(in.HmKey, in.GetKey(), in.GetValue(), expiration(in.Expire, in.Expiration), in.Precondition.Version)
+		return &bridge.HMPutRsp{HmKey:in.HmKey, Key:in.Key,  Value:in.Value, Version:ver}, err
+	}
+	err := s.db(ctx).HMPutEx(in.HmKey, in.GetKey(), in.GetValue(), expiration(in.Expire, in.Expiration))
 	return &bridge.HMPutRsp{HmKey:in.HmKey, Key:in.Key,  Value:in.Value}, err
 }
 
 func (s *server) HMDel(ctx context.Context, in *bridge.HMDelReq) (*bridge.HMDelRsp, error) {
-	err := s.cache.HMDel(in.HmKey)
+	if in.Precondition != nil {
+		err := s.db(ctx).HMDelCAS(in.HmKey, in.Precondition.Version)
+		return &bridge.HMDelRsp{HmKey:in.HmKey}, err
+	}
+	err := s.db(ctx).HMDel(in.HmKey)
 	return &bridge.HMDelRsp{HmKey:in.HmKey}, err
 }
 
 func (s *server) HMDelMember(ctx context.Context, in *bridge.HMDelMemberReq) (*bridge.HMDelMemberRsp, error) {
-	err := s.cache.HMDelMember(in.HmKey, in.Key)
+	if in.Precondition != nil {
+		ver, err := s.db(ctx).HMDelMemberCAS(in.HmKey, in.Key, in.Precondition.Version)
+		return &bridge.HMDelMemberRsp{HmKey:in.HmKey, Version:ver}, err
+	}
+	err := s.db(ctx).HMDelMember(in.HmKey, in.Key)
 	return &bridge.HMDelMemberRsp{HmKey:in.HmKey}, err
 }
 
 func (s *server) HMWatch(ctx context.Context, in *bridge.HMWatchReq) (*bridge.HMWatchRsp, error) {
 	s.handler.mutex.Lock()
 	cid := ctx.Value("curID")
+	name := s.scopedKey(ctx, in.HmKey)
 	proxy, ok := s.handler.proxyMap[cid.(string)]
 	if ok {
-		m, ok1 := proxy.watchMap[in.HmKey]
+		m, ok1 := proxy.watchMap[name]
 		if !ok1 {
 			m = make(map[string]string)
 		}
 		m[in.Key] = in.Key
-		proxy.watchMap[in.HmKey] = m
+		proxy.watchMap[name] = m
 	}
 
 	s.handler.mutex.Unlock()
@@ -377,13 +499,14 @@ func (s *server) HMWatch(ctx context.Context, in *bridge.HMWatchReq) (*bridge.HM
 func (s *server) HMUnWatch(ctx context.Context, in *bridge.HMWatchReq) (*bridge.HMWatchRsp, error) {
 	s.handler.mutex.Lock()
 	cid := ctx.Value("curID")
+	name := s.scopedKey(ctx, in.HmKey)
 	proxy, ok := s.handler.proxyMap[cid.(string)]
 	if ok {
-		m, ok1 := proxy.watchMap[in.HmKey]
+		m, ok1 := proxy.watchMap[name]
 		if ok1{
 			delete(m, in.Key)
 		}
-		proxy.watchMap[in.HmKey] = m
+		proxy.watchMap[name] = m
 	}
 	s.handler.mutex.Unlock()
 
@@ -394,27 +517,39 @@ func (s *server) HMUnWatch(ctx context.Context, in *bridge.HMWatchReq) (*bridge.
 List
  */
 func (s *server) LGet(ctx context.Context, in *bridge.LGetReq) (*bridge.LGetRsp, error) {
-	 arr, err := s.cache.LGet(in.Key)
-	 return &bridge.LGetRsp{Key:in.Key, Value:arr}, err
+	 arr, ver, err := s.db(ctx).LGetVersion(in.Key)
+	 return &bridge.LGetRsp{Key:in.Key, Value:arr, Version:ver}, err
 }
 
 func (s *server) LGetRange(ctx context.Context, in *bridge.LGetRangeReq) (*bridge.LGetRangeRsp, error) {
-	arr, err := s.cache.LGetRange(in.Key, in.BegIndex, in.EndIndex)
+	arr, err := s.db(ctx).LGetRange(in.Key, in.BegIndex, in.EndIndex)
 	return &bridge.LGetRangeRsp{Key:in.Key, Value:arr}, err
 }
 
 func (s *server) LPut(ctx context.Context,in *bridge.LPutReq) (*bridge.LPutRsp, error) {
-	err := s.cache.LPut(in.Key, in.Value, in.Expire)
+	if in.Precondition != nil {
+		ver, err := s.db(ctx).LPutCAS(in.Key, in.Value, expiration(in.Expire, in.Expiration), in.Precondition.Version)
+		return &bridge.LPutRsp{Key:in.Key,Value:in.Value,Expire:in.Expire,Version:ver}, err
+	}
+	err := s.db(ctx).LPutEx(in.Key, in.Value, expiration(in.Expire, in.Expiration))
 	return &bridge.LPutRsp{Key:in.Key,Value:in.Value,Expire:in.Expire}, err
 }
 
 func (s *server) LDel(ctx context.Context, in *bridge.LDelReq) (*bridge.LDelRsp, error) {
-	err := s.cache.LDel(in.Key)
+	if in.Precondition != nil {
+		err := s.db(ctx).LDelCAS(in.Key, in.Precondition.Version)
+		return &bridge.LDelRsp{Key:in.Key}, err
+	}
+	err := s.db(ctx).LDel(in.Key)
 	return &bridge.LDelRsp{Key:in.Key}, err
 }
 
 func (s *server) LDelRange(ctx context.Context,in *bridge.LDelRangeReq) (*bridge.LDelRangeRsp, error) {
-	err := s.cache.LDelRange(in.Key, in.BegIndex, in.EndIndex)
+	if in.Precondition != nil {
+		ver, err := s.db(ctx).LDelRangeCAS(in.Key, in.BegIndex, in.EndIndex, in.Precondition.Version)
+		return &bridge.LDelRangeRsp{Key:in.Key, Version:ver}, err
+	}
+	err := s.db(ctx).LDelRange(in.Key, in.BegIndex, in.EndIndex)
 	return &bridge.LDelRangeRsp{Key:in.Key}, err
 }
 
@@ -423,7 +558,7 @@ func (s *server) LWatch(ctx context.Context,in *bridge.LWatchReq) (*bridge.LWatc
 	cid := ctx.Value("curID")
 	proxy, ok := s.handler.proxyMap[cid.(string)]
 	if ok {
-		proxy.watchList[in.Key] = in.Key
+		proxy.watchList[s.scopedKey(ctx, in.Key)] = in.Key
 	}
 
 	s.handler.mutex.Unlock()
@@ -436,7 +571,7 @@ func (s *server) LUnWatch(ctx context.Context, in *bridge.LWatchReq) (*bridge.LW
 	cid := ctx.Value("curID")
 	proxy, ok := s.handler.proxyMap[cid.(string)]
 	if ok {
-		delete(proxy.watchList, in.Key)
+		delete(proxy.watchList, s.scopedKey(ctx, in.Key))
 	}
 
 	s.handler.mutex.Unlock()
@@ -447,22 +582,34 @@ func (s *server) LUnWatch(ctx context.Context, in *bridge.LWatchReq) (*bridge.LW
 set
 */
 func (s *server) SGet(ctx context.Context, in*bridge.SGetReq) (*bridge.SGetRsp, error) {
-	arr, err := s.cache.SGet(in.Key)
-	return &bridge.SGetRsp{Key:in.Key, Value:arr}, err
+	arr, ver, err := s.db(ctx).SGetVersion(in.Key)
+	return &bridge.SGetRsp{Key:in.Key, Value:arr, Version:ver}, err
 }
 
 func (s *server) SPut(ctx context.Context, in *bridge.SPutReq) (*bridge.SPutRsp, error) {
-	err := s.cache.SPut(in.Key, in.Value, in.Expire)
+	if in.Precondition != nil {
+		ver, err := s.db(ctx).SPutCAS(in.Key, in.Value, expiration(in.Expire, in.Expiration), in.Precondition.Version)
+		return &bridge.SPutRsp{Key:in.Key,Value:in.Value,Expire:in.Expire,Version:ver}, err
+	}
+	err := s.db(ctx).SPutEx(in.Key, in.Value, expiration(in.Expire, in.Expiration))
 	return &bridge.SPutRsp{Key:in.Key,Value:in.Value,Expire:in.Expire}, err
 }
 
 func (s *server) SDel(ctx context.Context, in *bridge.SDelReq) (*bridge.SDelRsp, error) {
-	err := s.cache.SDel(in.Key)
+	if in.Precondition != nil {
+		err := s.db(ctx).SDelCAS(in.Key, in.Precondition.Version)
+		return &bridge.SDelRsp{Key:in.Key}, err
+	}
+	err := s.db(ctx).SDel(in.Key)
 	return &bridge.SDelRsp{Key:in.Key}, err
 }
 
 func (s *server) SDelMember(ctx context.Context, in *bridge.SDelMemberReq) (*bridge.SDelMemberRsp, error) {
-	err := s.cache.SDelMember(in.Key, in.Value)
+	if in.Precondition != nil {
+		ver, err := s.db(ctx).SDelMemberCAS(in.Key, in.Value, in.Precondition.Version)
+		return &bridge.SDelMemberRsp{Key:in.Key, Value:in.Value, Version:ver}, err
+	}
+	err := s.db(ctx).SDelMember(in.Key, in.Value)
 	return &bridge.SDelMemberRsp{Key:in.Key, Value:in.Value}, err
 }
 
@@ -471,7 +618,7 @@ func (s *server) SWatch(ctx context.Context, in *bridge.SWatchReq) (*bridge.SWat
 	cid := ctx.Value("curID")
 	proxy, ok := s.handler.proxyMap[cid.(string)]
 	if ok {
-		proxy.watchSet[in.Key] = in.Key
+		proxy.watchSet[s.scopedKey(ctx, in.Key)] = in.Key
 	}
 
 	s.handler.mutex.Unlock()
@@ -483,14 +630,600 @@ func (s *server) SUnWatch(ctx context.Context, in *bridge.SWatchReq) (*bridge.SW
 	cid := ctx.Value("curID")
 	proxy, ok := s.handler.proxyMap[cid.(string)]
 	if ok {
-		delete(proxy.watchSet, in.Key)
+		delete(proxy.watchSet, s.scopedKey(ctx, in.Key))
 	}
 
 	s.handler.mutex.Unlock()
 	return &bridge.SWatchRsp{Key:in.Key}, nil
 }
 
-func NewRpcServer(c *cache.Cache)  {
+/*
+HyperLogLog
+*/
+func (s *server) PFAdd(ctx context.Context, in *bridge.PFAddReq) (*bridge.PFAddRsp, error) {
+	changed, err := s.db(ctx).PFAddEx(in.Key, in.Value, keepExpiration(in.Expire, in.Expiration))
+	return &bridge.PFAddRsp{Key:in.Key, Changed:changed}, err
+}
+
+func (s *server) PFCount(ctx context.Context, in *bridge.PFCountReq) (*bridge.PFCountRsp, error) {
+	count, err := s.db(ctx).PFCount(in.Key)
+	return &bridge.PFCountRsp{Key:in.Key, Count:count}, err
+}
+
+func (s *server) PFMerge(ctx context.Context, in *bridge.PFMergeReq) (*bridge.PFMergeRsp, error) {
+	err := s.db(ctx).PFMerge(in.DestKey, in.SrcKey)
+	return &bridge.PFMergeRsp{DestKey:in.DestKey}, err
+}
+
+func (s *server) PFDel(ctx context.Context, in *bridge.PFDelReq) (*bridge.PFDelRsp, error) {
+	err := s.db(ctx).PFDel(in.Key)
+	return &bridge.PFDelRsp{Key:in.Key}, err
+}
+
+func (s *server) ClearHLL(ctx context.Context, in *bridge.ClearReq) (*bridge.ClearRsp, error) {
+	s.db(ctx).ClearHLL()
+	return &bridge.ClearRsp{}, nil
+}
+
+/*
+geo
+*/
+func (s *server) GeoAdd(ctx context.Context, in *bridge.GeoAddReq) (*bridge.GeoAddRsp, error) {
+	members := make([]kv.GeoMember, len(in.Member))
+	for i, m := range in.Member {
+		members[i] = kv.GeoMember{Name:m.Name, Longitude:m.Longitude, Latitude:m.Latitude}
+	}
+	n, err := s.db(ctx).GeoAddEx(in.Key, members, keepExpiration(in.Expire, in.Expiration))
+	return &bridge.GeoAddRsp{Key:in.Key, Added:int32(n)}, err
+}
+
+func (s *server) GeoPos(ctx context.Context, in *bridge.GeoPosReq) (*bridge.GeoPosRsp, error) {
+	arr, err := s.db(ctx).GeoPos(in.Key, in.Member)
+	return &bridge.GeoPosRsp{Key:in.Key, Member:toPbGeoMembers(arr)}, err
+}
+
+func (s *server) GeoDist(ctx context.Context, in *bridge.GeoDistReq) (*bridge.GeoDistRsp, error) {
+	d, err := s.db(ctx).GeoDist(in.Key, in.Member1, in.Member2, in.Unit)
+	return &bridge.GeoDistRsp{Key:in.Key, Dist:d, Unit:in.Unit}, err
+}
+
+func (s *server) GeoSearch(ctx context.Context, in *bridge.GeoSearchReq) (*bridge.GeoSearchRsp, error) {
+	opt := kv.GeoSearchOption{Member:in.Member, Longitude:in.Longitude, Latitude:in.Latitude,
+		Radius:in.Radius, Width:in.Width, Height:in.Height, Unit:in.Unit, Sort:in.Sort, Count:int(in.Count)}
+	arr, err := s.db(ctx).GeoSearch(in.Key, opt)
+	return &bridge.GeoSearchRsp{Key:in.Key, Member:toPbGeoMembers(arr)}, err
+}
+
+func (s *server) GeoDel(ctx context.Context, in *bridge.GeoDelReq) (*bridge.GeoDelRsp, error) {
+	err := s.db(ctx).GeoDel(in.Key)
+	return &bridge.GeoDelRsp{Key:in.Key}, err
+}
+
+func (s *server) GeoDelMember(ctx context.Context, in *bridge.GeoDelMemberReq) (*bridge.GeoDelMemberRsp, error) {
+	err := s.db(ctx).GeoDelMember(in.Key, in.Member)
+	return &bridge.GeoDelMemberRsp{Key:in.Key, Member:in.Member}, err
+}
+
+func (s *server) ClearGeo(ctx context.Context, in *bridge.ClearReq) (*bridge.ClearRsp, error) {
+	s.db(ctx).ClearGeo()
+	return &bridge.ClearRsp{}, nil
+}
+
+func toPbGeoMembers(arr []kv.GeoMember) []*bridge.GeoMember {
+	r := make([]*bridge.GeoMember, len(arr))
+	for i, m := range arr {
+		r[i] = &bridge.GeoMember{Name:m.Name, Longitude:m.Longitude, Latitude:m.Latitude, Dist:m.Dist}
+	}
+	return r
+}
+
+/*
+stream
+*/
+func (s *server) XAdd(ctx context.Context, in *bridge.XAddReq) (*bridge.XAddRsp, error) {
+	id, err := s.db(ctx).XAdd(in.Key, in.Id, in.Field, in.Value, kv.StreamTrim{MaxLen:in.MaxLen, MaxAge:in.MaxAge})
+	return &bridge.XAddRsp{Key:in.Key, Id:id}, err
+}
+
+func (s *server) XLen(ctx context.Context, in *bridge.XLenReq) (*bridge.XLenRsp, error) {
+	n, err := s.db(ctx).XLen(in.Key)
+	return &bridge.XLenRsp{Key:in.Key, Len:int64(n)}, err
+}
+
+func (s *server) XRange(ctx context.Context, in *bridge.XRangeReq) (*bridge.XRangeRsp, error) {
+	var arr []kv.StreamEntry
+	var err error
+	if in.Rev {
+		arr, err = s.db(ctx).XRevRange(in.Key, in.End, in.Start, int(in.Count))
+	}else{
+		arr, err = s.db(ctx).XRange(in.Key, in.Start, in.End, int(in.Count))
+	}
+	return &bridge.XRangeRsp{Key:in.Key, Entry:toPbStreamEntries(arr)}, err
+}
+
+func (s *server) XRead(ctx context.Context, in *bridge.XReadReq) (*bridge.XReadRsp, error) {
+	arr, err := s.db(ctx).XRead(ctx, in.Key, in.Id, int(in.Count), in.Block)
+	return &bridge.XReadRsp{Stream:toPbStreamReads(arr)}, err
+}
+
+func (s *server) XGroupCreate(ctx context.Context, in *bridge.XGroupReq) (*bridge.XGroupRsp, error) {
+	err := s.db(ctx).XGroupCreate(in.Key, in.Group, in.Id, in.MkStream)
+	return &bridge.XGroupRsp{Key:in.Key, Group:in.Group}, err
+}
+
+func (s *server) XGroupDestroy(ctx context.Context, in *bridge.XGroupReq) (*bridge.XGroupRsp, error) {
+	_, err := s.db(ctx).XGroupDestroy(in.Key, in.Group)
+	return &bridge.XGroupRsp{Key:in.Key, Group:in.Group}, err
+}
+
+func (s *server) XReadGroup(ctx context.Context, in *bridge.XReadGroupReq) (*bridge.XReadRsp, error) {
+	arr, err := s.db(ctx).XReadGroup(ctx, in.Group, in.Consumer, in.Key, in.Id, int(in.Count), in.Block, in.NoAck)
+	return &bridge.XReadRsp{Stream:toPbStreamReads(arr)}, err
+}
+
+func (s *server) XAck(ctx context.Context, in *bridge.XAckReq) (*bridge.XAckRsp, error) {
+	n, err := s.db(ctx).XAck(in.Key, in.Group, in.Id)
+	return &bridge.XAckRsp{Key:in.Key, Count:int64(n)}, err
+}
+
+func (s *server) XPending(ctx context.Context, in *bridge.XPendingReq) (*bridge.XPendingRsp, error) {
+	arr, err := s.db(ctx).XPending(in.Key, in.Group, in.Consumer, int(in.Count))
+	now := time.Now().UnixNano() / int64(time.Millisecond)
+	r := make([]*bridge.StreamPending, len(arr))
+	for i, p := range arr {
+		r[i] = &bridge.StreamPending{Id:p.ID.String(), Consumer:p.Consumer, Idle:now-p.DeliveryTime, DeliveryCount:p.DeliveryCount}
+	}
+	return &bridge.XPendingRsp{Key:in.Key, Pending:r}, err
+}
+
+func (s *server) XClaim(ctx context.Context, in *bridge.XClaimReq) (*bridge.XClaimRsp, error) {
+	arr, err := s.db(ctx).XClaim(in.Key, in.Group, in.Consumer, in.MinIdle, in.Id)
+	return &bridge.XClaimRsp{Key:in.Key, Entry:toPbStreamEntries(arr)}, err
+}
+
+func (s *server) XTrim(ctx context.Context, in *bridge.XTrimReq) (*bridge.XTrimRsp, error) {
+	n, err := s.db(ctx).XTrim(in.Key, kv.StreamTrim{MaxLen:in.MaxLen, MaxAge:in.MaxAge})
+	return &bridge.XTrimRsp{Key:in.Key, Count:int64(n)}, err
+}
+
+func (s *server) XDelMember(ctx context.Context, in *bridge.XDelMemberReq) (*bridge.XDelMemberRsp, error) {
+	n, err := s.db(ctx).XDelMember(in.Key, in.Id)
+	return &bridge.XDelMemberRsp{Key:in.Key, Count:int64(n)}, err
+}
+
+func (s *server) XDel(ctx context.Context, in *bridge.XDelReq) (*bridge.XDelRsp, error) {
+	err := s.db(ctx).XDel(in.Key)
+	return &bridge.XDelRsp{Key:in.Key}, err
+}
+
+func (s *server) ClearStream(ctx context.Context, in *bridge.ClearReq) (*bridge.ClearRsp, error) {
+	s.db(ctx).ClearStream()
+	return &bridge.ClearRsp{}, nil
+}
+
+func toPbStreamEntries(arr []kv.StreamEntry) []*bridge.StreamEntry {
+	r := make([]*bridge.StreamEntry, len(arr))
+	for i, e := range arr {
+		r[i] = &bridge.StreamEntry{Id:e.ID.String(), Fields:e.Fields}
+	}
+	return r
+}
+
+func toPbStreamReads(arr []kv.StreamRead) []*bridge.StreamEntries {
+	r := make([]*bridge.StreamEntries, len(arr))
+	for i, e := range arr {
+		r[i] = &bridge.StreamEntries{Key:e.Key, Entry:toPbStreamEntries(e.Entries)}
+	}
+	return r
+}
+
+/*
+json
+*/
+func (s *server) JSet(ctx context.Context, in *bridge.JSetReq) (*bridge.JSetRsp, error) {
+	err := s.db(ctx).JSet(in.Key, in.Path, in.Value, in.Expire)
+	return &bridge.JSetRsp{Key:in.Key, Path:in.Path}, err
+}
+
+func (s *server) JGet(ctx context.Context, in *bridge.JGetReq) (*bridge.JGetRsp, error) {
+	v, err := s.db(ctx).JGet(in.Key, in.Path)
+	return &bridge.JGetRsp{Key:in.Key, Value:v}, err
+}
+
+func (s *server) JDelPath(ctx context.Context, in *bridge.JDelPathReq) (*bridge.JDelPathRsp, error) {
+	n, err := s.db(ctx).JDelPath(in.Key, in.Path)
+	return &bridge.JDelPathRsp{Key:in.Key, Count:int64(n)}, err
+}
+
+func (s *server) JArrAppend(ctx context.Context, in *bridge.JArrAppendReq) (*bridge.JArrAppendRsp, error) {
+	n, err := s.db(ctx).JArrAppend(in.Key, in.Path, in.Value)
+	return &bridge.JArrAppendRsp{Key:in.Key, Len:int64(n)}, err
+}
+
+func (s *server) JNumIncrBy(ctx context.Context, in *bridge.JNumIncrByReq) (*bridge.JNumIncrByRsp, error) {
+	v, err := s.db(ctx).JNumIncrBy(in.Key, in.Path, in.By)
+	return &bridge.JNumIncrByRsp{Key:in.Key, Value:v}, err
+}
+
+func (s *server) JDel(ctx context.Context, in *bridge.JDelReq) (*bridge.JDelRsp, error) {
+	err := s.db(ctx).JDel(in.Key)
+	return &bridge.JDelRsp{Key:in.Key}, err
+}
+
+func (s *server) JWatch(ctx context.Context, in *bridge.JWatchReq) (*bridge.JWatchRsp, error) {
+	s.handler.mutex.Lock()
+	cid := ctx.Value("curID")
+	proxy, ok := s.handler.proxyMap[cid.(string)]
+	if ok {
+		proxy.watchJSON[s.scopedKey(ctx, in.Key)] = in.Key
+	}
+
+	s.handler.mutex.Unlock()
+	return &bridge.JWatchRsp{Key:in.Key}, nil
+}
+
+func (s *server) JUnWatch(ctx context.Context, in *bridge.JWatchReq) (*bridge.JWatchRsp, error) {
+	s.handler.mutex.Lock()
+	cid := ctx.Value("curID")
+	proxy, ok := s.handler.proxyMap[cid.(string)]
+	if ok {
+		delete(proxy.watchJSON, s.scopedKey(ctx, in.Key))
+	}
+
+	s.handler.mutex.Unlock()
+	return &bridge.JWatchRsp{Key:in.Key}, nil
+}
+
+func (s *server) ClearJSON(ctx context.Context, in *bridge.ClearReq) (*bridge.ClearRsp, error) {
+	s.db(ctx).ClearJSON()
+	return &bridge.ClearRsp{}, nil
+}
+
+/*
+bloom filter
+*/
+func (s *server) BFReserve(ctx context.Context, in *bridge.BFReserveReq) (*bridge.BFReserveRsp, error) {
+	err := s.db(ctx).BFReserve(in.Key, in.ErrorRate, in.Capacity, in.Expire)
+	return &bridge.BFReserveRsp{Key:in.Key}, err
+}
+
+func (s *server) BFAdd(ctx context.Context, in *bridge.BFAddReq) (*bridge.BFAddRsp, error) {
+	r, err := s.db(ctx).BFAdd(in.Key, in.Item, in.Expire)
+	return &bridge.BFAddRsp{Key:in.Key, Added:r}, err
+}
+
+func (s *server) BFExists(ctx context.Context, in *bridge.BFExistsReq) (*bridge.BFExistsRsp, error) {
+	r, err := s.db(ctx).BFExists(in.Key, in.Item)
+	return &bridge.BFExistsRsp{Key:in.Key, Exists:r}, err
+}
+
+func (s *server) BFInfo(ctx context.Context, in *bridge.BFInfoReq) (*bridge.BFInfoRsp, error) {
+	r, err := s.db(ctx).BFInfo(in.Key)
+	return &bridge.BFInfoRsp{Key:in.Key, Capacity:r.Capacity, Size:int64(r.Size), Filters:int32(r.Filters),
+		Items:r.Items, ErrorRate:r.ErrorRate, Expansion:int32(r.Expansion)}, err
+}
+
+func (s *server) BFDel(ctx context.Context, in *bridge.BFDelReq) (*bridge.BFDelRsp, error) {
+	err := s.db(ctx).BFDel(in.Key)
+	return &bridge.BFDelRsp{Key:in.Key}, err
+}
+
+func (s *server) ClearBloom(ctx context.Context, in *bridge.ClearReq) (*bridge.ClearRsp, error) {
+	s.db(ctx).ClearBloom()
+	return &bridge.ClearRsp{}, nil
+}
+
+/*
+cuckoo filter
+*/
+func (s *server) CFReserve(ctx context.Context, in *bridge.CFReserveReq) (*bridge.CFReserveRsp, error) {
+	err := s.db(ctx).CFReserve(in.Key, in.Capacity, in.Expire)
+	return &bridge.CFReserveRsp{Key:in.Key}, err
+}
+
+func (s *server) CFAdd(ctx context.Context, in *bridge.CFAddReq) (*bridge.CFAddRsp, error) {
+	r, err := s.db(ctx).CFAdd(in.Key, in.Item, in.Nx, in.Expire)
+	return &bridge.CFAddRsp{Key:in.Key, Added:r}, err
+}
+
+func (s *server) CFExists(ctx context.Context, in *bridge.CFExistsReq) (*bridge.CFExistsRsp, error) {
+	r, err := s.db(ctx).CFExists(in.Key, in.Item)
+	return &bridge.CFExistsRsp{Key:in.Key, Exists:r}, err
+}
+
+func (s *server) CFDelMember(ctx context.Context, in *bridge.CFDelMemberReq) (*bridge.CFDelMemberRsp, error) {
+	r, err := s.db(ctx).CFDelMember(in.Key, in.Item)
+	return &bridge.CFDelMemberRsp{Key:in.Key, Deleted:r}, err
+}
+
+func (s *server) CFInfo(ctx context.Context, in *bridge.CFInfoReq) (*bridge.CFInfoRsp, error) {
+	r, err := s.db(ctx).CFInfo(in.Key)
+	return &bridge.CFInfoRsp{Key:in.Key, Capacity:r.Capacity, Size:int64(r.Size), Buckets:r.Buckets,
+		Filters:int32(r.Filters), Items:r.Items, Deleted:r.Deleted, BucketSize:int32(r.BucketSize),
+		Expansion:int32(r.Expansion), MaxIterations:int32(r.MaxIterations)}, err
+}
+
+func (s *server) CFDel(ctx context.Context, in *bridge.CFDelReq) (*bridge.CFDelRsp, error) {
+	err := s.db(ctx).CFDel(in.Key)
+	return &bridge.CFDelRsp{Key:in.Key}, err
+}
+
+func (s *server) ClearCuckoo(ctx context.Context, in *bridge.ClearReq) (*bridge.ClearRsp, error) {
+	s.db(ctx).ClearCuckoo()
+	return &bridge.ClearRsp{}, nil
+}
+
+/*
+time series
+*/
+func (s *server) TSCreate(ctx context.Context, in *bridge.TSCreateReq) (*bridge.TSCreateRsp, error) {
+	err := s.db(ctx).TSCreate(in.Key, in.Retention, in.Expire)
+	return &bridge.TSCreateRsp{Key:in.Key}, err
+}
+
+func (s *server) TSAdd(ctx context.Context, in *bridge.TSAddReq) (*bridge.TSAddRsp, error) {
+	samples := make([]kv.TSSample, len(in.Samples))
+	for i, sample := range in.Samples {
+		samples[i] = kv.TSSample{Time: sample.Timestamp, Value: sample.Value}
+	}
+	r, err := s.db(ctx).TSAdd(in.Key, samples, in.Expire)
+	return &bridge.TSAddRsp{Key:in.Key, Timestamps:r}, err
+}
+
+func (s *server) TSRange(ctx context.Context, in *bridge.TSRangeReq) (*bridge.TSRangeRsp, error) {
+	r, err := s.db(ctx).TSRange(in.Key, in.From, in.To, in.Aggregation, in.Bucket, int(in.Count))
+	samples := make([]*bridge.TSSample, len(r))
+	for i, sample := range r {
+		samples[i] = &bridge.TSSample{Timestamp: sample.Time, Value: sample.Value}
+	}
+	return &bridge.TSRangeRsp{Key:in.Key, Samples:samples}, err
+}
+
+func (s *server) TSGet(ctx context.Context, in *bridge.TSGetReq) (*bridge.TSGetRsp, error) {
+	r, err := s.db(ctx).TSGet(in.Key)
+	return &bridge.TSGetRsp{Key:in.Key, Sample:&bridge.TSSample{Timestamp: r.Time, Value: r.Value}}, err
+}
+
+func (s *server) TSDelRange(ctx context.Context, in *bridge.TSDelRangeReq) (*bridge.TSDelRangeRsp, error) {
+	r, err := s.db(ctx).TSDelRange(in.Key, in.From, in.To)
+	return &bridge.TSDelRangeRsp{Key:in.Key, Count:int32(r)}, err
+}
+
+func (s *server) TSCreateRule(ctx context.Context, in *bridge.TSRuleReq) (*bridge.TSRuleRsp, error) {
+	err := s.db(ctx).TSCreateRule(in.SourceKey, in.DestKey, in.Aggregation, in.Bucket)
+	return &bridge.TSRuleRsp{SourceKey:in.SourceKey, DestKey:in.DestKey}, err
+}
+
+func (s *server) TSDeleteRule(ctx context.Context, in *bridge.TSRuleReq) (*bridge.TSRuleRsp, error) {
+	err := s.db(ctx).TSDeleteRule(in.SourceKey, in.DestKey)
+	return &bridge.TSRuleRsp{SourceKey:in.SourceKey, DestKey:in.DestKey}, err
+}
+
+func (s *server) TSInfo(ctx context.Context, in *bridge.TSInfoReq) (*bridge.TSInfoRsp, error) {
+	r, err := s.db(ctx).TSInfo(in.Key)
+	rules := make([]*bridge.TSRule, len(r.Rules))
+	for i, rule := range r.Rules {
+		rules[i] = &bridge.TSRule{DestKey:rule.DestKey, Aggregation:rule.Aggregation, Bucket:rule.Bucket}
+	}
+	return &bridge.TSInfoRsp{Key:in.Key, TotalSamples:int64(r.TotalSamples), FirstTimestamp:r.FirstTimestamp,
+		LastTimestamp:r.LastTimestamp, Retention:r.Retention, SourceKey:r.SourceKey, Rules:rules}, err
+}
+
+func (s *server) TSDel(ctx context.Context, in *bridge.TSDelReq) (*bridge.TSDelRsp, error) {
+	err := s.db(ctx).TSDel(in.Key)
+	return &bridge.TSDelRsp{Key:in.Key}, err
+}
+
+func (s *server) ClearTS(ctx context.Context, in *bridge.ClearReq) (*bridge.ClearRsp, error) {
+	s.db(ctx).ClearTS()
+	return &bridge.ClearRsp{}, nil
+}
+
+/*
+scan
+*/
+func (s *server) Scan(ctx context.Context, in *bridge.ScanReq) (*bridge.ScanRsp, error) {
+	r, cursor, err := s.db(ctx).Scan(in.Cursor, in.Match, int(in.Count), in.Type)
+	keys := make([]*bridge.ScanKey, len(r))
+	for i, k := range r {
+		keys[i] = &bridge.ScanKey{Key:k.Key, Type:k.Type}
+	}
+	return &bridge.ScanRsp{Cursor:cursor, Keys:keys}, err
+}
+
+func (s *server) HScan(ctx context.Context, in *bridge.HScanReq) (*bridge.HScanRsp, error) {
+	r, cursor, err := s.db(ctx).HScan(in.HmKey, in.Cursor, in.Match, int(in.Count))
+	return &bridge.HScanRsp{HmKey:in.HmKey, Cursor:cursor, Fields:r}, err
+}
+
+func (s *server) SScan(ctx context.Context, in *bridge.SScanReq) (*bridge.SScanRsp, error) {
+	r, cursor, err := s.db(ctx).SScan(in.Key, in.Cursor, in.Match, int(in.Count))
+	return &bridge.SScanRsp{Key:in.Key, Cursor:cursor, Members:r}, err
+}
+
+/*
+ttl
+*/
+func (s *server) TTL(ctx context.Context, in *bridge.TTLReq) (*bridge.TTLRsp, error) {
+	r, err := s.db(ctx).TTL(in.Key, in.Type)
+	return &bridge.TTLRsp{Key:in.Key, Ttl:r}, err
+}
+
+func (s *server) PTTL(ctx context.Context, in *bridge.TTLReq) (*bridge.TTLRsp, error) {
+	r, err := s.db(ctx).PTTL(in.Key, in.Type)
+	return &bridge.TTLRsp{Key:in.Key, Ttl:r}, err
+}
+
+func (s *server) Expire(ctx context.Context, in *bridge.ExpireReq) (*bridge.ExpireRsp, error) {
+	r, err := s.db(ctx).Expire(in.Key, in.Type, in.Seconds)
+	return &bridge.ExpireRsp{Key:in.Key, Ok:r}, err
+}
+
+func (s *server) ExpireAt(ctx context.Context, in *bridge.ExpireAtReq) (*bridge.ExpireRsp, error) {
+	r, err := s.db(ctx).ExpireAt(in.Key, in.Type, in.Timestamp)
+	return &bridge.ExpireRsp{Key:in.Key, Ok:r}, err
+}
+
+func (s *server) PExpire(ctx context.Context, in *bridge.PExpireReq) (*bridge.ExpireRsp, error) {
+	r, err := s.db(ctx).PExpire(in.Key, in.Type, in.Milliseconds)
+	return &bridge.ExpireRsp{Key:in.Key, Ok:r}, err
+}
+
+func (s *server) PExpireAt(ctx context.Context, in *bridge.PExpireAtReq) (*bridge.ExpireRsp, error) {
+	r, err := s.db(ctx).PExpireAt(in.Key, in.Type, in.Timestamp)
+	return &bridge.ExpireRsp{Key:in.Key, Ok:r}, err
+}
+
+func (s *server) Persist(ctx context.Context, in *bridge.PersistReq) (*bridge.ExpireRsp, error) {
+	r, err := s.db(ctx).Persist(in.Key, in.Type)
+	return &bridge.ExpireRsp{Key:in.Key, Ok:r}, err
+}
+
+func (s *server) Type(ctx context.Context, in *bridge.TypeReq) (*bridge.TypeRsp, error) {
+	t, err := s.db(ctx).Type(in.Key)
+	return &bridge.TypeRsp{Key:in.Key, Type:t}, err
+}
+
+func (s *server) Exists(ctx context.Context, in *bridge.ExistsReq) (*bridge.ExistsRsp, error) {
+	n := s.db(ctx).Exists(in.Keys)
+	return &bridge.ExistsRsp{Count:int32(n)}, nil
+}
+
+func (s *server) DelKeys(ctx context.Context, in *bridge.DelKeysReq) (*bridge.DelKeysRsp, error) {
+	n := s.db(ctx).DelKeys(in.Keys)
+	return &bridge.DelKeysRsp{Count:int32(n)}, nil
+}
+
+func (s *server) Rename(ctx context.Context, in *bridge.RenameReq) (*bridge.RenameRsp, error) {
+	err := s.db(ctx).Rename(in.Key, in.NewKey)
+	return &bridge.RenameRsp{Key:in.Key, NewKey:in.NewKey}, err
+}
+
+func (s *server) Copy(ctx context.Context, in *bridge.CopyReq) (*bridge.CopyRsp, error) {
+	c := s.db(ctx)
+	dest := c
+	var err error
+	if in.Db != "" {
+		dest, err = s.dbs.Get(in.Db)
+	}
+	ok := false
+	if err == nil {
+		ok, err = c.CopyTo(dest, in.Key, in.NewKey, in.Replace)
+	}
+	return &bridge.CopyRsp{Key:in.Key, NewKey:in.NewKey, Ok:ok}, err
+}
+
+func (s *server) Move(ctx context.Context, in *bridge.MoveReq) (*bridge.MoveRsp, error) {
+	ok := false
+	dest, err := s.dbs.Get(in.Db)
+	if err == nil {
+		ok, err = s.db(ctx).MoveTo(dest, in.Key)
+	}
+	return &bridge.MoveRsp{Key:in.Key, Db:in.Db, Ok:ok}, err
+}
+
+func (s *server) KeyInfo(ctx context.Context, in *bridge.KeyInfoReq) (*bridge.KeyInfoRsp, error) {
+	info, err := s.db(ctx).KeyInfo(in.Key, in.Type)
+	if err != nil {
+		return &bridge.KeyInfoRsp{Key:in.Key}, err
+	}
+	return &bridge.KeyInfoRsp{Key:info.Key, Type:info.Type, Encoding:info.Encoding, Size:int64(info.Size),
+		Created:info.Created, Modified:info.Modified, Accessed:info.Accessed, Idle:info.Idle,
+		Hits:info.Hits, Freq:int64(info.Freq), Ttl:info.TTL}, nil
+}
+
+func (s *server) KeyStats(ctx context.Context, in *bridge.KeyStatsReq) (*bridge.KeyStatsRsp, error) {
+	stats := s.db(ctx).KeyStats()
+	r := make([]*bridge.TypeStats, len(stats))
+	for i, t := range stats {
+		r[i] = &bridge.TypeStats{Type:t.Type, Keys:int64(t.Keys), Size:int64(t.Size), MaxSize:int64(t.MaxSize),
+			Volatile:int64(t.Volatile), Hits:t.Hits, Evictions:t.Evictions, AvgIdle:t.AvgIdle, MaxIdle:t.MaxIdle,
+			Policy:t.Policy, Misses:t.Misses, HitRatio:t.HitRatio, Rejected:t.Rejected}
+	}
+	return &bridge.KeyStatsRsp{Stats:r}, nil
+}
+
+func (s *server) MemoryUsage(ctx context.Context, in *bridge.MemoryUsageReq) (*bridge.MemoryUsageRsp, error) {
+	n, err := s.db(ctx).MemoryUsage(in.Key, in.Type)
+	return &bridge.MemoryUsageRsp{Key:in.Key, Bytes:int64(n)}, err
+}
+
+func (s *server) MemoryStats(ctx context.Context, in *bridge.MemoryStatsReq) (*bridge.MemoryStatsRsp, error) {
+	m := cache.MemoryStats(in.Gc)
+	return &bridge.MemoryStatsRsp{Accounted:m.Accounted, Keys:int64(m.Keys), MaxMemory:m.MaxMemory, Policy:m.Policy,
+		HeapAlloc:m.HeapAlloc, HeapInuse:m.HeapInuse, HeapSys:m.HeapSys, HeapObjects:m.HeapObjects,
+		Sys:m.Sys, NumGC:m.NumGC, HeapRatio:m.HeapRatio}, nil
+}
+
+func (s *server) TxWatch(ctx context.Context, in *bridge.TxWatchReq) (*bridge.TxWatchRsp, error) {
+	return &bridge.TxWatchRsp{Revisions:s.db(ctx).Revisions(in.Keys)}, nil
+}
+
+func (s *server) Exec(ctx context.Context, in *bridge.ExecReq) (*bridge.ExecRsp, error) {
+	cmds := make([]kv.TxCommand, len(in.Commands))
+	for i, c := range in.Commands {
+		cmds[i] = kv.TxCommand{Cmd:c.Cmd, Key:c.Key, Args:c.Args, Expire:c.Expire}
+	}
+
+	results, err := s.db(ctx).Exec(cmds, in.Watch)
+	if err != nil {
+		return &bridge.ExecRsp{}, err
+	}
+	r := make([]*bridge.TxResult, len(results))
+	for i, t := range results {
+		r[i] = &bridge.TxResult{Value:t.Value, Values:t.Values, Fields:t.Fields}
+	}
+	return &bridge.ExecRsp{Results:r}, nil
+}
+
+func (s *server) MGet(ctx context.Context, in *bridge.MGetReq) (*bridge.MGetRsp, error) {
+	values, found := s.db(ctx).MGet(in.Keys)
+	return &bridge.MGetRsp{Values:values, Found:found}, nil
+}
+
+func (s *server) MSet(ctx context.Context, in *bridge.MSetReq) (*bridge.MSetRsp, error) {
+	err := s.db(ctx).MSet(in.Keys, in.Values, expiration(in.Expire, in.Expiration))
+	return &bridge.MSetRsp{}, err
+}
+
+func (s *server) Batch(ctx context.Context, in *bridge.BatchReq) (*bridge.BatchRsp, error) {
+	cmds := make([]kv.TxCommand, len(in.Commands))
+	for i, c := range in.Commands {
+		cmds[i] = kv.TxCommand{Cmd:c.Cmd, Key:c.Key, Args:c.Args, Expire:c.Expire}
+	}
+
+	results, err := s.db(ctx).Batch(cmds)
+	if err != nil {
+		return &bridge.BatchRsp{}, status.Error(codes.InvalidArgument, err.Error())
+	}
+	r := make([]*bridge.BatchResult, len(results))
+	for i, t := range results {
+		r[i] = &bridge.BatchResult{Value:t.Value, Values:t.Values, Fields:t.Fields, Error:t.Err}
+	}
+	return &bridge.BatchRsp{Results:r}, nil
+}
+
+/*
+请求中设置了 expiration 时使用它，否则 expire 为过期的秒数
+*/
+func expiration(expire int64, e *bridge.Expiration) kv.Expiration {
+	if e == nil {
+		return kv.ExpireSeconds(expire)
+	}
+	return kv.Expiration{Mode: kv.ExpireMode(e.Mode), Value: e.Value}
+}
+
+/*
+和 expiration 相同，但是 expire 为0时保留key原有的过期时间
+*/
+func keepExpiration(expire int64, e *bridge.Expiration) kv.Expiration {
+	if e == nil {
+		return kv.KeepOrExpireSeconds(expire)
+	}
+	return expiration(expire, e)
+}
+
+func NewRpcServer(dbs *cache.Databases)  {
 	listen, err := net.Listen("tcp", cache.Conf.RpcHost)
 	if err != nil {
 		fmt.Println(err.Error())
@@ -498,11 +1231,39 @@ func NewRpcServer(c *cache.Cache)  {
 	}
 
 	handler := &rpcHandler{proxyMap: make(map[string]*rpcProxy), curID:0}
-	ser := server{cache: c, handler: handler}
-	c.SetOnOP(handler.onOP)
-	s := grpc.NewServer(grpc.StatsHandler(handler))
+	ser := server{dbs: dbs, handler: handler}
+	dbs.SetOnOP(handler.onOP)
+	s := grpc.NewServer(grpc.StatsHandler(handler), grpc.UnaryInterceptor(ser.interceptor))
 	bridge.RegisterRpcBridgeServer(s, &ser)
 	s.Serve(listen)
 
 }
 
+
+/*
+选择请求所在的数据库放入ctx，把cache中有类型的错误转换成对应的grpc错误码，客户端可以据此区分错误
+*/
+func (s *server) interceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	c, err := s.selectDB(ctx)
+	if err != nil && cache.IsDBNotFound(err) {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	rsp, err := handler(context.WithValue(ctx, dbKey{}, c), req)
+	if err != nil && cache.IsWrongType(err) {
+		return rsp, status.Error(codes.FailedPrecondition, err.Error())
+	}
+	if err != nil && cache.IsDBNotFound(err) {
+		return rsp, status.Error(codes.NotFound, err.Error())
+	}
+	if err != nil && cache.IsOOM(err) {
+		return rsp, status.Error(codes.ResourceExhausted, err.Error())
+	}
+	if err != nil && (cache.IsWatchError(err) || cache.IsVersionError(err)) {
+		return rsp, status.Error(codes.Aborted, err.Error())
+	}
+	return rsp, err
+}
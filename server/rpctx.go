@@ -0,0 +1,180 @@
+package server
+
+import (
+	"errors"
+	"github.com/llr104/lightkv/cache"
+	"github.com/llr104/lightkv/cache/kv"
+	bridge "github.com/llr104/lightkv/pb"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"log"
+	"strconv"
+	"strings"
+)
+
+/*
+客户端的事务，命令先保存在本地，Exec 时一起发送到服务端原子地执行
+watch的key在 Exec 之前被修改过时事务不执行，Exec 返回的错误 IsWatchError 为true，可以重新读取后再试
+*/
+type rpcTx struct {
+	c        *rpcClient
+	watch    map[string]uint64
+	commands []*bridge.TxCommand
+	err      error
+}
+
+/*
+开始一个事务，同时watch keys
+*/
+func (s *rpcClient) Multi(keys ...string) (*rpcTx, error){
+	tx := &rpcTx{c: s, watch: make(map[string]uint64)}
+	if len(keys) > 0 {
+		if err := tx.Watch(keys...); err != nil {
+			return nil, err
+		}
+	}
+	return tx, nil
+}
+
+/*
+记录keys当前的修改版本，应该在读取这些key之前调用
+*/
+func (s *rpcTx) Watch(keys ...string) error{
+	rsp, err := s.c.c.TxWatch(context.Background(), &bridge.TxWatchReq{Keys:keys})
+	if err != nil{
+		log.Printf("TxWatch error: %s\n", err.Error())
+		return err
+	}
+	for k, v := range rsp.Revisions {
+		s.watch[k] = v
+	}
+	return nil
+}
+
+func (s *rpcTx) add(cmd string, key string, expire int64, args ...string) *rpcTx{
+	s.commands = append(s.commands, &bridge.TxCommand{Cmd:cmd, Key:key, Args:args, Expire:expire})
+	return s
+}
+
+func (s *rpcTx) Put(key string, value string, expire int64) *rpcTx{
+	return s.add(cache.TxPut, key, expire, value)
+}
+
+func (s *rpcTx) Get(key string) *rpcTx{
+	return s.add(cache.TxGet, key, 0)
+}
+
+func (s *rpcTx) IncrBy(key string, delta int64) *rpcTx{
+	return s.add(cache.TxIncrBy, key, 0, strconv.FormatInt(delta, 10))
+}
+
+/*
+删除string、map、list、set中的key
+*/
+func (s *rpcTx) Del(key string) *rpcTx{
+	return s.add(cache.TxDel, key, 0)
+}
+
+func (s *rpcTx) HMPut(hmKey string, key []string, val []string, expire int64) *rpcTx{
+	if len(key) != len(val) {
+		s.err = errors.New("map keys len not equal fields len")
+		return s
+	}
+	args := make([]string, 0, len(key)*2)
+	for i := range key {
+		args = append(args, key[i], val[i])
+	}
+	return s.add(cache.TxHMPut, hmKey, expire, args...)
+}
+
+func (s *rpcTx) HMGet(hmKey string) *rpcTx{
+	return s.add(cache.TxHMGet, hmKey, 0)
+}
+
+func (s *rpcTx) HMGetMember(hmKey string, key string) *rpcTx{
+	return s.add(cache.TxHMGetMember, hmKey, 0, key)
+}
+
+func (s *rpcTx) HMDelMember(hmKey string, key string) *rpcTx{
+	return s.add(cache.TxHMDelMember, hmKey, 0, key)
+}
+
+func (s *rpcTx) HMDel(hmKey string) *rpcTx{
+	return s.add(cache.TxHMDel, hmKey, 0)
+}
+
+func (s *rpcTx) LPut(key string, value []string, expire int64) *rpcTx{
+	return s.add(cache.TxLPut, key, expire, value...)
+}
+
+func (s *rpcTx) LGet(key string) *rpcTx{
+	return s.add(cache.TxLGet, key, 0)
+}
+
+func (s *rpcTx) LGetRange(key string, beg int32, end int32) *rpcTx{
+	return s.add(cache.TxLGetRange, key, 0, strconv.Itoa(int(beg)), strconv.Itoa(int(end)))
+}
+
+func (s *rpcTx) LDelRange(key string, beg int32, end int32) *rpcTx{
+	return s.add(cache.TxLDelRange, key, 0, strconv.Itoa(int(beg)), strconv.Itoa(int(end)))
+}
+
+func (s *rpcTx) LDel(key string) *rpcTx{
+	return s.add(cache.TxLDel, key, 0)
+}
+
+func (s *rpcTx) SPut(key string, value []string, expire int64) *rpcTx{
+	return s.add(cache.TxSPut, key, expire, value...)
+}
+
+func (s *rpcTx) SGet(key string) *rpcTx{
+	return s.add(cache.TxSGet, key, 0)
+}
+
+func (s *rpcTx) SDelMember(key string, value string) *rpcTx{
+	return s.add(cache.TxSDelMember, key, 0, value)
+}
+
+func (s *rpcTx) SDel(key string) *rpcTx{
+	return s.add(cache.TxSDel, key, 0)
+}
+
+/*
+执行事务，返回每条命令的结果，任意一条命令失败时服务端回滚所有修改
+*/
+func (s *rpcTx) Exec() ([]kv.TxResult, error){
+	if s.err != nil {
+		return nil, s.err
+	}
+
+	rsp, err := s.c.c.Exec(context.Background(), &bridge.ExecReq{Commands:s.commands, Watch:s.watch})
+	if err != nil{
+		log.Printf("Exec error: %s\n", err.Error())
+		return nil, err
+	}
+	r := make([]kv.TxResult, len(rsp.Results))
+	for i, t := range rsp.Results {
+		r[i] = kv.TxResult{Value:t.Value, Values:t.Values, Fields:t.Fields}
+	}
+	return r, nil
+}
+
+/*
+丢弃保存的命令和watch的key
+*/
+func (s *rpcTx) Discard() {
+	s.commands = nil
+	s.watch = make(map[string]uint64)
+	s.err = nil
+}
+
+/*
+watch的key被修改，事务没有执行时返回true
+*/
+func IsWatchError(err error) bool{
+	if st, ok := status.FromError(err); ok && st.Code() == codes.Aborted {
+		return strings.HasPrefix(st.Message(), "EXECABORT")
+	}
+	return cache.IsWatchError(err)
+}
@@ -0,0 +1,178 @@
+package server
+
+import (
+	"github.com/llr104/lightkv/cache"
+	"github.com/llr104/lightkv/cache/kv"
+	bridge "github.com/llr104/lightkv/pb"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"log"
+	"strings"
+)
+
+/*
+读取值和值的修改版本，版本可以作为 CAS 写入的前提条件
+*/
+func (s *rpcClient) GetVersion(key string) (string, uint64, error){
+	rsp, err := s.c.Get(context.Background(), &bridge.GetReq{Key: key})
+	if err != nil{
+		log.Printf("GetVersion error: %s\n", err.Error())
+		return "", 0, err
+	}
+	return rsp.Value, rsp.Version, nil
+}
+
+/*
+key当前的修改版本等于 version 时才写入，返回写入后的版本，version 为0表示key必须不存在
+版本不一致时返回的错误 IsVersionError 为true
+*/
+func (s *rpcClient) PutCAS(key string, value string, e kv.Expiration, version uint64) (uint64, error){
+	rsp, err := s.c.Put(context.Background(), &bridge.PutReq{Key:key, Value:value,
+		Expiration:toExpiration(e), Precondition:precondition(version)})
+	if err != nil{
+		log.Printf("PutCAS error: %s\n", err.Error())
+		return 0, err
+	}
+	return rsp.Version, nil
+}
+
+func (s *rpcClient) DelCAS(key string, version uint64) error{
+	_, err := s.c.Del(context.Background(), &bridge.DelReq{Key:key, Precondition:precondition(version)})
+	if err != nil{
+		log.Printf("DelCAS error: %s\n", err.Error())
+	}
+	return err
+}
+
+/*
+map
+*/
+func (s *rpcClient) HMGetVersion(hmKey string) (map[string]string, uint64, error){
+	rsp, err := s.c.HMGet(context.Background(), &bridge.HMGetReq{HmKey:hmKey})
+	if err != nil{
+		log.Printf("HMGetVersion error: %s\n", err.Error())
+		return map[string]string{}, 0, err
+	}
+	return rsp.GetFields(), rsp.Version, nil
+}
+
+func (s *rpcClient) HMPutCAS(hmKey string, key []string, val []string, e kv.Expiration, version uint64) (uint64, error){
+	rsp, err := s.c.HMPut(context.Background(), &bridge.HMPutReq{HmKey:hmKey, Key:key, Value:val,
+		Expiration:toExpiration(e), Precondition:precondition(version)})
+	if err != nil{
+		log.Printf("HMPutCAS error: %s\n", err.Error())
+		return 0, err
+	}
+	return rsp.Version, nil
+}
+
+func (s *rpcClient) HMDelMemberCAS(hmKey string, key string, version uint64) (uint64, error){
+	rsp, err := s.c.HMDelMember(context.Background(), &bridge.HMDelMemberReq{HmKey:hmKey, Key:key, Precondition:precondition(version)})
+	if err != nil{
+		log.Printf("HMDelMemberCAS error: %s\n", err.Error())
+		return 0, err
+	}
+	return rsp.Version, nil
+}
+
+func (s *rpcClient) HMDelCAS(hmKey string, version uint64) error{
+	_, err := s.c.HMDel(context.Background(), &bridge.HMDelReq{HmKey:hmKey, Precondition:precondition(version)})
+	if err != nil{
+		log.Printf("HMDelCAS error: %s\n", err.Error())
+	}
+	return err
+}
+
+/*
+list
+*/
+func (s *rpcClient) LGetVersion(key string) ([]string, uint64, error){
+	rsp, err := s.c.LGet(context.Background(), &bridge.LGetReq{Key:key})
+	if err != nil{
+		log.Printf("LGetVersion error: %s\n", err.Error())
+		return []string{}, 0, err
+	}
+	return rsp.Value, rsp.Version, nil
+}
+
+func (s *rpcClient) LPutCAS(key string, value []string, e kv.Expiration, version uint64) (uint64, error){
+	rsp, err := s.c.LPut(context.Background(), &bridge.LPutReq{Key:key, Value:value,
+		Expiration:toExpiration(e), Precondition:precondition(version)})
+	if err != nil{
+		log.Printf("LPutCAS error: %s\n", err.Error())
+		return 0, err
+	}
+	return rsp.Version, nil
+}
+
+func (s *rpcClient) LDelRangeCAS(key string, begIndex int32, endIndex int32, version uint64) (uint64, error){
+	rsp, err := s.c.LDelRange(context.Background(), &bridge.LDelRangeReq{Key:key, BegIndex:begIndex, EndIndex:endIndex,
+		Precondition:precondition(version)})
+	if err != nil{
+		log.Printf("LDelRangeCAS error: %s\n", err.Error())
+		return 0, err
+	}
+	return rsp.Version, nil
+}
+
+func (s *rpcClient) LDelCAS(key string, version uint64) error{
+	_, err := s.c.LDel(context.Background(), &bridge.LDelReq{Key:key, Precondition:precondition(version)})
+	if err != nil{
+		log.Printf("LDelCAS error: %s\n", err.Error())
+	}
+	return err
+}
+
+/*
+set
+*/
+func (s *rpcClient) SGetVersion(key string) ([]string, uint64, error){
+	rsp, err := s.c.SGet(context.Background(), &bridge.SGetReq{Key:key})
+	if err != nil{
+		log.Printf("SGetVersion error: %s\n", err.Error())
+		return []string{}, 0, err
+	}
+	return rsp.Value, rsp.Version, nil
+}
+
+func (s *rpcClient) SPutCAS(key string, value []string, e kv.Expiration, version uint64) (uint64, error){
+	rsp, err := s.c.SPut(context.Background(), &bridge.SPutReq{Key:key, Value:value,
+		Expiration:toExpiration(e), Precondition:precondition(version)})
+	if err != nil{
+		log.Printf("SPutCAS error: %s\n", err.Error())
+		return 0, err
+	}
+	return rsp.Version, nil
+}
+
+func (s *rpcClient) SDelMemberCAS(key string, value string, version uint64) (uint64, error){
+	rsp, err := s.c.SDelMember(context.Background(), &bridge.SDelMemberReq{Key:key, Value:value, Precondition:precondition(version)})
+	if err != nil{
+		log.Printf("SDelMemberCAS error: %s\n", err.Error())
+		return 0, err
+	}
+	return rsp.Version, nil
+}
+
+func (s *rpcClient) SDelCAS(key string, version uint64) error{
+	_, err := s.c.SDel(context.Background(), &bridge.SDelReq{Key:key, Precondition:precondition(version)})
+	if err != nil{
+		log.Printf("SDelCAS error: %s\n", err.Error())
+	}
+	return err
+}
+
+func precondition(version uint64) *bridge.Precondition {
+	return &bridge.Precondition{Version:version}
+}
+
+/*
+CAS 写入时key的版本和期望的不一致，没有写入时返回true，可以重新读取版本后再试
+*/
+func IsVersionError(err error) bool{
+	if st, ok := status.FromError(err); ok && st.Code() == codes.Aborted {
+		return strings.HasPrefix(st.Message(), "CONFLICT")
+	}
+	return cache.IsVersionError(err)
+}